@@ -3,8 +3,11 @@ package archive
 import (
 	"os"
 	"sort"
+	"strings"
+	"time"
 
 	"github.com/argoproj/pkg/errors"
+	argotime "github.com/argoproj/pkg/time"
 	log "github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -17,26 +20,53 @@ import (
 
 func NewListCommand() *cobra.Command {
 	var (
-		selector  string
-		output    string
-		chunkSize int64
+		selector       string
+		output         string
+		chunkSize      int64
+		prefix         string
+		namePattern    string
+		status         []string
+		since          string
+		finishedAfter  string
+		finishedBefore string
+		workflowTmpl   string
+		sortBy         string
 	)
 	var command = &cobra.Command{
 		Use: "list",
+		Example: `# List the archived workflows:
+
+  argo archive list
+
+# List the failed archived workflows of a workflow template that finished in the last week, whose name starts with "nightly-":
+
+  argo archive list --workflow-template my-wftmpl --status Failed,Error --finished-after 7d --prefix nightly-
+
+# List the archived workflows, the longest ago finished first:
+
+  argo archive list --sort-by finishedAt
+`,
 		Run: func(cmd *cobra.Command, args []string) {
 			ctx, apiClient := client.NewAPIClient()
 			serviceClient, err := apiClient.NewArchivedWorkflowServiceClient()
 			errors.CheckError(err)
 			namespace := client.Namespace()
 			listOpts := &metav1.ListOptions{
-				FieldSelector: "metadata.namespace=" + namespace,
+				FieldSelector: fieldSelector(namespace, since, finishedAfter, finishedBefore),
 				LabelSelector: selector,
 				Limit:         chunkSize,
 			}
 			var workflows wfv1.Workflows
 			for {
 				log.WithField("listOpts", listOpts).Debug()
-				resp, err := serviceClient.ListArchivedWorkflows(ctx, &workflowarchivepkg.ListArchivedWorkflowsRequest{ListOptions: listOpts})
+				resp, err := serviceClient.ListArchivedWorkflows(ctx, &workflowarchivepkg.ListArchivedWorkflowsRequest{
+					ListOptions:         listOpts,
+					NamePrefix:          prefix,
+					NamePattern:         namePattern,
+					Phases:              status,
+					WorkflowTemplateRef: workflowTmpl,
+					SortBy:              sortBy,
+				})
 				errors.CheckError(err)
 				workflows = append(workflows, resp.Items...)
 				if resp.Continue == "" {
@@ -44,7 +74,9 @@ func NewListCommand() *cobra.Command {
 				}
 				listOpts.Continue = resp.Continue
 			}
-			sort.Sort(workflows)
+			if sortBy == "" {
+				sort.Sort(workflows)
+			}
 			err = printer.PrintWorkflows(workflows, os.Stdout, printer.PrintOpts{Output: output, Namespace: true})
 			errors.CheckError(err)
 		},
@@ -52,5 +84,28 @@ func NewListCommand() *cobra.Command {
 	command.Flags().StringVarP(&output, "output", "o", "wide", "Output format. One of: json|yaml|wide")
 	command.Flags().StringVarP(&selector, "selector", "l", "", "Selector (label query) to filter on, not including uninitialized ones")
 	command.Flags().Int64VarP(&chunkSize, "chunk-size", "", 0, "Return large lists in chunks rather than all at once. Pass 0 to disable.")
+	command.Flags().StringVar(&prefix, "prefix", "", "Filter workflows by name prefix")
+	command.Flags().StringVar(&namePattern, "name-pattern", "", "Filter workflows by name glob pattern (e.g. nightly-*-build)")
+	command.Flags().StringSliceVar(&status, "status", []string{}, "Filter by status (comma separated)")
+	command.Flags().StringVar(&since, "since", "", "Show only workflows started after a relative duration (e.g. 10m, 3h, 1d)")
+	command.Flags().StringVar(&finishedAfter, "finished-after", "", "Show only workflows finished after a relative duration (e.g. 10m, 3h, 1d)")
+	command.Flags().StringVar(&finishedBefore, "finished-before", "", "Show only workflows finished before a relative duration (e.g. 10m, 3h, 1d)")
+	command.Flags().StringVar(&workflowTmpl, "workflow-template", "", "Filter workflows by the workflow template or cluster workflow template they reference")
+	command.Flags().StringVar(&sortBy, "sort-by", "", "Sort by one of startedAt, finishedAt, name, namespace or phase, prefixed by '-' for a descending sort (default -startedAt)")
 	return command
 }
+
+// fieldSelector returns the field selector for the namespace, and the start and finish times relative to now
+func fieldSelector(namespace, since, finishedAfter, finishedBefore string) string {
+	fieldSelectors := []string{"metadata.namespace=" + namespace}
+	for op, flag := range map[string]string{"spec.startedAt>": since, "spec.finishedAt>": finishedAfter, "spec.finishedAt<": finishedBefore} {
+		if flag == "" {
+			continue
+		}
+		t, err := argotime.ParseSince(flag)
+		errors.CheckError(err)
+		fieldSelectors = append(fieldSelectors, op+t.Format(time.RFC3339))
+	}
+	sort.Strings(fieldSelectors)
+	return strings.Join(fieldSelectors, ",")
+}
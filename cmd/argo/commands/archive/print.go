@@ -0,0 +1,36 @@
+package archive
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+
+	"sigs.k8s.io/yaml"
+
+	wfv1 "github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1"
+)
+
+// printWorkflow prints a workflow that was created from an archived workflow
+func printWorkflow(wf *wfv1.Workflow, output string) {
+	switch output {
+	case "name":
+		fmt.Println(wf.ObjectMeta.Name)
+	case "json":
+		output, err := json.Marshal(wf)
+		if err != nil {
+			log.Fatal(err)
+		}
+		fmt.Println(string(output))
+	case "yaml":
+		output, err := yaml.Marshal(wf)
+		if err != nil {
+			log.Fatal(err)
+		}
+		fmt.Println(string(output))
+	default:
+		const fmtStr = "%-20s %v\n"
+		fmt.Printf(fmtStr, "Name:", wf.ObjectMeta.Name)
+		fmt.Printf(fmtStr, "Namespace:", wf.ObjectMeta.Namespace)
+		fmt.Printf(fmtStr, "Status:", wf.Status.Phase)
+	}
+}
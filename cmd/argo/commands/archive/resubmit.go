@@ -0,0 +1,44 @@
+package archive
+
+import (
+	"github.com/argoproj/pkg/errors"
+	"github.com/spf13/cobra"
+
+	client "github.com/argoproj/argo/v2/cmd/argo/commands/client"
+	workflowarchivepkg "github.com/argoproj/argo/v2/pkg/apiclient/workflowarchive"
+)
+
+func NewResubmitCommand() *cobra.Command {
+	var (
+		memoized bool
+		output   string
+	)
+	var command = &cobra.Command{
+		Use:   "resubmit UID...",
+		Short: "resubmit one or more archived workflows",
+		Example: `# Resubmit an archived workflow:
+
+  argo archive resubmit 7e9cc8f1-35fb-4b8c-a5d5-2fa8c5f5a7b4
+
+# Resubmit an archived workflow, re-using successful steps & outputs from the previous run:
+
+  argo archive resubmit --memoized 7e9cc8f1-35fb-4b8c-a5d5-2fa8c5f5a7b4
+`,
+		Run: func(cmd *cobra.Command, args []string) {
+			ctx, apiClient := client.NewAPIClient()
+			serviceClient, err := apiClient.NewArchivedWorkflowServiceClient()
+			errors.CheckError(err)
+			for _, uid := range args {
+				created, err := serviceClient.ResubmitArchivedWorkflow(ctx, &workflowarchivepkg.ResubmitArchivedWorkflowRequest{
+					Uid:      uid,
+					Memoized: memoized,
+				})
+				errors.CheckError(err)
+				printWorkflow(created, output)
+			}
+		},
+	}
+	command.Flags().StringVarP(&output, "output", "o", "", "Output format. One of: name|json|yaml")
+	command.Flags().BoolVar(&memoized, "memoized", false, "re-use successful steps & outputs from the previous run (experimental)")
+	return command
+}
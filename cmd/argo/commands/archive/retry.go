@@ -0,0 +1,55 @@
+package archive
+
+import (
+	"log"
+
+	"github.com/argoproj/pkg/errors"
+	"github.com/spf13/cobra"
+	"k8s.io/apimachinery/pkg/fields"
+
+	client "github.com/argoproj/argo/v2/cmd/argo/commands/client"
+	workflowarchivepkg "github.com/argoproj/argo/v2/pkg/apiclient/workflowarchive"
+)
+
+func NewRetryCommand() *cobra.Command {
+	var (
+		nodeFieldSelector string
+		restartSuccessful bool
+		output            string
+	)
+	var command = &cobra.Command{
+		Use:   "retry UID...",
+		Short: "retry one or more archived workflows",
+		Long:  "Re-create archived workflows that are no longer in the cluster, and re-run their failed steps.",
+		Example: `# Retry an archived workflow:
+
+  argo archive retry 7e9cc8f1-35fb-4b8c-a5d5-2fa8c5f5a7b4
+
+# Retry an archived workflow, also restarting the successful steps that match a selector:
+
+  argo archive retry --restart-successful --node-field-selector templateName=main 7e9cc8f1-35fb-4b8c-a5d5-2fa8c5f5a7b4
+`,
+		Run: func(cmd *cobra.Command, args []string) {
+			selector, err := fields.ParseSelector(nodeFieldSelector)
+			if err != nil {
+				log.Fatalf("Unable to parse node field selector '%s': %s", nodeFieldSelector, err)
+			}
+			ctx, apiClient := client.NewAPIClient()
+			serviceClient, err := apiClient.NewArchivedWorkflowServiceClient()
+			errors.CheckError(err)
+			for _, uid := range args {
+				wf, err := serviceClient.RetryArchivedWorkflow(ctx, &workflowarchivepkg.RetryArchivedWorkflowRequest{
+					Uid:               uid,
+					RestartSuccessful: restartSuccessful,
+					NodeFieldSelector: selector.String(),
+				})
+				errors.CheckError(err)
+				printWorkflow(wf, output)
+			}
+		},
+	}
+	command.Flags().StringVarP(&output, "output", "o", "", "Output format. One of: name|json|yaml")
+	command.Flags().BoolVar(&restartSuccessful, "restart-successful", false, "indicates to restart successful nodes matching the --node-field-selector")
+	command.Flags().StringVar(&nodeFieldSelector, "node-field-selector", "", "selector of nodes to reset, eg: --node-field-selector inputs.paramaters.myparam.value=abc")
+	return command
+}
@@ -14,5 +14,8 @@ func NewArchiveCommand() *cobra.Command {
 	command.AddCommand(NewListCommand())
 	command.AddCommand(NewGetCommand())
 	command.AddCommand(NewDeleteCommand())
+	command.AddCommand(NewResubmitCommand())
+	command.AddCommand(NewRetryCommand())
+	command.AddCommand(NewStatsCommand())
 	return command
 }
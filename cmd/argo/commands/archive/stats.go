@@ -0,0 +1,123 @@
+package archive
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	"github.com/argoproj/pkg/errors"
+	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/yaml"
+
+	"github.com/argoproj/argo/v2/cmd/argo/commands/client"
+	workflowarchivepkg "github.com/argoproj/argo/v2/pkg/apiclient/workflowarchive"
+	wfv1 "github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1"
+)
+
+func NewStatsCommand() *cobra.Command {
+	var (
+		selector       string
+		output         string
+		groupBy        string
+		bucket         string
+		since          string
+		finishedAfter  string
+		finishedBefore string
+	)
+	var command = &cobra.Command{
+		Use:   "stats",
+		Short: "print statistics of the archived workflows",
+		Example: `# Print the number of archived workflows in each phase, their success rate and their p50, p90 and p99 durations:
+
+  argo archive stats
+
+# Print the statistics of each workflow template for the last week, day by day:
+
+  argo archive stats --group-by workflowTemplate --since 7d --bucket 1d
+
+# Print the statistics of each team, where the team is a label:
+
+  argo archive stats --group-by label:team -o json
+`,
+		Run: func(cmd *cobra.Command, args []string) {
+			ctx, apiClient := client.NewAPIClient()
+			serviceClient, err := apiClient.NewArchivedWorkflowServiceClient()
+			errors.CheckError(err)
+			stats, err := serviceClient.GetArchivedWorkflowStats(ctx, &workflowarchivepkg.GetArchivedWorkflowStatsRequest{
+				ListOptions: &metav1.ListOptions{
+					FieldSelector: fieldSelector(client.Namespace(), since, finishedAfter, finishedBefore),
+					LabelSelector: selector,
+				},
+				GroupBy: groupBy,
+				Bucket:  bucket,
+			})
+			errors.CheckError(err)
+			printStats(stats, output)
+		},
+	}
+	command.Flags().StringVarP(&output, "output", "o", "wide", "Output format. One of: json|yaml|wide")
+	command.Flags().StringVarP(&selector, "selector", "l", "", "Selector (label query) to filter on, not including uninitialized ones")
+	command.Flags().StringVar(&groupBy, "group-by", "", "Group by workflowTemplate or label:<key>")
+	command.Flags().StringVar(&bucket, "bucket", "", "Split each group into buckets of this duration by start time (e.g. 1h, 1d)")
+	command.Flags().StringVar(&since, "since", "", "Only include workflows started after a relative duration (e.g. 10m, 3h, 1d)")
+	command.Flags().StringVar(&finishedAfter, "finished-after", "", "Only include workflows finished after a relative duration (e.g. 10m, 3h, 1d)")
+	command.Flags().StringVar(&finishedBefore, "finished-before", "", "Only include workflows finished before a relative duration (e.g. 10m, 3h, 1d)")
+	return command
+}
+
+func printStats(stats *workflowarchivepkg.ArchivedWorkflowStats, output string) {
+	switch output {
+	case "json":
+		output, err := json.Marshal(stats)
+		errors.CheckError(err)
+		fmt.Println(string(output))
+	case "yaml":
+		output, err := yaml.Marshal(stats)
+		errors.CheckError(err)
+		fmt.Println(string(output))
+	case "wide", "":
+		w := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', 0)
+		_, _ = fmt.Fprintln(w, "GROUP\tTOTAL\tSUCCEEDED\tFAILED\tERROR\tSUCCESS RATE\tP50\tP90\tP99")
+		for _, g := range stats.Groups {
+			_, _ = fmt.Fprintf(w, "%s\t%d\t%d\t%d\t%d\t%s\t%s\t%s\t%s\n", groupKey(g.Key), g.Total,
+				g.PhaseCounts[string(wfv1.WorkflowSucceeded)], g.PhaseCounts[string(wfv1.WorkflowFailed)], g.PhaseCounts[string(wfv1.WorkflowError)],
+				percent(g.SuccessRate), seconds(g.DurationP50), seconds(g.DurationP90), seconds(g.DurationP99))
+		}
+		_ = w.Flush()
+		var buckets bool
+		for _, g := range stats.Groups {
+			buckets = buckets || len(g.Buckets) > 0
+		}
+		if !buckets {
+			return
+		}
+		fmt.Println()
+		_, _ = fmt.Fprintln(w, "GROUP\tSTARTED\tTOTAL\tSUCCEEDED\tSUCCESS RATE")
+		for _, g := range stats.Groups {
+			for _, b := range g.Buckets {
+				_, _ = fmt.Fprintf(w, "%s\t%s\t%d\t%d\t%s\n", groupKey(g.Key), b.StartedAt.Format(time.RFC3339), b.Total, b.Succeeded, percent(b.SuccessRate))
+			}
+		}
+		_ = w.Flush()
+	default:
+		errors.CheckError(fmt.Errorf("unknown output %q", output))
+	}
+}
+
+func groupKey(key string) string {
+	if key == "" {
+		return "<none>"
+	}
+	return key
+}
+
+func percent(rate float64) string {
+	return fmt.Sprintf("%.1f%%", rate*100)
+}
+
+func seconds(s float64) string {
+	return (time.Duration(s * float64(time.Second))).Round(time.Second).String()
+}
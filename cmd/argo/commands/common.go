@@ -60,8 +60,10 @@ func initializeSession() {
 			wfv1.NodeTypeSuspend: ansiFormat("Suspend", FgCyan),
 		}
 		workflowConditionIconMap = map[wfv1.ConditionType]string{
-			wfv1.ConditionTypeMetricsError: ansiFormat("Error", FgRed),
-			wfv1.ConditionTypeSpecWarning:  ansiFormat("Warning", FgYellow),
+			wfv1.ConditionTypeMetricsError:          ansiFormat("Error", FgRed),
+			wfv1.ConditionTypeArtifactGCError:       ansiFormat("Error", FgRed),
+			wfv1.ConditionTypeSpecWarning:           ansiFormat("Warning", FgYellow),
+			wfv1.ConditionTypeResourceQuotaExceeded: ansiFormat("Warning", FgYellow),
 		}
 	} else {
 		jobStatusIconMap = map[wfv1.NodePhase]string{
@@ -76,8 +78,10 @@ func initializeSession() {
 			wfv1.NodeTypeSuspend: ansiFormat("ǁ", FgCyan),
 		}
 		workflowConditionIconMap = map[wfv1.ConditionType]string{
-			wfv1.ConditionTypeMetricsError: ansiFormat("✖", FgRed),
-			wfv1.ConditionTypeSpecWarning:  ansiFormat("⚠", FgYellow),
+			wfv1.ConditionTypeMetricsError:          ansiFormat("✖", FgRed),
+			wfv1.ConditionTypeArtifactGCError:       ansiFormat("✖", FgRed),
+			wfv1.ConditionTypeSpecWarning:           ansiFormat("⚠", FgYellow),
+			wfv1.ConditionTypeResourceQuotaExceeded: ansiFormat("⚠", FgYellow),
 		}
 	}
 }
@@ -0,0 +1,72 @@
+package event
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"text/tabwriter"
+
+	"github.com/argoproj/pkg/errors"
+	"github.com/argoproj/pkg/humanize"
+	"github.com/spf13/cobra"
+	"sigs.k8s.io/yaml"
+
+	"github.com/argoproj/argo/v2/cmd/argo/commands/client"
+	eventpkg "github.com/argoproj/argo/v2/pkg/apiclient/event"
+)
+
+func NewGetCommand() *cobra.Command {
+	var (
+		output string
+	)
+	var command = &cobra.Command{
+		Use:   "get UID",
+		Short: "display the payload of an event, and the outcome of dispatching it to each binding",
+		Run: func(cmd *cobra.Command, args []string) {
+			if len(args) != 1 {
+				cmd.HelpFunc()(cmd, args)
+				os.Exit(1)
+			}
+			ctx, apiClient := client.NewAPIClient()
+			serviceClient, err := apiClient.NewEventServiceClient()
+			errors.CheckError(err)
+			event, err := serviceClient.GetEventDelivery(ctx, &eventpkg.GetEventDeliveryRequest{Namespace: client.Namespace(), Uid: args[0]})
+			errors.CheckError(err)
+			switch output {
+			case "json":
+				output, err := json.Marshal(event)
+				errors.CheckError(err)
+				fmt.Println(string(output))
+			case "yaml":
+				output, err := yaml.Marshal(event)
+				errors.CheckError(err)
+				fmt.Println(string(output))
+			case "wide", "":
+				const fmtStr = "%-20s %v\n"
+				fmt.Printf(fmtStr, "UID:", event.Uid)
+				fmt.Printf(fmtStr, "Namespace:", event.Namespace)
+				if event.Discriminator != "" {
+					fmt.Printf(fmtStr, "Discriminator:", event.Discriminator)
+				}
+				fmt.Printf(fmtStr, "Status:", event.Status)
+				fmt.Printf(fmtStr, "Received:", humanize.Timestamp(event.ReceivedAt.Time))
+				if event.Payload != nil {
+					fmt.Printf(fmtStr, "Payload:", string(event.Payload.Value))
+				}
+				if len(event.Dispatches) > 0 {
+					fmt.Println()
+					w := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', 0)
+					_, _ = fmt.Fprint(w, "BINDING\tMATCHED\tWORKFLOW\tDISPATCHED\tERROR\n")
+					for _, d := range event.Dispatches {
+						_, _ = fmt.Fprintf(w, "%s\t%v\t%s\t%s\t%s\n", d.Binding, d.Matched, d.WorkflowName, humanize.Timestamp(d.DispatchedAt.Time), d.Error)
+					}
+					_ = w.Flush()
+				}
+			default:
+				errors.CheckError(fmt.Errorf("unknown output %q", output))
+			}
+		},
+	}
+	command.Flags().StringVarP(&output, "output", "o", "wide", "Output format. One of: json|yaml|wide")
+	return command
+}
@@ -0,0 +1,73 @@
+package event
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	"github.com/argoproj/pkg/errors"
+	"github.com/argoproj/pkg/humanize"
+	"github.com/spf13/cobra"
+	"sigs.k8s.io/yaml"
+
+	"github.com/argoproj/argo/v2/cmd/argo/commands/client"
+	eventpkg "github.com/argoproj/argo/v2/pkg/apiclient/event"
+)
+
+func NewListCommand() *cobra.Command {
+	var (
+		undelivered bool
+		limit       int64
+		output      string
+	)
+	var command = &cobra.Command{
+		Use:   "list",
+		Short: "list the received events, most recent first",
+		Example: `# List the events that failed, or have not yet been dispatched:
+
+  argo event list --undelivered
+`,
+		Run: func(cmd *cobra.Command, args []string) {
+			ctx, apiClient := client.NewAPIClient()
+			serviceClient, err := apiClient.NewEventServiceClient()
+			errors.CheckError(err)
+			list, err := serviceClient.ListEventDeliveries(ctx, &eventpkg.ListEventDeliveriesRequest{
+				Namespace:   client.Namespace(),
+				Undelivered: undelivered,
+				Limit:       limit,
+			})
+			errors.CheckError(err)
+			switch output {
+			case "json":
+				output, err := json.Marshal(list.Items)
+				errors.CheckError(err)
+				fmt.Println(string(output))
+			case "yaml":
+				output, err := yaml.Marshal(list.Items)
+				errors.CheckError(err)
+				fmt.Println(string(output))
+			case "wide", "":
+				w := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', 0)
+				_, _ = fmt.Fprint(w, "UID\tDISCRIMINATOR\tSTATUS\tAGE\tBINDINGS\tWORKFLOWS\n")
+				for _, e := range list.Items {
+					workflows := 0
+					for _, d := range e.Dispatches {
+						if d.WorkflowName != "" {
+							workflows++
+						}
+					}
+					_, _ = fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%d\t%d\n", e.Uid, e.Discriminator, e.Status, humanize.RelativeDurationShort(e.ReceivedAt.Time, time.Now()), len(e.Dispatches), workflows)
+				}
+				_ = w.Flush()
+			default:
+				errors.CheckError(fmt.Errorf("unknown output %q", output))
+			}
+		},
+	}
+	command.Flags().BoolVar(&undelivered, "undelivered", false, "Only list events that failed, or have not yet been dispatched")
+	command.Flags().Int64Var(&limit, "limit", 100, "The maximum number of events to list")
+	command.Flags().StringVarP(&output, "output", "o", "wide", "Output format. One of: json|yaml|wide")
+	return command
+}
@@ -0,0 +1,42 @@
+package event
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/argoproj/pkg/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/argoproj/argo/v2/cmd/argo/commands/client"
+	eventpkg "github.com/argoproj/argo/v2/pkg/apiclient/event"
+)
+
+func NewRetryCommand() *cobra.Command {
+	var command = &cobra.Command{
+		Use:   "retry UID...",
+		Short: "dispatch pending events, or failed events to the bindings that failed",
+		Example: `# Retry an event:
+
+  argo event retry 7a3f2c1e-0d4b-4c3a-9e5f-1b2c3d4e5f60
+
+# Retry every undelivered event:
+
+  argo event list --undelivered -o json | jq -r '.[].uid' | xargs argo event retry
+`,
+		Run: func(cmd *cobra.Command, args []string) {
+			if len(args) == 0 {
+				cmd.HelpFunc()(cmd, args)
+				os.Exit(1)
+			}
+			ctx, apiClient := client.NewAPIClient()
+			serviceClient, err := apiClient.NewEventServiceClient()
+			errors.CheckError(err)
+			for _, uid := range args {
+				_, err := serviceClient.RetryEventDelivery(ctx, &eventpkg.RetryEventDeliveryRequest{Namespace: client.Namespace(), Uid: uid})
+				errors.CheckError(err)
+				fmt.Printf("Event %s retried\n", uid)
+			}
+		},
+	}
+	return command
+}
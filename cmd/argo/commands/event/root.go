@@ -0,0 +1,19 @@
+package event
+
+import (
+	"github.com/spf13/cobra"
+)
+
+func NewEventCommand() *cobra.Command {
+	var command = &cobra.Command{
+		Use:   "event",
+		Short: "inspect and retry the delivery of events to workflow event bindings",
+		Run: func(cmd *cobra.Command, args []string) {
+			cmd.HelpFunc()(cmd, args)
+		},
+	}
+	command.AddCommand(NewListCommand())
+	command.AddCommand(NewGetCommand())
+	command.AddCommand(NewRetryCommand())
+	return command
+}
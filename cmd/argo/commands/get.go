@@ -24,7 +24,10 @@ import (
 	"github.com/argoproj/argo/v2/workflow/util"
 )
 
-const onExitSuffix = "onExit"
+const (
+	onExitSuffix = "onExit"
+	hooksInfix   = "hooks"
+)
 
 type getFlags struct {
 	output                  string
@@ -205,6 +208,18 @@ func printWorkflowHelper(wf *wfv1.Workflow, getArgs getFlags) string {
 		}
 		mainRoot.renderNodes(w, wf, 0, " ", " ", getArgs)
 
+		// Print the trees of the hooks of the workflow, which are not in any boundary
+		var hookRoots []renderNode
+		for id, root := range roots {
+			if strings.HasPrefix(wf.Status.Nodes[id].Name, wf.ObjectMeta.Name+"."+hooksInfix+".") {
+				hookRoots = insertSorted(wf, hookRoots, root)
+			}
+		}
+		for _, hookRoot := range hookRoots {
+			_, _ = fmt.Fprintf(w, "\t\t\t\t\t\n")
+			hookRoot.renderNodes(w, wf, 0, " ", " ", getArgs)
+		}
+
 		onExitID := wf.NodeID(wf.ObjectMeta.Name + "." + onExitSuffix)
 		if onExitRoot, ok := roots[onExitID]; ok {
 			_, _ = fmt.Fprintf(w, "\t\t\t\t\t\n")
@@ -271,7 +286,7 @@ func isNonBoundaryParentNode(node wfv1.NodeType) bool {
 }
 
 func isExecutionNode(node wfv1.NodeType) bool {
-	return (node == wfv1.NodeTypePod) || (node == wfv1.NodeTypeSkipped) || (node == wfv1.NodeTypeSuspend)
+	return (node == wfv1.NodeTypePod) || (node == wfv1.NodeTypeSkipped) || (node == wfv1.NodeTypeSuspend) || (node == wfv1.NodeTypeHTTP) || (node == wfv1.NodeTypeWorkflow) || (node == wfv1.NodeTypeContainer)
 }
 
 func insertSorted(wf *wfv1.Workflow, sortedArray []renderNode, item renderNode) []renderNode {
@@ -527,9 +542,9 @@ func printNode(w *tabwriter.Writer, node wfv1.NodeStatus, nodePrefix string, get
 	var args []interface{}
 	duration := humanize.RelativeDurationShort(node.StartedAt.Time, node.FinishedAt.Time)
 	if node.Type == wfv1.NodeTypePod {
-		args = []interface{}{nodePrefix, nodeName, templateName, node.ID, duration, node.Message, ""}
+		args = []interface{}{nodePrefix, nodeName, templateName, node.ID, duration, getNodeMessage(node), ""}
 	} else {
-		args = []interface{}{nodePrefix, nodeName, templateName, "", "", node.Message, ""}
+		args = []interface{}{nodePrefix, nodeName, templateName, "", "", getNodeMessage(node), ""}
 	}
 	if getArgs.output == "wide" {
 		msg := args[len(args)-2]
@@ -545,6 +560,15 @@ func printNode(w *tabwriter.Writer, node wfv1.NodeStatus, nodePrefix string, get
 	}
 }
 
+// getNodeMessage returns the message of the node, or the concrete lock the node is waiting for, because the node's
+// message is not updated when the node starts waiting for another lock
+func getNodeMessage(node wfv1.NodeStatus) string {
+	if node.SynchronizationStatus == nil || node.SynchronizationStatus.Waiting == "" || strings.Contains(node.Message, node.SynchronizationStatus.Waiting) {
+		return node.Message
+	}
+	return fmt.Sprintf("Waiting for %s lock", node.SynchronizationStatus.Waiting)
+}
+
 // renderNodes for each renderNode Type
 // boundaryNode
 func (nodeInfo *boundaryNode) renderNodes(w *tabwriter.Writer, wf *wfv1.Workflow, depth int, nodePrefix string, childPrefix string, getArgs getFlags) {
@@ -369,3 +369,12 @@ status:
    ├─ sleep(11:eleven)  sleep           many-items-z26lj-3011405271  22s`)
 	})
 }
+
+func Test_getNodeMessage(t *testing.T) {
+	node := wfv1.NodeStatus{Message: "Waiting for default/Mutex/deploy-dev lock. Lock status: 0/1"}
+	assert.Equal(t, node.Message, getNodeMessage(node))
+	node.SynchronizationStatus = &wfv1.NodeSynchronizationStatus{Waiting: "default/Mutex/deploy-dev"}
+	assert.Equal(t, node.Message, getNodeMessage(node))
+	node.SynchronizationStatus.Waiting = "default/Mutex/deploy-prod"
+	assert.Equal(t, "Waiting for default/Mutex/deploy-prod lock", getNodeMessage(node))
+}
@@ -11,6 +11,8 @@ import (
 	"github.com/argoproj/argo/v2/cmd/argo/commands/client"
 	"github.com/argoproj/argo/v2/cmd/argo/commands/clustertemplate"
 	"github.com/argoproj/argo/v2/cmd/argo/commands/cron"
+	"github.com/argoproj/argo/v2/cmd/argo/commands/event"
+	"github.com/argoproj/argo/v2/cmd/argo/commands/sync"
 	"github.com/argoproj/argo/v2/cmd/argo/commands/template"
 	cmdutil "github.com/argoproj/argo/v2/util/cmd"
 )
@@ -106,6 +108,8 @@ If your server is behind an ingress with a path (you'll be running "argo server
 	command.AddCommand(NewNodeCommand())
 	command.AddCommand(NewTerminateCommand())
 	command.AddCommand(archive.NewArchiveCommand())
+	command.AddCommand(event.NewEventCommand())
+	command.AddCommand(sync.NewSyncCommand())
 	command.AddCommand(NewVersionCommand())
 	command.AddCommand(template.NewTemplateCommand())
 	command.AddCommand(cron.NewCronWorkflowCommand())
@@ -0,0 +1,117 @@
+package sync
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/argoproj/pkg/errors"
+	"github.com/argoproj/pkg/humanize"
+	"github.com/spf13/cobra"
+	"sigs.k8s.io/yaml"
+
+	"github.com/argoproj/argo/v2/cmd/argo/commands/client"
+	syncpkg "github.com/argoproj/argo/v2/pkg/apiclient/sync"
+	"github.com/argoproj/argo/v2/workflow/sync"
+)
+
+func NewGetCommand() *cobra.Command {
+	var (
+		output string
+	)
+	var command = &cobra.Command{
+		Use:   "get LOCK",
+		Short: "display the holders of a semaphore or mutex, and the waiters in the order they will acquire it",
+		Example: `# Get a mutex:
+
+  argo sync get mutex/my-mutex
+
+# Get a config map semaphore, by the name of the config map and the key:
+
+  argo sync get configmap/my-config/my-key
+
+# Get a database semaphore:
+
+  argo sync get database/my-key
+
+# Get a lock of another namespace, by the name shown by "argo sync list":
+
+  argo sync get my-ns/Mutex/my-mutex
+`,
+		Run: func(cmd *cobra.Command, args []string) {
+			if len(args) != 1 {
+				cmd.HelpFunc()(cmd, args)
+				os.Exit(1)
+			}
+			req, err := parseLock(client.Namespace(), args[0])
+			errors.CheckError(err)
+			ctx, apiClient := client.NewAPIClient()
+			serviceClient, err := apiClient.NewSyncServiceClient()
+			errors.CheckError(err)
+			lock, err := serviceClient.GetSyncLock(ctx, req)
+			errors.CheckError(err)
+			switch output {
+			case "json":
+				output, err := json.Marshal(lock)
+				errors.CheckError(err)
+				fmt.Println(string(output))
+			case "yaml":
+				output, err := yaml.Marshal(lock)
+				errors.CheckError(err)
+				fmt.Println(string(output))
+			case "wide", "":
+				const fmtStr = "%-20s %v\n"
+				fmt.Printf(fmtStr, "Name:", lock.Name)
+				fmt.Printf(fmtStr, "Type:", lock.Type)
+				fmt.Printf(fmtStr, "Limit:", lock.Limit)
+				fmt.Printf(fmtStr, "Holders:", strings.Join(lock.Holders, ","))
+				if len(lock.Waiters) > 0 {
+					fmt.Println()
+					w := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', 0)
+					_, _ = fmt.Fprint(w, "WAITER\tPRIORITY\tCREATED\n")
+					for _, waiter := range lock.Waiters {
+						created := ""
+						if waiter.CreationTimestamp != nil {
+							created = humanize.Timestamp(waiter.CreationTimestamp.Time)
+						}
+						_, _ = fmt.Fprintf(w, "%s\t%d\t%s\n", waiter.HolderKey, waiter.Priority, created)
+					}
+					_ = w.Flush()
+				}
+			default:
+				errors.CheckError(fmt.Errorf("unknown output %q", output))
+			}
+		},
+	}
+	command.Flags().StringVarP(&output, "output", "o", "wide", "Output format. One of: json|yaml|wide")
+	return command
+}
+
+// parseLock parses "kind/name[/key]", where the kind is case insensitive, or the "namespace/Kind/name[/key]" shown by
+// "argo sync list"
+func parseLock(namespace, lock string) (*syncpkg.GetSyncLockRequest, error) {
+	parts := strings.Split(lock, "/")
+	if len(parts) > 0 && getLockKind(parts[0]) == "" {
+		namespace = parts[0]
+		parts = parts[1:]
+	}
+	if len(parts) < 2 || len(parts) > 3 || getLockKind(parts[0]) == "" {
+		return nil, fmt.Errorf("invalid lock %q, expected one of mutex/NAME, configmap/NAME/KEY or database/KEY", lock)
+	}
+	req := &syncpkg.GetSyncLockRequest{Namespace: namespace, Kind: string(getLockKind(parts[0])), Name: parts[1]}
+	if len(parts) == 3 {
+		req.Key = parts[2]
+	}
+	return req, nil
+}
+
+func getLockKind(kind string) sync.LockKind {
+	for _, k := range []sync.LockKind{sync.LockKindConfigMap, sync.LockKindDatabase, sync.LockKindMutex} {
+		if strings.EqualFold(kind, string(k)) {
+			return k
+		}
+	}
+	return ""
+}
@@ -0,0 +1,32 @@
+package sync
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	syncpkg "github.com/argoproj/argo/v2/pkg/apiclient/sync"
+)
+
+func Test_parseLock(t *testing.T) {
+	for lock, expected := range map[string]*syncpkg.GetSyncLockRequest{
+		"mutex/my-mutex":                      {Namespace: "my-ns", Kind: "Mutex", Name: "my-mutex"},
+		"configmap/my-config/my-key":          {Namespace: "my-ns", Kind: "ConfigMap", Name: "my-config", Key: "my-key"},
+		"Database/my-key":                     {Namespace: "my-ns", Kind: "Database", Name: "my-key"},
+		"other-ns/Mutex/my-mutex":             {Namespace: "other-ns", Kind: "Mutex", Name: "my-mutex"},
+		"other-ns/ConfigMap/my-config/my-key": {Namespace: "other-ns", Kind: "ConfigMap", Name: "my-config", Key: "my-key"},
+	} {
+		t.Run(lock, func(t *testing.T) {
+			req, err := parseLock("my-ns", lock)
+			if assert.NoError(t, err) {
+				assert.Equal(t, expected, req)
+			}
+		})
+	}
+	for _, lock := range []string{"my-mutex", "foo/my-mutex", "other-ns/foo/my-mutex", "mutex/a/b/c"} {
+		t.Run(lock, func(t *testing.T) {
+			_, err := parseLock("my-ns", lock)
+			assert.Error(t, err)
+		})
+	}
+}
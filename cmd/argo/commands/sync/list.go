@@ -0,0 +1,63 @@
+package sync
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"text/tabwriter"
+
+	"github.com/argoproj/pkg/errors"
+	"github.com/spf13/cobra"
+	"sigs.k8s.io/yaml"
+
+	"github.com/argoproj/argo/v2/cmd/argo/commands/client"
+	syncpkg "github.com/argoproj/argo/v2/pkg/apiclient/sync"
+)
+
+func NewListCommand() *cobra.Command {
+	var (
+		allNamespaces bool
+		output        string
+	)
+	var command = &cobra.Command{
+		Use:   "list",
+		Short: "list the semaphores and mutexes, with their holders and waiters",
+		Example: `# List the locks of every namespace:
+
+  argo sync list -A
+`,
+		Run: func(cmd *cobra.Command, args []string) {
+			ctx, apiClient := client.NewAPIClient()
+			serviceClient, err := apiClient.NewSyncServiceClient()
+			errors.CheckError(err)
+			namespace := client.Namespace()
+			if allNamespaces {
+				namespace = ""
+			}
+			list, err := serviceClient.ListSyncLocks(ctx, &syncpkg.ListSyncLocksRequest{Namespace: namespace})
+			errors.CheckError(err)
+			switch output {
+			case "json":
+				output, err := json.Marshal(list.Items)
+				errors.CheckError(err)
+				fmt.Println(string(output))
+			case "yaml":
+				output, err := yaml.Marshal(list.Items)
+				errors.CheckError(err)
+				fmt.Println(string(output))
+			case "wide", "":
+				w := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', 0)
+				_, _ = fmt.Fprint(w, "NAME\tTYPE\tLIMIT\tHOLDERS\tWAITERS\n")
+				for _, l := range list.Items {
+					_, _ = fmt.Fprintf(w, "%s\t%s\t%d\t%d\t%d\n", l.Name, l.Type, l.Limit, len(l.Holders), len(l.Waiters))
+				}
+				_ = w.Flush()
+			default:
+				errors.CheckError(fmt.Errorf("unknown output %q", output))
+			}
+		},
+	}
+	command.Flags().BoolVarP(&allNamespaces, "all-namespaces", "A", false, "Show locks from all namespaces")
+	command.Flags().StringVarP(&output, "output", "o", "wide", "Output format. One of: json|yaml|wide")
+	return command
+}
@@ -0,0 +1,18 @@
+package sync
+
+import (
+	"github.com/spf13/cobra"
+)
+
+func NewSyncCommand() *cobra.Command {
+	var command = &cobra.Command{
+		Use:   "sync",
+		Short: "inspect the semaphores and mutexes of the workflow controller",
+		Run: func(cmd *cobra.Command, args []string) {
+			cmd.HelpFunc()(cmd, args)
+		},
+	}
+	command.AddCommand(NewListCommand())
+	command.AddCommand(NewGetCommand())
+	return command
+}
@@ -0,0 +1,55 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+
+	"github.com/argoproj/argo/v2/util"
+	"github.com/argoproj/argo/v2/workflow/common"
+	"github.com/argoproj/argo/v2/workflow/executor/containerset"
+)
+
+func NewContainerSetCommand() *cobra.Command {
+	var dependencies []string
+	var command = cobra.Command{
+		Use:   "container-set NAME -- COMMAND [ARG...]",
+		Short: "run the command of a container of a container set, once its dependencies have succeeded",
+		Args:  cobra.MinimumNArgs(2),
+		Run: func(cmd *cobra.Command, args []string) {
+			ctx := context.Background()
+			exitCode, err := runContainerSetContainer(ctx, args[0], dependencies, args[1:])
+			if err != nil {
+				util.WriteTeriminateMessage(err.Error())
+				log.Fatalf("%+v", err)
+			}
+			os.Exit(exitCode)
+		},
+	}
+	command.Flags().StringSliceVar(&dependencies, "dependencies", nil, "Names of the containers that must succeed before the command is run")
+	return &command
+}
+
+func runContainerSetContainer(ctx context.Context, name string, dependencies, command []string) (int, error) {
+	failed, err := containerset.WaitDependencies(ctx, common.ExecutorVarRunArgoDir, dependencies)
+	if err != nil {
+		return 0, err
+	}
+	if failed != "" {
+		message := fmt.Sprintf("%sdependency '%s' did not succeed", common.ContainerSetOmittedMessagePrefix, failed)
+		log.Info(message)
+		util.WriteTeriminateMessage(message)
+		// exit successfully, the pod fails because of the dependency
+		return 0, containerset.WriteExitCode(common.ExecutorVarRunArgoDir, name, containerset.OmittedExitCode)
+	}
+	exitCode, err := containerset.RunCommand(ctx, command)
+	if err != nil {
+		// the dependants must not wait forever for a command that could not be started
+		_ = containerset.WriteExitCode(common.ExecutorVarRunArgoDir, name, 1)
+		return 0, err
+	}
+	return exitCode, containerset.WriteExitCode(common.ExecutorVarRunArgoDir, name, exitCode)
+}
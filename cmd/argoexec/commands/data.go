@@ -0,0 +1,34 @@
+package commands
+
+import (
+	"context"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+)
+
+func NewDataCommand() *cobra.Command {
+	var command = cobra.Command{
+		Use:   "data",
+		Short: "source and transform the data of a data template",
+		Run: func(cmd *cobra.Command, args []string) {
+			ctx := context.Background()
+			err := execData(ctx)
+			if err != nil {
+				log.Fatalf("%+v", err)
+			}
+		},
+	}
+	return &command
+}
+
+func execData(ctx context.Context) error {
+	wfExecutor := initExecutor()
+	defer wfExecutor.HandleError(ctx)
+	err := wfExecutor.Data(ctx)
+	if err != nil {
+		wfExecutor.AddError(err)
+		return err
+	}
+	return nil
+}
@@ -56,6 +56,8 @@ func NewRootCommand() *cobra.Command {
 		},
 	}
 
+	command.AddCommand(NewContainerSetCommand())
+	command.AddCommand(NewDataCommand())
 	command.AddCommand(NewInitCommand())
 	command.AddCommand(NewResourceCommand())
 	command.AddCommand(NewWaitCommand())
@@ -45,6 +45,7 @@ func NewRootCommand() *cobra.Command {
 		workflowTTLWorkers       int    // --workflow-ttl-workers
 		podWorkers               int    // --pod-workers
 		podCleanupWorkers        int    // --pod-cleanup-workers
+		artifactGCWorkers        int    // --artifact-gc-workers
 		burst                    int
 		qps                      float32
 		namespaced               bool   // --namespaced
@@ -92,7 +93,7 @@ func NewRootCommand() *cobra.Command {
 			wfController, err := controller.NewWorkflowController(ctx, config, kubeclientset, wfclientset, namespace, managedNamespace, executorImage, executorImagePullPolicy, containerRuntimeExecutor, configMap)
 			errors.CheckError(err)
 
-			go wfController.Run(ctx, workflowWorkers, workflowTTLWorkers, podWorkers, podCleanupWorkers)
+			go wfController.Run(ctx, workflowWorkers, workflowTTLWorkers, podWorkers, podCleanupWorkers, artifactGCWorkers)
 
 			go func() {
 				log.Println(http.ListenAndServe("localhost:6060", nil))
@@ -115,6 +116,7 @@ func NewRootCommand() *cobra.Command {
 	command.Flags().IntVar(&workflowTTLWorkers, "workflow-ttl-workers", 4, "Number of workflow TTL workers")
 	command.Flags().IntVar(&podWorkers, "pod-workers", 32, "Number of pod workers")
 	command.Flags().IntVar(&podCleanupWorkers, "pod-cleanup-workers", 4, "Number of pod cleanup workers")
+	command.Flags().IntVar(&artifactGCWorkers, "artifact-gc-workers", 4, "Number of workflow artifact GC workers")
 	command.Flags().IntVar(&burst, "burst", 30, "Maximum burst for throttle.")
 	command.Flags().Float32Var(&qps, "qps", 20.0, "Queries per second")
 	command.Flags().BoolVar(&namespaced, "namespaced", false, "run workflow-controller as namespaced mode")
@@ -5,6 +5,7 @@ import (
 	"path"
 
 	apiv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
 
@@ -77,6 +78,12 @@ type Config struct {
 	// Parallelism limits the max total parallel workflows that can execute at the same time
 	Parallelism int `json:"parallelism,omitempty"`
 
+	// NamespaceParallelism limits the max parallel workflows that can execute at the same time in each namespace
+	NamespaceParallelism *ParallelismQuota `json:"namespaceParallelism,omitempty"`
+
+	// LabelParallelism limits the max parallel workflows that can execute at the same time with the same value of a label, e.g. per team
+	LabelParallelism []LabelParallelismQuota `json:"labelParallelism,omitempty"`
+
 	// Persistence contains the workflow persistence DB configuration
 	Persistence *PersistConfig `json:"persistence,omitempty"`
 
@@ -95,10 +102,36 @@ type Config struct {
 	// WorkflowRestrictions restricts the controller to executing Workflows that meet certain restrictions
 	WorkflowRestrictions *WorkflowRestrictions `json:"workflowRestrictions,omitempty"`
 
+	// MemoizationCache configures the eviction of memoization cache entries
+	MemoizationCache *MemoizationCacheConfig `json:"memoizationCache,omitempty"`
+
+	// Estimation configures how the durations of workflows and nodes are estimated
+	Estimation Estimation `json:"estimation,omitempty"`
+
+	// HTTPTemplate configures how the controller sends the requests of HTTP templates
+	HTTPTemplate HTTPTemplate `json:"httpTemplate,omitempty"`
+
 	//Adding configurable initial delay (for K8S clusters with mutating webhooks) to prevent workflow getting modified by MWC.
 	InitialDelay metav1.Duration `json:"initialDelay,omitempty"`
 }
 
+// MemoizationCacheConfig configures when entries are evicted from memoization caches, whatever their type
+type MemoizationCacheConfig struct {
+	// MaxAge is the age (e.g. "7d", "24h") after which entries are evicted, entries are not evicted by age if zero
+	MaxAge TTL `json:"maxAge,omitempty"`
+	// MaxSize is the maximum size (e.g. "500Mi") of the entries of each cache, the oldest entries are evicted first, no
+	// limit if empty. ConfigMap caches are always kept under the 1MiB limit of ConfigMaps.
+	MaxSize *resource.Quantity `json:"maxSize,omitempty"`
+}
+
+// GetMaxSize returns the maximum size of each cache in bytes, zero if there is no limit
+func (c MemoizationCacheConfig) GetMaxSize() int64 {
+	if c.MaxSize == nil {
+		return 0
+	}
+	return c.MaxSize.Value()
+}
+
 // PodSpecLogStrategy contains the configuration for logging the pod spec in controller log for debugging purpose
 type PodSpecLogStrategy struct {
 	FailedPod bool `json:"failedPod,omitempty"`
@@ -134,6 +167,8 @@ type ArtifactRepository struct {
 	OSS *OSSArtifactRepository `json:"oss,omitempty"`
 	// GCS stores artifact in a GCS object store
 	GCS *GCSArtifactRepository `json:"gcs,omitempty"`
+	// Azure stores artifact in an Azure Blob Storage container
+	Azure *AzureArtifactRepository `json:"azure,omitempty"`
 }
 
 func (a *ArtifactRepository) IsArchiveLogs() bool {
@@ -149,6 +184,8 @@ func (a *ArtifactRepository) Get() ArtifactRepositoryType {
 		return nil
 	} else if a.Artifactory != nil {
 		return a.Artifactory
+	} else if a.Azure != nil {
+		return a.Azure
 	} else if a.GCS != nil {
 		return a.GCS
 	} else if a.HDFS != nil {
@@ -188,6 +225,8 @@ type PersistConfig struct {
 	PostgreSQL     *PostgreSQLConfig `json:"postgresql,omitempty"`
 	MySQL          *MySQLConfig      `json:"mysql,omitempty"`
 	SkipMigration  bool              `json:"skipMigration,omitempty"`
+	// EventTTL is how long dispatched events are kept in the event history, forever if zero
+	EventTTL TTL `json:"eventTTL,omitempty"`
 }
 
 func (c PersistConfig) GetArchiveLabelSelector() (labels.Selector, error) {
@@ -289,6 +328,22 @@ func (r *GCSArtifactRepository) IntoArtifactLocation(l *wfv1.ArtifactLocation) {
 	l.GCS = &wfv1.GCSArtifact{GCSBucket: r.GCSBucket, Key: k}
 }
 
+// AzureArtifactRepository defines the controller configuration for an Azure Blob Storage artifact repository
+type AzureArtifactRepository struct {
+	wfv1.AzureBlobContainer `json:",inline"`
+
+	// BlobNameFormat is defines the format of how to store blob names. Can reference workflow variables
+	BlobNameFormat string `json:"blobNameFormat,omitempty"`
+}
+
+func (r *AzureArtifactRepository) IntoArtifactLocation(l *wfv1.ArtifactLocation) {
+	k := r.BlobNameFormat
+	if k == "" {
+		k = common.DefaultArchivePattern
+	}
+	l.Azure = &wfv1.AzureArtifact{AzureBlobContainer: r.AzureBlobContainer, Blob: k}
+}
+
 // ArtifactoryArtifactRepository defines the controller configuration for an artifactory artifact repository
 type ArtifactoryArtifactRepository struct {
 	wfv1.ArtifactoryAuth `json:",inline"`
@@ -0,0 +1,41 @@
+package config
+
+const (
+	// BaselineEstimator estimates durations from the most recent successful workflow
+	BaselineEstimator = "baseline"
+	// PercentileEstimator estimates durations from a percentile of the durations of the most recent successful archived workflows
+	PercentileEstimator = "percentile"
+)
+
+// Estimation configures how the durations of workflows and nodes are estimated
+type Estimation struct {
+	// Estimator is either "baseline" (the default) or "percentile", the latter needs the workflow archive
+	Estimator string `json:"estimator,omitempty"`
+	// Percentile (1-100) of the durations the percentile estimator uses, defaults to 50
+	Percentile int `json:"percentile,omitempty"`
+	// Runs is the number of most recent successful archived workflows the percentile estimator uses, defaults to 10
+	Runs int `json:"runs,omitempty"`
+	// MinRuns is the number of archived workflows below which the percentile estimator falls back to the baseline estimator, defaults to 3
+	MinRuns int `json:"minRuns,omitempty"`
+}
+
+func (e Estimation) GetPercentile() int {
+	if e.Percentile <= 0 || e.Percentile > 100 {
+		return 50
+	}
+	return e.Percentile
+}
+
+func (e Estimation) GetRuns() int {
+	if e.Runs <= 0 {
+		return 10
+	}
+	return e.Runs
+}
+
+func (e Estimation) GetMinRuns() int {
+	if e.MinRuns <= 0 {
+		return 3
+	}
+	return e.MinRuns
+}
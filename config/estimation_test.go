@@ -0,0 +1,19 @@
+package config
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEstimation(t *testing.T) {
+	e := Estimation{}
+	assert.Equal(t, 50, e.GetPercentile())
+	assert.Equal(t, 10, e.GetRuns())
+	assert.Equal(t, 3, e.GetMinRuns())
+	e = Estimation{Percentile: 90, Runs: 20, MinRuns: 5}
+	assert.Equal(t, 90, e.GetPercentile())
+	assert.Equal(t, 20, e.GetRuns())
+	assert.Equal(t, 5, e.GetMinRuns())
+	assert.Equal(t, 50, Estimation{Percentile: 101}.GetPercentile())
+}
@@ -0,0 +1,67 @@
+package config
+
+import (
+	"fmt"
+	"regexp"
+)
+
+// HTTPTemplate configures how the controller sends the requests of HTTP templates
+type HTTPTemplate struct {
+	// Parallelism is the number of requests the controller sends at the same time, defaults to 10
+	Parallelism int `json:"parallelism,omitempty"`
+	// MaxTimeoutSeconds is the maximum timeout of a request, defaults to 60
+	MaxTimeoutSeconds int64 `json:"maxTimeoutSeconds,omitempty"`
+	// AllowedURLs are regular expressions matching whole URLs, a request is only sent if its URL matches one of them.
+	// Any URL is allowed if there are none.
+	AllowedURLs []string `json:"allowedURLs,omitempty"`
+	// DeniedURLs are regular expressions matching whole URLs, a request is not sent if its URL matches one of them
+	DeniedURLs []string `json:"deniedURLs,omitempty"`
+}
+
+func (h HTTPTemplate) GetParallelism() int {
+	if h.Parallelism <= 0 {
+		return 10
+	}
+	return h.Parallelism
+}
+
+func (h HTTPTemplate) GetMaxTimeoutSeconds() int64 {
+	if h.MaxTimeoutSeconds <= 0 {
+		return 60
+	}
+	return h.MaxTimeoutSeconds
+}
+
+// CheckURL returns an error if a request must not be sent to the URL
+func (h HTTPTemplate) CheckURL(url string) error {
+	for _, pattern := range h.DeniedURLs {
+		matched, err := matchURL(pattern, url)
+		if err != nil {
+			return err
+		}
+		if matched {
+			return fmt.Errorf("URL %s is denied by the controller's configuration", url)
+		}
+	}
+	for _, pattern := range h.AllowedURLs {
+		matched, err := matchURL(pattern, url)
+		if err != nil {
+			return err
+		}
+		if matched {
+			return nil
+		}
+	}
+	if len(h.AllowedURLs) > 0 {
+		return fmt.Errorf("URL %s is not allowed by the controller's configuration", url)
+	}
+	return nil
+}
+
+func matchURL(pattern, url string) (bool, error) {
+	r, err := regexp.Compile("^(?:" + pattern + ")$")
+	if err != nil {
+		return false, fmt.Errorf("invalid URL pattern %q in the controller's configuration: %w", pattern, err)
+	}
+	return r.MatchString(url), nil
+}
@@ -0,0 +1,34 @@
+package config
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHTTPTemplate(t *testing.T) {
+	h := HTTPTemplate{}
+	assert.Equal(t, 10, h.GetParallelism())
+	assert.Equal(t, int64(60), h.GetMaxTimeoutSeconds())
+	assert.NoError(t, h.CheckURL("http://169.254.169.254/latest/meta-data"))
+
+	h = HTTPTemplate{Parallelism: 2, MaxTimeoutSeconds: 10}
+	assert.Equal(t, 2, h.GetParallelism())
+	assert.Equal(t, int64(10), h.GetMaxTimeoutSeconds())
+
+	t.Run("Denied", func(t *testing.T) {
+		h := HTTPTemplate{DeniedURLs: []string{`https?://169\.254\..*`, `https?://[^/]*\.svc(\.cluster\.local)?([:/].*)?`}}
+		assert.EqualError(t, h.CheckURL("http://169.254.169.254/latest/meta-data"), "URL http://169.254.169.254/latest/meta-data is denied by the controller's configuration")
+		assert.Error(t, h.CheckURL("http://argo-server.argo.svc:2746/api"))
+		assert.NoError(t, h.CheckURL("https://example.com/items"))
+	})
+	t.Run("Allowed", func(t *testing.T) {
+		h := HTTPTemplate{AllowedURLs: []string{`https://example\.com/.*`}, DeniedURLs: []string{`https://example\.com/admin/.*`}}
+		assert.NoError(t, h.CheckURL("https://example.com/items"))
+		assert.EqualError(t, h.CheckURL("https://example.com.evil.io/items"), "URL https://example.com.evil.io/items is not allowed by the controller's configuration")
+		assert.Error(t, h.CheckURL("https://example.com/admin/users"))
+	})
+	t.Run("InvalidPattern", func(t *testing.T) {
+		assert.Error(t, HTTPTemplate{DeniedURLs: []string{"("}}.CheckURL("https://example.com"))
+	})
+}
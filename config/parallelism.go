@@ -0,0 +1,28 @@
+package config
+
+// ParallelismQuota limits the number of workflows that execute at the same time in each bucket, e.g. in each
+// namespace, or with each value of a label
+type ParallelismQuota struct {
+	// Default is the limit of each bucket that is not in Limits, 0 is unlimited
+	Default int `json:"default,omitempty"`
+	// Limits overrides the default limit of specific buckets, e.g. `{"my-ns": 20}`
+	Limits map[string]int `json:"limits,omitempty"`
+}
+
+// GetLimit returns the limit of the bucket, 0 is unlimited
+func (q *ParallelismQuota) GetLimit(bucket string) int {
+	if q == nil {
+		return 0
+	}
+	if limit, ok := q.Limits[bucket]; ok {
+		return limit
+	}
+	return q.Default
+}
+
+// LabelParallelismQuota limits the workflows with the same value of the label. Workflows without the label are not
+// limited by the quota.
+type LabelParallelismQuota struct {
+	Label            string `json:"label"`
+	ParallelismQuota `json:",inline"`
+}
@@ -0,0 +1,16 @@
+package config
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParallelismQuota_GetLimit(t *testing.T) {
+	var q *ParallelismQuota
+	assert.Equal(t, 0, q.GetLimit("my-ns"))
+	q = &ParallelismQuota{Default: 2, Limits: map[string]int{"my-ns": 5, "unlimited-ns": 0}}
+	assert.Equal(t, 5, q.GetLimit("my-ns"))
+	assert.Equal(t, 0, q.GetLimit("unlimited-ns"))
+	assert.Equal(t, 2, q.GetLimit("other-ns"))
+}
@@ -27,6 +27,10 @@ if [[ "$(kubectl -n argo get pod -l app=minio -o name)" != "" ]]; then
   pf MinIO deploy/minio 9000
 fi
 
+if [[ "$(kubectl -n argo get pod -l app=azurite -o name)" != "" ]]; then
+  pf Azurite deploy/azurite 10000
+fi
+
 dex=$(kubectl -n argo get pod -l app=dex -o name)
 if [[ "$dex" != "" ]]; then
   pf DEX svc/dex 5556
@@ -0,0 +1,110 @@
+package sqldb
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"k8s.io/apimachinery/pkg/labels"
+	"upper.io/db.v3"
+
+	wfv1 "github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1"
+)
+
+// ListOptions filters, sorts and pages the archived workflows.
+// Every filter is optional, and the zero value lists all archived workflows, most recently started first.
+type ListOptions struct {
+	Namespace string
+	// NamePrefix only lists workflows whose name starts with this prefix
+	NamePrefix string
+	// NamePattern only lists workflows whose name matches this glob pattern, where "*" matches any sequence of
+	// characters and "?" matches any single character
+	NamePattern string
+	// Phases only lists workflows in one of these phases
+	Phases                       []wfv1.WorkflowPhase
+	MinStartedAt, MaxStartedAt   time.Time
+	MinFinishedAt, MaxFinishedAt time.Time
+	// WorkflowTemplateName only lists workflows that reference this (cluster) workflow template
+	WorkflowTemplateName string
+	LabelRequirements    labels.Requirements
+	// SortBy is one of "startedAt", "finishedAt", "name", "namespace" or "phase", prefixed by "-" for a descending sort.
+	// Defaults to "-startedAt".
+	SortBy string
+	// Limit is the maximum number of workflows to list, or 0 to list all of them
+	Limit  int
+	Offset int
+}
+
+// sortableColumns maps the names that can be used in ListOptions.SortBy to their column
+var sortableColumns = map[string]string{
+	"startedAt":  "startedat",
+	"finishedAt": "finishedat",
+	"name":       "name",
+	"namespace":  "namespace",
+	"phase":      "phase",
+}
+
+// orderBy returns the order of the workflows, the uid breaks ties so that pages are stable
+func orderBy(sortBy string) ([]interface{}, error) {
+	if sortBy == "" {
+		return []interface{}{"-startedat", "-uid"}, nil
+	}
+	desc := strings.HasPrefix(sortBy, "-")
+	column, ok := sortableColumns[strings.TrimPrefix(sortBy, "-")]
+	if !ok {
+		return nil, fmt.Errorf("cannot sort by %q", sortBy)
+	}
+	if desc {
+		return []interface{}{"-" + column, "-uid"}, nil
+	}
+	return []interface{}{column, "uid"}, nil
+}
+
+func (o ListOptions) clause(t dbType) (db.Compound, error) {
+	labelsCond, err := labelsClause(t, o.LabelRequirements)
+	if err != nil {
+		return nil, err
+	}
+	conds := []db.Compound{
+		namespaceEqual(o.Namespace),
+		timeRangeClause("startedat", o.MinStartedAt, o.MaxStartedAt),
+		timeRangeClause("finishedat", o.MinFinishedAt, o.MaxFinishedAt),
+		labelsCond,
+	}
+	if o.NamePrefix != "" {
+		conds = append(conds, db.Cond{"name LIKE": escapeLike(o.NamePrefix) + "%"})
+	}
+	if o.NamePattern != "" {
+		conds = append(conds, db.Cond{"name LIKE": globToLike(o.NamePattern)})
+	}
+	if len(o.Phases) > 0 {
+		conds = append(conds, db.Cond{"phase IN": o.Phases})
+	}
+	if o.WorkflowTemplateName != "" {
+		conds = append(conds, db.Cond{"workflowtemplatename": o.WorkflowTemplateName})
+	}
+	return db.And(conds...), nil
+}
+
+func timeRangeClause(column string, from, to time.Time) db.Compound {
+	var conds []db.Compound
+	if !from.IsZero() {
+		conds = append(conds, db.Cond{column + " > ": from})
+	}
+	if !to.IsZero() {
+		conds = append(conds, db.Cond{column + " < ": to})
+	}
+	return db.And(conds...)
+}
+
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+// escapeLike escapes the LIKE wildcards, using backslash which is the default escape character in both Postgres and MySQL
+func escapeLike(s string) string {
+	return likeEscaper.Replace(s)
+}
+
+// globToLike converts a glob pattern into a LIKE pattern
+func globToLike(pattern string) string {
+	return strings.NewReplacer("*", "%", "?", "_").Replace(escapeLike(pattern))
+}
@@ -0,0 +1,87 @@
+package sqldb
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"upper.io/db.v3"
+
+	wfv1 "github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1"
+)
+
+// conditions flattens the conditions of a compound, as nested compounds cannot be compared
+func conditions(c db.Compound) []interface{} {
+	var conds []interface{}
+	for _, s := range c.Sentences() {
+		switch v := s.(type) {
+		case db.Cond:
+			if len(v) > 0 {
+				conds = append(conds, v)
+			}
+		case db.RawValue:
+			conds = append(conds, v.String())
+		default:
+			conds = append(conds, conditions(v)...)
+		}
+	}
+	return conds
+}
+
+func TestListOptions_clause(t *testing.T) {
+	t.Run("Empty", func(t *testing.T) {
+		clause, err := ListOptions{}.clause(Postgres)
+		if assert.NoError(t, err) {
+			assert.Empty(t, conditions(clause))
+		}
+	})
+	t.Run("All", func(t *testing.T) {
+		from := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+		to := from.Add(24 * time.Hour)
+		clause, err := ListOptions{
+			Namespace:            "my-ns",
+			NamePrefix:           "nightly_",
+			NamePattern:          "*-build-?",
+			Phases:               []wfv1.WorkflowPhase{wfv1.WorkflowFailed},
+			MinStartedAt:         from,
+			MaxFinishedAt:        to,
+			WorkflowTemplateName: "my-wftmpl",
+			LabelRequirements:    requirements("foo"),
+		}.clause(Postgres)
+		if assert.NoError(t, err) {
+			assert.Equal(t, []interface{}{
+				db.Cond{"namespace": "my-ns"},
+				db.Cond{"startedat > ": from},
+				db.Cond{"finishedat < ": to},
+				"exists (select 1 from argo_archived_workflows_labels where clustername = argo_archived_workflows.clustername and uid = argo_archived_workflows.uid and name = 'foo')",
+				db.Cond{"name LIKE": `nightly\_%`},
+				db.Cond{"name LIKE": "%-build-_"},
+				db.Cond{"phase IN": []wfv1.WorkflowPhase{wfv1.WorkflowFailed}},
+				db.Cond{"workflowtemplatename": "my-wftmpl"},
+			}, conditions(clause))
+		}
+	})
+}
+
+func Test_orderBy(t *testing.T) {
+	for sortBy, want := range map[string][]interface{}{
+		"":            {"-startedat", "-uid"},
+		"startedAt":   {"startedat", "uid"},
+		"-finishedAt": {"-finishedat", "-uid"},
+		"name":        {"name", "uid"},
+		"-phase":      {"-phase", "-uid"},
+	} {
+		got, err := orderBy(sortBy)
+		if assert.NoError(t, err) {
+			assert.Equal(t, want, got, sortBy)
+		}
+	}
+	_, err := orderBy("workflow")
+	assert.EqualError(t, err, `cannot sort by "workflow"`)
+}
+
+func Test_globToLike(t *testing.T) {
+	assert.Equal(t, "nightly-%", globToLike("nightly-*"))
+	assert.Equal(t, "a_b", globToLike("a?b"))
+	assert.Equal(t, `100\%\_done\\%`, globToLike(`100%_done\*`))
+}
@@ -0,0 +1,278 @@
+package sqldb
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"time"
+
+	"k8s.io/apimachinery/pkg/util/validation"
+	"upper.io/db.v3"
+
+	wfv1 "github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1"
+)
+
+const (
+	// GroupByWorkflowTemplate groups the statistics by the workflow template the workflows reference
+	GroupByWorkflowTemplate = "workflowTemplate"
+	// GroupByLabelPrefix groups the statistics by the value of a label, e.g. "label:my-label"
+	GroupByLabelPrefix = "label:"
+)
+
+// StatsOptions selects and groups the archived workflows to aggregate.
+type StatsOptions struct {
+	// ListOptions filters the archived workflows, its sort, limit and offset are ignored
+	ListOptions
+	// GroupBy is either empty, GroupByWorkflowTemplate or GroupByLabelPrefix followed by a label key
+	GroupBy string
+	// Bucket is the width of the time buckets the workflows are split into by their start time, or 0 for no buckets
+	Bucket time.Duration
+}
+
+// WorkflowStats are aggregate statistics of archived workflows, one group per distinct GroupBy value.
+type WorkflowStats struct {
+	Groups []WorkflowStatsGroup
+}
+
+type WorkflowStatsGroup struct {
+	// Key is the group's workflow template name or label value, empty if the workflows do not have one
+	Key         string
+	PhaseCounts map[wfv1.WorkflowPhase]int64
+	// DurationP50, DurationP90 and DurationP99 are percentiles of the workflows' durations, interpolated like
+	// Postgres's percentile_cont
+	DurationP50, DurationP90, DurationP99 time.Duration
+	Buckets                               []WorkflowStatsBucket
+}
+
+// Total is the number of workflows in the group
+func (g WorkflowStatsGroup) Total() int64 {
+	var total int64
+	for _, n := range g.PhaseCounts {
+		total += n
+	}
+	return total
+}
+
+// SuccessRate is the fraction of the group's workflows that succeeded
+func (g WorkflowStatsGroup) SuccessRate() float64 {
+	return successRate(g.PhaseCounts[wfv1.WorkflowSucceeded], g.Total())
+}
+
+type WorkflowStatsBucket struct {
+	StartedAt time.Time
+	Total     int64
+	Succeeded int64
+}
+
+// SuccessRate is the fraction of the bucket's workflows that succeeded
+func (b WorkflowStatsBucket) SuccessRate() float64 {
+	return successRate(b.Succeeded, b.Total)
+}
+
+func successRate(succeeded, total int64) float64 {
+	if total == 0 {
+		return 0
+	}
+	return float64(succeeded) / float64(total)
+}
+
+// groupKeyExpression returns the SQL expression of the value the statistics are grouped by
+func groupKeyExpression(groupBy string) (string, error) {
+	switch {
+	case groupBy == "":
+		return "''", nil
+	case groupBy == GroupByWorkflowTemplate:
+		return "coalesce(workflowtemplatename, '')", nil
+	case strings.HasPrefix(groupBy, GroupByLabelPrefix):
+		key := strings.TrimPrefix(groupBy, GroupByLabelPrefix)
+		// unlike label selectors, this is not validated by the API server, and we interpolate it into the SQL
+		if errs := validation.IsQualifiedName(key); len(errs) > 0 {
+			return "", fmt.Errorf("cannot group by label %q: %s", key, strings.Join(errs, ", "))
+		}
+		return fmt.Sprintf("coalesce((select value from %s where clustername = %s.clustername and uid = %s.uid and name = '%s'), '')", archiveLabelsTableName, archiveTableName, archiveTableName, key), nil
+	}
+	return "", fmt.Errorf("cannot group by %q, must be %q or %q followed by a label key", groupBy, GroupByWorkflowTemplate, GroupByLabelPrefix)
+}
+
+func (t dbType) durationSecondsExpression() string {
+	if t == MySQL {
+		return "timestampdiff(microsecond, startedat, finishedat) / 1000000"
+	}
+	return "extract(epoch from finishedat - startedat)"
+}
+
+func (t dbType) bucketExpression(bucket time.Duration) string {
+	seconds := int64(bucket.Seconds())
+	if t == MySQL {
+		return fmt.Sprintf("cast(floor(unix_timestamp(startedat) / %d) * %d as signed)", seconds, seconds)
+	}
+	return fmt.Sprintf("cast(floor(extract(epoch from startedat) / %d) * %d as bigint)", seconds, seconds)
+}
+
+func (r *workflowArchive) GetWorkflowStats(options StatsOptions) (*WorkflowStats, error) {
+	groupKey, err := groupKeyExpression(options.GroupBy)
+	if err != nil {
+		return nil, err
+	}
+	if options.Bucket != 0 && options.Bucket < time.Second {
+		return nil, fmt.Errorf("bucket must be at least 1s")
+	}
+	clause, err := options.clause(r.dbType)
+	if err != nil {
+		return nil, err
+	}
+	groups := map[string]*WorkflowStatsGroup{}
+	group := func(key string) *WorkflowStatsGroup {
+		if _, ok := groups[key]; !ok {
+			groups[key] = &WorkflowStatsGroup{Key: key, PhaseCounts: map[wfv1.WorkflowPhase]int64{}}
+		}
+		return groups[key]
+	}
+
+	var phaseCounts []struct {
+		GroupKey string             `db:"groupkey"`
+		Phase    wfv1.WorkflowPhase `db:"phase"`
+		Total    int64              `db:"total"`
+	}
+	err = r.session.
+		Select(db.Raw(groupKey+" as groupkey"), "phase", db.Raw("count(*) as total")).
+		From(archiveTableName).
+		Where(r.clusterManagedNamespaceAndInstanceID()).
+		And(clause).
+		GroupBy("groupkey", "phase").
+		All(&phaseCounts)
+	if err != nil {
+		return nil, err
+	}
+	for _, c := range phaseCounts {
+		group(c.GroupKey).PhaseCounts[c.Phase] = c.Total
+	}
+
+	if r.dbType == Postgres {
+		var durations []struct {
+			GroupKey string  `db:"groupkey"`
+			P50      float64 `db:"p50"`
+			P90      float64 `db:"p90"`
+			P99      float64 `db:"p99"`
+		}
+		durationSeconds := r.dbType.durationSecondsExpression()
+		err = r.session.
+			Select(
+				db.Raw(groupKey+" as groupkey"),
+				db.Raw(fmt.Sprintf("percentile_cont(0.5) within group (order by %s) as p50", durationSeconds)),
+				db.Raw(fmt.Sprintf("percentile_cont(0.9) within group (order by %s) as p90", durationSeconds)),
+				db.Raw(fmt.Sprintf("percentile_cont(0.99) within group (order by %s) as p99", durationSeconds)),
+			).
+			From(archiveTableName).
+			Where(r.clusterManagedNamespaceAndInstanceID()).
+			And(clause).
+			GroupBy("groupkey").
+			All(&durations)
+		if err != nil {
+			return nil, err
+		}
+		for _, d := range durations {
+			g := group(d.GroupKey)
+			g.DurationP50, g.DurationP90, g.DurationP99 = seconds(d.P50), seconds(d.P90), seconds(d.P99)
+		}
+	} else {
+		// MySQL does not have percentile functions, so we rank the durations of each group with window functions, and
+		// only fetch the two durations each percentile is interpolated between
+		var durations []struct {
+			GroupKey string  `db:"groupkey"`
+			N        int64   `db:"n"`
+			P50Lower float64 `db:"p50_lower"`
+			P50Upper float64 `db:"p50_upper"`
+			P90Lower float64 `db:"p90_lower"`
+			P90Upper float64 `db:"p90_upper"`
+			P99Lower float64 `db:"p99_lower"`
+			P99Upper float64 `db:"p99_upper"`
+		}
+		durationSeconds := r.dbType.durationSecondsExpression()
+		ranked := r.session.
+			Select(
+				db.Raw(groupKey+" as groupkey"),
+				db.Raw(durationSeconds+" as duration"),
+				db.Raw(fmt.Sprintf("row_number() over (partition by %s order by %s) - 1 as rn", groupKey, durationSeconds)),
+				db.Raw(fmt.Sprintf("count(*) over (partition by %s) as n", groupKey)),
+			).
+			From(archiveTableName).
+			Where(r.clusterManagedNamespaceAndInstanceID()).
+			And(clause)
+		columns := []interface{}{"groupkey", db.Raw("max(n) as n")}
+		for _, p := range []string{"50", "90", "99"} {
+			columns = append(columns, db.Raw(percentileBoundsExpressions("0."+p, "p"+p)))
+		}
+		err = r.session.
+			Select(columns...).
+			From(db.Raw("? as ranked", ranked)).
+			GroupBy("groupkey").
+			All(&durations)
+		if err != nil {
+			return nil, err
+		}
+		for _, d := range durations {
+			g := group(d.GroupKey)
+			g.DurationP50 = seconds(interpolate(d.P50Lower, d.P50Upper, d.N, 0.5))
+			g.DurationP90 = seconds(interpolate(d.P90Lower, d.P90Upper, d.N, 0.9))
+			g.DurationP99 = seconds(interpolate(d.P99Lower, d.P99Upper, d.N, 0.99))
+		}
+	}
+
+	if options.Bucket > 0 {
+		var buckets []struct {
+			GroupKey  string `db:"groupkey"`
+			Bucket    int64  `db:"bucket"`
+			Total     int64  `db:"total"`
+			Succeeded int64  `db:"succeeded"`
+		}
+		err = r.session.
+			Select(
+				db.Raw(groupKey+" as groupkey"),
+				db.Raw(r.dbType.bucketExpression(options.Bucket)+" as bucket"),
+				db.Raw("count(*) as total"),
+				db.Raw(fmt.Sprintf("sum(case when phase = '%s' then 1 else 0 end) as succeeded", wfv1.WorkflowSucceeded)),
+			).
+			From(archiveTableName).
+			Where(r.clusterManagedNamespaceAndInstanceID()).
+			And(clause).
+			GroupBy("groupkey", "bucket").
+			OrderBy("bucket").
+			All(&buckets)
+		if err != nil {
+			return nil, err
+		}
+		for _, b := range buckets {
+			g := group(b.GroupKey)
+			g.Buckets = append(g.Buckets, WorkflowStatsBucket{StartedAt: time.Unix(b.Bucket, 0).UTC(), Total: b.Total, Succeeded: b.Succeeded})
+		}
+	}
+
+	stats := &WorkflowStats{}
+	for _, g := range groups {
+		stats.Groups = append(stats.Groups, *g)
+	}
+	sort.Slice(stats.Groups, func(i, j int) bool { return stats.Groups[i].Key < stats.Groups[j].Key })
+	return stats, nil
+}
+
+func seconds(s float64) time.Duration {
+	return time.Duration(s * float64(time.Second)).Round(time.Millisecond)
+}
+
+// percentileBoundsExpressions returns the SQL expressions of the ranked durations the p-th percentile is interpolated
+// between, named with the prefix followed by "_lower" and "_upper"
+func percentileBoundsExpressions(p, prefix string) string {
+	return fmt.Sprintf("max(case when rn = floor(%[1]s * (n - 1)) then duration end) as %[2]s_lower, max(case when rn = ceil(%[1]s * (n - 1)) then duration end) as %[2]s_upper", p, prefix)
+}
+
+// interpolate returns the p-th percentile of n values, given the values it is between, the same way as Postgres's
+// percentile_cont
+func interpolate(lower, upper float64, n int64, p float64) float64 {
+	if n == 0 {
+		return 0
+	}
+	rank := p * float64(n-1)
+	return lower + (rank-math.Floor(rank))*(upper-lower)
+}
@@ -0,0 +1,66 @@
+package sqldb
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	wfv1 "github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1"
+)
+
+func Test_groupKeyExpression(t *testing.T) {
+	t.Run("None", func(t *testing.T) {
+		expr, err := groupKeyExpression("")
+		if assert.NoError(t, err) {
+			assert.Equal(t, "''", expr)
+		}
+	})
+	t.Run("WorkflowTemplate", func(t *testing.T) {
+		expr, err := groupKeyExpression("workflowTemplate")
+		if assert.NoError(t, err) {
+			assert.Equal(t, "coalesce(workflowtemplatename, '')", expr)
+		}
+	})
+	t.Run("Label", func(t *testing.T) {
+		expr, err := groupKeyExpression("label:example.com/team")
+		if assert.NoError(t, err) {
+			assert.Equal(t, "coalesce((select value from argo_archived_workflows_labels where clustername = argo_archived_workflows.clustername and uid = argo_archived_workflows.uid and name = 'example.com/team'), '')", expr)
+		}
+	})
+	t.Run("InvalidLabel", func(t *testing.T) {
+		_, err := groupKeyExpression("label:team'; drop table argo_archived_workflows; --")
+		assert.Error(t, err)
+	})
+	t.Run("Unknown", func(t *testing.T) {
+		_, err := groupKeyExpression("phase")
+		assert.EqualError(t, err, `cannot group by "phase", must be "workflowTemplate" or "label:" followed by a label key`)
+	})
+}
+
+func Test_bucketExpression(t *testing.T) {
+	assert.Equal(t, "cast(floor(extract(epoch from startedat) / 3600) * 3600 as bigint)", Postgres.bucketExpression(time.Hour))
+	assert.Equal(t, "cast(floor(unix_timestamp(startedat) / 86400) * 86400 as signed)", MySQL.bucketExpression(24*time.Hour))
+}
+
+func Test_percentileBoundsExpressions(t *testing.T) {
+	assert.Equal(t, "max(case when rn = floor(0.9 * (n - 1)) then duration end) as p90_lower, max(case when rn = ceil(0.9 * (n - 1)) then duration end) as p90_upper", percentileBoundsExpressions("0.9", "p90"))
+}
+
+func Test_interpolate(t *testing.T) {
+	assert.Equal(t, 0.0, interpolate(0, 0, 0, 0.5))
+	assert.Equal(t, 3.0, interpolate(3, 3, 1, 0.99))
+	// the percentiles of 1, 2, ..., 10
+	assert.InDelta(t, 5.5, interpolate(5, 6, 10, 0.5), 0.001)
+	assert.InDelta(t, 9.1, interpolate(9, 10, 10, 0.9), 0.001)
+	assert.InDelta(t, 9.91, interpolate(9, 10, 10, 0.99), 0.001)
+	assert.Equal(t, 3.0, interpolate(3, 3, 5, 0.5))
+}
+
+func TestWorkflowStatsGroup_SuccessRate(t *testing.T) {
+	g := WorkflowStatsGroup{PhaseCounts: map[wfv1.WorkflowPhase]int64{wfv1.WorkflowSucceeded: 3, wfv1.WorkflowFailed: 1}}
+	assert.Equal(t, int64(4), g.Total())
+	assert.Equal(t, 0.75, g.SuccessRate())
+	assert.Equal(t, 0.0, WorkflowStatsBucket{}.SuccessRate())
+	assert.Equal(t, 0.5, WorkflowStatsBucket{Total: 2, Succeeded: 1}.SuccessRate())
+}
@@ -0,0 +1,269 @@
+package sqldb
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"upper.io/db.v3"
+	"upper.io/db.v3/lib/sqlbuilder"
+
+	"github.com/argoproj/argo/v2/util/instanceid"
+)
+
+const eventsTableName = "argo_events"
+const eventDispatchesTableName = "argo_event_dispatches"
+
+type EventStatus string
+
+const (
+	// EventPending events have been received, but not yet dispatched, e.g. because the server restarted
+	EventPending EventStatus = "Pending"
+	// EventDispatching events are being dispatched, so they cannot be retried until they have been
+	EventDispatching EventStatus = "Dispatching"
+	// EventDispatched events have been evaluated against every binding without error
+	EventDispatched EventStatus = "Dispatched"
+	// EventFailed events could not be dispatched to one or more bindings
+	EventFailed EventStatus = "Failed"
+)
+
+// Undelivered is true if the event needs to be retried
+func (s EventStatus) Undelivered() bool {
+	return s == EventPending || s == EventFailed
+}
+
+type EventRecord struct {
+	ClusterName   string `db:"clustername"`
+	InstanceID    string `db:"instanceid"`
+	UID           string `db:"uid"`
+	Namespace     string `db:"namespace"`
+	Discriminator string `db:"discriminator"`
+	// Environment is the JSON of the expression environment the bindings are evaluated against, including the payload
+	Environment string      `db:"environment"`
+	Status      EventStatus `db:"status"`
+	ReceivedAt  time.Time   `db:"receivedat"`
+}
+
+type EventDispatchRecord struct {
+	ClusterName      string `db:"clustername"`
+	EventUID         string `db:"eventuid"`
+	BindingNamespace string `db:"bindingnamespace"`
+	Binding          string `db:"binding"`
+	Matched          bool   `db:"matched"`
+	WorkflowName     string `db:"workflowname"`
+	// Why is this called "errormessage" not "error"? Error is a keyword in some databases.
+	Error        string    `db:"errormessage"`
+	DispatchedAt time.Time `db:"dispatchedat"`
+}
+
+// Event is a received event, and the outcome of its most recent dispatch to each binding.
+type Event struct {
+	EventRecord
+	Dispatches []EventDispatchRecord
+}
+
+//go:generate mockery -name EventHistory
+
+type EventHistory interface {
+	IsEnabled() bool
+	// RecordEvent records a received event, before it is dispatched
+	RecordEvent(event *EventRecord) error
+	// UpdateEventStatus updates the status of the event if it has the expected one, it returns false if it does not,
+	// e.g. because another dispatch of the event has already started
+	UpdateEventStatus(uid string, from, to EventStatus) (bool, error)
+	// RecordDispatches records the outcome of dispatching the event, replacing any previous outcome for the same bindings
+	RecordDispatches(uid string, dispatches []EventDispatchRecord) error
+	// ListEvents lists the events, most recently received first, optionally only those that are pending or failed
+	ListEvents(namespace string, undelivered bool, limit int) ([]Event, error)
+	// GetEvent returns nil if the event does not exist
+	GetEvent(uid string) (*Event, error)
+	// DeleteExpiredEvents deletes the dispatched events received more than ttl ago, and their dispatches. Undelivered
+	// events are kept, so that they can be retried.
+	DeleteExpiredEvents(ttl time.Duration) error
+}
+
+type eventHistory struct {
+	session           sqlbuilder.Database
+	clusterName       string
+	instanceIDService instanceid.Service
+}
+
+// NewEventHistory returns a new eventHistory
+func NewEventHistory(session sqlbuilder.Database, clusterName string, instanceIDService instanceid.Service) EventHistory {
+	return &eventHistory{session: session, clusterName: clusterName, instanceIDService: instanceIDService}
+}
+
+func (r *eventHistory) IsEnabled() bool {
+	return true
+}
+
+func (r *eventHistory) clusterAndInstanceID() db.Cond {
+	return db.Cond{"clustername": r.clusterName, "instanceid": r.instanceIDService.InstanceID()}
+}
+
+func (r *eventHistory) RecordEvent(event *EventRecord) error {
+	event.ClusterName = r.clusterName
+	event.InstanceID = r.instanceIDService.InstanceID()
+	if event.Status == "" {
+		event.Status = EventPending
+	}
+	_, err := r.session.Collection(eventsTableName).Insert(event)
+	return err
+}
+
+func (r *eventHistory) UpdateEventStatus(uid string, from, to EventStatus) (bool, error) {
+	rs, err := r.session.
+		Update(eventsTableName).
+		Set("status", to).
+		Where(db.Cond{"clustername": r.clusterName, "uid": uid, "status": from}).
+		Exec()
+	if err != nil {
+		return false, err
+	}
+	rowsAffected, err := rs.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return rowsAffected == 1, nil
+}
+
+func (r *eventHistory) RecordDispatches(uid string, dispatches []EventDispatchRecord) error {
+	return r.session.Tx(context.Background(), func(sess sqlbuilder.Tx) error {
+		for _, d := range dispatches {
+			d.ClusterName = r.clusterName
+			d.EventUID = uid
+			_, err := sess.
+				DeleteFrom(eventDispatchesTableName).
+				Where(db.Cond{"clustername": r.clusterName, "eventuid": uid, "bindingnamespace": d.BindingNamespace, "binding": d.Binding}).
+				Exec()
+			if err != nil {
+				return err
+			}
+			_, err = sess.Collection(eventDispatchesTableName).Insert(&d)
+			if err != nil {
+				return err
+			}
+		}
+		// the status reflects every binding's outcome, not only the ones just dispatched
+		failed := &struct {
+			Total int64 `db:"total"`
+		}{}
+		err := sess.
+			Select(db.Raw("count(*) as total")).
+			From(eventDispatchesTableName).
+			Where(db.Cond{"clustername": r.clusterName, "eventuid": uid, "errormessage <>": ""}).
+			One(failed)
+		if err != nil {
+			return err
+		}
+		status := EventDispatched
+		if failed.Total > 0 {
+			status = EventFailed
+		}
+		_, err = sess.
+			Update(eventsTableName).
+			Set("status", status).
+			Where(db.Cond{"clustername": r.clusterName, "uid": uid}).
+			Exec()
+		return err
+	})
+}
+
+func (r *eventHistory) ListEvents(namespace string, undelivered bool, limit int) ([]Event, error) {
+	cond := r.clusterAndInstanceID()
+	if namespace != "" {
+		cond["namespace"] = namespace
+	}
+	if undelivered {
+		cond["status IN"] = []EventStatus{EventPending, EventFailed}
+	}
+	if limit <= 0 {
+		limit = -1
+	}
+	var records []EventRecord
+	err := r.session.
+		Select("uid", "namespace", "discriminator", "environment", "status", "receivedat").
+		From(eventsTableName).
+		Where(cond).
+		OrderBy("-receivedat").
+		Limit(limit).
+		All(&records)
+	if err != nil {
+		return nil, err
+	}
+	events := make([]Event, len(records))
+	if len(records) == 0 {
+		return events, nil
+	}
+	uids := make([]string, len(records))
+	for i, record := range records {
+		uids[i] = record.UID
+	}
+	dispatches, err := r.dispatches(uids...)
+	if err != nil {
+		return nil, err
+	}
+	for i, record := range records {
+		events[i] = Event{EventRecord: record, Dispatches: dispatches[record.UID]}
+	}
+	return events, nil
+}
+
+func (r *eventHistory) GetEvent(uid string) (*Event, error) {
+	record := &EventRecord{}
+	err := r.session.
+		Select("uid", "namespace", "discriminator", "environment", "status", "receivedat").
+		From(eventsTableName).
+		Where(r.clusterAndInstanceID()).
+		And(db.Cond{"uid": uid}).
+		One(record)
+	if err != nil {
+		if err == db.ErrNoMoreRows {
+			return nil, nil
+		}
+		return nil, err
+	}
+	dispatches, err := r.dispatches(uid)
+	if err != nil {
+		return nil, err
+	}
+	return &Event{EventRecord: *record, Dispatches: dispatches[uid]}, nil
+}
+
+// dispatches returns the dispatches of the events, keyed by event UID
+func (r *eventHistory) dispatches(uids ...string) (map[string][]EventDispatchRecord, error) {
+	var records []EventDispatchRecord
+	err := r.session.
+		Select("eventuid", "bindingnamespace", "binding", "matched", "workflowname", "errormessage", "dispatchedat").
+		From(eventDispatchesTableName).
+		Where(db.Cond{"clustername": r.clusterName, "eventuid IN": uids}).
+		OrderBy("bindingnamespace", "binding").
+		All(&records)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list event dispatches: %w", err)
+	}
+	dispatches := make(map[string][]EventDispatchRecord)
+	for _, record := range records {
+		dispatches[record.EventUID] = append(dispatches[record.EventUID], record)
+	}
+	return dispatches, nil
+}
+
+func (r *eventHistory) DeleteExpiredEvents(ttl time.Duration) error {
+	// the dispatches are deleted by the foreign key's cascade
+	rs, err := r.session.
+		DeleteFrom(eventsTableName).
+		Where(r.clusterAndInstanceID()).
+		And(db.Cond{"status": EventDispatched, "receivedat <": time.Now().UTC().Add(-ttl)}).
+		Exec()
+	if err != nil {
+		return err
+	}
+	rowsAffected, err := rs.RowsAffected()
+	if err != nil {
+		return err
+	}
+	log.WithFields(log.Fields{"rowsAffected": rowsAffected}).Info("Deleted expired events")
+	return nil
+}
@@ -249,6 +249,79 @@ func (m migrate) Exec(ctx context.Context) error {
 		ansiSQLChange(`create index ` + m.tableName + `_i1 on ` + m.tableName + ` (clustername,namespace,updatedat)`),
 		// index to find records that need deleting, this omits namespaces as this might be null
 		ansiSQLChange(`create index argo_archived_workflows_i2 on argo_archived_workflows (clustername,instanceid,finishedat)`),
+		// memoization cache entries, see workflow/controller/cache/sql_cache.go
+		ansiSQLChange(`create table if not exists argo_memoization_cache (
+    clustername varchar(64) not null,
+    cachename varchar(256) not null,
+    cachekey varchar(256) not null,
+    nodeid varchar(256) not null,
+    outputs json not null,
+    createdat timestamp not null default current_timestamp,
+    primary key (clustername, cachename, cachekey)
+)`),
+		// columns and indexes for the archived workflow list filters, see persist/sqldb/archived_workflow_list_options.go
+		ansiSQLChange(`alter table argo_archived_workflows add column workflowtemplatename varchar(256)`),
+		ansiSQLChange(`create index argo_archived_workflows_i3 on argo_archived_workflows (clustername,instanceid,phase,startedat)`),
+		ansiSQLChange(`create index argo_archived_workflows_i4 on argo_archived_workflows (clustername,instanceid,workflowtemplatename)`),
+		// Postgres only uses an index for "name like 'prefix%'" with the pattern operator class, unless the database uses the C locale
+		ternary(dbType == MySQL,
+			ansiSQLChange(`create index argo_archived_workflows_i5 on argo_archived_workflows (clustername,instanceid,name)`),
+			ansiSQLChange(`create index argo_archived_workflows_i5 on argo_archived_workflows (clustername,instanceid,name varchar_pattern_ops)`),
+		),
+		// event delivery history, see persist/sqldb/event_history.go
+		ansiSQLChange(`create table if not exists argo_events (
+    clustername varchar(64) not null,
+    instanceid varchar(64) not null,
+    uid varchar(128) not null,
+    namespace varchar(256) not null,
+    discriminator varchar(256) not null,
+    environment json not null,
+    status varchar(25) not null,
+    receivedat timestamp not null default current_timestamp,
+    primary key (clustername, uid)
+)`),
+		ansiSQLChange(`create index argo_events_i1 on argo_events (clustername,instanceid,namespace,receivedat)`),
+		ansiSQLChange(`create table if not exists argo_event_dispatches (
+    clustername varchar(64) not null,
+    eventuid varchar(128) not null,
+    bindingnamespace varchar(256) not null,
+    binding varchar(256) not null,
+    matched boolean not null,
+    workflowname varchar(256) not null,
+    errormessage text not null,
+    dispatchedat timestamp not null default current_timestamp,
+    primary key (clustername, eventuid, bindingnamespace, binding),
+    foreign key (clustername, eventuid) references argo_events(clustername, uid) on delete cascade
+)`),
+		// database semaphores, see persist/sqldb/semaphore_repository.go
+		ansiSQLChange(`create table if not exists argo_semaphores (
+    name varchar(128) not null,
+    sizelimit int not null,
+    primary key (name)
+)`),
+		ansiSQLChange(`create table if not exists argo_semaphore_holders (
+    name varchar(128) not null,
+    clustername varchar(64) not null,
+    instanceid varchar(64) not null,
+    holderkey varchar(256) not null,
+    heartbeatat timestamp not null,
+    primary key (name, clustername, instanceid, holderkey),
+    foreign key (name) references argo_semaphores(name) on delete cascade
+)`),
+		ansiSQLChange(`create index argo_semaphore_holders_i1 on argo_semaphore_holders (clustername,instanceid)`),
+		// the sub-trees of memoized steps and DAG nodes
+		ansiSQLChange(`alter table argo_memoization_cache add column nodes json`),
+		// backfill the workflow template names of the workflows archived before the column was added
+		ternary(dbType == MySQL,
+			ansiSQLChange(`update argo_archived_workflows set workflowtemplatename = coalesce(json_unquote(json_extract(workflow, '$.spec.workflowTemplateRef.name')), '') where workflowtemplatename is null`),
+			ansiSQLChange(`update argo_archived_workflows set workflowtemplatename = coalesce(workflow->'spec'->'workflowTemplateRef'->>'name', '') where workflowtemplatename is null`),
+		),
+		// the sizes of the memoization cache entries, for eviction by size
+		ansiSQLChange(`alter table argo_memoization_cache add column size bigint not null default 0`),
+		ternary(dbType == MySQL,
+			ansiSQLChange(`update argo_memoization_cache set size = length(outputs) + coalesce(length(nodes), 0)`),
+			ansiSQLChange(`update argo_memoization_cache set size = octet_length(outputs::text) + coalesce(octet_length(nodes::text), 0)`),
+		),
 	} {
 		err := m.applyChange(ctx, changeSchemaVersion, change)
 		if err != nil {
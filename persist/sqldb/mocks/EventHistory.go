@@ -0,0 +1,138 @@
+// Code generated by mockery v1.1.1. DO NOT EDIT.
+
+package mocks
+
+import (
+	sqldb "github.com/argoproj/argo/v2/persist/sqldb"
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
+
+// EventHistory is an autogenerated mock type for the EventHistory type
+type EventHistory struct {
+	mock.Mock
+}
+
+// DeleteExpiredEvents provides a mock function with given fields: ttl
+func (_m *EventHistory) DeleteExpiredEvents(ttl time.Duration) error {
+	ret := _m.Called(ttl)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(time.Duration) error); ok {
+		r0 = rf(ttl)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GetEvent provides a mock function with given fields: uid
+func (_m *EventHistory) GetEvent(uid string) (*sqldb.Event, error) {
+	ret := _m.Called(uid)
+
+	var r0 *sqldb.Event
+	if rf, ok := ret.Get(0).(func(string) *sqldb.Event); ok {
+		r0 = rf(uid)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*sqldb.Event)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(uid)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IsEnabled provides a mock function with given fields:
+func (_m *EventHistory) IsEnabled() bool {
+	ret := _m.Called()
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
+// ListEvents provides a mock function with given fields: namespace, undelivered, limit
+func (_m *EventHistory) ListEvents(namespace string, undelivered bool, limit int) ([]sqldb.Event, error) {
+	ret := _m.Called(namespace, undelivered, limit)
+
+	var r0 []sqldb.Event
+	if rf, ok := ret.Get(0).(func(string, bool, int) []sqldb.Event); ok {
+		r0 = rf(namespace, undelivered, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]sqldb.Event)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, bool, int) error); ok {
+		r1 = rf(namespace, undelivered, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RecordDispatches provides a mock function with given fields: uid, dispatches
+func (_m *EventHistory) RecordDispatches(uid string, dispatches []sqldb.EventDispatchRecord) error {
+	ret := _m.Called(uid, dispatches)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, []sqldb.EventDispatchRecord) error); ok {
+		r0 = rf(uid, dispatches)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RecordEvent provides a mock function with given fields: event
+func (_m *EventHistory) RecordEvent(event *sqldb.EventRecord) error {
+	ret := _m.Called(event)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*sqldb.EventRecord) error); ok {
+		r0 = rf(event)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UpdateEventStatus provides a mock function with given fields: uid, from, to
+func (_m *EventHistory) UpdateEventStatus(uid string, from sqldb.EventStatus, to sqldb.EventStatus) (bool, error) {
+	ret := _m.Called(uid, from, to)
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func(string, sqldb.EventStatus, sqldb.EventStatus) bool); ok {
+		r0 = rf(uid, from, to)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, sqldb.EventStatus, sqldb.EventStatus) error); ok {
+		r1 = rf(uid, from, to)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
@@ -0,0 +1,121 @@
+// Code generated by mockery v1.1.1. DO NOT EDIT.
+
+package mocks
+
+import (
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
+
+// SemaphoreRepository is an autogenerated mock type for the SemaphoreRepository type
+type SemaphoreRepository struct {
+	mock.Mock
+}
+
+// GetLimit provides a mock function with given fields: name
+func (_m *SemaphoreRepository) GetLimit(name string) (int, error) {
+	ret := _m.Called(name)
+
+	var r0 int
+	if rf, ok := ret.Get(0).(func(string) int); ok {
+		r0 = rf(name)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(name)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Heartbeat provides a mock function with given fields: holders, since
+func (_m *SemaphoreRepository) Heartbeat(holders map[string][]string, since time.Time) error {
+	ret := _m.Called(holders, since)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(map[string][]string, time.Time) error); ok {
+		r0 = rf(holders, since)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// IsEnabled provides a mock function with given fields:
+func (_m *SemaphoreRepository) IsEnabled() bool {
+	ret := _m.Called()
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
+// ListHolders provides a mock function with given fields: name
+func (_m *SemaphoreRepository) ListHolders(name string) ([]string, error) {
+	ret := _m.Called(name)
+
+	var r0 []string
+	if rf, ok := ret.Get(0).(func(string) []string); ok {
+		r0 = rf(name)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(name)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Release provides a mock function with given fields: name, holderKey
+func (_m *SemaphoreRepository) Release(name string, holderKey string) error {
+	ret := _m.Called(name, holderKey)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, string) error); ok {
+		r0 = rf(name, holderKey)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// TryAcquire provides a mock function with given fields: name, holderKey
+func (_m *SemaphoreRepository) TryAcquire(name string, holderKey string) (bool, error) {
+	ret := _m.Called(name, holderKey)
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func(string, string) bool); ok {
+		r0 = rf(name, holderKey)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, string) error); ok {
+		r1 = rf(name, holderKey)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
@@ -3,8 +3,8 @@
 package mocks
 
 import (
+	sqldb "github.com/argoproj/argo/v2/persist/sqldb"
 	mock "github.com/stretchr/testify/mock"
-	labels "k8s.io/apimachinery/pkg/labels"
 
 	time "time"
 
@@ -30,6 +30,27 @@ func (_m *WorkflowArchive) ArchiveWorkflow(wf *v1alpha1.Workflow) error {
 	return r0
 }
 
+// CountWorkflows provides a mock function with given fields: options
+func (_m *WorkflowArchive) CountWorkflows(options sqldb.ListOptions) (int64, error) {
+	ret := _m.Called(options)
+
+	var r0 int64
+	if rf, ok := ret.Get(0).(func(sqldb.ListOptions) int64); ok {
+		r0 = rf(options)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(sqldb.ListOptions) error); ok {
+		r1 = rf(options)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // DeleteExpiredWorkflows provides a mock function with given fields: ttl
 func (_m *WorkflowArchive) DeleteExpiredWorkflows(ttl time.Duration) error {
 	ret := _m.Called(ttl)
@@ -81,6 +102,29 @@ func (_m *WorkflowArchive) GetWorkflow(uid string) (*v1alpha1.Workflow, error) {
 	return r0, r1
 }
 
+// GetWorkflowStats provides a mock function with given fields: options
+func (_m *WorkflowArchive) GetWorkflowStats(options sqldb.StatsOptions) (*sqldb.WorkflowStats, error) {
+	ret := _m.Called(options)
+
+	var r0 *sqldb.WorkflowStats
+	if rf, ok := ret.Get(0).(func(sqldb.StatsOptions) *sqldb.WorkflowStats); ok {
+		r0 = rf(options)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*sqldb.WorkflowStats)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(sqldb.StatsOptions) error); ok {
+		r1 = rf(options)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // IsEnabled provides a mock function with given fields:
 func (_m *WorkflowArchive) IsEnabled() bool {
 	ret := _m.Called()
@@ -95,13 +139,13 @@ func (_m *WorkflowArchive) IsEnabled() bool {
 	return r0
 }
 
-// ListWorkflows provides a mock function with given fields: namespace, minStartAt, maxStartAt, labelRequirements, limit, offset
-func (_m *WorkflowArchive) ListWorkflows(namespace string, minStartAt time.Time, maxStartAt time.Time, labelRequirements labels.Requirements, limit int, offset int) (v1alpha1.Workflows, error) {
-	ret := _m.Called(namespace, minStartAt, maxStartAt, labelRequirements, limit, offset)
+// ListWorkflows provides a mock function with given fields: options
+func (_m *WorkflowArchive) ListWorkflows(options sqldb.ListOptions) (v1alpha1.Workflows, error) {
+	ret := _m.Called(options)
 
 	var r0 v1alpha1.Workflows
-	if rf, ok := ret.Get(0).(func(string, time.Time, time.Time, labels.Requirements, int, int) v1alpha1.Workflows); ok {
-		r0 = rf(namespace, minStartAt, maxStartAt, labelRequirements, limit, offset)
+	if rf, ok := ret.Get(0).(func(sqldb.ListOptions) v1alpha1.Workflows); ok {
+		r0 = rf(options)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(v1alpha1.Workflows)
@@ -109,8 +153,8 @@ func (_m *WorkflowArchive) ListWorkflows(namespace string, minStartAt time.Time,
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(string, time.Time, time.Time, labels.Requirements, int, int) error); ok {
-		r1 = rf(namespace, minStartAt, maxStartAt, labelRequirements, limit, offset)
+	if rf, ok := ret.Get(1).(func(sqldb.ListOptions) error); ok {
+		r1 = rf(options)
 	} else {
 		r1 = ret.Error(1)
 	}
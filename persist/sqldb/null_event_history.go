@@ -0,0 +1,39 @@
+package sqldb
+
+import (
+	"fmt"
+	"time"
+)
+
+var NullEventHistory EventHistory = &nullEventHistory{}
+
+type nullEventHistory struct {
+}
+
+func (r *nullEventHistory) IsEnabled() bool {
+	return false
+}
+
+func (r *nullEventHistory) RecordEvent(*EventRecord) error {
+	return nil
+}
+
+func (r *nullEventHistory) UpdateEventStatus(string, EventStatus, EventStatus) (bool, error) {
+	return false, fmt.Errorf("event history not supported")
+}
+
+func (r *nullEventHistory) RecordDispatches(string, []EventDispatchRecord) error {
+	return nil
+}
+
+func (r *nullEventHistory) ListEvents(string, bool, int) ([]Event, error) {
+	return nil, fmt.Errorf("event history not supported")
+}
+
+func (r *nullEventHistory) GetEvent(string) (*Event, error) {
+	return nil, fmt.Errorf("event history not supported")
+}
+
+func (r *nullEventHistory) DeleteExpiredEvents(time.Duration) error {
+	return nil
+}
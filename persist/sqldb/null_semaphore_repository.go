@@ -0,0 +1,35 @@
+package sqldb
+
+import (
+	"fmt"
+	"time"
+)
+
+var NullSemaphoreRepository SemaphoreRepository = &nullSemaphoreRepository{}
+
+type nullSemaphoreRepository struct {
+}
+
+func (r *nullSemaphoreRepository) IsEnabled() bool {
+	return false
+}
+
+func (r *nullSemaphoreRepository) GetLimit(string) (int, error) {
+	return 0, fmt.Errorf("database semaphores not supported")
+}
+
+func (r *nullSemaphoreRepository) TryAcquire(string, string) (bool, error) {
+	return false, fmt.Errorf("database semaphores not supported")
+}
+
+func (r *nullSemaphoreRepository) Release(string, string) error {
+	return nil
+}
+
+func (r *nullSemaphoreRepository) ListHolders(string) ([]string, error) {
+	return nil, nil
+}
+
+func (r *nullSemaphoreRepository) Heartbeat(map[string][]string, time.Time) error {
+	return nil
+}
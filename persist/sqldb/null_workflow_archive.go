@@ -4,8 +4,6 @@ import (
 	"fmt"
 	"time"
 
-	"k8s.io/apimachinery/pkg/labels"
-
 	wfv1 "github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1"
 )
 
@@ -22,10 +20,18 @@ func (r *nullWorkflowArchive) ArchiveWorkflow(*wfv1.Workflow) error {
 	return nil
 }
 
-func (r *nullWorkflowArchive) ListWorkflows(string, time.Time, time.Time, labels.Requirements, int, int) (wfv1.Workflows, error) {
+func (r *nullWorkflowArchive) ListWorkflows(ListOptions) (wfv1.Workflows, error) {
 	return wfv1.Workflows{}, nil
 }
 
+func (r *nullWorkflowArchive) CountWorkflows(ListOptions) (int64, error) {
+	return 0, nil
+}
+
+func (r *nullWorkflowArchive) GetWorkflowStats(StatsOptions) (*WorkflowStats, error) {
+	return &WorkflowStats{}, nil
+}
+
 func (r *nullWorkflowArchive) GetWorkflow(string) (*wfv1.Workflow, error) {
 	return nil, fmt.Errorf("getting archived workflows not supported")
 }
@@ -0,0 +1,218 @@
+package sqldb
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"upper.io/db.v3"
+	"upper.io/db.v3/lib/sqlbuilder"
+
+	"github.com/argoproj/argo/v2/util/instanceid"
+)
+
+const semaphoresTableName = "argo_semaphores"
+const semaphoreHoldersTableName = "argo_semaphore_holders"
+
+// SemaphoreRecord is the limit of a semaphore. Semaphores are not scoped by cluster or instance ID, so that they can be
+// shared by several controllers.
+type SemaphoreRecord struct {
+	Name  string `db:"name"`
+	Limit int    `db:"sizelimit"`
+}
+
+type SemaphoreHolderRecord struct {
+	Name        string    `db:"name"`
+	ClusterName string    `db:"clustername"`
+	InstanceID  string    `db:"instanceid"`
+	HolderKey   string    `db:"holderkey"`
+	HeartbeatAt time.Time `db:"heartbeatat"`
+}
+
+//go:generate mockery -name SemaphoreRepository
+
+type SemaphoreRepository interface {
+	IsEnabled() bool
+	// GetLimit returns the limit of the semaphore, or an error if the semaphore does not exist
+	GetLimit(name string) (int, error)
+	// TryAcquire makes the holder a holder of the semaphore, unless the semaphore already has as many unexpired holders
+	// as its limit. It is true if the holder is already holding the semaphore.
+	TryAcquire(name, holderKey string) (bool, error)
+	// Release is a no-op if the holder is not holding the semaphore
+	Release(name, holderKey string) error
+	// ListHolders lists the keys of the holders of the semaphore from this controller
+	ListHolders(name string) ([]string, error)
+	// Heartbeat refreshes the holders from this controller that are listed in holders, by semaphore name, and releases
+	// the other holders from this controller that have not been refreshed since `since`, e.g. holders leaked by a failed
+	// release, as well as the holders from any controller that have not been refreshed within the expiry
+	Heartbeat(holders map[string][]string, since time.Time) error
+}
+
+type semaphoreRepository struct {
+	session           sqlbuilder.Database
+	clusterName       string
+	instanceIDService instanceid.Service
+	expiry            time.Duration
+}
+
+// NewSemaphoreRepository returns a new semaphoreRepository, whose holders expire if they are not refreshed within the expiry
+func NewSemaphoreRepository(session sqlbuilder.Database, clusterName string, instanceIDService instanceid.Service, expiry time.Duration) SemaphoreRepository {
+	return &semaphoreRepository{session: session, clusterName: clusterName, instanceIDService: instanceIDService, expiry: expiry}
+}
+
+func (r *semaphoreRepository) IsEnabled() bool {
+	return true
+}
+
+func (r *semaphoreRepository) holder(name, holderKey string) db.Cond {
+	return db.Cond{"name": name, "clustername": r.clusterName, "instanceid": r.instanceIDService.InstanceID(), "holderkey": holderKey}
+}
+
+func (r *semaphoreRepository) getLimit(sess sqlbuilder.SQLBuilder, name string, forUpdate bool) (int, error) {
+	record := &SemaphoreRecord{}
+	query := sess.
+		Select("name", "sizelimit").
+		From(semaphoresTableName).
+		Where(db.Cond{"name": name})
+	if forUpdate {
+		// lock the semaphore's row, so that only one controller at a time can count and add its holders
+		query = query.Amend(func(queryIn string) string { return queryIn + " for update" })
+	}
+	err := query.One(record)
+	if err == db.ErrNoMoreRows {
+		return 0, fmt.Errorf("semaphore \"%s\" not found in table %s", name, semaphoresTableName)
+	}
+	return record.Limit, err
+}
+
+func (r *semaphoreRepository) GetLimit(name string) (int, error) {
+	return r.getLimit(r.session, name, false)
+}
+
+func (r *semaphoreRepository) TryAcquire(name, holderKey string) (bool, error) {
+	acquired := false
+	err := r.session.Tx(context.Background(), func(sess sqlbuilder.Tx) error {
+		limit, err := r.getLimit(sess, name, true)
+		if err != nil {
+			return err
+		}
+		now := time.Now().UTC()
+		res, err := sess.
+			Update(semaphoreHoldersTableName).
+			Set("heartbeatat", now).
+			Where(r.holder(name, holderKey)).
+			Exec()
+		if err != nil {
+			return err
+		}
+		rowsAffected, err := res.RowsAffected()
+		if err != nil {
+			return err
+		}
+		if rowsAffected > 0 {
+			acquired = true
+			return nil
+		}
+		holders := &struct {
+			Total int64 `db:"total"`
+		}{}
+		err = sess.
+			Select(db.Raw("count(*) as total")).
+			From(semaphoreHoldersTableName).
+			Where(db.Cond{"name": name, "heartbeatat >": now.Add(-r.expiry)}).
+			One(holders)
+		if err != nil {
+			return err
+		}
+		if holders.Total >= int64(limit) {
+			return nil
+		}
+		_, err = sess.Collection(semaphoreHoldersTableName).Insert(&SemaphoreHolderRecord{
+			Name:        name,
+			ClusterName: r.clusterName,
+			InstanceID:  r.instanceIDService.InstanceID(),
+			HolderKey:   holderKey,
+			HeartbeatAt: now,
+		})
+		acquired = err == nil
+		return err
+	})
+	return acquired, err
+}
+
+func (r *semaphoreRepository) Release(name, holderKey string) error {
+	_, err := r.session.
+		DeleteFrom(semaphoreHoldersTableName).
+		Where(r.holder(name, holderKey)).
+		Exec()
+	return err
+}
+
+func (r *semaphoreRepository) ListHolders(name string) ([]string, error) {
+	var records []SemaphoreHolderRecord
+	err := r.session.
+		Select("holderkey").
+		From(semaphoreHoldersTableName).
+		Where(db.Cond{"name": name, "clustername": r.clusterName, "instanceid": r.instanceIDService.InstanceID()}).
+		OrderBy("holderkey").
+		All(&records)
+	if err != nil {
+		return nil, err
+	}
+	holderKeys := make([]string, len(records))
+	for i, record := range records {
+		holderKeys[i] = record.HolderKey
+	}
+	return holderKeys, nil
+}
+
+func (r *semaphoreRepository) Heartbeat(holders map[string][]string, since time.Time) error {
+	held := make(map[string]bool)
+	for name, holderKeys := range holders {
+		for _, holderKey := range holderKeys {
+			held[name+"/"+holderKey] = true
+		}
+	}
+	now := time.Now().UTC()
+	return r.session.Tx(context.Background(), func(sess sqlbuilder.Tx) error {
+		var records []SemaphoreHolderRecord
+		err := sess.
+			Select("name", "holderkey").
+			From(semaphoreHoldersTableName).
+			Where(db.Cond{"clustername": r.clusterName, "instanceid": r.instanceIDService.InstanceID()}).
+			All(&records)
+		if err != nil {
+			return fmt.Errorf("failed to list semaphore holders: %w", err)
+		}
+		for _, record := range records {
+			if held[record.Name+"/"+record.HolderKey] {
+				_, err = sess.
+					Update(semaphoreHoldersTableName).
+					Set("heartbeatat", now).
+					Where(r.holder(record.Name, record.HolderKey)).
+					Exec()
+				if err != nil {
+					return fmt.Errorf("failed to refresh semaphore holder %s: %w", record.HolderKey, err)
+				}
+				continue
+			}
+			// a holder acquired since the holders were listed is not listed, but it has been refreshed since then
+			_, err = sess.
+				DeleteFrom(semaphoreHoldersTableName).
+				Where(r.holder(record.Name, record.HolderKey)).
+				And(db.Cond{"heartbeatat <": since.UTC()}).
+				Exec()
+			if err != nil {
+				return fmt.Errorf("failed to release semaphore holder %s: %w", record.HolderKey, err)
+			}
+		}
+		_, err = sess.
+			DeleteFrom(semaphoreHoldersTableName).
+			Where(db.Cond{"heartbeatat <": now.Add(-r.expiry)}).
+			Exec()
+		if err != nil {
+			return fmt.Errorf("failed to release expired semaphore holders: %w", err)
+		}
+		return nil
+	})
+}
@@ -8,7 +8,6 @@ import (
 
 	log "github.com/sirupsen/logrus"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/types"
 	"upper.io/db.v3"
 	"upper.io/db.v3/lib/sqlbuilder"
@@ -33,7 +32,8 @@ type archivedWorkflowMetadata struct {
 
 type archivedWorkflowRecord struct {
 	archivedWorkflowMetadata
-	Workflow string `db:"workflow"`
+	WorkflowTemplateName string `db:"workflowtemplatename"`
+	Workflow             string `db:"workflow"`
 }
 
 type archivedWorkflowLabelRecord struct {
@@ -48,8 +48,12 @@ type archivedWorkflowLabelRecord struct {
 
 type WorkflowArchive interface {
 	ArchiveWorkflow(wf *wfv1.Workflow) error
-	// list workflows, with the most recently started workflows at the beginning (i.e. index 0 is the most recent)
-	ListWorkflows(namespace string, minStartAt, maxStartAt time.Time, labelRequirements labels.Requirements, limit, offset int) (wfv1.Workflows, error)
+	// list workflows, by default with the most recently started workflows at the beginning (i.e. index 0 is the most recent)
+	ListWorkflows(options ListOptions) (wfv1.Workflows, error)
+	// count the workflows matching the options, ignoring their limit and offset
+	CountWorkflows(options ListOptions) (int64, error)
+	// aggregate statistics of the workflows matching the options
+	GetWorkflowStats(options StatsOptions) (*WorkflowStats, error)
 	GetWorkflow(uid string) (*wfv1.Workflow, error)
 	DeleteWorkflow(uid string) error
 	DeleteExpiredWorkflows(ttl time.Duration) error
@@ -101,7 +105,8 @@ func (r *workflowArchive) ArchiveWorkflow(wf *wfv1.Workflow) error {
 					StartedAt:   wf.Status.StartedAt.Time,
 					FinishedAt:  wf.Status.FinishedAt.Time,
 				},
-				Workflow: string(workflow),
+				WorkflowTemplateName: workflowTemplateName(wf),
+				Workflow:             string(workflow),
 			})
 		if err != nil {
 			return err
@@ -124,15 +129,28 @@ func (r *workflowArchive) ArchiveWorkflow(wf *wfv1.Workflow) error {
 	})
 }
 
-func (r *workflowArchive) ListWorkflows(namespace string, minStartedAt, maxStartedAt time.Time, labelRequirements labels.Requirements, limit int, offset int) (wfv1.Workflows, error) {
+// workflowTemplateName returns the name of the (cluster) workflow template the workflow references, if any
+func workflowTemplateName(wf *wfv1.Workflow) string {
+	if wf.Spec.WorkflowTemplateRef != nil {
+		return wf.Spec.WorkflowTemplateRef.Name
+	}
+	return ""
+}
+
+func (r *workflowArchive) ListWorkflows(options ListOptions) (wfv1.Workflows, error) {
 	var archivedWfs []archivedWorkflowMetadata
-	clause, err := labelsClause(r.dbType, labelRequirements)
+	clause, err := options.clause(r.dbType)
+	if err != nil {
+		return nil, err
+	}
+	order, err := orderBy(options.SortBy)
 	if err != nil {
 		return nil, err
 	}
 
 	// If we were passed 0 as the limit, then we should load all available archived workflows
 	// to match the behavior of the `List` operations in the Kubernetes API
+	limit, offset := options.Limit, options.Offset
 	if limit == 0 {
 		limit = -1
 		offset = -1
@@ -142,10 +160,8 @@ func (r *workflowArchive) ListWorkflows(namespace string, minStartedAt, maxStart
 		Select("name", "namespace", "uid", "phase", "startedat", "finishedat").
 		From(archiveTableName).
 		Where(r.clusterManagedNamespaceAndInstanceID()).
-		And(namespaceEqual(namespace)).
-		And(startedAtClause(minStartedAt, maxStartedAt)).
 		And(clause).
-		OrderBy("-startedat").
+		OrderBy(order...).
 		Limit(limit).
 		Offset(offset).
 		All(&archivedWfs)
@@ -171,6 +187,26 @@ func (r *workflowArchive) ListWorkflows(namespace string, minStartedAt, maxStart
 	return wfs, nil
 }
 
+func (r *workflowArchive) CountWorkflows(options ListOptions) (int64, error) {
+	clause, err := options.clause(r.dbType)
+	if err != nil {
+		return 0, err
+	}
+	total := &struct {
+		Total int64 `db:"total"`
+	}{}
+	err = r.session.
+		Select(db.Raw("count(*) as total")).
+		From(archiveTableName).
+		Where(r.clusterManagedNamespaceAndInstanceID()).
+		And(clause).
+		One(total)
+	if err != nil {
+		return 0, err
+	}
+	return total.Total, nil
+}
+
 func (r *workflowArchive) clusterManagedNamespaceAndInstanceID() db.Compound {
 	return db.And(
 		db.Cond{"clustername": r.clusterName},
@@ -179,17 +215,6 @@ func (r *workflowArchive) clusterManagedNamespaceAndInstanceID() db.Compound {
 	)
 }
 
-func startedAtClause(from, to time.Time) db.Compound {
-	var conds []db.Compound
-	if !from.IsZero() {
-		conds = append(conds, db.Cond{"startedat > ": from})
-	}
-	if !to.IsZero() {
-		conds = append(conds, db.Cond{"startedat < ": to})
-	}
-	return db.And(conds...)
-}
-
 func namespaceEqual(namespace string) db.Cond {
 	if namespace == "" {
 		return db.Cond{}
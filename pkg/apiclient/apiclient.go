@@ -9,7 +9,9 @@ import (
 
 	clusterworkflowtmplpkg "github.com/argoproj/argo/v2/pkg/apiclient/clusterworkflowtemplate"
 	cronworkflowpkg "github.com/argoproj/argo/v2/pkg/apiclient/cronworkflow"
+	eventpkg "github.com/argoproj/argo/v2/pkg/apiclient/event"
 	infopkg "github.com/argoproj/argo/v2/pkg/apiclient/info"
+	syncpkg "github.com/argoproj/argo/v2/pkg/apiclient/sync"
 	workflowpkg "github.com/argoproj/argo/v2/pkg/apiclient/workflow"
 	workflowarchivepkg "github.com/argoproj/argo/v2/pkg/apiclient/workflowarchive"
 	workflowtemplatepkg "github.com/argoproj/argo/v2/pkg/apiclient/workflowtemplate"
@@ -23,6 +25,8 @@ type Client interface {
 	NewWorkflowTemplateServiceClient() workflowtemplatepkg.WorkflowTemplateServiceClient
 	NewClusterWorkflowTemplateServiceClient() clusterworkflowtmplpkg.ClusterWorkflowTemplateServiceClient
 	NewInfoServiceClient() (infopkg.InfoServiceClient, error)
+	NewEventServiceClient() (eventpkg.EventServiceClient, error)
+	NewSyncServiceClient() (syncpkg.SyncServiceClient, error)
 }
 
 type Opts struct {
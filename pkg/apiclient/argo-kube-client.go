@@ -12,7 +12,9 @@ import (
 	"github.com/argoproj/argo/v2/persist/sqldb"
 	"github.com/argoproj/argo/v2/pkg/apiclient/clusterworkflowtemplate"
 	"github.com/argoproj/argo/v2/pkg/apiclient/cronworkflow"
+	eventpkg "github.com/argoproj/argo/v2/pkg/apiclient/event"
 	infopkg "github.com/argoproj/argo/v2/pkg/apiclient/info"
+	syncpkg "github.com/argoproj/argo/v2/pkg/apiclient/sync"
 	workflowpkg "github.com/argoproj/argo/v2/pkg/apiclient/workflow"
 	workflowarchivepkg "github.com/argoproj/argo/v2/pkg/apiclient/workflowarchive"
 	"github.com/argoproj/argo/v2/pkg/apiclient/workflowtemplate"
@@ -87,6 +89,14 @@ func (a *argoKubeClient) NewInfoServiceClient() (infopkg.InfoServiceClient, erro
 	return nil, NoArgoServerErr
 }
 
+func (a *argoKubeClient) NewEventServiceClient() (eventpkg.EventServiceClient, error) {
+	return nil, NoArgoServerErr
+}
+
+func (a *argoKubeClient) NewSyncServiceClient() (syncpkg.SyncServiceClient, error) {
+	return nil, NoArgoServerErr
+}
+
 func (a *argoKubeClient) NewClusterWorkflowTemplateServiceClient() clusterworkflowtemplate.ClusterWorkflowTemplateServiceClient {
 	return &errorTranslatingWorkflowClusterTemplateServiceClient{&argoKubeWorkflowClusterTemplateServiceClient{clusterworkflowtmplserver.NewClusterWorkflowTemplateServer(a.instanceIDService)}}
 }
@@ -10,7 +10,9 @@ import (
 
 	clusterworkflowtmplpkg "github.com/argoproj/argo/v2/pkg/apiclient/clusterworkflowtemplate"
 	cronworkflowpkg "github.com/argoproj/argo/v2/pkg/apiclient/cronworkflow"
+	eventpkg "github.com/argoproj/argo/v2/pkg/apiclient/event"
 	infopkg "github.com/argoproj/argo/v2/pkg/apiclient/info"
+	syncpkg "github.com/argoproj/argo/v2/pkg/apiclient/sync"
 	workflowpkg "github.com/argoproj/argo/v2/pkg/apiclient/workflow"
 	workflowarchivepkg "github.com/argoproj/argo/v2/pkg/apiclient/workflowarchive"
 	workflowtemplatepkg "github.com/argoproj/argo/v2/pkg/apiclient/workflowtemplate"
@@ -57,6 +59,14 @@ func (a *argoServerClient) NewInfoServiceClient() (infopkg.InfoServiceClient, er
 	return infopkg.NewInfoServiceClient(a.ClientConn), nil
 }
 
+func (a *argoServerClient) NewEventServiceClient() (eventpkg.EventServiceClient, error) {
+	return eventpkg.NewEventServiceClient(a.ClientConn), nil
+}
+
+func (a *argoServerClient) NewSyncServiceClient() (syncpkg.SyncServiceClient, error) {
+	return syncpkg.NewSyncServiceClient(a.ClientConn), nil
+}
+
 func newClientConn(opts ArgoServerOpts) (*grpc.ClientConn, error) {
 	creds := grpc.WithInsecure()
 	if opts.Secure {
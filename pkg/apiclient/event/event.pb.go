@@ -194,46 +194,482 @@ func (m *ListWorkflowEventBindingsRequest) GetListOptions() *v1.ListOptions {
 	return nil
 }
 
+// EventDelivery is a received event and the outcome of dispatching it to each binding. Only available when persistence is enabled.
+type EventDelivery struct {
+	Uid           string   `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	Namespace     string   `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Discriminator string   `protobuf:"bytes,3,opt,name=discriminator,proto3" json:"discriminator,omitempty"`
+	ReceivedAt    *v1.Time `protobuf:"bytes,4,opt,name=receivedAt,proto3" json:"receivedAt,omitempty"`
+	// Pending, Dispatched or Failed
+	Status               string           `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
+	Payload              *v1alpha1.Item   `protobuf:"bytes,6,opt,name=payload,proto3" json:"payload,omitempty"`
+	Dispatches           []*EventDispatch `protobuf:"bytes,7,rep,name=dispatches,proto3" json:"dispatches,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *EventDelivery) Reset()         { *m = EventDelivery{} }
+func (m *EventDelivery) String() string { return proto.CompactTextString(m) }
+func (*EventDelivery) ProtoMessage()    {}
+func (*EventDelivery) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d80a0d2509a47d1c, []int{3}
+}
+func (m *EventDelivery) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventDelivery) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventDelivery.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventDelivery) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventDelivery.Merge(m, src)
+}
+func (m *EventDelivery) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventDelivery) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventDelivery.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventDelivery proto.InternalMessageInfo
+
+func (m *EventDelivery) GetUid() string {
+	if m != nil {
+		return m.Uid
+	}
+	return ""
+}
+
+func (m *EventDelivery) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
+func (m *EventDelivery) GetDiscriminator() string {
+	if m != nil {
+		return m.Discriminator
+	}
+	return ""
+}
+
+func (m *EventDelivery) GetReceivedAt() *v1.Time {
+	if m != nil {
+		return m.ReceivedAt
+	}
+	return nil
+}
+
+func (m *EventDelivery) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+func (m *EventDelivery) GetPayload() *v1alpha1.Item {
+	if m != nil {
+		return m.Payload
+	}
+	return nil
+}
+
+func (m *EventDelivery) GetDispatches() []*EventDispatch {
+	if m != nil {
+		return m.Dispatches
+	}
+	return nil
+}
+
+// EventDispatch is the outcome of the most recent dispatch of an event to a binding.
+type EventDispatch struct {
+	BindingNamespace string `protobuf:"bytes,1,opt,name=bindingNamespace,proto3" json:"bindingNamespace,omitempty"`
+	Binding          string `protobuf:"bytes,2,opt,name=binding,proto3" json:"binding,omitempty"`
+	// Whether the binding's selector matched the event.
+	Matched bool `protobuf:"varint,3,opt,name=matched,proto3" json:"matched,omitempty"`
+	// The names of the workflows the binding submitted, resumed, stopped or terminated, comma separated.
+	WorkflowName         string   `protobuf:"bytes,4,opt,name=workflowName,proto3" json:"workflowName,omitempty"`
+	Error                string   `protobuf:"bytes,5,opt,name=error,proto3" json:"error,omitempty"`
+	DispatchedAt         *v1.Time `protobuf:"bytes,6,opt,name=dispatchedAt,proto3" json:"dispatchedAt,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *EventDispatch) Reset()         { *m = EventDispatch{} }
+func (m *EventDispatch) String() string { return proto.CompactTextString(m) }
+func (*EventDispatch) ProtoMessage()    {}
+func (*EventDispatch) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d80a0d2509a47d1c, []int{4}
+}
+func (m *EventDispatch) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventDispatch) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventDispatch.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventDispatch) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventDispatch.Merge(m, src)
+}
+func (m *EventDispatch) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventDispatch) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventDispatch.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventDispatch proto.InternalMessageInfo
+
+func (m *EventDispatch) GetBindingNamespace() string {
+	if m != nil {
+		return m.BindingNamespace
+	}
+	return ""
+}
+
+func (m *EventDispatch) GetBinding() string {
+	if m != nil {
+		return m.Binding
+	}
+	return ""
+}
+
+func (m *EventDispatch) GetMatched() bool {
+	if m != nil {
+		return m.Matched
+	}
+	return false
+}
+
+func (m *EventDispatch) GetWorkflowName() string {
+	if m != nil {
+		return m.WorkflowName
+	}
+	return ""
+}
+
+func (m *EventDispatch) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+func (m *EventDispatch) GetDispatchedAt() *v1.Time {
+	if m != nil {
+		return m.DispatchedAt
+	}
+	return nil
+}
+
+type EventDeliveryList struct {
+	Items                []*EventDelivery `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *EventDeliveryList) Reset()         { *m = EventDeliveryList{} }
+func (m *EventDeliveryList) String() string { return proto.CompactTextString(m) }
+func (*EventDeliveryList) ProtoMessage()    {}
+func (*EventDeliveryList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d80a0d2509a47d1c, []int{5}
+}
+func (m *EventDeliveryList) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventDeliveryList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventDeliveryList.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventDeliveryList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventDeliveryList.Merge(m, src)
+}
+func (m *EventDeliveryList) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventDeliveryList) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventDeliveryList.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventDeliveryList proto.InternalMessageInfo
+
+func (m *EventDeliveryList) GetItems() []*EventDelivery {
+	if m != nil {
+		return m.Items
+	}
+	return nil
+}
+
+type ListEventDeliveriesRequest struct {
+	Namespace string `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	// Only list the events that are pending or failed.
+	Undelivered          bool     `protobuf:"varint,2,opt,name=undelivered,proto3" json:"undelivered,omitempty"`
+	Limit                int64    `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListEventDeliveriesRequest) Reset()         { *m = ListEventDeliveriesRequest{} }
+func (m *ListEventDeliveriesRequest) String() string { return proto.CompactTextString(m) }
+func (*ListEventDeliveriesRequest) ProtoMessage()    {}
+func (*ListEventDeliveriesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d80a0d2509a47d1c, []int{6}
+}
+func (m *ListEventDeliveriesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ListEventDeliveriesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ListEventDeliveriesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ListEventDeliveriesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListEventDeliveriesRequest.Merge(m, src)
+}
+func (m *ListEventDeliveriesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ListEventDeliveriesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListEventDeliveriesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListEventDeliveriesRequest proto.InternalMessageInfo
+
+func (m *ListEventDeliveriesRequest) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
+func (m *ListEventDeliveriesRequest) GetUndelivered() bool {
+	if m != nil {
+		return m.Undelivered
+	}
+	return false
+}
+
+func (m *ListEventDeliveriesRequest) GetLimit() int64 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+type GetEventDeliveryRequest struct {
+	Namespace            string   `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Uid                  string   `protobuf:"bytes,2,opt,name=uid,proto3" json:"uid,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetEventDeliveryRequest) Reset()         { *m = GetEventDeliveryRequest{} }
+func (m *GetEventDeliveryRequest) String() string { return proto.CompactTextString(m) }
+func (*GetEventDeliveryRequest) ProtoMessage()    {}
+func (*GetEventDeliveryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d80a0d2509a47d1c, []int{7}
+}
+func (m *GetEventDeliveryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetEventDeliveryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetEventDeliveryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetEventDeliveryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetEventDeliveryRequest.Merge(m, src)
+}
+func (m *GetEventDeliveryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetEventDeliveryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetEventDeliveryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetEventDeliveryRequest proto.InternalMessageInfo
+
+func (m *GetEventDeliveryRequest) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
+func (m *GetEventDeliveryRequest) GetUid() string {
+	if m != nil {
+		return m.Uid
+	}
+	return ""
+}
+
+type RetryEventDeliveryRequest struct {
+	Namespace            string   `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Uid                  string   `protobuf:"bytes,2,opt,name=uid,proto3" json:"uid,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RetryEventDeliveryRequest) Reset()         { *m = RetryEventDeliveryRequest{} }
+func (m *RetryEventDeliveryRequest) String() string { return proto.CompactTextString(m) }
+func (*RetryEventDeliveryRequest) ProtoMessage()    {}
+func (*RetryEventDeliveryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d80a0d2509a47d1c, []int{8}
+}
+func (m *RetryEventDeliveryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RetryEventDeliveryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RetryEventDeliveryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RetryEventDeliveryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RetryEventDeliveryRequest.Merge(m, src)
+}
+func (m *RetryEventDeliveryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *RetryEventDeliveryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RetryEventDeliveryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RetryEventDeliveryRequest proto.InternalMessageInfo
+
+func (m *RetryEventDeliveryRequest) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
+func (m *RetryEventDeliveryRequest) GetUid() string {
+	if m != nil {
+		return m.Uid
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*EventRequest)(nil), "event.EventRequest")
 	proto.RegisterType((*EventResponse)(nil), "event.EventResponse")
 	proto.RegisterType((*ListWorkflowEventBindingsRequest)(nil), "event.ListWorkflowEventBindingsRequest")
+	proto.RegisterType((*EventDelivery)(nil), "event.EventDelivery")
+	proto.RegisterType((*EventDispatch)(nil), "event.EventDispatch")
+	proto.RegisterType((*EventDeliveryList)(nil), "event.EventDeliveryList")
+	proto.RegisterType((*ListEventDeliveriesRequest)(nil), "event.ListEventDeliveriesRequest")
+	proto.RegisterType((*GetEventDeliveryRequest)(nil), "event.GetEventDeliveryRequest")
+	proto.RegisterType((*RetryEventDeliveryRequest)(nil), "event.RetryEventDeliveryRequest")
 }
 
 func init() { proto.RegisterFile("pkg/apiclient/event/event.proto", fileDescriptor_d80a0d2509a47d1c) }
 
 var fileDescriptor_d80a0d2509a47d1c = []byte{
-	// 476 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x93, 0xd1, 0x8a, 0x13, 0x31,
-	0x14, 0x86, 0x49, 0x45, 0x65, 0xd3, 0x5d, 0x84, 0xe8, 0x45, 0x2d, 0x4b, 0x2d, 0x83, 0xe0, 0xa2,
-	0x36, 0x61, 0xba, 0x0a, 0xb2, 0x7a, 0xb5, 0xe2, 0x85, 0xb0, 0xa0, 0xcc, 0x82, 0x82, 0x77, 0xe9,
-	0xf4, 0x38, 0x8d, 0x9d, 0x49, 0x62, 0x92, 0xcd, 0xb2, 0x2c, 0x7b, 0xe3, 0x2b, 0x88, 0x2f, 0xe1,
-	0x93, 0x08, 0xde, 0x08, 0xfa, 0x00, 0x52, 0x7c, 0x10, 0x99, 0x74, 0x66, 0x67, 0x8a, 0x4a, 0x65,
-	0x6f, 0x4a, 0x7a, 0x72, 0xce, 0xc9, 0xf7, 0xff, 0xe7, 0x0c, 0xbe, 0xa5, 0xe7, 0x19, 0xe3, 0x5a,
-	0xa4, 0xb9, 0x00, 0xe9, 0x18, 0xf8, 0xf3, 0x5f, 0xaa, 0x8d, 0x72, 0x8a, 0x5c, 0x0e, 0x7f, 0xfa,
-	0xdb, 0x99, 0x52, 0x59, 0x0e, 0x65, 0x2a, 0xe3, 0x52, 0x2a, 0xc7, 0x9d, 0x50, 0xd2, 0x2e, 0x93,
-	0xfa, 0x0f, 0xe6, 0x8f, 0x2c, 0x15, 0xaa, 0xbc, 0x2d, 0x78, 0x3a, 0x13, 0x12, 0xcc, 0x09, 0xab,
-	0x3a, 0x5b, 0x56, 0x80, 0xe3, 0xcc, 0xc7, 0x2c, 0x03, 0x09, 0x86, 0x3b, 0x98, 0x56, 0x55, 0x4f,
-	0x33, 0xe1, 0x66, 0x47, 0x13, 0x9a, 0xaa, 0x82, 0x71, 0x93, 0x29, 0x6d, 0xd4, 0xbb, 0x70, 0x68,
-	0x4a, 0x8f, 0x95, 0x99, 0xbf, 0xcd, 0xd5, 0x31, 0xf3, 0x31, 0xcf, 0xf5, 0x8c, 0xff, 0xd1, 0x24,
-	0xfa, 0x8c, 0xf0, 0xe6, 0xb3, 0x12, 0x31, 0x81, 0xf7, 0x47, 0x60, 0x1d, 0xd9, 0xc6, 0x1b, 0x92,
-	0x17, 0x60, 0x35, 0x4f, 0xa1, 0x87, 0x86, 0x68, 0x67, 0x23, 0x69, 0x02, 0xe4, 0x36, 0xde, 0x9a,
-	0x0a, 0x9b, 0x1a, 0x51, 0x08, 0xc9, 0x9d, 0x32, 0xbd, 0x4e, 0xc8, 0x58, 0x0d, 0x92, 0x57, 0xf8,
-	0xaa, 0xe6, 0x27, 0xb9, 0xe2, 0xd3, 0xde, 0xa5, 0x21, 0xda, 0xe9, 0x8e, 0x9f, 0xd0, 0x86, 0x95,
-	0xd6, 0xac, 0xe1, 0x40, 0xfd, 0x98, 0xea, 0x79, 0x46, 0x4b, 0x5c, 0x5a, 0xe3, 0xd2, 0x1a, 0x97,
-	0x3e, 0x77, 0x50, 0x24, 0x75, 0xb3, 0xe8, 0x1a, 0xde, 0xaa, 0x58, 0xad, 0x56, 0xd2, 0x42, 0xf4,
-	0x09, 0xe1, 0xe1, 0x81, 0xb0, 0xee, 0x75, 0x55, 0x18, 0x6e, 0xf7, 0x85, 0x9c, 0x0a, 0x99, 0xd9,
-	0xff, 0x53, 0x74, 0x88, 0xbb, 0xb9, 0xb0, 0xee, 0x85, 0x0e, 0x03, 0x09, 0x7a, 0xba, 0xe3, 0x98,
-	0x2e, 0x27, 0x42, 0xdb, 0x13, 0x69, 0x38, 0xcb, 0x89, 0x50, 0x1f, 0xd3, 0x83, 0xa6, 0x30, 0x69,
-	0x77, 0x19, 0xff, 0xe8, 0x54, 0xae, 0x1e, 0x82, 0xf1, 0x22, 0x05, 0xe2, 0xf1, 0x66, 0x02, 0x29,
-	0x08, 0x0f, 0x21, 0x4c, 0xae, 0xd3, 0xe5, 0x92, 0xb4, 0xad, 0xef, 0xdf, 0x58, 0x0d, 0x56, 0x1a,
-	0x1f, 0x7f, 0xf8, 0xfe, 0xeb, 0x63, 0xe7, 0x61, 0x74, 0x37, 0x2c, 0x8f, 0x8f, 0x97, 0xeb, 0x65,
-	0xd9, 0xe9, 0xb9, 0x86, 0x33, 0x76, 0xba, 0xe2, 0xff, 0xd9, 0x5e, 0xed, 0x18, 0xf9, 0x8a, 0xf0,
-	0xcd, 0x7f, 0x1a, 0x44, 0xee, 0x54, 0x0f, 0xae, 0xb3, 0xb0, 0xff, 0xf2, 0xa2, 0xf3, 0xfb, 0x5b,
-	0xd7, 0xf2, 0xb5, 0x68, 0x37, 0xa8, 0x1a, 0x91, 0x7b, 0xb5, 0xaa, 0xba, 0x76, 0x14, 0x90, 0x46,
-	0x93, 0x8a, 0xa0, 0x2d, 0x73, 0x7f, 0xef, 0xcb, 0x62, 0x80, 0xbe, 0x2d, 0x06, 0xe8, 0xe7, 0x62,
-	0x80, 0xde, 0xdc, 0x5f, 0xb7, 0xff, 0xed, 0x8f, 0x72, 0x72, 0x25, 0xec, 0xfb, 0xee, 0xef, 0x00,
-	0x00, 0x00, 0xff, 0xff, 0x52, 0x7d, 0x82, 0xa7, 0xb2, 0x03, 0x00, 0x00,
+	// 824 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0x4d, 0x6b, 0x3b, 0x45,
+	0x18, 0x67, 0x13, 0x93, 0xb6, 0x93, 0x14, 0xeb, 0xb4, 0xe8, 0x76, 0x29, 0x31, 0x2e, 0x45, 0x4b,
+	0x6c, 0x66, 0x4d, 0x5a, 0x41, 0xaa, 0x20, 0x56, 0x45, 0xaa, 0xa5, 0xca, 0x56, 0x14, 0xbc, 0x4d,
+	0x77, 0xc7, 0xcd, 0x98, 0xdd, 0x9d, 0x75, 0x66, 0xb2, 0x25, 0xd4, 0x82, 0x78, 0xf3, 0x2c, 0x7e,
+	0x09, 0x3f, 0x89, 0xe0, 0x45, 0xf0, 0xe0, 0x55, 0x8b, 0x5f, 0xc2, 0x9b, 0xec, 0xec, 0x6c, 0xb2,
+	0xdb, 0xa6, 0x24, 0xfe, 0xe9, 0x25, 0xec, 0x3c, 0xf3, 0xbc, 0xfc, 0x9e, 0xdf, 0xf3, 0x92, 0x01,
+	0x2f, 0x27, 0xe3, 0xc0, 0xc1, 0x09, 0xf5, 0x42, 0x4a, 0x62, 0xe9, 0x90, 0x74, 0xf6, 0x8b, 0x12,
+	0xce, 0x24, 0x83, 0x0d, 0x75, 0xb0, 0xf6, 0x02, 0xc6, 0x82, 0x90, 0x64, 0xaa, 0x0e, 0x8e, 0x63,
+	0x26, 0xb1, 0xa4, 0x2c, 0x16, 0xb9, 0x92, 0x75, 0x3c, 0x7e, 0x4b, 0x20, 0xca, 0xb2, 0xdb, 0x08,
+	0x7b, 0x23, 0x1a, 0x13, 0x3e, 0x75, 0xb4, 0x67, 0xe1, 0x44, 0x44, 0x62, 0x27, 0x1d, 0x38, 0x01,
+	0x89, 0x09, 0xc7, 0x92, 0xf8, 0xda, 0xea, 0xfd, 0x80, 0xca, 0xd1, 0xe4, 0x0a, 0x79, 0x2c, 0x72,
+	0x30, 0x0f, 0x58, 0xc2, 0xd9, 0x37, 0xea, 0x63, 0x6e, 0x7a, 0xcd, 0xf8, 0xf8, 0xeb, 0x90, 0x5d,
+	0x3b, 0xe9, 0x00, 0x87, 0xc9, 0x08, 0x3f, 0x70, 0x62, 0xff, 0x62, 0x80, 0xf6, 0x87, 0x19, 0x44,
+	0x97, 0x7c, 0x3b, 0x21, 0x42, 0xc2, 0x3d, 0xb0, 0x11, 0xe3, 0x88, 0x88, 0x04, 0x7b, 0xc4, 0x34,
+	0xba, 0xc6, 0xc1, 0x86, 0x3b, 0x17, 0xc0, 0x7d, 0xb0, 0xe9, 0x53, 0xe1, 0x71, 0x1a, 0xd1, 0x18,
+	0x4b, 0xc6, 0xcd, 0x9a, 0xd2, 0xa8, 0x0a, 0xe1, 0x17, 0x60, 0x2d, 0xc1, 0xd3, 0x90, 0x61, 0xdf,
+	0xac, 0x77, 0x8d, 0x83, 0xd6, 0xf0, 0x1d, 0x34, 0xc7, 0x8a, 0x0a, 0xac, 0xea, 0x03, 0xa5, 0x43,
+	0x94, 0x8c, 0x03, 0x94, 0xc1, 0x45, 0x05, 0x5c, 0x54, 0xc0, 0x45, 0x67, 0x92, 0x44, 0x6e, 0xe1,
+	0xcc, 0x7e, 0x1e, 0x6c, 0x6a, 0xac, 0x22, 0x61, 0xb1, 0x20, 0xf6, 0xcf, 0x06, 0xe8, 0x9e, 0x53,
+	0x21, 0xbf, 0xd4, 0x86, 0xea, 0xf6, 0x94, 0xc6, 0x3e, 0x8d, 0x03, 0xb1, 0x5a, 0x46, 0x97, 0xa0,
+	0x15, 0x52, 0x21, 0x3f, 0x4d, 0x54, 0x41, 0x54, 0x3e, 0xad, 0xe1, 0x00, 0xe5, 0x15, 0x41, 0xe5,
+	0x8a, 0xcc, 0x71, 0x66, 0x15, 0x41, 0xe9, 0x00, 0x9d, 0xcf, 0x0d, 0xdd, 0xb2, 0x17, 0xfb, 0xcf,
+	0x9a, 0x46, 0xfa, 0x01, 0x09, 0x69, 0x4a, 0xf8, 0x14, 0x6e, 0x81, 0xfa, 0x84, 0xfa, 0x3a, 0x7c,
+	0xf6, 0x59, 0x85, 0x55, 0x5b, 0x4a, 0x74, 0x7d, 0x11, 0xd1, 0x1f, 0x03, 0xc0, 0x89, 0x47, 0x68,
+	0x4a, 0xfc, 0xf7, 0xa4, 0xf9, 0x9c, 0xc2, 0xde, 0x5b, 0x0d, 0xfb, 0xe7, 0x34, 0x22, 0x6e, 0xc9,
+	0x1a, 0xbe, 0x08, 0x9a, 0x42, 0x62, 0x39, 0x11, 0x66, 0x43, 0x85, 0xd2, 0xa7, 0x72, 0x31, 0x9b,
+	0x4f, 0x58, 0x4c, 0x78, 0x0c, 0x80, 0x4f, 0x45, 0x82, 0xa5, 0x37, 0x22, 0xc2, 0x5c, 0xeb, 0xd6,
+	0x0f, 0x5a, 0xc3, 0x1d, 0x94, 0xcf, 0x4e, 0xce, 0x9d, 0xbe, 0x75, 0x4b, 0x7a, 0xf6, 0xbf, 0x46,
+	0xc1, 0xac, 0x96, 0xc1, 0x1e, 0xd8, 0xba, 0xca, 0x2b, 0x7e, 0x71, 0xaf, 0xca, 0x0f, 0xe4, 0xd0,
+	0x04, 0x6b, 0x5a, 0xa6, 0x19, 0x2f, 0x8e, 0xd9, 0x4d, 0xa4, 0x42, 0xe4, 0x2d, 0xbb, 0xee, 0x16,
+	0x47, 0x68, 0x83, 0x76, 0x91, 0x4a, 0xe6, 0x48, 0xb1, 0xbc, 0xe1, 0x56, 0x64, 0x70, 0x07, 0x34,
+	0x08, 0xe7, 0x8c, 0x6b, 0xea, 0xf2, 0x03, 0xbc, 0x00, 0xed, 0x19, 0xf2, 0xac, 0x3e, 0xcd, 0xff,
+	0x5d, 0x9f, 0x8a, 0xbd, 0xfd, 0x2e, 0x78, 0xa1, 0xd2, 0x54, 0x59, 0xfb, 0xc1, 0x1e, 0x68, 0x50,
+	0x49, 0x22, 0x61, 0x1a, 0x0b, 0x18, 0xd4, 0x8a, 0x6e, 0xae, 0x62, 0x73, 0x60, 0x65, 0x36, 0xe5,
+	0x3b, 0x4a, 0x56, 0x9c, 0x93, 0x2e, 0x68, 0x4d, 0x62, 0x3f, 0x37, 0x22, 0xbe, 0xa2, 0x6f, 0xdd,
+	0x2d, 0x8b, 0x32, 0x12, 0x42, 0x1a, 0x51, 0xa9, 0x08, 0xac, 0xbb, 0xf9, 0xc1, 0x3e, 0x03, 0x2f,
+	0x7d, 0x44, 0x64, 0x15, 0xce, 0x4a, 0x01, 0xf5, 0xc4, 0xd4, 0x66, 0x13, 0x63, 0x7f, 0x02, 0x76,
+	0x5d, 0x22, 0xf9, 0xf4, 0x29, 0x9c, 0x0d, 0xff, 0x6e, 0xe8, 0xc5, 0x77, 0x49, 0x78, 0x4a, 0x3d,
+	0x02, 0x53, 0xd0, 0x76, 0xf3, 0x69, 0x50, 0x62, 0xb8, 0x5d, 0x66, 0x52, 0x47, 0xb1, 0x76, 0xaa,
+	0x42, 0xbd, 0x86, 0xde, 0xfe, 0xe1, 0x8f, 0x7f, 0x7e, 0xaa, 0xbd, 0x79, 0x32, 0x5b, 0x54, 0x3d,
+	0xb5, 0xe8, 0xd3, 0x41, 0xfe, 0x57, 0x20, 0x9c, 0x9b, 0x19, 0x92, 0x5b, 0xe7, 0xa6, 0x32, 0xc2,
+	0xb7, 0xf0, 0x37, 0x03, 0xec, 0x3e, 0xba, 0xc3, 0xe0, 0x6b, 0x3a, 0xe0, 0xb2, 0x2d, 0x67, 0x7d,
+	0xf6, 0xac, 0x53, 0xb9, 0xc8, 0x6b, 0x16, 0xcd, 0x3e, 0x52, 0x59, 0xf5, 0xe1, 0xeb, 0x45, 0x32,
+	0x85, 0x6d, 0x5f, 0x41, 0xea, 0xeb, 0xd1, 0xa9, 0x64, 0x07, 0xbf, 0x37, 0xc0, 0xf6, 0x82, 0x1e,
+	0x83, 0xaf, 0x94, 0xf2, 0x58, 0xdc, 0x7f, 0x96, 0xb9, 0xa8, 0x75, 0x15, 0x92, 0x43, 0x85, 0xe4,
+	0x55, 0xb8, 0x5f, 0xa1, 0xb5, 0xef, 0xcf, 0x5c, 0x54, 0x20, 0x7c, 0x07, 0xb6, 0xee, 0x77, 0x1c,
+	0xec, 0x68, 0xdf, 0x8f, 0xb4, 0xa2, 0xb5, 0x70, 0x6c, 0xec, 0xa1, 0x8a, 0x7b, 0x08, 0x7b, 0xab,
+	0xc4, 0x75, 0x6e, 0x26, 0xd4, 0xbf, 0x85, 0x3f, 0x1a, 0x00, 0x3e, 0xec, 0x52, 0xd8, 0xd5, 0x01,
+	0x1e, 0x6d, 0xe0, 0x25, 0xad, 0x65, 0xf4, 0xac, 0x37, 0x56, 0x47, 0xe1, 0xf0, 0x2c, 0xcc, 0xe9,
+	0xc9, 0xaf, 0x77, 0x1d, 0xe3, 0xf7, 0xbb, 0x8e, 0xf1, 0xd7, 0x5d, 0xc7, 0xf8, 0xea, 0x70, 0xd9,
+	0x7b, 0xa1, 0xfc, 0x88, 0xb9, 0x6a, 0xaa, 0xf7, 0xc1, 0xd1, 0x7f, 0x01, 0x00, 0x00, 0xff, 0xff,
+	0x1b, 0x65, 0xad, 0xaf, 0xe2, 0x08, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -250,6 +686,9 @@ const _ = grpc.SupportPackageIsVersion4
 type EventServiceClient interface {
 	ReceiveEvent(ctx context.Context, in *EventRequest, opts ...grpc.CallOption) (*EventResponse, error)
 	ListWorkflowEventBindings(ctx context.Context, in *ListWorkflowEventBindingsRequest, opts ...grpc.CallOption) (*v1alpha1.WorkflowEventBindingList, error)
+	ListEventDeliveries(ctx context.Context, in *ListEventDeliveriesRequest, opts ...grpc.CallOption) (*EventDeliveryList, error)
+	GetEventDelivery(ctx context.Context, in *GetEventDeliveryRequest, opts ...grpc.CallOption) (*EventDelivery, error)
+	RetryEventDelivery(ctx context.Context, in *RetryEventDeliveryRequest, opts ...grpc.CallOption) (*EventResponse, error)
 }
 
 type eventServiceClient struct {
@@ -278,13 +717,43 @@ func (c *eventServiceClient) ListWorkflowEventBindings(ctx context.Context, in *
 	return out, nil
 }
 
-// EventServiceServer is the server API for EventService service.
-type EventServiceServer interface {
-	ReceiveEvent(context.Context, *EventRequest) (*EventResponse, error)
-	ListWorkflowEventBindings(context.Context, *ListWorkflowEventBindingsRequest) (*v1alpha1.WorkflowEventBindingList, error)
+func (c *eventServiceClient) ListEventDeliveries(ctx context.Context, in *ListEventDeliveriesRequest, opts ...grpc.CallOption) (*EventDeliveryList, error) {
+	out := new(EventDeliveryList)
+	err := c.cc.Invoke(ctx, "/event.EventService/ListEventDeliveries", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-// UnimplementedEventServiceServer can be embedded to have forward compatible implementations.
+func (c *eventServiceClient) GetEventDelivery(ctx context.Context, in *GetEventDeliveryRequest, opts ...grpc.CallOption) (*EventDelivery, error) {
+	out := new(EventDelivery)
+	err := c.cc.Invoke(ctx, "/event.EventService/GetEventDelivery", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceClient) RetryEventDelivery(ctx context.Context, in *RetryEventDeliveryRequest, opts ...grpc.CallOption) (*EventResponse, error) {
+	out := new(EventResponse)
+	err := c.cc.Invoke(ctx, "/event.EventService/RetryEventDelivery", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// EventServiceServer is the server API for EventService service.
+type EventServiceServer interface {
+	ReceiveEvent(context.Context, *EventRequest) (*EventResponse, error)
+	ListWorkflowEventBindings(context.Context, *ListWorkflowEventBindingsRequest) (*v1alpha1.WorkflowEventBindingList, error)
+	ListEventDeliveries(context.Context, *ListEventDeliveriesRequest) (*EventDeliveryList, error)
+	GetEventDelivery(context.Context, *GetEventDeliveryRequest) (*EventDelivery, error)
+	RetryEventDelivery(context.Context, *RetryEventDeliveryRequest) (*EventResponse, error)
+}
+
+// UnimplementedEventServiceServer can be embedded to have forward compatible implementations.
 type UnimplementedEventServiceServer struct {
 }
 
@@ -294,6 +763,15 @@ func (*UnimplementedEventServiceServer) ReceiveEvent(ctx context.Context, req *E
 func (*UnimplementedEventServiceServer) ListWorkflowEventBindings(ctx context.Context, req *ListWorkflowEventBindingsRequest) (*v1alpha1.WorkflowEventBindingList, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListWorkflowEventBindings not implemented")
 }
+func (*UnimplementedEventServiceServer) ListEventDeliveries(ctx context.Context, req *ListEventDeliveriesRequest) (*EventDeliveryList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListEventDeliveries not implemented")
+}
+func (*UnimplementedEventServiceServer) GetEventDelivery(ctx context.Context, req *GetEventDeliveryRequest) (*EventDelivery, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEventDelivery not implemented")
+}
+func (*UnimplementedEventServiceServer) RetryEventDelivery(ctx context.Context, req *RetryEventDeliveryRequest) (*EventResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RetryEventDelivery not implemented")
+}
 
 func RegisterEventServiceServer(s *grpc.Server, srv EventServiceServer) {
 	s.RegisterService(&_EventService_serviceDesc, srv)
@@ -335,6 +813,60 @@ func _EventService_ListWorkflowEventBindings_Handler(srv interface{}, ctx contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _EventService_ListEventDeliveries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListEventDeliveriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).ListEventDeliveries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/event.EventService/ListEventDeliveries",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).ListEventDeliveries(ctx, req.(*ListEventDeliveriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventService_GetEventDelivery_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetEventDeliveryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).GetEventDelivery(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/event.EventService/GetEventDelivery",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).GetEventDelivery(ctx, req.(*GetEventDeliveryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventService_RetryEventDelivery_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RetryEventDeliveryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).RetryEventDelivery(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/event.EventService/RetryEventDelivery",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).RetryEventDelivery(ctx, req.(*RetryEventDeliveryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _EventService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "event.EventService",
 	HandlerType: (*EventServiceServer)(nil),
@@ -347,6 +879,18 @@ var _EventService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ListWorkflowEventBindings",
 			Handler:    _EventService_ListWorkflowEventBindings_Handler,
 		},
+		{
+			MethodName: "ListEventDeliveries",
+			Handler:    _EventService_ListEventDeliveries_Handler,
+		},
+		{
+			MethodName: "GetEventDelivery",
+			Handler:    _EventService_GetEventDelivery_Handler,
+		},
+		{
+			MethodName: "RetryEventDelivery",
+			Handler:    _EventService_RetryEventDelivery_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "pkg/apiclient/event/event.proto",
@@ -478,80 +1022,1435 @@ func (m *ListWorkflowEventBindingsRequest) MarshalToSizedBuffer(dAtA []byte) (in
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintEvent(dAtA []byte, offset int, v uint64) int {
-	offset -= sovEvent(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *EventDelivery) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *EventRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *EventDelivery) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventDelivery) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Namespace)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	l = len(m.Discriminator)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	if len(m.Dispatches) > 0 {
+		for iNdEx := len(m.Dispatches) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Dispatches[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvent(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
 	}
 	if m.Payload != nil {
-		l = m.Payload.Size()
-		n += 1 + l + sovEvent(uint64(l))
+		{
+			size, err := m.Payload.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvent(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Status) > 0 {
+		i -= len(m.Status)
+		copy(dAtA[i:], m.Status)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Status)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.ReceivedAt != nil {
+		{
+			size, err := m.ReceivedAt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvent(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
 	}
+	if len(m.Discriminator) > 0 {
+		i -= len(m.Discriminator)
+		copy(dAtA[i:], m.Discriminator)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Discriminator)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Namespace) > 0 {
+		i -= len(m.Namespace)
+		copy(dAtA[i:], m.Namespace)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Namespace)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Uid) > 0 {
+		i -= len(m.Uid)
+		copy(dAtA[i:], m.Uid)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Uid)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventDispatch) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventDispatch) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventDispatch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	if m.DispatchedAt != nil {
+		{
+			size, err := m.DispatchedAt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvent(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.WorkflowName) > 0 {
+		i -= len(m.WorkflowName)
+		copy(dAtA[i:], m.WorkflowName)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.WorkflowName)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Matched {
+		i--
+		if m.Matched {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Binding) > 0 {
+		i -= len(m.Binding)
+		copy(dAtA[i:], m.Binding)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Binding)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.BindingNamespace) > 0 {
+		i -= len(m.BindingNamespace)
+		copy(dAtA[i:], m.BindingNamespace)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.BindingNamespace)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *EventResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EventDeliveryList) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *EventDeliveryList) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventDeliveryList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Items) > 0 {
+		for iNdEx := len(m.Items) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Items[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvent(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ListEventDeliveriesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListEventDeliveriesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListEventDeliveriesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Limit != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.Limit))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Undelivered {
+		i--
+		if m.Undelivered {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Namespace) > 0 {
+		i -= len(m.Namespace)
+		copy(dAtA[i:], m.Namespace)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Namespace)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetEventDeliveryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetEventDeliveryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetEventDeliveryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Uid) > 0 {
+		i -= len(m.Uid)
+		copy(dAtA[i:], m.Uid)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Uid)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Namespace) > 0 {
+		i -= len(m.Namespace)
+		copy(dAtA[i:], m.Namespace)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Namespace)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RetryEventDeliveryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RetryEventDeliveryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RetryEventDeliveryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Uid) > 0 {
+		i -= len(m.Uid)
+		copy(dAtA[i:], m.Uid)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Uid)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Namespace) > 0 {
+		i -= len(m.Namespace)
+		copy(dAtA[i:], m.Namespace)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Namespace)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintEvent(dAtA []byte, offset int, v uint64) int {
+	offset -= sovEvent(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *EventRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Discriminator)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.Payload != nil {
+		l = m.Payload.Size()
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *EventResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ListWorkflowEventBindingsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.ListOptions != nil {
+		l = m.ListOptions.Size()
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *EventDelivery) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Uid)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Discriminator)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.ReceivedAt != nil {
+		l = m.ReceivedAt.Size()
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Status)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.Payload != nil {
+		l = m.Payload.Size()
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if len(m.Dispatches) > 0 {
+		for _, e := range m.Dispatches {
+			l = e.Size()
+			n += 1 + l + sovEvent(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *EventDispatch) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BindingNamespace)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Binding)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.Matched {
+		n += 2
+	}
+	l = len(m.WorkflowName)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.DispatchedAt != nil {
+		l = m.DispatchedAt.Size()
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *EventDeliveryList) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Items) > 0 {
+		for _, e := range m.Items {
+			l = e.Size()
+			n += 1 + l + sovEvent(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ListEventDeliveriesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.Undelivered {
+		n += 2
+	}
+	if m.Limit != 0 {
+		n += 1 + sovEvent(uint64(m.Limit))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetEventDeliveryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Uid)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RetryEventDeliveryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Uid)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovEvent(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozEvent(x uint64) (n int) {
+	return sovEvent(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *EventRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Discriminator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Discriminator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Payload", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Payload == nil {
+				m.Payload = &v1alpha1.Item{}
+			}
+			if err := m.Payload.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListWorkflowEventBindingsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListWorkflowEventBindingsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListWorkflowEventBindingsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ListOptions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ListOptions == nil {
+				m.ListOptions = &v1.ListOptions{}
+			}
+			if err := m.ListOptions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventDelivery) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventDelivery: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventDelivery: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Uid", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Uid = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Discriminator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Discriminator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReceivedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ReceivedAt == nil {
+				m.ReceivedAt = &v1.Time{}
+			}
+			if err := m.ReceivedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Status = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Payload", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Payload == nil {
+				m.Payload = &v1alpha1.Item{}
+			}
+			if err := m.Payload.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Dispatches", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Dispatches = append(m.Dispatches, &EventDispatch{})
+			if err := m.Dispatches[len(m.Dispatches)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventDispatch) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventDispatch: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventDispatch: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BindingNamespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BindingNamespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Binding", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Binding = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Matched", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Matched = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WorkflowName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DispatchedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DispatchedAt == nil {
+				m.DispatchedAt = &v1.Time{}
+			}
+			if err := m.DispatchedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *ListWorkflowEventBindingsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Namespace)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
-	}
-	if m.ListOptions != nil {
-		l = m.ListOptions.Size()
-		n += 1 + l + sovEvent(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovEvent(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozEvent(x uint64) (n int) {
-	return sovEvent(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *EventRequest) Unmarshal(dAtA []byte) error {
+func (m *EventDeliveryList) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -574,17 +2473,17 @@ func (m *EventRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventDeliveryList: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventDeliveryList: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -594,27 +2493,83 @@ func (m *EventRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthEvent
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvent
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Namespace = string(dAtA[iNdEx:postIndex])
+			m.Items = append(m.Items, &EventDelivery{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListEventDeliveriesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListEventDeliveriesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListEventDeliveriesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Discriminator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -642,13 +2597,13 @@ func (m *EventRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Discriminator = string(dAtA[iNdEx:postIndex])
+			m.Namespace = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Payload", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Undelivered", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -658,28 +2613,31 @@ func (m *EventRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthEvent
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvent
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Payload == nil {
-				m.Payload = &v1alpha1.Item{}
+			m.Undelivered = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
 			}
-			if err := m.Payload.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Limit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Limit |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvent(dAtA[iNdEx:])
@@ -705,7 +2663,7 @@ func (m *EventRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventResponse) Unmarshal(dAtA []byte) error {
+func (m *GetEventDeliveryRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -728,12 +2686,76 @@ func (m *EventResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetEventDeliveryRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetEventDeliveryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Uid", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Uid = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvent(dAtA[iNdEx:])
@@ -759,7 +2781,7 @@ func (m *EventResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ListWorkflowEventBindingsRequest) Unmarshal(dAtA []byte) error {
+func (m *RetryEventDeliveryRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -782,10 +2804,10 @@ func (m *ListWorkflowEventBindingsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ListWorkflowEventBindingsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: RetryEventDeliveryRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListWorkflowEventBindingsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RetryEventDeliveryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -822,9 +2844,9 @@ func (m *ListWorkflowEventBindingsRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ListOptions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Uid", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -834,27 +2856,23 @@ func (m *ListWorkflowEventBindingsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEvent
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvent
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ListOptions == nil {
-				m.ListOptions = &v1.ListOptions{}
-			}
-			if err := m.ListOptions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Uid = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
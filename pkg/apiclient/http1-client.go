@@ -5,8 +5,10 @@ import (
 
 	"github.com/argoproj/argo/v2/pkg/apiclient/clusterworkflowtemplate"
 	cronworkflowpkg "github.com/argoproj/argo/v2/pkg/apiclient/cronworkflow"
+	eventpkg "github.com/argoproj/argo/v2/pkg/apiclient/event"
 	"github.com/argoproj/argo/v2/pkg/apiclient/http1"
 	infopkg "github.com/argoproj/argo/v2/pkg/apiclient/info"
+	syncpkg "github.com/argoproj/argo/v2/pkg/apiclient/sync"
 	workflowpkg "github.com/argoproj/argo/v2/pkg/apiclient/workflow"
 	workflowarchivepkg "github.com/argoproj/argo/v2/pkg/apiclient/workflowarchive"
 	workflowtemplatepkg "github.com/argoproj/argo/v2/pkg/apiclient/workflowtemplate"
@@ -38,6 +40,14 @@ func (h httpClient) NewInfoServiceClient() (infopkg.InfoServiceClient, error) {
 	return http1.InfoServiceClient(h), nil
 }
 
+func (h httpClient) NewEventServiceClient() (eventpkg.EventServiceClient, error) {
+	return http1.EventServiceClient(h), nil
+}
+
+func (h httpClient) NewSyncServiceClient() (syncpkg.SyncServiceClient, error) {
+	return http1.SyncServiceClient(h), nil
+}
+
 func newHTTP1Client(baseUrl string, auth string) (context.Context, Client, error) {
 	return context.Background(), httpClient(http1.NewFacade(baseUrl, auth)), nil
 }
@@ -22,11 +22,26 @@ func (h ArchivedWorkflowsServiceClient) GetArchivedWorkflow(_ context.Context, i
 	return out, h.Get(in, out, "/api/v1/archived-workflows/{uid}")
 }
 
+func (h ArchivedWorkflowsServiceClient) GetArchivedWorkflowStats(_ context.Context, in *workflowarchivepkg.GetArchivedWorkflowStatsRequest, _ ...grpc.CallOption) (*workflowarchivepkg.ArchivedWorkflowStats, error) {
+	out := &workflowarchivepkg.ArchivedWorkflowStats{}
+	return out, h.Get(in, out, "/api/v1/archived-workflows-stats")
+}
+
 func (h ArchivedWorkflowsServiceClient) DeleteArchivedWorkflow(_ context.Context, in *workflowarchivepkg.DeleteArchivedWorkflowRequest, _ ...grpc.CallOption) (*workflowarchivepkg.ArchivedWorkflowDeletedResponse, error) {
 	out := &workflowarchivepkg.ArchivedWorkflowDeletedResponse{}
 	return out, h.Delete(in, out, "/api/v1/archived-workflows/{uid}")
 }
 
+func (h ArchivedWorkflowsServiceClient) ResubmitArchivedWorkflow(_ context.Context, in *workflowarchivepkg.ResubmitArchivedWorkflowRequest, _ ...grpc.CallOption) (*wfv1.Workflow, error) {
+	out := &wfv1.Workflow{}
+	return out, h.Put(in, out, "/api/v1/archived-workflows/{uid}/resubmit")
+}
+
+func (h ArchivedWorkflowsServiceClient) RetryArchivedWorkflow(_ context.Context, in *workflowarchivepkg.RetryArchivedWorkflowRequest, _ ...grpc.CallOption) (*wfv1.Workflow, error) {
+	out := &wfv1.Workflow{}
+	return out, h.Put(in, out, "/api/v1/archived-workflows/{uid}/retry")
+}
+
 func (h ArchivedWorkflowsServiceClient) DeleteClusterWorkflowTemplate(_ context.Context, in *clusterworkflowtemplate.ClusterWorkflowTemplateDeleteRequest, _ ...grpc.CallOption) (*clusterworkflowtemplate.ClusterWorkflowTemplateDeleteResponse, error) {
 	out := &clusterworkflowtemplate.ClusterWorkflowTemplateDeleteResponse{}
 	return out, h.Delete(in, out, "/api/v1/cluster-workflow-templates/{name}")
@@ -0,0 +1,37 @@
+package http1
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+
+	eventpkg "github.com/argoproj/argo/v2/pkg/apiclient/event"
+	wfv1 "github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1"
+)
+
+type EventServiceClient = Facade
+
+func (h EventServiceClient) ReceiveEvent(_ context.Context, in *eventpkg.EventRequest, _ ...grpc.CallOption) (*eventpkg.EventResponse, error) {
+	out := &eventpkg.EventResponse{}
+	return out, h.Post(in.Payload, out, "/api/v1/events/"+in.Namespace+"/"+in.Discriminator)
+}
+
+func (h EventServiceClient) ListWorkflowEventBindings(_ context.Context, in *eventpkg.ListWorkflowEventBindingsRequest, _ ...grpc.CallOption) (*wfv1.WorkflowEventBindingList, error) {
+	out := &wfv1.WorkflowEventBindingList{}
+	return out, h.Get(in, out, "/api/v1/workflow-event-bindings/{namespace}")
+}
+
+func (h EventServiceClient) ListEventDeliveries(_ context.Context, in *eventpkg.ListEventDeliveriesRequest, _ ...grpc.CallOption) (*eventpkg.EventDeliveryList, error) {
+	out := &eventpkg.EventDeliveryList{}
+	return out, h.Get(in, out, "/api/v1/event-deliveries/{namespace}")
+}
+
+func (h EventServiceClient) GetEventDelivery(_ context.Context, in *eventpkg.GetEventDeliveryRequest, _ ...grpc.CallOption) (*eventpkg.EventDelivery, error) {
+	out := &eventpkg.EventDelivery{}
+	return out, h.Get(in, out, "/api/v1/event-deliveries/{namespace}/{uid}")
+}
+
+func (h EventServiceClient) RetryEventDelivery(_ context.Context, in *eventpkg.RetryEventDeliveryRequest, _ ...grpc.CallOption) (*eventpkg.EventResponse, error) {
+	out := &eventpkg.EventResponse{}
+	return out, h.Put(in, out, "/api/v1/event-deliveries/{namespace}/{uid}/retry")
+}
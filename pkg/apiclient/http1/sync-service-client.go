@@ -0,0 +1,21 @@
+package http1
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+
+	syncpkg "github.com/argoproj/argo/v2/pkg/apiclient/sync"
+)
+
+type SyncServiceClient = Facade
+
+func (h SyncServiceClient) ListSyncLocks(_ context.Context, in *syncpkg.ListSyncLocksRequest, _ ...grpc.CallOption) (*syncpkg.SyncLockList, error) {
+	out := &syncpkg.SyncLockList{}
+	return out, h.Get(in, out, "/api/v1/sync-locks/{namespace}")
+}
+
+func (h SyncServiceClient) GetSyncLock(_ context.Context, in *syncpkg.GetSyncLockRequest, _ ...grpc.CallOption) (*syncpkg.SyncLock, error) {
+	out := &syncpkg.SyncLock{}
+	return out, h.Get(in, out, "/api/v1/sync-locks/{namespace}/{kind}/{name}")
+}
@@ -5,6 +5,7 @@ package workflowarchive
 
 import (
 	context "context"
+	encoding_binary "encoding/binary"
 	fmt "fmt"
 	v1alpha1 "github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1"
 	proto "github.com/gogo/protobuf/proto"
@@ -30,10 +31,23 @@ var _ = math.Inf
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 type ListArchivedWorkflowsRequest struct {
-	ListOptions          *v1.ListOptions `protobuf:"bytes,1,opt,name=listOptions,proto3" json:"listOptions,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
-	XXX_unrecognized     []byte          `json:"-"`
-	XXX_sizecache        int32           `json:"-"`
+	// Supported field selectors are metadata.namespace=, spec.startedAt>, spec.startedAt<, spec.finishedAt> and spec.finishedAt<.
+	// When listOptions.limit is set, listMeta.remainingItemCount is set in the response, so the total number of matching workflows is
+	// listOptions.continue + len(items) + remainingItemCount.
+	ListOptions *v1.ListOptions `protobuf:"bytes,1,opt,name=listOptions,proto3" json:"listOptions,omitempty"`
+	// Only list workflows whose name starts with this prefix.
+	NamePrefix string `protobuf:"bytes,2,opt,name=namePrefix,proto3" json:"namePrefix,omitempty"`
+	// Only list workflows whose name matches this glob pattern, where "*" matches any characters and "?" any single character.
+	NamePattern string `protobuf:"bytes,3,opt,name=namePattern,proto3" json:"namePattern,omitempty"`
+	// Only list workflows in one of these phases.
+	Phases []string `protobuf:"bytes,4,rep,name=phases,proto3" json:"phases,omitempty"`
+	// Only list workflows that reference this workflow template or cluster workflow template.
+	WorkflowTemplateRef string `protobuf:"bytes,5,opt,name=workflowTemplateRef,proto3" json:"workflowTemplateRef,omitempty"`
+	// Sort by one of startedAt, finishedAt, name, namespace or phase, prefixed by "-" for a descending sort. Defaults to "-startedAt".
+	SortBy               string   `protobuf:"bytes,6,opt,name=sortBy,proto3" json:"sortBy,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *ListArchivedWorkflowsRequest) Reset()         { *m = ListArchivedWorkflowsRequest{} }
@@ -76,6 +90,41 @@ func (m *ListArchivedWorkflowsRequest) GetListOptions() *v1.ListOptions {
 	return nil
 }
 
+func (m *ListArchivedWorkflowsRequest) GetNamePrefix() string {
+	if m != nil {
+		return m.NamePrefix
+	}
+	return ""
+}
+
+func (m *ListArchivedWorkflowsRequest) GetNamePattern() string {
+	if m != nil {
+		return m.NamePattern
+	}
+	return ""
+}
+
+func (m *ListArchivedWorkflowsRequest) GetPhases() []string {
+	if m != nil {
+		return m.Phases
+	}
+	return nil
+}
+
+func (m *ListArchivedWorkflowsRequest) GetWorkflowTemplateRef() string {
+	if m != nil {
+		return m.WorkflowTemplateRef
+	}
+	return ""
+}
+
+func (m *ListArchivedWorkflowsRequest) GetSortBy() string {
+	if m != nil {
+		return m.SortBy
+	}
+	return ""
+}
+
 type GetArchivedWorkflowRequest struct {
 	Uid                  string   `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -209,414 +258,2243 @@ func (m *ArchivedWorkflowDeletedResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ArchivedWorkflowDeletedResponse proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*ListArchivedWorkflowsRequest)(nil), "workflowarchive.ListArchivedWorkflowsRequest")
-	proto.RegisterType((*GetArchivedWorkflowRequest)(nil), "workflowarchive.GetArchivedWorkflowRequest")
-	proto.RegisterType((*DeleteArchivedWorkflowRequest)(nil), "workflowarchive.DeleteArchivedWorkflowRequest")
-	proto.RegisterType((*ArchivedWorkflowDeletedResponse)(nil), "workflowarchive.ArchivedWorkflowDeletedResponse")
+type ResubmitArchivedWorkflowRequest struct {
+	Uid                  string   `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	Memoized             bool     `protobuf:"varint,2,opt,name=memoized,proto3" json:"memoized,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func init() {
-	proto.RegisterFile("pkg/apiclient/workflowarchive/workflow-archive.proto", fileDescriptor_95ca9a2d33e8bb19)
+func (m *ResubmitArchivedWorkflowRequest) Reset()         { *m = ResubmitArchivedWorkflowRequest{} }
+func (m *ResubmitArchivedWorkflowRequest) String() string { return proto.CompactTextString(m) }
+func (*ResubmitArchivedWorkflowRequest) ProtoMessage()    {}
+func (*ResubmitArchivedWorkflowRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95ca9a2d33e8bb19, []int{4}
 }
-
-var fileDescriptor_95ca9a2d33e8bb19 = []byte{
-	// 451 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x94, 0xcb, 0x8a, 0xd4, 0x40,
-	0x14, 0x86, 0x29, 0x15, 0xc1, 0x9a, 0x85, 0x52, 0xe2, 0x85, 0xd0, 0xb6, 0x63, 0x56, 0x83, 0xd2,
-	0xa7, 0x4c, 0x3b, 0x88, 0x4b, 0x2f, 0x0d, 0x6e, 0x06, 0x84, 0x9e, 0x85, 0xe0, 0xae, 0x26, 0x39,
-	0xa6, 0xcb, 0x4e, 0xa7, 0xca, 0xaa, 0x4a, 0x1a, 0x11, 0x37, 0xbe, 0x82, 0xaf, 0x30, 0x6b, 0xf1,
-	0x31, 0x5c, 0x0a, 0xbe, 0x80, 0x34, 0x3e, 0x88, 0xe4, 0x36, 0x19, 0x92, 0xcc, 0xb4, 0x30, 0xbb,
-	0x93, 0xca, 0x39, 0xff, 0xf9, 0xf8, 0xff, 0x54, 0xe8, 0xbe, 0x5e, 0xc6, 0x5c, 0x68, 0x19, 0x26,
-	0x12, 0x53, 0xc7, 0xd7, 0xca, 0x2c, 0xdf, 0x27, 0x6a, 0x2d, 0x4c, 0xb8, 0x90, 0x39, 0x9e, 0x3c,
-	0x4f, 0xea, 0x03, 0xd0, 0x46, 0x39, 0xc5, 0xae, 0x77, 0xfa, 0xbc, 0x51, 0xac, 0x54, 0x9c, 0x60,
-	0xa1, 0xc4, 0x45, 0x9a, 0x2a, 0x27, 0x9c, 0x54, 0xa9, 0xad, 0xda, 0xbd, 0xfd, 0xe5, 0x33, 0x0b,
-	0x52, 0x15, 0x6f, 0x57, 0x22, 0x5c, 0xc8, 0x14, 0xcd, 0x27, 0x5e, 0x2f, 0xb6, 0x7c, 0x85, 0x4e,
-	0xf0, 0x3c, 0xe0, 0x31, 0xa6, 0x68, 0x84, 0xc3, 0xa8, 0x9e, 0x7a, 0x15, 0x4b, 0xb7, 0xc8, 0x8e,
-	0x20, 0x54, 0x2b, 0x2e, 0x4c, 0xac, 0xb4, 0x51, 0x1f, 0xca, 0xa2, 0x1d, 0x6d, 0x30, 0x78, 0x1e,
-	0x88, 0x44, 0x2f, 0x44, 0x4f, 0xc4, 0xb7, 0x74, 0x74, 0x20, 0xad, 0x7b, 0x51, 0x71, 0x46, 0x6f,
-	0xeb, 0x01, 0x3b, 0xc7, 0x8f, 0x19, 0x5a, 0xc7, 0x0e, 0xe9, 0x4e, 0x22, 0xad, 0x7b, 0xa3, 0x4b,
-	0xde, 0xbb, 0x64, 0x97, 0xec, 0xed, 0x4c, 0x03, 0xa8, 0x80, 0xe1, 0x34, 0x30, 0xe8, 0x65, 0x5c,
-	0x1c, 0x58, 0x28, 0x80, 0x21, 0x0f, 0xe0, 0xa0, 0x1d, 0x9c, 0x9f, 0x56, 0xf1, 0x81, 0x7a, 0xaf,
-	0xb1, 0xb7, 0xb3, 0x59, 0x79, 0x83, 0x5e, 0xce, 0x64, 0x54, 0xae, 0xba, 0x36, 0x2f, 0x4a, 0x3f,
-	0xa0, 0xf7, 0x66, 0x98, 0xa0, 0xc3, 0xff, 0x1f, 0x79, 0x40, 0xef, 0x77, 0x9b, 0x2b, 0x89, 0x68,
-	0x8e, 0x56, 0xab, 0xd4, 0xe2, 0xf4, 0xf8, 0x0a, 0xbd, 0xd3, 0xed, 0x39, 0x44, 0x93, 0xcb, 0x10,
-	0xd9, 0x0f, 0x42, 0x6f, 0x0d, 0xfa, 0xc2, 0x26, 0xd0, 0xc9, 0x16, 0xce, 0xf3, 0xcf, 0x9b, 0x41,
-	0x9b, 0x12, 0x34, 0x29, 0x95, 0x05, 0xe4, 0xd3, 0xd6, 0xb2, 0x46, 0x13, 0x9a, 0xa0, 0xa0, 0x51,
-	0x2a, 0xd4, 0x7d, 0xff, 0xeb, 0xef, 0xbf, 0xdf, 0x2e, 0x8d, 0x98, 0x57, 0x7e, 0x40, 0x79, 0xc0,
-	0xeb, 0xdd, 0xd1, 0x64, 0x7d, 0x02, 0xf6, 0x9d, 0xd0, 0x9b, 0x03, 0xae, 0xb2, 0x47, 0x3d, 0xe0,
-	0xb3, 0xbd, 0xf7, 0x9e, 0x5f, 0x14, 0xd7, 0xdf, 0x2b, 0x51, 0x7d, 0xb6, 0x7b, 0x36, 0x2a, 0xff,
-	0x9c, 0xc9, 0xe8, 0x0b, 0x3b, 0x26, 0xf4, 0xf6, 0x70, 0xac, 0x0c, 0x7a, 0xcc, 0xe7, 0xe6, 0xef,
-	0x3d, 0xee, 0xf5, 0x6f, 0x09, 0xbf, 0xc1, 0x7c, 0xb8, 0x15, 0xf3, 0xe5, 0xec, 0xe7, 0x66, 0x4c,
-	0x7e, 0x6d, 0xc6, 0xe4, 0xcf, 0x66, 0x4c, 0xde, 0x3d, 0xdd, 0x76, 0xe9, 0x86, 0x7f, 0x14, 0x47,
-	0x57, 0xcb, 0xeb, 0xf6, 0xe4, 0x5f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x00, 0x34, 0x04, 0xf6, 0x50,
-	0x04, 0x00, 0x00,
+func (m *ResubmitArchivedWorkflowRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ResubmitArchivedWorkflowRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ResubmitArchivedWorkflowRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ResubmitArchivedWorkflowRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResubmitArchivedWorkflowRequest.Merge(m, src)
+}
+func (m *ResubmitArchivedWorkflowRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ResubmitArchivedWorkflowRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResubmitArchivedWorkflowRequest.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_ResubmitArchivedWorkflowRequest proto.InternalMessageInfo
 
-// ArchivedWorkflowServiceClient is the client API for ArchivedWorkflowService service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type ArchivedWorkflowServiceClient interface {
-	ListArchivedWorkflows(ctx context.Context, in *ListArchivedWorkflowsRequest, opts ...grpc.CallOption) (*v1alpha1.WorkflowList, error)
-	GetArchivedWorkflow(ctx context.Context, in *GetArchivedWorkflowRequest, opts ...grpc.CallOption) (*v1alpha1.Workflow, error)
-	DeleteArchivedWorkflow(ctx context.Context, in *DeleteArchivedWorkflowRequest, opts ...grpc.CallOption) (*ArchivedWorkflowDeletedResponse, error)
+func (m *ResubmitArchivedWorkflowRequest) GetUid() string {
+	if m != nil {
+		return m.Uid
+	}
+	return ""
 }
 
-type archivedWorkflowServiceClient struct {
-	cc *grpc.ClientConn
+func (m *ResubmitArchivedWorkflowRequest) GetMemoized() bool {
+	if m != nil {
+		return m.Memoized
+	}
+	return false
 }
 
-func NewArchivedWorkflowServiceClient(cc *grpc.ClientConn) ArchivedWorkflowServiceClient {
-	return &archivedWorkflowServiceClient{cc}
+type RetryArchivedWorkflowRequest struct {
+	Uid                  string   `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	RestartSuccessful    bool     `protobuf:"varint,2,opt,name=restartSuccessful,proto3" json:"restartSuccessful,omitempty"`
+	NodeFieldSelector    string   `protobuf:"bytes,3,opt,name=nodeFieldSelector,proto3" json:"nodeFieldSelector,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (c *archivedWorkflowServiceClient) ListArchivedWorkflows(ctx context.Context, in *ListArchivedWorkflowsRequest, opts ...grpc.CallOption) (*v1alpha1.WorkflowList, error) {
-	out := new(v1alpha1.WorkflowList)
-	err := c.cc.Invoke(ctx, "/workflowarchive.ArchivedWorkflowService/ListArchivedWorkflows", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *RetryArchivedWorkflowRequest) Reset()         { *m = RetryArchivedWorkflowRequest{} }
+func (m *RetryArchivedWorkflowRequest) String() string { return proto.CompactTextString(m) }
+func (*RetryArchivedWorkflowRequest) ProtoMessage()    {}
+func (*RetryArchivedWorkflowRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95ca9a2d33e8bb19, []int{5}
+}
+func (m *RetryArchivedWorkflowRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RetryArchivedWorkflowRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RetryArchivedWorkflowRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *RetryArchivedWorkflowRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RetryArchivedWorkflowRequest.Merge(m, src)
+}
+func (m *RetryArchivedWorkflowRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *RetryArchivedWorkflowRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RetryArchivedWorkflowRequest.DiscardUnknown(m)
 }
 
-func (c *archivedWorkflowServiceClient) GetArchivedWorkflow(ctx context.Context, in *GetArchivedWorkflowRequest, opts ...grpc.CallOption) (*v1alpha1.Workflow, error) {
-	out := new(v1alpha1.Workflow)
-	err := c.cc.Invoke(ctx, "/workflowarchive.ArchivedWorkflowService/GetArchivedWorkflow", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_RetryArchivedWorkflowRequest proto.InternalMessageInfo
+
+func (m *RetryArchivedWorkflowRequest) GetUid() string {
+	if m != nil {
+		return m.Uid
 	}
-	return out, nil
+	return ""
 }
 
-func (c *archivedWorkflowServiceClient) DeleteArchivedWorkflow(ctx context.Context, in *DeleteArchivedWorkflowRequest, opts ...grpc.CallOption) (*ArchivedWorkflowDeletedResponse, error) {
-	out := new(ArchivedWorkflowDeletedResponse)
-	err := c.cc.Invoke(ctx, "/workflowarchive.ArchivedWorkflowService/DeleteArchivedWorkflow", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *RetryArchivedWorkflowRequest) GetRestartSuccessful() bool {
+	if m != nil {
+		return m.RestartSuccessful
 	}
-	return out, nil
+	return false
 }
 
-// ArchivedWorkflowServiceServer is the server API for ArchivedWorkflowService service.
-type ArchivedWorkflowServiceServer interface {
-	ListArchivedWorkflows(context.Context, *ListArchivedWorkflowsRequest) (*v1alpha1.WorkflowList, error)
-	GetArchivedWorkflow(context.Context, *GetArchivedWorkflowRequest) (*v1alpha1.Workflow, error)
-	DeleteArchivedWorkflow(context.Context, *DeleteArchivedWorkflowRequest) (*ArchivedWorkflowDeletedResponse, error)
+func (m *RetryArchivedWorkflowRequest) GetNodeFieldSelector() string {
+	if m != nil {
+		return m.NodeFieldSelector
+	}
+	return ""
 }
 
-// UnimplementedArchivedWorkflowServiceServer can be embedded to have forward compatible implementations.
-type UnimplementedArchivedWorkflowServiceServer struct {
+type GetArchivedWorkflowStatsRequest struct {
+	// Supported field selectors and label selectors are the same as ListArchivedWorkflowsRequest's. The limit and continue are ignored.
+	ListOptions *v1.ListOptions `protobuf:"bytes,1,opt,name=listOptions,proto3" json:"listOptions,omitempty"`
+	// Group by "workflowTemplate" or "label:<key>", e.g. "label:team". Defaults to a single group of all workflows.
+	GroupBy string `protobuf:"bytes,2,opt,name=groupBy,proto3" json:"groupBy,omitempty"`
+	// Split each group into buckets of this width by start time, e.g. "1h" or "1d". Defaults to no buckets.
+	Bucket               string   `protobuf:"bytes,3,opt,name=bucket,proto3" json:"bucket,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (*UnimplementedArchivedWorkflowServiceServer) ListArchivedWorkflows(ctx context.Context, req *ListArchivedWorkflowsRequest) (*v1alpha1.WorkflowList, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListArchivedWorkflows not implemented")
+func (m *GetArchivedWorkflowStatsRequest) Reset()         { *m = GetArchivedWorkflowStatsRequest{} }
+func (m *GetArchivedWorkflowStatsRequest) String() string { return proto.CompactTextString(m) }
+func (*GetArchivedWorkflowStatsRequest) ProtoMessage()    {}
+func (*GetArchivedWorkflowStatsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95ca9a2d33e8bb19, []int{6}
 }
-func (*UnimplementedArchivedWorkflowServiceServer) GetArchivedWorkflow(ctx context.Context, req *GetArchivedWorkflowRequest) (*v1alpha1.Workflow, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetArchivedWorkflow not implemented")
+func (m *GetArchivedWorkflowStatsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedArchivedWorkflowServiceServer) DeleteArchivedWorkflow(ctx context.Context, req *DeleteArchivedWorkflowRequest) (*ArchivedWorkflowDeletedResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteArchivedWorkflow not implemented")
+func (m *GetArchivedWorkflowStatsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetArchivedWorkflowStatsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-
-func RegisterArchivedWorkflowServiceServer(s *grpc.Server, srv ArchivedWorkflowServiceServer) {
-	s.RegisterService(&_ArchivedWorkflowService_serviceDesc, srv)
+func (m *GetArchivedWorkflowStatsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetArchivedWorkflowStatsRequest.Merge(m, src)
+}
+func (m *GetArchivedWorkflowStatsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetArchivedWorkflowStatsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetArchivedWorkflowStatsRequest.DiscardUnknown(m)
 }
 
-func _ArchivedWorkflowService_ListArchivedWorkflows_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListArchivedWorkflowsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(ArchivedWorkflowServiceServer).ListArchivedWorkflows(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/workflowarchive.ArchivedWorkflowService/ListArchivedWorkflows",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ArchivedWorkflowServiceServer).ListArchivedWorkflows(ctx, req.(*ListArchivedWorkflowsRequest))
+var xxx_messageInfo_GetArchivedWorkflowStatsRequest proto.InternalMessageInfo
+
+func (m *GetArchivedWorkflowStatsRequest) GetListOptions() *v1.ListOptions {
+	if m != nil {
+		return m.ListOptions
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _ArchivedWorkflowService_GetArchivedWorkflow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetArchivedWorkflowRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(ArchivedWorkflowServiceServer).GetArchivedWorkflow(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/workflowarchive.ArchivedWorkflowService/GetArchivedWorkflow",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ArchivedWorkflowServiceServer).GetArchivedWorkflow(ctx, req.(*GetArchivedWorkflowRequest))
+func (m *GetArchivedWorkflowStatsRequest) GetGroupBy() string {
+	if m != nil {
+		return m.GroupBy
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _ArchivedWorkflowService_DeleteArchivedWorkflow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteArchivedWorkflowRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(ArchivedWorkflowServiceServer).DeleteArchivedWorkflow(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/workflowarchive.ArchivedWorkflowService/DeleteArchivedWorkflow",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ArchivedWorkflowServiceServer).DeleteArchivedWorkflow(ctx, req.(*DeleteArchivedWorkflowRequest))
+func (m *GetArchivedWorkflowStatsRequest) GetBucket() string {
+	if m != nil {
+		return m.Bucket
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-var _ArchivedWorkflowService_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "workflowarchive.ArchivedWorkflowService",
-	HandlerType: (*ArchivedWorkflowServiceServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "ListArchivedWorkflows",
-			Handler:    _ArchivedWorkflowService_ListArchivedWorkflows_Handler,
-		},
-		{
-			MethodName: "GetArchivedWorkflow",
-			Handler:    _ArchivedWorkflowService_GetArchivedWorkflow_Handler,
-		},
-		{
-			MethodName: "DeleteArchivedWorkflow",
-			Handler:    _ArchivedWorkflowService_DeleteArchivedWorkflow_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "pkg/apiclient/workflowarchive/workflow-archive.proto",
+type ArchivedWorkflowStats struct {
+	// The groups, sorted by key.
+	Groups               []*ArchivedWorkflowStatsGroup `protobuf:"bytes,1,rep,name=groups,proto3" json:"groups,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                      `json:"-"`
+	XXX_unrecognized     []byte                        `json:"-"`
+	XXX_sizecache        int32                         `json:"-"`
 }
 
-func (m *ListArchivedWorkflowsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ArchivedWorkflowStats) Reset()         { *m = ArchivedWorkflowStats{} }
+func (m *ArchivedWorkflowStats) String() string { return proto.CompactTextString(m) }
+func (*ArchivedWorkflowStats) ProtoMessage()    {}
+func (*ArchivedWorkflowStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95ca9a2d33e8bb19, []int{7}
+}
+func (m *ArchivedWorkflowStats) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ArchivedWorkflowStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ArchivedWorkflowStats.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *ListArchivedWorkflowsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ArchivedWorkflowStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ArchivedWorkflowStats.Merge(m, src)
+}
+func (m *ArchivedWorkflowStats) XXX_Size() int {
+	return m.Size()
+}
+func (m *ArchivedWorkflowStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_ArchivedWorkflowStats.DiscardUnknown(m)
 }
 
-func (m *ListArchivedWorkflowsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+var xxx_messageInfo_ArchivedWorkflowStats proto.InternalMessageInfo
+
+func (m *ArchivedWorkflowStats) GetGroups() []*ArchivedWorkflowStatsGroup {
+	if m != nil {
+		return m.Groups
 	}
-	if m.ListOptions != nil {
-		{
-			size, err := m.ListOptions.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintWorkflowArchive(dAtA, i, uint64(size))
+	return nil
+}
+
+type ArchivedWorkflowStatsGroup struct {
+	// The workflow template name or label value of this group, empty if the workflows do not have one.
+	Key   string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Total int64  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	// The number of workflows in each phase.
+	PhaseCounts map[string]int64 `protobuf:"bytes,3,rep,name=phaseCounts,proto3" json:"phaseCounts,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	// The fraction of workflows that succeeded.
+	SuccessRate float64 `protobuf:"fixed64,4,opt,name=successRate,proto3" json:"successRate,omitempty"`
+	// Percentiles of the workflows' durations, in seconds.
+	DurationP50          float64                        `protobuf:"fixed64,5,opt,name=durationP50,proto3" json:"durationP50,omitempty"`
+	DurationP90          float64                        `protobuf:"fixed64,6,opt,name=durationP90,proto3" json:"durationP90,omitempty"`
+	DurationP99          float64                        `protobuf:"fixed64,7,opt,name=durationP99,proto3" json:"durationP99,omitempty"`
+	Buckets              []*ArchivedWorkflowStatsBucket `protobuf:"bytes,8,rep,name=buckets,proto3" json:"buckets,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                       `json:"-"`
+	XXX_unrecognized     []byte                         `json:"-"`
+	XXX_sizecache        int32                          `json:"-"`
+}
+
+func (m *ArchivedWorkflowStatsGroup) Reset()         { *m = ArchivedWorkflowStatsGroup{} }
+func (m *ArchivedWorkflowStatsGroup) String() string { return proto.CompactTextString(m) }
+func (*ArchivedWorkflowStatsGroup) ProtoMessage()    {}
+func (*ArchivedWorkflowStatsGroup) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95ca9a2d33e8bb19, []int{8}
+}
+func (m *ArchivedWorkflowStatsGroup) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ArchivedWorkflowStatsGroup) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ArchivedWorkflowStatsGroup.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *ArchivedWorkflowStatsGroup) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ArchivedWorkflowStatsGroup.Merge(m, src)
+}
+func (m *ArchivedWorkflowStatsGroup) XXX_Size() int {
+	return m.Size()
+}
+func (m *ArchivedWorkflowStatsGroup) XXX_DiscardUnknown() {
+	xxx_messageInfo_ArchivedWorkflowStatsGroup.DiscardUnknown(m)
 }
 
-func (m *GetArchivedWorkflowRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_ArchivedWorkflowStatsGroup proto.InternalMessageInfo
+
+func (m *ArchivedWorkflowStatsGroup) GetKey() string {
+	if m != nil {
+		return m.Key
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *GetArchivedWorkflowRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ArchivedWorkflowStatsGroup) GetTotal() int64 {
+	if m != nil {
+		return m.Total
+	}
+	return 0
 }
 
-func (m *GetArchivedWorkflowRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.Uid) > 0 {
-		i -= len(m.Uid)
-		copy(dAtA[i:], m.Uid)
-		i = encodeVarintWorkflowArchive(dAtA, i, uint64(len(m.Uid)))
-		i--
-		dAtA[i] = 0xa
+func (m *ArchivedWorkflowStatsGroup) GetPhaseCounts() map[string]int64 {
+	if m != nil {
+		return m.PhaseCounts
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *DeleteArchivedWorkflowRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ArchivedWorkflowStatsGroup) GetSuccessRate() float64 {
+	if m != nil {
+		return m.SuccessRate
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *DeleteArchivedWorkflowRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ArchivedWorkflowStatsGroup) GetDurationP50() float64 {
+	if m != nil {
+		return m.DurationP50
+	}
+	return 0
 }
 
-func (m *DeleteArchivedWorkflowRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+func (m *ArchivedWorkflowStatsGroup) GetDurationP90() float64 {
+	if m != nil {
+		return m.DurationP90
 	}
-	if len(m.Uid) > 0 {
-		i -= len(m.Uid)
-		copy(dAtA[i:], m.Uid)
-		i = encodeVarintWorkflowArchive(dAtA, i, uint64(len(m.Uid)))
-		i--
-		dAtA[i] = 0xa
+	return 0
+}
+
+func (m *ArchivedWorkflowStatsGroup) GetDurationP99() float64 {
+	if m != nil {
+		return m.DurationP99
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *ArchivedWorkflowDeletedResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ArchivedWorkflowStatsGroup) GetBuckets() []*ArchivedWorkflowStatsBucket {
+	if m != nil {
+		return m.Buckets
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *ArchivedWorkflowDeletedResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type ArchivedWorkflowStatsBucket struct {
+	// The start of the bucket.
+	StartedAt *v1.Time `protobuf:"bytes,1,opt,name=startedAt,proto3" json:"startedAt,omitempty"`
+	Total     int64    `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	Succeeded int64    `protobuf:"varint,3,opt,name=succeeded,proto3" json:"succeeded,omitempty"`
+	// The fraction of workflows started in this bucket that succeeded.
+	SuccessRate          float64  `protobuf:"fixed64,4,opt,name=successRate,proto3" json:"successRate,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ArchivedWorkflowDeletedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+func (m *ArchivedWorkflowStatsBucket) Reset()         { *m = ArchivedWorkflowStatsBucket{} }
+func (m *ArchivedWorkflowStatsBucket) String() string { return proto.CompactTextString(m) }
+func (*ArchivedWorkflowStatsBucket) ProtoMessage()    {}
+func (*ArchivedWorkflowStatsBucket) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95ca9a2d33e8bb19, []int{9}
+}
+func (m *ArchivedWorkflowStatsBucket) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ArchivedWorkflowStatsBucket) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ArchivedWorkflowStatsBucket.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *ArchivedWorkflowStatsBucket) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ArchivedWorkflowStatsBucket.Merge(m, src)
+}
+func (m *ArchivedWorkflowStatsBucket) XXX_Size() int {
+	return m.Size()
+}
+func (m *ArchivedWorkflowStatsBucket) XXX_DiscardUnknown() {
+	xxx_messageInfo_ArchivedWorkflowStatsBucket.DiscardUnknown(m)
 }
 
-func encodeVarintWorkflowArchive(dAtA []byte, offset int, v uint64) int {
-	offset -= sovWorkflowArchive(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+var xxx_messageInfo_ArchivedWorkflowStatsBucket proto.InternalMessageInfo
+
+func (m *ArchivedWorkflowStatsBucket) GetStartedAt() *v1.Time {
+	if m != nil {
+		return m.StartedAt
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return nil
 }
-func (m *ListArchivedWorkflowsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.ListOptions != nil {
-		l = m.ListOptions.Size()
-		n += 1 + l + sovWorkflowArchive(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+
+func (m *ArchivedWorkflowStatsBucket) GetTotal() int64 {
+	if m != nil {
+		return m.Total
 	}
-	return n
+	return 0
 }
 
-func (m *GetArchivedWorkflowRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Uid)
-	if l > 0 {
-		n += 1 + l + sovWorkflowArchive(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func (m *ArchivedWorkflowStatsBucket) GetSucceeded() int64 {
+	if m != nil {
+		return m.Succeeded
 	}
-	return n
+	return 0
 }
 
-func (m *DeleteArchivedWorkflowRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Uid)
-	if l > 0 {
-		n += 1 + l + sovWorkflowArchive(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func (m *ArchivedWorkflowStatsBucket) GetSuccessRate() float64 {
+	if m != nil {
+		return m.SuccessRate
 	}
-	return n
+	return 0
 }
 
-func (m *ArchivedWorkflowDeletedResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
+func init() {
+	proto.RegisterType((*ListArchivedWorkflowsRequest)(nil), "workflowarchive.ListArchivedWorkflowsRequest")
+	proto.RegisterType((*GetArchivedWorkflowRequest)(nil), "workflowarchive.GetArchivedWorkflowRequest")
+	proto.RegisterType((*DeleteArchivedWorkflowRequest)(nil), "workflowarchive.DeleteArchivedWorkflowRequest")
+	proto.RegisterType((*ArchivedWorkflowDeletedResponse)(nil), "workflowarchive.ArchivedWorkflowDeletedResponse")
+	proto.RegisterType((*ResubmitArchivedWorkflowRequest)(nil), "workflowarchive.ResubmitArchivedWorkflowRequest")
+	proto.RegisterType((*RetryArchivedWorkflowRequest)(nil), "workflowarchive.RetryArchivedWorkflowRequest")
+	proto.RegisterType((*GetArchivedWorkflowStatsRequest)(nil), "workflowarchive.GetArchivedWorkflowStatsRequest")
+	proto.RegisterType((*ArchivedWorkflowStats)(nil), "workflowarchive.ArchivedWorkflowStats")
+	proto.RegisterType((*ArchivedWorkflowStatsGroup)(nil), "workflowarchive.ArchivedWorkflowStatsGroup")
+	proto.RegisterMapType((map[string]int64)(nil), "workflowarchive.ArchivedWorkflowStatsGroup.PhaseCountsEntry")
+	proto.RegisterType((*ArchivedWorkflowStatsBucket)(nil), "workflowarchive.ArchivedWorkflowStatsBucket")
 }
 
-func sovWorkflowArchive(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func init() {
+	proto.RegisterFile("pkg/apiclient/workflowarchive/workflow-archive.proto", fileDescriptor_95ca9a2d33e8bb19)
 }
-func sozWorkflowArchive(x uint64) (n int) {
-	return sovWorkflowArchive(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+var fileDescriptor_95ca9a2d33e8bb19 = []byte{
+	// 953 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0x4f, 0x6f, 0xe3, 0x44,
+	0x14, 0xd7, 0x34, 0xbb, 0x6d, 0x33, 0x39, 0xb0, 0xcc, 0xd2, 0xc5, 0x32, 0xa1, 0x0d, 0x3e, 0xac,
+	0x42, 0x77, 0x3b, 0x4e, 0x4a, 0x41, 0xdb, 0x15, 0x42, 0x6c, 0x5b, 0x76, 0x39, 0xac, 0xb4, 0xd5,
+	0x74, 0x25, 0x24, 0x84, 0x90, 0xa6, 0xf1, 0x6b, 0x62, 0x62, 0x7b, 0xcc, 0xcc, 0x38, 0x25, 0x20,
+	0x2e, 0x9c, 0xb9, 0x71, 0xe0, 0xc6, 0x89, 0x03, 0x27, 0xc4, 0x81, 0x1b, 0xe2, 0xc6, 0x01, 0x71,
+	0x42, 0xe2, 0x0b, 0xa0, 0x8a, 0x0f, 0x82, 0x3c, 0xb1, 0x1b, 0x93, 0x38, 0x8d, 0x11, 0xcb, 0xcd,
+	0xef, 0xcd, 0x7b, 0x6f, 0x7e, 0xef, 0xdf, 0xfc, 0x8c, 0xf7, 0xe2, 0x61, 0xdf, 0xe5, 0xb1, 0xdf,
+	0x0b, 0x7c, 0x88, 0xb4, 0x7b, 0x2e, 0xe4, 0xf0, 0x2c, 0x10, 0xe7, 0x5c, 0xf6, 0x06, 0xfe, 0x08,
+	0x2e, 0xe5, 0x9d, 0x4c, 0x41, 0x63, 0x29, 0xb4, 0x20, 0xcf, 0xcd, 0xd8, 0xd9, 0xcd, 0xbe, 0x10,
+	0xfd, 0x00, 0xd2, 0x48, 0x2e, 0x8f, 0x22, 0xa1, 0xb9, 0xf6, 0x45, 0xa4, 0x26, 0xe6, 0xf6, 0xde,
+	0xf0, 0x9e, 0xa2, 0xbe, 0x48, 0x4f, 0x43, 0xde, 0x1b, 0xf8, 0x11, 0xc8, 0xb1, 0x9b, 0x5d, 0xac,
+	0xdc, 0x10, 0x34, 0x77, 0x47, 0x5d, 0xb7, 0x0f, 0x11, 0x48, 0xae, 0xc1, 0xcb, 0xbc, 0x0e, 0xfb,
+	0xbe, 0x1e, 0x24, 0xa7, 0xb4, 0x27, 0x42, 0x97, 0xcb, 0xbe, 0x88, 0xa5, 0xf8, 0xc8, 0x7c, 0x4c,
+	0x5d, 0x73, 0x18, 0xee, 0xa8, 0xcb, 0x83, 0x78, 0xc0, 0xe7, 0x82, 0x38, 0x5f, 0xaf, 0xe0, 0xe6,
+	0x63, 0x5f, 0xe9, 0x07, 0x13, 0xa0, 0xde, 0x7b, 0x99, 0x87, 0x62, 0xf0, 0x71, 0x02, 0x4a, 0x93,
+	0x13, 0xdc, 0x08, 0x7c, 0xa5, 0x9f, 0xc4, 0x06, 0xb0, 0x85, 0x5a, 0xa8, 0xdd, 0xd8, 0xed, 0xd2,
+	0x09, 0x62, 0x5a, 0x44, 0x4c, 0xe3, 0x61, 0x3f, 0x55, 0x28, 0x9a, 0x22, 0xa6, 0xa3, 0x2e, 0x7d,
+	0x3c, 0x75, 0x64, 0xc5, 0x28, 0x64, 0x13, 0xe3, 0x88, 0x87, 0x70, 0x2c, 0xe1, 0xcc, 0xff, 0xc4,
+	0x5a, 0x69, 0xa1, 0x76, 0x9d, 0x15, 0x34, 0xa4, 0x85, 0x1b, 0x46, 0xe2, 0x5a, 0x83, 0x8c, 0xac,
+	0x9a, 0x31, 0x28, 0xaa, 0xc8, 0x2d, 0xbc, 0x1a, 0x0f, 0xb8, 0x02, 0x65, 0x5d, 0x6b, 0xd5, 0xda,
+	0x75, 0x96, 0x49, 0xa4, 0x83, 0x6f, 0xe6, 0x49, 0x3f, 0x85, 0x30, 0x0e, 0xb8, 0x06, 0x06, 0x67,
+	0xd6, 0x75, 0x13, 0xa1, 0xec, 0x28, 0x8d, 0xa4, 0x84, 0xd4, 0x07, 0x63, 0x6b, 0xd5, 0x18, 0x65,
+	0x92, 0x43, 0xb1, 0xfd, 0x08, 0xe6, 0xea, 0x92, 0x97, 0xe5, 0x06, 0xae, 0x25, 0xbe, 0x67, 0xca,
+	0x51, 0x67, 0xe9, 0xa7, 0xd3, 0xc5, 0x2f, 0x1f, 0x41, 0x00, 0x1a, 0xaa, 0xbb, 0xbc, 0x82, 0xb7,
+	0x66, 0x8d, 0x27, 0x21, 0x3c, 0x06, 0x2a, 0x16, 0x91, 0x02, 0xe7, 0x09, 0xde, 0x62, 0xa0, 0x92,
+	0xd3, 0xd0, 0xaf, 0x0e, 0x85, 0xd8, 0x78, 0x3d, 0x84, 0x50, 0xf8, 0x9f, 0x82, 0x67, 0x8a, 0xbb,
+	0xce, 0x2e, 0x65, 0xe7, 0x4b, 0x84, 0x9b, 0x0c, 0xb4, 0x1c, 0x57, 0x0f, 0x77, 0x17, 0x3f, 0x2f,
+	0x41, 0x69, 0x2e, 0xf5, 0x49, 0xd2, 0xeb, 0x81, 0x52, 0x67, 0x49, 0x90, 0xc5, 0x9d, 0x3f, 0x48,
+	0xad, 0x23, 0xe1, 0xc1, 0x43, 0x1f, 0x02, 0xef, 0x04, 0x02, 0xe8, 0x69, 0x21, 0xb3, 0x0e, 0xce,
+	0x1f, 0x38, 0xdf, 0x21, 0xbc, 0x55, 0x52, 0xe6, 0x13, 0xcd, 0xf5, 0xff, 0x3b, 0x82, 0x16, 0x5e,
+	0xeb, 0x4b, 0x91, 0xc4, 0x07, 0xe3, 0x6c, 0xfe, 0x72, 0x31, 0x1d, 0x88, 0xd3, 0xa4, 0x37, 0x04,
+	0x9d, 0xa1, 0xce, 0x24, 0xe7, 0x03, 0xbc, 0x51, 0x0a, 0x93, 0x1c, 0xe2, 0x55, 0xe3, 0x9b, 0x42,
+	0xab, 0xb5, 0x1b, 0xbb, 0x77, 0xe8, 0xcc, 0xfa, 0xd3, 0x52, 0xbf, 0x47, 0xa9, 0x0f, 0xcb, 0x5c,
+	0x9d, 0x1f, 0x6b, 0xd8, 0x5e, 0x6c, 0x96, 0x76, 0x65, 0x08, 0xe3, 0xbc, 0x2b, 0x43, 0x18, 0x93,
+	0x17, 0xf0, 0x75, 0x2d, 0x34, 0x9f, 0x74, 0xa2, 0xc6, 0x26, 0x02, 0xf9, 0x10, 0x37, 0xcc, 0x26,
+	0x1c, 0x8a, 0x24, 0xd2, 0xca, 0xaa, 0x19, 0x40, 0x6f, 0xfe, 0x0b, 0x40, 0xf4, 0x78, 0xea, 0xfe,
+	0x4e, 0xa4, 0xe5, 0x98, 0x15, 0x03, 0xa6, 0x9b, 0xa9, 0x26, 0xbd, 0x66, 0x5c, 0x83, 0x75, 0xad,
+	0x85, 0xda, 0x88, 0x15, 0x55, 0xa9, 0x85, 0x97, 0x48, 0xf3, 0xbe, 0x1d, 0xbf, 0xde, 0x31, 0x9b,
+	0x87, 0x58, 0x51, 0xf5, 0x0f, 0x8b, 0xfd, 0x8e, 0x59, 0xbb, 0xa2, 0xc5, 0xfe, 0x8c, 0xc5, 0xbe,
+	0xb5, 0x36, 0x6b, 0xb1, 0x4f, 0x1e, 0xe2, 0xb5, 0x49, 0x5b, 0x94, 0xb5, 0x6e, 0x72, 0xbc, 0x5b,
+	0x2d, 0xc7, 0x03, 0xe3, 0xc4, 0x72, 0x67, 0xfb, 0x2d, 0x7c, 0x63, 0x36, 0xe1, 0xf2, 0x5a, 0x8f,
+	0x78, 0x90, 0x40, 0x5e, 0x6b, 0x23, 0xdc, 0x5f, 0xb9, 0x87, 0x9c, 0x9f, 0x11, 0x7e, 0xe9, 0x8a,
+	0x8b, 0xc8, 0xbb, 0xb8, 0x6e, 0x16, 0x04, 0xbc, 0x07, 0x3a, 0x9b, 0xdc, 0xed, 0x6a, 0x93, 0xfb,
+	0xd4, 0x0f, 0x81, 0x4d, 0x9d, 0x17, 0xf4, 0xbb, 0x89, 0xeb, 0xa6, 0xf8, 0xe0, 0x81, 0x67, 0xe6,
+	0xb5, 0xc6, 0xa6, 0x8a, 0xe5, 0xdd, 0xda, 0xfd, 0x6d, 0x1d, 0xbf, 0x38, 0x87, 0x1f, 0xe4, 0xc8,
+	0xef, 0x01, 0xf9, 0x01, 0xe1, 0x8d, 0x52, 0x6e, 0x20, 0x3b, 0x73, 0xc5, 0xbe, 0x8a, 0x43, 0xec,
+	0x23, 0x3a, 0xa5, 0x2a, 0x9a, 0x53, 0x95, 0xf9, 0xa0, 0xa3, 0xdd, 0x69, 0xe6, 0x79, 0x4c, 0x9a,
+	0xb3, 0x15, 0xcd, 0x23, 0xa5, 0xd1, 0x1d, 0xe7, 0x8b, 0x3f, 0xfe, 0xfa, 0x6a, 0xa5, 0x49, 0x6c,
+	0xc3, 0xa2, 0xa3, 0xae, 0x9b, 0xdd, 0xed, 0xed, 0x9c, 0x5f, 0x02, 0xfb, 0x1e, 0xe1, 0x9b, 0x25,
+	0xcf, 0x09, 0x99, 0x5f, 0xc9, 0xc5, 0x6f, 0xbb, 0xfd, 0xf6, 0x7f, 0x85, 0xeb, 0xb4, 0x0d, 0x54,
+	0x87, 0xb4, 0x16, 0x43, 0x75, 0x3f, 0x4b, 0x7c, 0xef, 0x73, 0xf2, 0x0d, 0xc2, 0xd6, 0xa2, 0xf7,
+	0x8f, 0x74, 0xaa, 0xa0, 0x2e, 0x3e, 0x95, 0xf6, 0xed, 0x6a, 0x5b, 0x50, 0x05, 0xe0, 0x8e, 0x32,
+	0x18, 0xbe, 0x45, 0xf8, 0x56, 0x39, 0xaf, 0x11, 0x3a, 0x77, 0xd9, 0x95, 0x04, 0x68, 0x77, 0x96,
+	0x82, 0x9b, 0x65, 0xbf, 0x0c, 0xe6, 0xf6, 0xf2, 0x3a, 0xfe, 0x82, 0xb0, 0xb5, 0x88, 0x28, 0x4b,
+	0xea, 0xb8, 0x84, 0x53, 0x9f, 0xc1, 0x08, 0xec, 0x19, 0xe8, 0xf4, 0x3e, 0xda, 0xb6, 0x5f, 0x5d,
+	0x86, 0xde, 0x95, 0x19, 0x1c, 0xf2, 0x13, 0xc2, 0x1b, 0xa5, 0xec, 0x5c, 0xb2, 0x72, 0x57, 0xb1,
+	0xf8, 0x33, 0x48, 0xa0, 0x6b, 0x12, 0xb8, 0x93, 0x26, 0x70, 0xbb, 0x42, 0x02, 0x5a, 0x8e, 0x0f,
+	0x8e, 0x7e, 0xbd, 0xd8, 0x44, 0xbf, 0x5f, 0x6c, 0xa2, 0x3f, 0x2f, 0x36, 0xd1, 0xfb, 0x6f, 0x2c,
+	0xfb, 0x3f, 0x2d, 0xff, 0xa7, 0x3e, 0x5d, 0x35, 0x7f, 0xa6, 0xaf, 0xfd, 0x1d, 0x00, 0x00, 0xff,
+	0xff, 0x0f, 0xd2, 0x3f, 0xfc, 0x7b, 0x0b, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// ArchivedWorkflowServiceClient is the client API for ArchivedWorkflowService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type ArchivedWorkflowServiceClient interface {
+	ListArchivedWorkflows(ctx context.Context, in *ListArchivedWorkflowsRequest, opts ...grpc.CallOption) (*v1alpha1.WorkflowList, error)
+	GetArchivedWorkflow(ctx context.Context, in *GetArchivedWorkflowRequest, opts ...grpc.CallOption) (*v1alpha1.Workflow, error)
+	GetArchivedWorkflowStats(ctx context.Context, in *GetArchivedWorkflowStatsRequest, opts ...grpc.CallOption) (*ArchivedWorkflowStats, error)
+	DeleteArchivedWorkflow(ctx context.Context, in *DeleteArchivedWorkflowRequest, opts ...grpc.CallOption) (*ArchivedWorkflowDeletedResponse, error)
+	ResubmitArchivedWorkflow(ctx context.Context, in *ResubmitArchivedWorkflowRequest, opts ...grpc.CallOption) (*v1alpha1.Workflow, error)
+	RetryArchivedWorkflow(ctx context.Context, in *RetryArchivedWorkflowRequest, opts ...grpc.CallOption) (*v1alpha1.Workflow, error)
+}
+
+type archivedWorkflowServiceClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewArchivedWorkflowServiceClient(cc *grpc.ClientConn) ArchivedWorkflowServiceClient {
+	return &archivedWorkflowServiceClient{cc}
+}
+
+func (c *archivedWorkflowServiceClient) ListArchivedWorkflows(ctx context.Context, in *ListArchivedWorkflowsRequest, opts ...grpc.CallOption) (*v1alpha1.WorkflowList, error) {
+	out := new(v1alpha1.WorkflowList)
+	err := c.cc.Invoke(ctx, "/workflowarchive.ArchivedWorkflowService/ListArchivedWorkflows", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *archivedWorkflowServiceClient) GetArchivedWorkflow(ctx context.Context, in *GetArchivedWorkflowRequest, opts ...grpc.CallOption) (*v1alpha1.Workflow, error) {
+	out := new(v1alpha1.Workflow)
+	err := c.cc.Invoke(ctx, "/workflowarchive.ArchivedWorkflowService/GetArchivedWorkflow", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *archivedWorkflowServiceClient) GetArchivedWorkflowStats(ctx context.Context, in *GetArchivedWorkflowStatsRequest, opts ...grpc.CallOption) (*ArchivedWorkflowStats, error) {
+	out := new(ArchivedWorkflowStats)
+	err := c.cc.Invoke(ctx, "/workflowarchive.ArchivedWorkflowService/GetArchivedWorkflowStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *archivedWorkflowServiceClient) DeleteArchivedWorkflow(ctx context.Context, in *DeleteArchivedWorkflowRequest, opts ...grpc.CallOption) (*ArchivedWorkflowDeletedResponse, error) {
+	out := new(ArchivedWorkflowDeletedResponse)
+	err := c.cc.Invoke(ctx, "/workflowarchive.ArchivedWorkflowService/DeleteArchivedWorkflow", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *archivedWorkflowServiceClient) ResubmitArchivedWorkflow(ctx context.Context, in *ResubmitArchivedWorkflowRequest, opts ...grpc.CallOption) (*v1alpha1.Workflow, error) {
+	out := new(v1alpha1.Workflow)
+	err := c.cc.Invoke(ctx, "/workflowarchive.ArchivedWorkflowService/ResubmitArchivedWorkflow", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *archivedWorkflowServiceClient) RetryArchivedWorkflow(ctx context.Context, in *RetryArchivedWorkflowRequest, opts ...grpc.CallOption) (*v1alpha1.Workflow, error) {
+	out := new(v1alpha1.Workflow)
+	err := c.cc.Invoke(ctx, "/workflowarchive.ArchivedWorkflowService/RetryArchivedWorkflow", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ArchivedWorkflowServiceServer is the server API for ArchivedWorkflowService service.
+type ArchivedWorkflowServiceServer interface {
+	ListArchivedWorkflows(context.Context, *ListArchivedWorkflowsRequest) (*v1alpha1.WorkflowList, error)
+	GetArchivedWorkflow(context.Context, *GetArchivedWorkflowRequest) (*v1alpha1.Workflow, error)
+	GetArchivedWorkflowStats(context.Context, *GetArchivedWorkflowStatsRequest) (*ArchivedWorkflowStats, error)
+	DeleteArchivedWorkflow(context.Context, *DeleteArchivedWorkflowRequest) (*ArchivedWorkflowDeletedResponse, error)
+	ResubmitArchivedWorkflow(context.Context, *ResubmitArchivedWorkflowRequest) (*v1alpha1.Workflow, error)
+	RetryArchivedWorkflow(context.Context, *RetryArchivedWorkflowRequest) (*v1alpha1.Workflow, error)
+}
+
+// UnimplementedArchivedWorkflowServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedArchivedWorkflowServiceServer struct {
+}
+
+func (*UnimplementedArchivedWorkflowServiceServer) ListArchivedWorkflows(ctx context.Context, req *ListArchivedWorkflowsRequest) (*v1alpha1.WorkflowList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListArchivedWorkflows not implemented")
+}
+func (*UnimplementedArchivedWorkflowServiceServer) GetArchivedWorkflow(ctx context.Context, req *GetArchivedWorkflowRequest) (*v1alpha1.Workflow, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetArchivedWorkflow not implemented")
+}
+func (*UnimplementedArchivedWorkflowServiceServer) GetArchivedWorkflowStats(ctx context.Context, req *GetArchivedWorkflowStatsRequest) (*ArchivedWorkflowStats, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetArchivedWorkflowStats not implemented")
+}
+func (*UnimplementedArchivedWorkflowServiceServer) DeleteArchivedWorkflow(ctx context.Context, req *DeleteArchivedWorkflowRequest) (*ArchivedWorkflowDeletedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteArchivedWorkflow not implemented")
+}
+func (*UnimplementedArchivedWorkflowServiceServer) ResubmitArchivedWorkflow(ctx context.Context, req *ResubmitArchivedWorkflowRequest) (*v1alpha1.Workflow, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResubmitArchivedWorkflow not implemented")
+}
+func (*UnimplementedArchivedWorkflowServiceServer) RetryArchivedWorkflow(ctx context.Context, req *RetryArchivedWorkflowRequest) (*v1alpha1.Workflow, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RetryArchivedWorkflow not implemented")
+}
+
+func RegisterArchivedWorkflowServiceServer(s *grpc.Server, srv ArchivedWorkflowServiceServer) {
+	s.RegisterService(&_ArchivedWorkflowService_serviceDesc, srv)
+}
+
+func _ArchivedWorkflowService_ListArchivedWorkflows_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListArchivedWorkflowsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArchivedWorkflowServiceServer).ListArchivedWorkflows(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/workflowarchive.ArchivedWorkflowService/ListArchivedWorkflows",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArchivedWorkflowServiceServer).ListArchivedWorkflows(ctx, req.(*ListArchivedWorkflowsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ArchivedWorkflowService_GetArchivedWorkflow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetArchivedWorkflowRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArchivedWorkflowServiceServer).GetArchivedWorkflow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/workflowarchive.ArchivedWorkflowService/GetArchivedWorkflow",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArchivedWorkflowServiceServer).GetArchivedWorkflow(ctx, req.(*GetArchivedWorkflowRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ArchivedWorkflowService_GetArchivedWorkflowStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetArchivedWorkflowStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArchivedWorkflowServiceServer).GetArchivedWorkflowStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/workflowarchive.ArchivedWorkflowService/GetArchivedWorkflowStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArchivedWorkflowServiceServer).GetArchivedWorkflowStats(ctx, req.(*GetArchivedWorkflowStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ArchivedWorkflowService_DeleteArchivedWorkflow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteArchivedWorkflowRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArchivedWorkflowServiceServer).DeleteArchivedWorkflow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/workflowarchive.ArchivedWorkflowService/DeleteArchivedWorkflow",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArchivedWorkflowServiceServer).DeleteArchivedWorkflow(ctx, req.(*DeleteArchivedWorkflowRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ArchivedWorkflowService_ResubmitArchivedWorkflow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResubmitArchivedWorkflowRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArchivedWorkflowServiceServer).ResubmitArchivedWorkflow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/workflowarchive.ArchivedWorkflowService/ResubmitArchivedWorkflow",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArchivedWorkflowServiceServer).ResubmitArchivedWorkflow(ctx, req.(*ResubmitArchivedWorkflowRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ArchivedWorkflowService_RetryArchivedWorkflow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RetryArchivedWorkflowRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArchivedWorkflowServiceServer).RetryArchivedWorkflow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/workflowarchive.ArchivedWorkflowService/RetryArchivedWorkflow",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArchivedWorkflowServiceServer).RetryArchivedWorkflow(ctx, req.(*RetryArchivedWorkflowRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _ArchivedWorkflowService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "workflowarchive.ArchivedWorkflowService",
+	HandlerType: (*ArchivedWorkflowServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListArchivedWorkflows",
+			Handler:    _ArchivedWorkflowService_ListArchivedWorkflows_Handler,
+		},
+		{
+			MethodName: "GetArchivedWorkflow",
+			Handler:    _ArchivedWorkflowService_GetArchivedWorkflow_Handler,
+		},
+		{
+			MethodName: "GetArchivedWorkflowStats",
+			Handler:    _ArchivedWorkflowService_GetArchivedWorkflowStats_Handler,
+		},
+		{
+			MethodName: "DeleteArchivedWorkflow",
+			Handler:    _ArchivedWorkflowService_DeleteArchivedWorkflow_Handler,
+		},
+		{
+			MethodName: "ResubmitArchivedWorkflow",
+			Handler:    _ArchivedWorkflowService_ResubmitArchivedWorkflow_Handler,
+		},
+		{
+			MethodName: "RetryArchivedWorkflow",
+			Handler:    _ArchivedWorkflowService_RetryArchivedWorkflow_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "pkg/apiclient/workflowarchive/workflow-archive.proto",
+}
+
+func (m *ListArchivedWorkflowsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListArchivedWorkflowsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListArchivedWorkflowsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.SortBy) > 0 {
+		i -= len(m.SortBy)
+		copy(dAtA[i:], m.SortBy)
+		i = encodeVarintWorkflowArchive(dAtA, i, uint64(len(m.SortBy)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.WorkflowTemplateRef) > 0 {
+		i -= len(m.WorkflowTemplateRef)
+		copy(dAtA[i:], m.WorkflowTemplateRef)
+		i = encodeVarintWorkflowArchive(dAtA, i, uint64(len(m.WorkflowTemplateRef)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Phases) > 0 {
+		for iNdEx := len(m.Phases) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Phases[iNdEx])
+			copy(dAtA[i:], m.Phases[iNdEx])
+			i = encodeVarintWorkflowArchive(dAtA, i, uint64(len(m.Phases[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.NamePattern) > 0 {
+		i -= len(m.NamePattern)
+		copy(dAtA[i:], m.NamePattern)
+		i = encodeVarintWorkflowArchive(dAtA, i, uint64(len(m.NamePattern)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.NamePrefix) > 0 {
+		i -= len(m.NamePrefix)
+		copy(dAtA[i:], m.NamePrefix)
+		i = encodeVarintWorkflowArchive(dAtA, i, uint64(len(m.NamePrefix)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ListOptions != nil {
+		{
+			size, err := m.ListOptions.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintWorkflowArchive(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetArchivedWorkflowRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetArchivedWorkflowRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetArchivedWorkflowRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Uid) > 0 {
+		i -= len(m.Uid)
+		copy(dAtA[i:], m.Uid)
+		i = encodeVarintWorkflowArchive(dAtA, i, uint64(len(m.Uid)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DeleteArchivedWorkflowRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeleteArchivedWorkflowRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DeleteArchivedWorkflowRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Uid) > 0 {
+		i -= len(m.Uid)
+		copy(dAtA[i:], m.Uid)
+		i = encodeVarintWorkflowArchive(dAtA, i, uint64(len(m.Uid)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ArchivedWorkflowDeletedResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ArchivedWorkflowDeletedResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ArchivedWorkflowDeletedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ResubmitArchivedWorkflowRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ResubmitArchivedWorkflowRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ResubmitArchivedWorkflowRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Memoized {
+		i--
+		if m.Memoized {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Uid) > 0 {
+		i -= len(m.Uid)
+		copy(dAtA[i:], m.Uid)
+		i = encodeVarintWorkflowArchive(dAtA, i, uint64(len(m.Uid)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RetryArchivedWorkflowRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RetryArchivedWorkflowRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RetryArchivedWorkflowRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.NodeFieldSelector) > 0 {
+		i -= len(m.NodeFieldSelector)
+		copy(dAtA[i:], m.NodeFieldSelector)
+		i = encodeVarintWorkflowArchive(dAtA, i, uint64(len(m.NodeFieldSelector)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.RestartSuccessful {
+		i--
+		if m.RestartSuccessful {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Uid) > 0 {
+		i -= len(m.Uid)
+		copy(dAtA[i:], m.Uid)
+		i = encodeVarintWorkflowArchive(dAtA, i, uint64(len(m.Uid)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetArchivedWorkflowStatsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetArchivedWorkflowStatsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetArchivedWorkflowStatsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Bucket) > 0 {
+		i -= len(m.Bucket)
+		copy(dAtA[i:], m.Bucket)
+		i = encodeVarintWorkflowArchive(dAtA, i, uint64(len(m.Bucket)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.GroupBy) > 0 {
+		i -= len(m.GroupBy)
+		copy(dAtA[i:], m.GroupBy)
+		i = encodeVarintWorkflowArchive(dAtA, i, uint64(len(m.GroupBy)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ListOptions != nil {
+		{
+			size, err := m.ListOptions.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintWorkflowArchive(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ArchivedWorkflowStats) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ArchivedWorkflowStats) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ArchivedWorkflowStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Groups) > 0 {
+		for iNdEx := len(m.Groups) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Groups[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintWorkflowArchive(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ArchivedWorkflowStatsGroup) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ArchivedWorkflowStatsGroup) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ArchivedWorkflowStatsGroup) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Buckets) > 0 {
+		for iNdEx := len(m.Buckets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Buckets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintWorkflowArchive(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if m.DurationP99 != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.DurationP99))))
+		i--
+		dAtA[i] = 0x39
+	}
+	if m.DurationP90 != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.DurationP90))))
+		i--
+		dAtA[i] = 0x31
+	}
+	if m.DurationP50 != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.DurationP50))))
+		i--
+		dAtA[i] = 0x29
+	}
+	if m.SuccessRate != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.SuccessRate))))
+		i--
+		dAtA[i] = 0x21
+	}
+	if len(m.PhaseCounts) > 0 {
+		for k := range m.PhaseCounts {
+			v := m.PhaseCounts[k]
+			baseI := i
+			i = encodeVarintWorkflowArchive(dAtA, i, uint64(v))
+			i--
+			dAtA[i] = 0x10
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintWorkflowArchive(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintWorkflowArchive(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.Total != 0 {
+		i = encodeVarintWorkflowArchive(dAtA, i, uint64(m.Total))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintWorkflowArchive(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ArchivedWorkflowStatsBucket) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ArchivedWorkflowStatsBucket) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ArchivedWorkflowStatsBucket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.SuccessRate != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.SuccessRate))))
+		i--
+		dAtA[i] = 0x21
+	}
+	if m.Succeeded != 0 {
+		i = encodeVarintWorkflowArchive(dAtA, i, uint64(m.Succeeded))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Total != 0 {
+		i = encodeVarintWorkflowArchive(dAtA, i, uint64(m.Total))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.StartedAt != nil {
+		{
+			size, err := m.StartedAt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintWorkflowArchive(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintWorkflowArchive(dAtA []byte, offset int, v uint64) int {
+	offset -= sovWorkflowArchive(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ListArchivedWorkflowsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ListOptions != nil {
+		l = m.ListOptions.Size()
+		n += 1 + l + sovWorkflowArchive(uint64(l))
+	}
+	l = len(m.NamePrefix)
+	if l > 0 {
+		n += 1 + l + sovWorkflowArchive(uint64(l))
+	}
+	l = len(m.NamePattern)
+	if l > 0 {
+		n += 1 + l + sovWorkflowArchive(uint64(l))
+	}
+	if len(m.Phases) > 0 {
+		for _, s := range m.Phases {
+			l = len(s)
+			n += 1 + l + sovWorkflowArchive(uint64(l))
+		}
+	}
+	l = len(m.WorkflowTemplateRef)
+	if l > 0 {
+		n += 1 + l + sovWorkflowArchive(uint64(l))
+	}
+	l = len(m.SortBy)
+	if l > 0 {
+		n += 1 + l + sovWorkflowArchive(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetArchivedWorkflowRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Uid)
+	if l > 0 {
+		n += 1 + l + sovWorkflowArchive(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DeleteArchivedWorkflowRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Uid)
+	if l > 0 {
+		n += 1 + l + sovWorkflowArchive(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ArchivedWorkflowDeletedResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ResubmitArchivedWorkflowRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Uid)
+	if l > 0 {
+		n += 1 + l + sovWorkflowArchive(uint64(l))
+	}
+	if m.Memoized {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RetryArchivedWorkflowRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Uid)
+	if l > 0 {
+		n += 1 + l + sovWorkflowArchive(uint64(l))
+	}
+	if m.RestartSuccessful {
+		n += 2
+	}
+	l = len(m.NodeFieldSelector)
+	if l > 0 {
+		n += 1 + l + sovWorkflowArchive(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetArchivedWorkflowStatsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ListOptions != nil {
+		l = m.ListOptions.Size()
+		n += 1 + l + sovWorkflowArchive(uint64(l))
+	}
+	l = len(m.GroupBy)
+	if l > 0 {
+		n += 1 + l + sovWorkflowArchive(uint64(l))
+	}
+	l = len(m.Bucket)
+	if l > 0 {
+		n += 1 + l + sovWorkflowArchive(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ArchivedWorkflowStats) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Groups) > 0 {
+		for _, e := range m.Groups {
+			l = e.Size()
+			n += 1 + l + sovWorkflowArchive(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ArchivedWorkflowStatsGroup) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovWorkflowArchive(uint64(l))
+	}
+	if m.Total != 0 {
+		n += 1 + sovWorkflowArchive(uint64(m.Total))
+	}
+	if len(m.PhaseCounts) > 0 {
+		for k, v := range m.PhaseCounts {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovWorkflowArchive(uint64(len(k))) + 1 + sovWorkflowArchive(uint64(v))
+			n += mapEntrySize + 1 + sovWorkflowArchive(uint64(mapEntrySize))
+		}
+	}
+	if m.SuccessRate != 0 {
+		n += 9
+	}
+	if m.DurationP50 != 0 {
+		n += 9
+	}
+	if m.DurationP90 != 0 {
+		n += 9
+	}
+	if m.DurationP99 != 0 {
+		n += 9
+	}
+	if len(m.Buckets) > 0 {
+		for _, e := range m.Buckets {
+			l = e.Size()
+			n += 1 + l + sovWorkflowArchive(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ArchivedWorkflowStatsBucket) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StartedAt != nil {
+		l = m.StartedAt.Size()
+		n += 1 + l + sovWorkflowArchive(uint64(l))
+	}
+	if m.Total != 0 {
+		n += 1 + sovWorkflowArchive(uint64(m.Total))
+	}
+	if m.Succeeded != 0 {
+		n += 1 + sovWorkflowArchive(uint64(m.Succeeded))
+	}
+	if m.SuccessRate != 0 {
+		n += 9
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovWorkflowArchive(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozWorkflowArchive(x uint64) (n int) {
+	return sovWorkflowArchive(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ListArchivedWorkflowsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowWorkflowArchive
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListArchivedWorkflowsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListArchivedWorkflowsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ListOptions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflowArchive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthWorkflowArchive
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorkflowArchive
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ListOptions == nil {
+				m.ListOptions = &v1.ListOptions{}
+			}
+			if err := m.ListOptions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NamePrefix", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflowArchive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWorkflowArchive
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorkflowArchive
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NamePrefix = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NamePattern", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflowArchive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWorkflowArchive
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorkflowArchive
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NamePattern = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Phases", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflowArchive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWorkflowArchive
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorkflowArchive
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Phases = append(m.Phases, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowTemplateRef", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflowArchive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWorkflowArchive
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorkflowArchive
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WorkflowTemplateRef = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SortBy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflowArchive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWorkflowArchive
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorkflowArchive
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SortBy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipWorkflowArchive(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthWorkflowArchive
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthWorkflowArchive
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetArchivedWorkflowRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowWorkflowArchive
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetArchivedWorkflowRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetArchivedWorkflowRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Uid", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflowArchive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWorkflowArchive
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorkflowArchive
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Uid = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipWorkflowArchive(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthWorkflowArchive
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthWorkflowArchive
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DeleteArchivedWorkflowRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowWorkflowArchive
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeleteArchivedWorkflowRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeleteArchivedWorkflowRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Uid", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflowArchive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWorkflowArchive
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorkflowArchive
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Uid = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipWorkflowArchive(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthWorkflowArchive
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthWorkflowArchive
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ArchivedWorkflowDeletedResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowWorkflowArchive
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ArchivedWorkflowDeletedResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ArchivedWorkflowDeletedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipWorkflowArchive(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthWorkflowArchive
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthWorkflowArchive
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ResubmitArchivedWorkflowRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowWorkflowArchive
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ResubmitArchivedWorkflowRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ResubmitArchivedWorkflowRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Uid", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflowArchive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWorkflowArchive
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorkflowArchive
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Uid = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Memoized", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflowArchive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Memoized = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipWorkflowArchive(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthWorkflowArchive
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthWorkflowArchive
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RetryArchivedWorkflowRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowWorkflowArchive
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RetryArchivedWorkflowRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RetryArchivedWorkflowRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Uid", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflowArchive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWorkflowArchive
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorkflowArchive
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Uid = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RestartSuccessful", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflowArchive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RestartSuccessful = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeFieldSelector", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflowArchive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWorkflowArchive
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorkflowArchive
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NodeFieldSelector = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipWorkflowArchive(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthWorkflowArchive
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthWorkflowArchive
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *ListArchivedWorkflowsRequest) Unmarshal(dAtA []byte) error {
+func (m *GetArchivedWorkflowStatsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -639,10 +2517,10 @@ func (m *ListArchivedWorkflowsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ListArchivedWorkflowsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetArchivedWorkflowStatsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListArchivedWorkflowsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetArchivedWorkflowStatsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -681,6 +2559,70 @@ func (m *ListArchivedWorkflowsRequest) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupBy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflowArchive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWorkflowArchive
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorkflowArchive
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GroupBy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bucket", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflowArchive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWorkflowArchive
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorkflowArchive
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Bucket = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipWorkflowArchive(dAtA[iNdEx:])
@@ -706,7 +2648,7 @@ func (m *ListArchivedWorkflowsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetArchivedWorkflowRequest) Unmarshal(dAtA []byte) error {
+func (m *ArchivedWorkflowStats) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -729,17 +2671,17 @@ func (m *GetArchivedWorkflowRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetArchivedWorkflowRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ArchivedWorkflowStats: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetArchivedWorkflowRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ArchivedWorkflowStats: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Uid", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Groups", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowWorkflowArchive
@@ -749,23 +2691,25 @@ func (m *GetArchivedWorkflowRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthWorkflowArchive
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthWorkflowArchive
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Uid = string(dAtA[iNdEx:postIndex])
+			m.Groups = append(m.Groups, &ArchivedWorkflowStatsGroup{})
+			if err := m.Groups[len(m.Groups)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -792,7 +2736,7 @@ func (m *GetArchivedWorkflowRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DeleteArchivedWorkflowRequest) Unmarshal(dAtA []byte) error {
+func (m *ArchivedWorkflowStatsGroup) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -815,15 +2759,15 @@ func (m *DeleteArchivedWorkflowRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DeleteArchivedWorkflowRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ArchivedWorkflowStatsGroup: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DeleteArchivedWorkflowRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ArchivedWorkflowStatsGroup: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Uid", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -851,7 +2795,217 @@ func (m *DeleteArchivedWorkflowRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Uid = string(dAtA[iNdEx:postIndex])
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
+			}
+			m.Total = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflowArchive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Total |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PhaseCounts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflowArchive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthWorkflowArchive
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorkflowArchive
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PhaseCounts == nil {
+				m.PhaseCounts = make(map[string]int64)
+			}
+			var mapkey string
+			var mapvalue int64
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowWorkflowArchive
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowWorkflowArchive
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthWorkflowArchive
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthWorkflowArchive
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowWorkflowArchive
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvalue |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipWorkflowArchive(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthWorkflowArchive
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.PhaseCounts[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 4:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SuccessRate", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.SuccessRate = float64(math.Float64frombits(v))
+		case 5:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DurationP50", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.DurationP50 = float64(math.Float64frombits(v))
+		case 6:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DurationP90", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.DurationP90 = float64(math.Float64frombits(v))
+		case 7:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DurationP99", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.DurationP99 = float64(math.Float64frombits(v))
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Buckets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflowArchive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthWorkflowArchive
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorkflowArchive
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Buckets = append(m.Buckets, &ArchivedWorkflowStatsBucket{})
+			if err := m.Buckets[len(m.Buckets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -878,7 +3032,7 @@ func (m *DeleteArchivedWorkflowRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ArchivedWorkflowDeletedResponse) Unmarshal(dAtA []byte) error {
+func (m *ArchivedWorkflowStatsBucket) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -901,12 +3055,97 @@ func (m *ArchivedWorkflowDeletedResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ArchivedWorkflowDeletedResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ArchivedWorkflowStatsBucket: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ArchivedWorkflowDeletedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ArchivedWorkflowStatsBucket: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflowArchive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthWorkflowArchive
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorkflowArchive
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.StartedAt == nil {
+				m.StartedAt = &v1.Time{}
+			}
+			if err := m.StartedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
+			}
+			m.Total = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflowArchive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Total |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Succeeded", wireType)
+			}
+			m.Succeeded = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflowArchive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Succeeded |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SuccessRate", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.SuccessRate = float64(math.Float64frombits(v))
 		default:
 			iNdEx = preIndex
 			skippy, err := skipWorkflowArchive(dAtA[iNdEx:])
@@ -29,6 +29,12 @@ type WorkflowEventBindingSpec struct {
 	Event Event `json:"event" protobuf:"bytes,1,opt,name=event"`
 	// Submit is the workflow template to submit
 	Submit *Submit `json:"submit,omitempty" protobuf:"bytes,2,opt,name=submit"`
+	// Resume resumes the selected workflows, e.g. to approve a suspended step
+	Resume *ResumeAction `json:"resume,omitempty" protobuf:"bytes,3,opt,name=resume"`
+	// Stop stops the selected workflows, or fails their suspended nodes
+	Stop *StopAction `json:"stop,omitempty" protobuf:"bytes,4,opt,name=stop"`
+	// Terminate terminates the selected workflows
+	Terminate *TerminateAction `json:"terminate,omitempty" protobuf:"bytes,5,opt,name=terminate"`
 }
 
 type Event struct {
@@ -46,3 +52,36 @@ type Submit struct {
 	// Arguments extracted from the event and then set as arguments to the workflow created.
 	Arguments *Arguments `json:"arguments,omitempty" protobuf:"bytes,2,opt,name=arguments"`
 }
+
+// EventWorkflowSelector selects the running workflows, in the binding's namespace, that an event acts on.
+// At least one of the name or labels must be specified.
+type EventWorkflowSelector struct {
+	// Name is an expression that evaluates to the name of the workflow, e.g. `payload.workflow`
+	Name string `json:"name,omitempty" protobuf:"bytes,1,opt,name=name"`
+	// Labels the workflows must have, the values are expressions, e.g. `{"approval-id": "payload.id"}`
+	Labels map[string]string `json:"labels,omitempty" protobuf:"bytes,2,rep,name=labels"`
+}
+
+type ResumeAction struct {
+	// Workflows selects the workflows to resume
+	Workflows EventWorkflowSelector `json:"workflows" protobuf:"bytes,1,opt,name=workflows"`
+	// NodeFieldSelector selects the suspended nodes to resume, e.g. `displayName=approve`, if empty then all are resumed
+	NodeFieldSelector string `json:"nodeFieldSelector,omitempty" protobuf:"bytes,2,opt,name=nodeFieldSelector"`
+	// OutputParameters set on the suspended nodes, extracted from the event using `valueFrom.event`.
+	// Requires a node field selector.
+	OutputParameters []Parameter `json:"outputParameters,omitempty" protobuf:"bytes,3,rep,name=outputParameters"`
+}
+
+type StopAction struct {
+	// Workflows selects the workflows to stop
+	Workflows EventWorkflowSelector `json:"workflows" protobuf:"bytes,1,opt,name=workflows"`
+	// NodeFieldSelector selects the suspended nodes to fail, if empty then the workflows are stopped
+	NodeFieldSelector string `json:"nodeFieldSelector,omitempty" protobuf:"bytes,2,opt,name=nodeFieldSelector"`
+	// Message is an expression for the message set on the failed nodes, e.g. `"rejected by " + payload.user`
+	Message string `json:"message,omitempty" protobuf:"bytes,3,opt,name=message"`
+}
+
+type TerminateAction struct {
+	// Workflows selects the workflows to terminate
+	Workflows EventWorkflowSelector `json:"workflows" protobuf:"bytes,1,opt,name=workflows"`
+}
@@ -148,10 +148,66 @@ func (m *Artifact) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Artifact proto.InternalMessageInfo
 
+func (m *ArtifactCache) Reset()      { *m = ArtifactCache{} }
+func (*ArtifactCache) ProtoMessage() {}
+func (*ArtifactCache) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f89c80cffb38c82c, []int{4}
+}
+func (m *ArtifactCache) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ArtifactCache) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ArtifactCache) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ArtifactCache.Merge(m, src)
+}
+func (m *ArtifactCache) XXX_Size() int {
+	return m.Size()
+}
+func (m *ArtifactCache) XXX_DiscardUnknown() {
+	xxx_messageInfo_ArtifactCache.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ArtifactCache proto.InternalMessageInfo
+
+func (m *ArtifactGC) Reset()      { *m = ArtifactGC{} }
+func (*ArtifactGC) ProtoMessage() {}
+func (*ArtifactGC) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f89c80cffb38c82c, []int{5}
+}
+func (m *ArtifactGC) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ArtifactGC) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ArtifactGC) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ArtifactGC.Merge(m, src)
+}
+func (m *ArtifactGC) XXX_Size() int {
+	return m.Size()
+}
+func (m *ArtifactGC) XXX_DiscardUnknown() {
+	xxx_messageInfo_ArtifactGC.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ArtifactGC proto.InternalMessageInfo
+
 func (m *ArtifactLocation) Reset()      { *m = ArtifactLocation{} }
 func (*ArtifactLocation) ProtoMessage() {}
 func (*ArtifactLocation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f89c80cffb38c82c, []int{4}
+	return fileDescriptor_f89c80cffb38c82c, []int{6}
 }
 func (m *ArtifactLocation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -179,7 +235,7 @@ var xxx_messageInfo_ArtifactLocation proto.InternalMessageInfo
 func (m *ArtifactRepositoryRef) Reset()      { *m = ArtifactRepositoryRef{} }
 func (*ArtifactRepositoryRef) ProtoMessage() {}
 func (*ArtifactRepositoryRef) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f89c80cffb38c82c, []int{5}
+	return fileDescriptor_f89c80cffb38c82c, []int{7}
 }
 func (m *ArtifactRepositoryRef) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -207,7 +263,7 @@ var xxx_messageInfo_ArtifactRepositoryRef proto.InternalMessageInfo
 func (m *ArtifactRepositoryRefStatus) Reset()      { *m = ArtifactRepositoryRefStatus{} }
 func (*ArtifactRepositoryRefStatus) ProtoMessage() {}
 func (*ArtifactRepositoryRefStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f89c80cffb38c82c, []int{6}
+	return fileDescriptor_f89c80cffb38c82c, []int{8}
 }
 func (m *ArtifactRepositoryRefStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -235,7 +291,7 @@ var xxx_messageInfo_ArtifactRepositoryRefStatus proto.InternalMessageInfo
 func (m *ArtifactoryArtifact) Reset()      { *m = ArtifactoryArtifact{} }
 func (*ArtifactoryArtifact) ProtoMessage() {}
 func (*ArtifactoryArtifact) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f89c80cffb38c82c, []int{7}
+	return fileDescriptor_f89c80cffb38c82c, []int{9}
 }
 func (m *ArtifactoryArtifact) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -263,7 +319,7 @@ var xxx_messageInfo_ArtifactoryArtifact proto.InternalMessageInfo
 func (m *ArtifactoryAuth) Reset()      { *m = ArtifactoryAuth{} }
 func (*ArtifactoryAuth) ProtoMessage() {}
 func (*ArtifactoryAuth) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f89c80cffb38c82c, []int{8}
+	return fileDescriptor_f89c80cffb38c82c, []int{10}
 }
 func (m *ArtifactoryAuth) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -288,10 +344,66 @@ func (m *ArtifactoryAuth) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ArtifactoryAuth proto.InternalMessageInfo
 
+func (m *AzureArtifact) Reset()      { *m = AzureArtifact{} }
+func (*AzureArtifact) ProtoMessage() {}
+func (*AzureArtifact) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f89c80cffb38c82c, []int{11}
+}
+func (m *AzureArtifact) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AzureArtifact) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *AzureArtifact) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AzureArtifact.Merge(m, src)
+}
+func (m *AzureArtifact) XXX_Size() int {
+	return m.Size()
+}
+func (m *AzureArtifact) XXX_DiscardUnknown() {
+	xxx_messageInfo_AzureArtifact.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AzureArtifact proto.InternalMessageInfo
+
+func (m *AzureBlobContainer) Reset()      { *m = AzureBlobContainer{} }
+func (*AzureBlobContainer) ProtoMessage() {}
+func (*AzureBlobContainer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f89c80cffb38c82c, []int{12}
+}
+func (m *AzureBlobContainer) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AzureBlobContainer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *AzureBlobContainer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AzureBlobContainer.Merge(m, src)
+}
+func (m *AzureBlobContainer) XXX_Size() int {
+	return m.Size()
+}
+func (m *AzureBlobContainer) XXX_DiscardUnknown() {
+	xxx_messageInfo_AzureBlobContainer.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AzureBlobContainer proto.InternalMessageInfo
+
 func (m *Backoff) Reset()      { *m = Backoff{} }
 func (*Backoff) ProtoMessage() {}
 func (*Backoff) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f89c80cffb38c82c, []int{9}
+	return fileDescriptor_f89c80cffb38c82c, []int{13}
 }
 func (m *Backoff) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -319,7 +431,7 @@ var xxx_messageInfo_Backoff proto.InternalMessageInfo
 func (m *Cache) Reset()      { *m = Cache{} }
 func (*Cache) ProtoMessage() {}
 func (*Cache) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f89c80cffb38c82c, []int{10}
+	return fileDescriptor_f89c80cffb38c82c, []int{14}
 }
 func (m *Cache) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -344,10 +456,38 @@ func (m *Cache) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Cache proto.InternalMessageInfo
 
+func (m *ChildWorkflowTemplate) Reset()      { *m = ChildWorkflowTemplate{} }
+func (*ChildWorkflowTemplate) ProtoMessage() {}
+func (*ChildWorkflowTemplate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f89c80cffb38c82c, []int{15}
+}
+func (m *ChildWorkflowTemplate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ChildWorkflowTemplate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ChildWorkflowTemplate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChildWorkflowTemplate.Merge(m, src)
+}
+func (m *ChildWorkflowTemplate) XXX_Size() int {
+	return m.Size()
+}
+func (m *ChildWorkflowTemplate) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChildWorkflowTemplate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChildWorkflowTemplate proto.InternalMessageInfo
+
 func (m *ClusterWorkflowTemplate) Reset()      { *m = ClusterWorkflowTemplate{} }
 func (*ClusterWorkflowTemplate) ProtoMessage() {}
 func (*ClusterWorkflowTemplate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f89c80cffb38c82c, []int{11}
+	return fileDescriptor_f89c80cffb38c82c, []int{16}
 }
 func (m *ClusterWorkflowTemplate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -375,7 +515,7 @@ var xxx_messageInfo_ClusterWorkflowTemplate proto.InternalMessageInfo
 func (m *ClusterWorkflowTemplateList) Reset()      { *m = ClusterWorkflowTemplateList{} }
 func (*ClusterWorkflowTemplateList) ProtoMessage() {}
 func (*ClusterWorkflowTemplateList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f89c80cffb38c82c, []int{12}
+	return fileDescriptor_f89c80cffb38c82c, []int{17}
 }
 func (m *ClusterWorkflowTemplateList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -403,7 +543,7 @@ var xxx_messageInfo_ClusterWorkflowTemplateList proto.InternalMessageInfo
 func (m *Condition) Reset()      { *m = Condition{} }
 func (*Condition) ProtoMessage() {}
 func (*Condition) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f89c80cffb38c82c, []int{13}
+	return fileDescriptor_f89c80cffb38c82c, []int{18}
 }
 func (m *Condition) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -428,10 +568,66 @@ func (m *Condition) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Condition proto.InternalMessageInfo
 
+func (m *ContainerNode) Reset()      { *m = ContainerNode{} }
+func (*ContainerNode) ProtoMessage() {}
+func (*ContainerNode) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f89c80cffb38c82c, []int{19}
+}
+func (m *ContainerNode) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ContainerNode) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ContainerNode) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContainerNode.Merge(m, src)
+}
+func (m *ContainerNode) XXX_Size() int {
+	return m.Size()
+}
+func (m *ContainerNode) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContainerNode.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ContainerNode proto.InternalMessageInfo
+
+func (m *ContainerSetTemplate) Reset()      { *m = ContainerSetTemplate{} }
+func (*ContainerSetTemplate) ProtoMessage() {}
+func (*ContainerSetTemplate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f89c80cffb38c82c, []int{20}
+}
+func (m *ContainerSetTemplate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ContainerSetTemplate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ContainerSetTemplate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContainerSetTemplate.Merge(m, src)
+}
+func (m *ContainerSetTemplate) XXX_Size() int {
+	return m.Size()
+}
+func (m *ContainerSetTemplate) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContainerSetTemplate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ContainerSetTemplate proto.InternalMessageInfo
+
 func (m *ContinueOn) Reset()      { *m = ContinueOn{} }
 func (*ContinueOn) ProtoMessage() {}
 func (*ContinueOn) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f89c80cffb38c82c, []int{14}
+	return fileDescriptor_f89c80cffb38c82c, []int{21}
 }
 func (m *ContinueOn) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -459,7 +655,7 @@ var xxx_messageInfo_ContinueOn proto.InternalMessageInfo
 func (m *Counter) Reset()      { *m = Counter{} }
 func (*Counter) ProtoMessage() {}
 func (*Counter) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f89c80cffb38c82c, []int{15}
+	return fileDescriptor_f89c80cffb38c82c, []int{22}
 }
 func (m *Counter) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -487,7 +683,7 @@ var xxx_messageInfo_Counter proto.InternalMessageInfo
 func (m *CreateS3BucketOptions) Reset()      { *m = CreateS3BucketOptions{} }
 func (*CreateS3BucketOptions) ProtoMessage() {}
 func (*CreateS3BucketOptions) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f89c80cffb38c82c, []int{16}
+	return fileDescriptor_f89c80cffb38c82c, []int{23}
 }
 func (m *CreateS3BucketOptions) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -515,7 +711,7 @@ var xxx_messageInfo_CreateS3BucketOptions proto.InternalMessageInfo
 func (m *CronWorkflow) Reset()      { *m = CronWorkflow{} }
 func (*CronWorkflow) ProtoMessage() {}
 func (*CronWorkflow) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f89c80cffb38c82c, []int{17}
+	return fileDescriptor_f89c80cffb38c82c, []int{24}
 }
 func (m *CronWorkflow) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -543,7 +739,7 @@ var xxx_messageInfo_CronWorkflow proto.InternalMessageInfo
 func (m *CronWorkflowList) Reset()      { *m = CronWorkflowList{} }
 func (*CronWorkflowList) ProtoMessage() {}
 func (*CronWorkflowList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f89c80cffb38c82c, []int{18}
+	return fileDescriptor_f89c80cffb38c82c, []int{25}
 }
 func (m *CronWorkflowList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -571,7 +767,7 @@ var xxx_messageInfo_CronWorkflowList proto.InternalMessageInfo
 func (m *CronWorkflowSpec) Reset()      { *m = CronWorkflowSpec{} }
 func (*CronWorkflowSpec) ProtoMessage() {}
 func (*CronWorkflowSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f89c80cffb38c82c, []int{19}
+	return fileDescriptor_f89c80cffb38c82c, []int{26}
 }
 func (m *CronWorkflowSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -599,7 +795,7 @@ var xxx_messageInfo_CronWorkflowSpec proto.InternalMessageInfo
 func (m *CronWorkflowStatus) Reset()      { *m = CronWorkflowStatus{} }
 func (*CronWorkflowStatus) ProtoMessage() {}
 func (*CronWorkflowStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f89c80cffb38c82c, []int{20}
+	return fileDescriptor_f89c80cffb38c82c, []int{27}
 }
 func (m *CronWorkflowStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -627,7 +823,7 @@ var xxx_messageInfo_CronWorkflowStatus proto.InternalMessageInfo
 func (m *DAGTask) Reset()      { *m = DAGTask{} }
 func (*DAGTask) ProtoMessage() {}
 func (*DAGTask) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f89c80cffb38c82c, []int{21}
+	return fileDescriptor_f89c80cffb38c82c, []int{28}
 }
 func (m *DAGTask) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -655,7 +851,7 @@ var xxx_messageInfo_DAGTask proto.InternalMessageInfo
 func (m *DAGTemplate) Reset()      { *m = DAGTemplate{} }
 func (*DAGTemplate) ProtoMessage() {}
 func (*DAGTemplate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f89c80cffb38c82c, []int{22}
+	return fileDescriptor_f89c80cffb38c82c, []int{29}
 }
 func (m *DAGTemplate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -680,10 +876,66 @@ func (m *DAGTemplate) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_DAGTemplate proto.InternalMessageInfo
 
+func (m *DataSource) Reset()      { *m = DataSource{} }
+func (*DataSource) ProtoMessage() {}
+func (*DataSource) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f89c80cffb38c82c, []int{30}
+}
+func (m *DataSource) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DataSource) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *DataSource) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DataSource.Merge(m, src)
+}
+func (m *DataSource) XXX_Size() int {
+	return m.Size()
+}
+func (m *DataSource) XXX_DiscardUnknown() {
+	xxx_messageInfo_DataSource.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DataSource proto.InternalMessageInfo
+
+func (m *DataTemplate) Reset()      { *m = DataTemplate{} }
+func (*DataTemplate) ProtoMessage() {}
+func (*DataTemplate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f89c80cffb38c82c, []int{31}
+}
+func (m *DataTemplate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DataTemplate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *DataTemplate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DataTemplate.Merge(m, src)
+}
+func (m *DataTemplate) XXX_Size() int {
+	return m.Size()
+}
+func (m *DataTemplate) XXX_DiscardUnknown() {
+	xxx_messageInfo_DataTemplate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DataTemplate proto.InternalMessageInfo
+
 func (m *Event) Reset()      { *m = Event{} }
 func (*Event) ProtoMessage() {}
 func (*Event) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f89c80cffb38c82c, []int{23}
+	return fileDescriptor_f89c80cffb38c82c, []int{32}
 }
 func (m *Event) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -708,10 +960,38 @@ func (m *Event) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Event proto.InternalMessageInfo
 
+func (m *EventWorkflowSelector) Reset()      { *m = EventWorkflowSelector{} }
+func (*EventWorkflowSelector) ProtoMessage() {}
+func (*EventWorkflowSelector) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f89c80cffb38c82c, []int{33}
+}
+func (m *EventWorkflowSelector) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventWorkflowSelector) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *EventWorkflowSelector) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventWorkflowSelector.Merge(m, src)
+}
+func (m *EventWorkflowSelector) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventWorkflowSelector) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventWorkflowSelector.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventWorkflowSelector proto.InternalMessageInfo
+
 func (m *ExecutorConfig) Reset()      { *m = ExecutorConfig{} }
 func (*ExecutorConfig) ProtoMessage() {}
 func (*ExecutorConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f89c80cffb38c82c, []int{24}
+	return fileDescriptor_f89c80cffb38c82c, []int{34}
 }
 func (m *ExecutorConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -739,7 +1019,7 @@ var xxx_messageInfo_ExecutorConfig proto.InternalMessageInfo
 func (m *GCSArtifact) Reset()      { *m = GCSArtifact{} }
 func (*GCSArtifact) ProtoMessage() {}
 func (*GCSArtifact) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f89c80cffb38c82c, []int{25}
+	return fileDescriptor_f89c80cffb38c82c, []int{35}
 }
 func (m *GCSArtifact) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -767,7 +1047,7 @@ var xxx_messageInfo_GCSArtifact proto.InternalMessageInfo
 func (m *GCSBucket) Reset()      { *m = GCSBucket{} }
 func (*GCSBucket) ProtoMessage() {}
 func (*GCSBucket) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f89c80cffb38c82c, []int{26}
+	return fileDescriptor_f89c80cffb38c82c, []int{36}
 }
 func (m *GCSBucket) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -795,7 +1075,7 @@ var xxx_messageInfo_GCSBucket proto.InternalMessageInfo
 func (m *Gauge) Reset()      { *m = Gauge{} }
 func (*Gauge) ProtoMessage() {}
 func (*Gauge) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f89c80cffb38c82c, []int{27}
+	return fileDescriptor_f89c80cffb38c82c, []int{37}
 }
 func (m *Gauge) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -823,7 +1103,7 @@ var xxx_messageInfo_Gauge proto.InternalMessageInfo
 func (m *GitArtifact) Reset()      { *m = GitArtifact{} }
 func (*GitArtifact) ProtoMessage() {}
 func (*GitArtifact) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f89c80cffb38c82c, []int{28}
+	return fileDescriptor_f89c80cffb38c82c, []int{38}
 }
 func (m *GitArtifact) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -851,7 +1131,7 @@ var xxx_messageInfo_GitArtifact proto.InternalMessageInfo
 func (m *HDFSArtifact) Reset()      { *m = HDFSArtifact{} }
 func (*HDFSArtifact) ProtoMessage() {}
 func (*HDFSArtifact) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f89c80cffb38c82c, []int{29}
+	return fileDescriptor_f89c80cffb38c82c, []int{39}
 }
 func (m *HDFSArtifact) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -879,7 +1159,7 @@ var xxx_messageInfo_HDFSArtifact proto.InternalMessageInfo
 func (m *HDFSConfig) Reset()      { *m = HDFSConfig{} }
 func (*HDFSConfig) ProtoMessage() {}
 func (*HDFSConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f89c80cffb38c82c, []int{30}
+	return fileDescriptor_f89c80cffb38c82c, []int{40}
 }
 func (m *HDFSConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -907,7 +1187,7 @@ var xxx_messageInfo_HDFSConfig proto.InternalMessageInfo
 func (m *HDFSKrbConfig) Reset()      { *m = HDFSKrbConfig{} }
 func (*HDFSKrbConfig) ProtoMessage() {}
 func (*HDFSKrbConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f89c80cffb38c82c, []int{31}
+	return fileDescriptor_f89c80cffb38c82c, []int{41}
 }
 func (m *HDFSKrbConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -935,7 +1215,7 @@ var xxx_messageInfo_HDFSKrbConfig proto.InternalMessageInfo
 func (m *HTTPArtifact) Reset()      { *m = HTTPArtifact{} }
 func (*HTTPArtifact) ProtoMessage() {}
 func (*HTTPArtifact) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f89c80cffb38c82c, []int{32}
+	return fileDescriptor_f89c80cffb38c82c, []int{42}
 }
 func (m *HTTPArtifact) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -960,15 +1240,15 @@ func (m *HTTPArtifact) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_HTTPArtifact proto.InternalMessageInfo
 
-func (m *Header) Reset()      { *m = Header{} }
-func (*Header) ProtoMessage() {}
-func (*Header) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f89c80cffb38c82c, []int{33}
+func (m *HTTPHeader) Reset()      { *m = HTTPHeader{} }
+func (*HTTPHeader) ProtoMessage() {}
+func (*HTTPHeader) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f89c80cffb38c82c, []int{43}
 }
-func (m *Header) XXX_Unmarshal(b []byte) error {
+func (m *HTTPHeader) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *Header) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *HTTPHeader) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	b = b[:cap(b)]
 	n, err := m.MarshalToSizedBuffer(b)
 	if err != nil {
@@ -976,27 +1256,27 @@ func (m *Header) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	}
 	return b[:n], nil
 }
-func (m *Header) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Header.Merge(m, src)
+func (m *HTTPHeader) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HTTPHeader.Merge(m, src)
 }
-func (m *Header) XXX_Size() int {
+func (m *HTTPHeader) XXX_Size() int {
 	return m.Size()
 }
-func (m *Header) XXX_DiscardUnknown() {
-	xxx_messageInfo_Header.DiscardUnknown(m)
+func (m *HTTPHeader) XXX_DiscardUnknown() {
+	xxx_messageInfo_HTTPHeader.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Header proto.InternalMessageInfo
+var xxx_messageInfo_HTTPHeader proto.InternalMessageInfo
 
-func (m *Histogram) Reset()      { *m = Histogram{} }
-func (*Histogram) ProtoMessage() {}
-func (*Histogram) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f89c80cffb38c82c, []int{34}
+func (m *HTTPHeaderSource) Reset()      { *m = HTTPHeaderSource{} }
+func (*HTTPHeaderSource) ProtoMessage() {}
+func (*HTTPHeaderSource) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f89c80cffb38c82c, []int{44}
 }
-func (m *Histogram) XXX_Unmarshal(b []byte) error {
+func (m *HTTPHeaderSource) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *Histogram) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *HTTPHeaderSource) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	b = b[:cap(b)]
 	n, err := m.MarshalToSizedBuffer(b)
 	if err != nil {
@@ -1004,22 +1284,106 @@ func (m *Histogram) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	}
 	return b[:n], nil
 }
-func (m *Histogram) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Histogram.Merge(m, src)
+func (m *HTTPHeaderSource) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HTTPHeaderSource.Merge(m, src)
 }
-func (m *Histogram) XXX_Size() int {
+func (m *HTTPHeaderSource) XXX_Size() int {
 	return m.Size()
 }
-func (m *Histogram) XXX_DiscardUnknown() {
-	xxx_messageInfo_Histogram.DiscardUnknown(m)
+func (m *HTTPHeaderSource) XXX_DiscardUnknown() {
+	xxx_messageInfo_HTTPHeaderSource.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Histogram proto.InternalMessageInfo
+var xxx_messageInfo_HTTPHeaderSource proto.InternalMessageInfo
 
-func (m *Inputs) Reset()      { *m = Inputs{} }
-func (*Inputs) ProtoMessage() {}
+func (m *HTTPTemplate) Reset()      { *m = HTTPTemplate{} }
+func (*HTTPTemplate) ProtoMessage() {}
+func (*HTTPTemplate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f89c80cffb38c82c, []int{45}
+}
+func (m *HTTPTemplate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HTTPTemplate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *HTTPTemplate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HTTPTemplate.Merge(m, src)
+}
+func (m *HTTPTemplate) XXX_Size() int {
+	return m.Size()
+}
+func (m *HTTPTemplate) XXX_DiscardUnknown() {
+	xxx_messageInfo_HTTPTemplate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HTTPTemplate proto.InternalMessageInfo
+
+func (m *Header) Reset()      { *m = Header{} }
+func (*Header) ProtoMessage() {}
+func (*Header) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f89c80cffb38c82c, []int{46}
+}
+func (m *Header) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Header) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *Header) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Header.Merge(m, src)
+}
+func (m *Header) XXX_Size() int {
+	return m.Size()
+}
+func (m *Header) XXX_DiscardUnknown() {
+	xxx_messageInfo_Header.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Header proto.InternalMessageInfo
+
+func (m *Histogram) Reset()      { *m = Histogram{} }
+func (*Histogram) ProtoMessage() {}
+func (*Histogram) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f89c80cffb38c82c, []int{47}
+}
+func (m *Histogram) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Histogram) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *Histogram) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Histogram.Merge(m, src)
+}
+func (m *Histogram) XXX_Size() int {
+	return m.Size()
+}
+func (m *Histogram) XXX_DiscardUnknown() {
+	xxx_messageInfo_Histogram.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Histogram proto.InternalMessageInfo
+
+func (m *Inputs) Reset()      { *m = Inputs{} }
+func (*Inputs) ProtoMessage() {}
 func (*Inputs) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f89c80cffb38c82c, []int{35}
+	return fileDescriptor_f89c80cffb38c82c, []int{48}
 }
 func (m *Inputs) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1047,7 +1411,7 @@ var xxx_messageInfo_Inputs proto.InternalMessageInfo
 func (m *Item) Reset()      { *m = Item{} }
 func (*Item) ProtoMessage() {}
 func (*Item) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f89c80cffb38c82c, []int{36}
+	return fileDescriptor_f89c80cffb38c82c, []int{49}
 }
 func (m *Item) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1072,10 +1436,38 @@ func (m *Item) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Item proto.InternalMessageInfo
 
+func (m *LifecycleHook) Reset()      { *m = LifecycleHook{} }
+func (*LifecycleHook) ProtoMessage() {}
+func (*LifecycleHook) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f89c80cffb38c82c, []int{50}
+}
+func (m *LifecycleHook) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LifecycleHook) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *LifecycleHook) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LifecycleHook.Merge(m, src)
+}
+func (m *LifecycleHook) XXX_Size() int {
+	return m.Size()
+}
+func (m *LifecycleHook) XXX_DiscardUnknown() {
+	xxx_messageInfo_LifecycleHook.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LifecycleHook proto.InternalMessageInfo
+
 func (m *Link) Reset()      { *m = Link{} }
 func (*Link) ProtoMessage() {}
 func (*Link) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f89c80cffb38c82c, []int{37}
+	return fileDescriptor_f89c80cffb38c82c, []int{51}
 }
 func (m *Link) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1103,7 +1495,7 @@ var xxx_messageInfo_Link proto.InternalMessageInfo
 func (m *MemoizationStatus) Reset()      { *m = MemoizationStatus{} }
 func (*MemoizationStatus) ProtoMessage() {}
 func (*MemoizationStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f89c80cffb38c82c, []int{38}
+	return fileDescriptor_f89c80cffb38c82c, []int{52}
 }
 func (m *MemoizationStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1131,7 +1523,7 @@ var xxx_messageInfo_MemoizationStatus proto.InternalMessageInfo
 func (m *Memoize) Reset()      { *m = Memoize{} }
 func (*Memoize) ProtoMessage() {}
 func (*Memoize) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f89c80cffb38c82c, []int{39}
+	return fileDescriptor_f89c80cffb38c82c, []int{53}
 }
 func (m *Memoize) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1159,7 +1551,7 @@ var xxx_messageInfo_Memoize proto.InternalMessageInfo
 func (m *Metadata) Reset()      { *m = Metadata{} }
 func (*Metadata) ProtoMessage() {}
 func (*Metadata) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f89c80cffb38c82c, []int{40}
+	return fileDescriptor_f89c80cffb38c82c, []int{54}
 }
 func (m *Metadata) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1187,7 +1579,7 @@ var xxx_messageInfo_Metadata proto.InternalMessageInfo
 func (m *MetricLabel) Reset()      { *m = MetricLabel{} }
 func (*MetricLabel) ProtoMessage() {}
 func (*MetricLabel) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f89c80cffb38c82c, []int{41}
+	return fileDescriptor_f89c80cffb38c82c, []int{55}
 }
 func (m *MetricLabel) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1215,7 +1607,7 @@ var xxx_messageInfo_MetricLabel proto.InternalMessageInfo
 func (m *Metrics) Reset()      { *m = Metrics{} }
 func (*Metrics) ProtoMessage() {}
 func (*Metrics) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f89c80cffb38c82c, []int{42}
+	return fileDescriptor_f89c80cffb38c82c, []int{56}
 }
 func (m *Metrics) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1243,7 +1635,7 @@ var xxx_messageInfo_Metrics proto.InternalMessageInfo
 func (m *Mutex) Reset()      { *m = Mutex{} }
 func (*Mutex) ProtoMessage() {}
 func (*Mutex) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f89c80cffb38c82c, []int{43}
+	return fileDescriptor_f89c80cffb38c82c, []int{57}
 }
 func (m *Mutex) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1271,7 +1663,7 @@ var xxx_messageInfo_Mutex proto.InternalMessageInfo
 func (m *MutexHolding) Reset()      { *m = MutexHolding{} }
 func (*MutexHolding) ProtoMessage() {}
 func (*MutexHolding) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f89c80cffb38c82c, []int{44}
+	return fileDescriptor_f89c80cffb38c82c, []int{58}
 }
 func (m *MutexHolding) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1299,7 +1691,7 @@ var xxx_messageInfo_MutexHolding proto.InternalMessageInfo
 func (m *MutexStatus) Reset()      { *m = MutexStatus{} }
 func (*MutexStatus) ProtoMessage() {}
 func (*MutexStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f89c80cffb38c82c, []int{45}
+	return fileDescriptor_f89c80cffb38c82c, []int{59}
 }
 func (m *MutexStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1327,7 +1719,7 @@ var xxx_messageInfo_MutexStatus proto.InternalMessageInfo
 func (m *NodeStatus) Reset()      { *m = NodeStatus{} }
 func (*NodeStatus) ProtoMessage() {}
 func (*NodeStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f89c80cffb38c82c, []int{46}
+	return fileDescriptor_f89c80cffb38c82c, []int{60}
 }
 func (m *NodeStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1355,7 +1747,7 @@ var xxx_messageInfo_NodeStatus proto.InternalMessageInfo
 func (m *NodeSynchronizationStatus) Reset()      { *m = NodeSynchronizationStatus{} }
 func (*NodeSynchronizationStatus) ProtoMessage() {}
 func (*NodeSynchronizationStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f89c80cffb38c82c, []int{47}
+	return fileDescriptor_f89c80cffb38c82c, []int{61}
 }
 func (m *NodeSynchronizationStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1383,7 +1775,7 @@ var xxx_messageInfo_NodeSynchronizationStatus proto.InternalMessageInfo
 func (m *NoneStrategy) Reset()      { *m = NoneStrategy{} }
 func (*NoneStrategy) ProtoMessage() {}
 func (*NoneStrategy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f89c80cffb38c82c, []int{48}
+	return fileDescriptor_f89c80cffb38c82c, []int{62}
 }
 func (m *NoneStrategy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1411,7 +1803,7 @@ var xxx_messageInfo_NoneStrategy proto.InternalMessageInfo
 func (m *OSSArtifact) Reset()      { *m = OSSArtifact{} }
 func (*OSSArtifact) ProtoMessage() {}
 func (*OSSArtifact) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f89c80cffb38c82c, []int{49}
+	return fileDescriptor_f89c80cffb38c82c, []int{63}
 }
 func (m *OSSArtifact) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1439,7 +1831,7 @@ var xxx_messageInfo_OSSArtifact proto.InternalMessageInfo
 func (m *OSSBucket) Reset()      { *m = OSSBucket{} }
 func (*OSSBucket) ProtoMessage() {}
 func (*OSSBucket) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f89c80cffb38c82c, []int{50}
+	return fileDescriptor_f89c80cffb38c82c, []int{64}
 }
 func (m *OSSBucket) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1467,7 +1859,7 @@ var xxx_messageInfo_OSSBucket proto.InternalMessageInfo
 func (m *Outputs) Reset()      { *m = Outputs{} }
 func (*Outputs) ProtoMessage() {}
 func (*Outputs) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f89c80cffb38c82c, []int{51}
+	return fileDescriptor_f89c80cffb38c82c, []int{65}
 }
 func (m *Outputs) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1495,7 +1887,7 @@ var xxx_messageInfo_Outputs proto.InternalMessageInfo
 func (m *ParallelSteps) Reset()      { *m = ParallelSteps{} }
 func (*ParallelSteps) ProtoMessage() {}
 func (*ParallelSteps) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f89c80cffb38c82c, []int{52}
+	return fileDescriptor_f89c80cffb38c82c, []int{66}
 }
 func (m *ParallelSteps) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1523,7 +1915,7 @@ var xxx_messageInfo_ParallelSteps proto.InternalMessageInfo
 func (m *Parameter) Reset()      { *m = Parameter{} }
 func (*Parameter) ProtoMessage() {}
 func (*Parameter) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f89c80cffb38c82c, []int{53}
+	return fileDescriptor_f89c80cffb38c82c, []int{67}
 }
 func (m *Parameter) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1551,7 +1943,7 @@ var xxx_messageInfo_Parameter proto.InternalMessageInfo
 func (m *PodGC) Reset()      { *m = PodGC{} }
 func (*PodGC) ProtoMessage() {}
 func (*PodGC) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f89c80cffb38c82c, []int{54}
+	return fileDescriptor_f89c80cffb38c82c, []int{68}
 }
 func (m *PodGC) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1579,7 +1971,7 @@ var xxx_messageInfo_PodGC proto.InternalMessageInfo
 func (m *Prometheus) Reset()      { *m = Prometheus{} }
 func (*Prometheus) ProtoMessage() {}
 func (*Prometheus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f89c80cffb38c82c, []int{55}
+	return fileDescriptor_f89c80cffb38c82c, []int{69}
 }
 func (m *Prometheus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1607,7 +1999,7 @@ var xxx_messageInfo_Prometheus proto.InternalMessageInfo
 func (m *RawArtifact) Reset()      { *m = RawArtifact{} }
 func (*RawArtifact) ProtoMessage() {}
 func (*RawArtifact) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f89c80cffb38c82c, []int{56}
+	return fileDescriptor_f89c80cffb38c82c, []int{70}
 }
 func (m *RawArtifact) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1635,7 +2027,7 @@ var xxx_messageInfo_RawArtifact proto.InternalMessageInfo
 func (m *ResourceTemplate) Reset()      { *m = ResourceTemplate{} }
 func (*ResourceTemplate) ProtoMessage() {}
 func (*ResourceTemplate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f89c80cffb38c82c, []int{57}
+	return fileDescriptor_f89c80cffb38c82c, []int{71}
 }
 func (m *ResourceTemplate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1660,10 +2052,38 @@ func (m *ResourceTemplate) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ResourceTemplate proto.InternalMessageInfo
 
+func (m *ResumeAction) Reset()      { *m = ResumeAction{} }
+func (*ResumeAction) ProtoMessage() {}
+func (*ResumeAction) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f89c80cffb38c82c, []int{72}
+}
+func (m *ResumeAction) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ResumeAction) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ResumeAction) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResumeAction.Merge(m, src)
+}
+func (m *ResumeAction) XXX_Size() int {
+	return m.Size()
+}
+func (m *ResumeAction) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResumeAction.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ResumeAction proto.InternalMessageInfo
+
 func (m *RetryAffinity) Reset()      { *m = RetryAffinity{} }
 func (*RetryAffinity) ProtoMessage() {}
 func (*RetryAffinity) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f89c80cffb38c82c, []int{58}
+	return fileDescriptor_f89c80cffb38c82c, []int{73}
 }
 func (m *RetryAffinity) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1691,7 +2111,7 @@ var xxx_messageInfo_RetryAffinity proto.InternalMessageInfo
 func (m *RetryNodeAntiAffinity) Reset()      { *m = RetryNodeAntiAffinity{} }
 func (*RetryNodeAntiAffinity) ProtoMessage() {}
 func (*RetryNodeAntiAffinity) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f89c80cffb38c82c, []int{59}
+	return fileDescriptor_f89c80cffb38c82c, []int{74}
 }
 func (m *RetryNodeAntiAffinity) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1719,7 +2139,7 @@ var xxx_messageInfo_RetryNodeAntiAffinity proto.InternalMessageInfo
 func (m *RetryStrategy) Reset()      { *m = RetryStrategy{} }
 func (*RetryStrategy) ProtoMessage() {}
 func (*RetryStrategy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f89c80cffb38c82c, []int{60}
+	return fileDescriptor_f89c80cffb38c82c, []int{75}
 }
 func (m *RetryStrategy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1747,7 +2167,7 @@ var xxx_messageInfo_RetryStrategy proto.InternalMessageInfo
 func (m *S3Artifact) Reset()      { *m = S3Artifact{} }
 func (*S3Artifact) ProtoMessage() {}
 func (*S3Artifact) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f89c80cffb38c82c, []int{61}
+	return fileDescriptor_f89c80cffb38c82c, []int{76}
 }
 func (m *S3Artifact) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1775,7 +2195,7 @@ var xxx_messageInfo_S3Artifact proto.InternalMessageInfo
 func (m *S3Bucket) Reset()      { *m = S3Bucket{} }
 func (*S3Bucket) ProtoMessage() {}
 func (*S3Bucket) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f89c80cffb38c82c, []int{62}
+	return fileDescriptor_f89c80cffb38c82c, []int{77}
 }
 func (m *S3Bucket) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1800,10 +2220,38 @@ func (m *S3Bucket) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_S3Bucket proto.InternalMessageInfo
 
+func (m *SQLCache) Reset()      { *m = SQLCache{} }
+func (*SQLCache) ProtoMessage() {}
+func (*SQLCache) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f89c80cffb38c82c, []int{78}
+}
+func (m *SQLCache) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SQLCache) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *SQLCache) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SQLCache.Merge(m, src)
+}
+func (m *SQLCache) XXX_Size() int {
+	return m.Size()
+}
+func (m *SQLCache) XXX_DiscardUnknown() {
+	xxx_messageInfo_SQLCache.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SQLCache proto.InternalMessageInfo
+
 func (m *ScriptTemplate) Reset()      { *m = ScriptTemplate{} }
 func (*ScriptTemplate) ProtoMessage() {}
 func (*ScriptTemplate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f89c80cffb38c82c, []int{63}
+	return fileDescriptor_f89c80cffb38c82c, []int{79}
 }
 func (m *ScriptTemplate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1831,7 +2279,7 @@ var xxx_messageInfo_ScriptTemplate proto.InternalMessageInfo
 func (m *SemaphoreHolding) Reset()      { *m = SemaphoreHolding{} }
 func (*SemaphoreHolding) ProtoMessage() {}
 func (*SemaphoreHolding) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f89c80cffb38c82c, []int{64}
+	return fileDescriptor_f89c80cffb38c82c, []int{80}
 }
 func (m *SemaphoreHolding) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1859,7 +2307,7 @@ var xxx_messageInfo_SemaphoreHolding proto.InternalMessageInfo
 func (m *SemaphoreRef) Reset()      { *m = SemaphoreRef{} }
 func (*SemaphoreRef) ProtoMessage() {}
 func (*SemaphoreRef) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f89c80cffb38c82c, []int{65}
+	return fileDescriptor_f89c80cffb38c82c, []int{81}
 }
 func (m *SemaphoreRef) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1887,7 +2335,7 @@ var xxx_messageInfo_SemaphoreRef proto.InternalMessageInfo
 func (m *SemaphoreStatus) Reset()      { *m = SemaphoreStatus{} }
 func (*SemaphoreStatus) ProtoMessage() {}
 func (*SemaphoreStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f89c80cffb38c82c, []int{66}
+	return fileDescriptor_f89c80cffb38c82c, []int{82}
 }
 func (m *SemaphoreStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1915,7 +2363,7 @@ var xxx_messageInfo_SemaphoreStatus proto.InternalMessageInfo
 func (m *Sequence) Reset()      { *m = Sequence{} }
 func (*Sequence) ProtoMessage() {}
 func (*Sequence) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f89c80cffb38c82c, []int{67}
+	return fileDescriptor_f89c80cffb38c82c, []int{83}
 }
 func (m *Sequence) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1940,10 +2388,38 @@ func (m *Sequence) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Sequence proto.InternalMessageInfo
 
+func (m *StopAction) Reset()      { *m = StopAction{} }
+func (*StopAction) ProtoMessage() {}
+func (*StopAction) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f89c80cffb38c82c, []int{84}
+}
+func (m *StopAction) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StopAction) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *StopAction) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StopAction.Merge(m, src)
+}
+func (m *StopAction) XXX_Size() int {
+	return m.Size()
+}
+func (m *StopAction) XXX_DiscardUnknown() {
+	xxx_messageInfo_StopAction.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StopAction proto.InternalMessageInfo
+
 func (m *Submit) Reset()      { *m = Submit{} }
 func (*Submit) ProtoMessage() {}
 func (*Submit) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f89c80cffb38c82c, []int{68}
+	return fileDescriptor_f89c80cffb38c82c, []int{85}
 }
 func (m *Submit) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1971,7 +2447,7 @@ var xxx_messageInfo_Submit proto.InternalMessageInfo
 func (m *SubmitOpts) Reset()      { *m = SubmitOpts{} }
 func (*SubmitOpts) ProtoMessage() {}
 func (*SubmitOpts) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f89c80cffb38c82c, []int{69}
+	return fileDescriptor_f89c80cffb38c82c, []int{86}
 }
 func (m *SubmitOpts) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1999,7 +2475,7 @@ var xxx_messageInfo_SubmitOpts proto.InternalMessageInfo
 func (m *SuppliedValueFrom) Reset()      { *m = SuppliedValueFrom{} }
 func (*SuppliedValueFrom) ProtoMessage() {}
 func (*SuppliedValueFrom) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f89c80cffb38c82c, []int{70}
+	return fileDescriptor_f89c80cffb38c82c, []int{87}
 }
 func (m *SuppliedValueFrom) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2027,7 +2503,7 @@ var xxx_messageInfo_SuppliedValueFrom proto.InternalMessageInfo
 func (m *SuspendTemplate) Reset()      { *m = SuspendTemplate{} }
 func (*SuspendTemplate) ProtoMessage() {}
 func (*SuspendTemplate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f89c80cffb38c82c, []int{71}
+	return fileDescriptor_f89c80cffb38c82c, []int{88}
 }
 func (m *SuspendTemplate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2052,10 +2528,38 @@ func (m *SuspendTemplate) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_SuspendTemplate proto.InternalMessageInfo
 
+func (m *SyncDatabaseRef) Reset()      { *m = SyncDatabaseRef{} }
+func (*SyncDatabaseRef) ProtoMessage() {}
+func (*SyncDatabaseRef) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f89c80cffb38c82c, []int{89}
+}
+func (m *SyncDatabaseRef) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SyncDatabaseRef) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *SyncDatabaseRef) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SyncDatabaseRef.Merge(m, src)
+}
+func (m *SyncDatabaseRef) XXX_Size() int {
+	return m.Size()
+}
+func (m *SyncDatabaseRef) XXX_DiscardUnknown() {
+	xxx_messageInfo_SyncDatabaseRef.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SyncDatabaseRef proto.InternalMessageInfo
+
 func (m *Synchronization) Reset()      { *m = Synchronization{} }
 func (*Synchronization) ProtoMessage() {}
 func (*Synchronization) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f89c80cffb38c82c, []int{72}
+	return fileDescriptor_f89c80cffb38c82c, []int{90}
 }
 func (m *Synchronization) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2083,7 +2587,7 @@ var xxx_messageInfo_Synchronization proto.InternalMessageInfo
 func (m *SynchronizationStatus) Reset()      { *m = SynchronizationStatus{} }
 func (*SynchronizationStatus) ProtoMessage() {}
 func (*SynchronizationStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f89c80cffb38c82c, []int{73}
+	return fileDescriptor_f89c80cffb38c82c, []int{91}
 }
 func (m *SynchronizationStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2111,7 +2615,7 @@ var xxx_messageInfo_SynchronizationStatus proto.InternalMessageInfo
 func (m *TTLStrategy) Reset()      { *m = TTLStrategy{} }
 func (*TTLStrategy) ProtoMessage() {}
 func (*TTLStrategy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f89c80cffb38c82c, []int{74}
+	return fileDescriptor_f89c80cffb38c82c, []int{92}
 }
 func (m *TTLStrategy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2139,7 +2643,7 @@ var xxx_messageInfo_TTLStrategy proto.InternalMessageInfo
 func (m *TarStrategy) Reset()      { *m = TarStrategy{} }
 func (*TarStrategy) ProtoMessage() {}
 func (*TarStrategy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f89c80cffb38c82c, []int{75}
+	return fileDescriptor_f89c80cffb38c82c, []int{93}
 }
 func (m *TarStrategy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2167,7 +2671,7 @@ var xxx_messageInfo_TarStrategy proto.InternalMessageInfo
 func (m *Template) Reset()      { *m = Template{} }
 func (*Template) ProtoMessage() {}
 func (*Template) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f89c80cffb38c82c, []int{76}
+	return fileDescriptor_f89c80cffb38c82c, []int{94}
 }
 func (m *Template) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2195,7 +2699,7 @@ var xxx_messageInfo_Template proto.InternalMessageInfo
 func (m *TemplateRef) Reset()      { *m = TemplateRef{} }
 func (*TemplateRef) ProtoMessage() {}
 func (*TemplateRef) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f89c80cffb38c82c, []int{77}
+	return fileDescriptor_f89c80cffb38c82c, []int{95}
 }
 func (m *TemplateRef) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2220,15 +2724,15 @@ func (m *TemplateRef) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_TemplateRef proto.InternalMessageInfo
 
-func (m *UserContainer) Reset()      { *m = UserContainer{} }
-func (*UserContainer) ProtoMessage() {}
-func (*UserContainer) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f89c80cffb38c82c, []int{78}
+func (m *TerminateAction) Reset()      { *m = TerminateAction{} }
+func (*TerminateAction) ProtoMessage() {}
+func (*TerminateAction) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f89c80cffb38c82c, []int{96}
 }
-func (m *UserContainer) XXX_Unmarshal(b []byte) error {
+func (m *TerminateAction) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *UserContainer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *TerminateAction) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	b = b[:cap(b)]
 	n, err := m.MarshalToSizedBuffer(b)
 	if err != nil {
@@ -2236,14 +2740,70 @@ func (m *UserContainer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error
 	}
 	return b[:n], nil
 }
-func (m *UserContainer) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_UserContainer.Merge(m, src)
+func (m *TerminateAction) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TerminateAction.Merge(m, src)
 }
-func (m *UserContainer) XXX_Size() int {
+func (m *TerminateAction) XXX_Size() int {
 	return m.Size()
 }
-func (m *UserContainer) XXX_DiscardUnknown() {
-	xxx_messageInfo_UserContainer.DiscardUnknown(m)
+func (m *TerminateAction) XXX_DiscardUnknown() {
+	xxx_messageInfo_TerminateAction.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TerminateAction proto.InternalMessageInfo
+
+func (m *TransformationStep) Reset()      { *m = TransformationStep{} }
+func (*TransformationStep) ProtoMessage() {}
+func (*TransformationStep) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f89c80cffb38c82c, []int{97}
+}
+func (m *TransformationStep) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TransformationStep) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *TransformationStep) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TransformationStep.Merge(m, src)
+}
+func (m *TransformationStep) XXX_Size() int {
+	return m.Size()
+}
+func (m *TransformationStep) XXX_DiscardUnknown() {
+	xxx_messageInfo_TransformationStep.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TransformationStep proto.InternalMessageInfo
+
+func (m *UserContainer) Reset()      { *m = UserContainer{} }
+func (*UserContainer) ProtoMessage() {}
+func (*UserContainer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f89c80cffb38c82c, []int{98}
+}
+func (m *UserContainer) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UserContainer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *UserContainer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UserContainer.Merge(m, src)
+}
+func (m *UserContainer) XXX_Size() int {
+	return m.Size()
+}
+func (m *UserContainer) XXX_DiscardUnknown() {
+	xxx_messageInfo_UserContainer.DiscardUnknown(m)
 }
 
 var xxx_messageInfo_UserContainer proto.InternalMessageInfo
@@ -2251,7 +2811,7 @@ var xxx_messageInfo_UserContainer proto.InternalMessageInfo
 func (m *ValueFrom) Reset()      { *m = ValueFrom{} }
 func (*ValueFrom) ProtoMessage() {}
 func (*ValueFrom) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f89c80cffb38c82c, []int{79}
+	return fileDescriptor_f89c80cffb38c82c, []int{99}
 }
 func (m *ValueFrom) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2279,7 +2839,7 @@ var xxx_messageInfo_ValueFrom proto.InternalMessageInfo
 func (m *Version) Reset()      { *m = Version{} }
 func (*Version) ProtoMessage() {}
 func (*Version) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f89c80cffb38c82c, []int{80}
+	return fileDescriptor_f89c80cffb38c82c, []int{100}
 }
 func (m *Version) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2307,7 +2867,7 @@ var xxx_messageInfo_Version proto.InternalMessageInfo
 func (m *VolumeClaimGC) Reset()      { *m = VolumeClaimGC{} }
 func (*VolumeClaimGC) ProtoMessage() {}
 func (*VolumeClaimGC) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f89c80cffb38c82c, []int{81}
+	return fileDescriptor_f89c80cffb38c82c, []int{101}
 }
 func (m *VolumeClaimGC) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2335,7 +2895,7 @@ var xxx_messageInfo_VolumeClaimGC proto.InternalMessageInfo
 func (m *Workflow) Reset()      { *m = Workflow{} }
 func (*Workflow) ProtoMessage() {}
 func (*Workflow) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f89c80cffb38c82c, []int{82}
+	return fileDescriptor_f89c80cffb38c82c, []int{102}
 }
 func (m *Workflow) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2363,7 +2923,7 @@ var xxx_messageInfo_Workflow proto.InternalMessageInfo
 func (m *WorkflowEventBinding) Reset()      { *m = WorkflowEventBinding{} }
 func (*WorkflowEventBinding) ProtoMessage() {}
 func (*WorkflowEventBinding) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f89c80cffb38c82c, []int{83}
+	return fileDescriptor_f89c80cffb38c82c, []int{103}
 }
 func (m *WorkflowEventBinding) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2391,7 +2951,7 @@ var xxx_messageInfo_WorkflowEventBinding proto.InternalMessageInfo
 func (m *WorkflowEventBindingList) Reset()      { *m = WorkflowEventBindingList{} }
 func (*WorkflowEventBindingList) ProtoMessage() {}
 func (*WorkflowEventBindingList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f89c80cffb38c82c, []int{84}
+	return fileDescriptor_f89c80cffb38c82c, []int{104}
 }
 func (m *WorkflowEventBindingList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2419,7 +2979,7 @@ var xxx_messageInfo_WorkflowEventBindingList proto.InternalMessageInfo
 func (m *WorkflowEventBindingSpec) Reset()      { *m = WorkflowEventBindingSpec{} }
 func (*WorkflowEventBindingSpec) ProtoMessage() {}
 func (*WorkflowEventBindingSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f89c80cffb38c82c, []int{85}
+	return fileDescriptor_f89c80cffb38c82c, []int{105}
 }
 func (m *WorkflowEventBindingSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2447,7 +3007,7 @@ var xxx_messageInfo_WorkflowEventBindingSpec proto.InternalMessageInfo
 func (m *WorkflowList) Reset()      { *m = WorkflowList{} }
 func (*WorkflowList) ProtoMessage() {}
 func (*WorkflowList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f89c80cffb38c82c, []int{86}
+	return fileDescriptor_f89c80cffb38c82c, []int{106}
 }
 func (m *WorkflowList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2475,7 +3035,7 @@ var xxx_messageInfo_WorkflowList proto.InternalMessageInfo
 func (m *WorkflowSpec) Reset()      { *m = WorkflowSpec{} }
 func (*WorkflowSpec) ProtoMessage() {}
 func (*WorkflowSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f89c80cffb38c82c, []int{87}
+	return fileDescriptor_f89c80cffb38c82c, []int{107}
 }
 func (m *WorkflowSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2503,7 +3063,7 @@ var xxx_messageInfo_WorkflowSpec proto.InternalMessageInfo
 func (m *WorkflowStatus) Reset()      { *m = WorkflowStatus{} }
 func (*WorkflowStatus) ProtoMessage() {}
 func (*WorkflowStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f89c80cffb38c82c, []int{88}
+	return fileDescriptor_f89c80cffb38c82c, []int{108}
 }
 func (m *WorkflowStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2531,7 +3091,7 @@ var xxx_messageInfo_WorkflowStatus proto.InternalMessageInfo
 func (m *WorkflowStep) Reset()      { *m = WorkflowStep{} }
 func (*WorkflowStep) ProtoMessage() {}
 func (*WorkflowStep) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f89c80cffb38c82c, []int{89}
+	return fileDescriptor_f89c80cffb38c82c, []int{109}
 }
 func (m *WorkflowStep) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2559,7 +3119,7 @@ var xxx_messageInfo_WorkflowStep proto.InternalMessageInfo
 func (m *WorkflowTemplate) Reset()      { *m = WorkflowTemplate{} }
 func (*WorkflowTemplate) ProtoMessage() {}
 func (*WorkflowTemplate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f89c80cffb38c82c, []int{90}
+	return fileDescriptor_f89c80cffb38c82c, []int{110}
 }
 func (m *WorkflowTemplate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2587,7 +3147,7 @@ var xxx_messageInfo_WorkflowTemplate proto.InternalMessageInfo
 func (m *WorkflowTemplateList) Reset()      { *m = WorkflowTemplateList{} }
 func (*WorkflowTemplateList) ProtoMessage() {}
 func (*WorkflowTemplateList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f89c80cffb38c82c, []int{91}
+	return fileDescriptor_f89c80cffb38c82c, []int{111}
 }
 func (m *WorkflowTemplateList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2615,7 +3175,7 @@ var xxx_messageInfo_WorkflowTemplateList proto.InternalMessageInfo
 func (m *WorkflowTemplateRef) Reset()      { *m = WorkflowTemplateRef{} }
 func (*WorkflowTemplateRef) ProtoMessage() {}
 func (*WorkflowTemplateRef) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f89c80cffb38c82c, []int{92}
+	return fileDescriptor_f89c80cffb38c82c, []int{112}
 }
 func (m *WorkflowTemplateRef) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2643,7 +3203,7 @@ var xxx_messageInfo_WorkflowTemplateRef proto.InternalMessageInfo
 func (m *WorkflowTemplateSpec) Reset()      { *m = WorkflowTemplateSpec{} }
 func (*WorkflowTemplateSpec) ProtoMessage() {}
 func (*WorkflowTemplateSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f89c80cffb38c82c, []int{93}
+	return fileDescriptor_f89c80cffb38c82c, []int{113}
 }
 func (m *WorkflowTemplateSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2671,7 +3231,7 @@ var xxx_messageInfo_WorkflowTemplateSpec proto.InternalMessageInfo
 func (m *ZipStrategy) Reset()      { *m = ZipStrategy{} }
 func (*ZipStrategy) ProtoMessage() {}
 func (*ZipStrategy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f89c80cffb38c82c, []int{94}
+	return fileDescriptor_f89c80cffb38c82c, []int{114}
 }
 func (m *ZipStrategy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2701,16 +3261,23 @@ func init() {
 	proto.RegisterType((*ArchiveStrategy)(nil), "github.com.argoproj.argo.v2.pkg.apis.workflow.v1alpha1.ArchiveStrategy")
 	proto.RegisterType((*Arguments)(nil), "github.com.argoproj.argo.v2.pkg.apis.workflow.v1alpha1.Arguments")
 	proto.RegisterType((*Artifact)(nil), "github.com.argoproj.argo.v2.pkg.apis.workflow.v1alpha1.Artifact")
+	proto.RegisterType((*ArtifactCache)(nil), "github.com.argoproj.argo.v2.pkg.apis.workflow.v1alpha1.ArtifactCache")
+	proto.RegisterType((*ArtifactGC)(nil), "github.com.argoproj.argo.v2.pkg.apis.workflow.v1alpha1.ArtifactGC")
 	proto.RegisterType((*ArtifactLocation)(nil), "github.com.argoproj.argo.v2.pkg.apis.workflow.v1alpha1.ArtifactLocation")
 	proto.RegisterType((*ArtifactRepositoryRef)(nil), "github.com.argoproj.argo.v2.pkg.apis.workflow.v1alpha1.ArtifactRepositoryRef")
 	proto.RegisterType((*ArtifactRepositoryRefStatus)(nil), "github.com.argoproj.argo.v2.pkg.apis.workflow.v1alpha1.ArtifactRepositoryRefStatus")
 	proto.RegisterType((*ArtifactoryArtifact)(nil), "github.com.argoproj.argo.v2.pkg.apis.workflow.v1alpha1.ArtifactoryArtifact")
 	proto.RegisterType((*ArtifactoryAuth)(nil), "github.com.argoproj.argo.v2.pkg.apis.workflow.v1alpha1.ArtifactoryAuth")
+	proto.RegisterType((*AzureArtifact)(nil), "github.com.argoproj.argo.v2.pkg.apis.workflow.v1alpha1.AzureArtifact")
+	proto.RegisterType((*AzureBlobContainer)(nil), "github.com.argoproj.argo.v2.pkg.apis.workflow.v1alpha1.AzureBlobContainer")
 	proto.RegisterType((*Backoff)(nil), "github.com.argoproj.argo.v2.pkg.apis.workflow.v1alpha1.Backoff")
 	proto.RegisterType((*Cache)(nil), "github.com.argoproj.argo.v2.pkg.apis.workflow.v1alpha1.Cache")
+	proto.RegisterType((*ChildWorkflowTemplate)(nil), "github.com.argoproj.argo.v2.pkg.apis.workflow.v1alpha1.ChildWorkflowTemplate")
 	proto.RegisterType((*ClusterWorkflowTemplate)(nil), "github.com.argoproj.argo.v2.pkg.apis.workflow.v1alpha1.ClusterWorkflowTemplate")
 	proto.RegisterType((*ClusterWorkflowTemplateList)(nil), "github.com.argoproj.argo.v2.pkg.apis.workflow.v1alpha1.ClusterWorkflowTemplateList")
 	proto.RegisterType((*Condition)(nil), "github.com.argoproj.argo.v2.pkg.apis.workflow.v1alpha1.Condition")
+	proto.RegisterType((*ContainerNode)(nil), "github.com.argoproj.argo.v2.pkg.apis.workflow.v1alpha1.ContainerNode")
+	proto.RegisterType((*ContainerSetTemplate)(nil), "github.com.argoproj.argo.v2.pkg.apis.workflow.v1alpha1.ContainerSetTemplate")
 	proto.RegisterType((*ContinueOn)(nil), "github.com.argoproj.argo.v2.pkg.apis.workflow.v1alpha1.ContinueOn")
 	proto.RegisterType((*Counter)(nil), "github.com.argoproj.argo.v2.pkg.apis.workflow.v1alpha1.Counter")
 	proto.RegisterType((*CreateS3BucketOptions)(nil), "github.com.argoproj.argo.v2.pkg.apis.workflow.v1alpha1.CreateS3BucketOptions")
@@ -2719,8 +3286,13 @@ func init() {
 	proto.RegisterType((*CronWorkflowSpec)(nil), "github.com.argoproj.argo.v2.pkg.apis.workflow.v1alpha1.CronWorkflowSpec")
 	proto.RegisterType((*CronWorkflowStatus)(nil), "github.com.argoproj.argo.v2.pkg.apis.workflow.v1alpha1.CronWorkflowStatus")
 	proto.RegisterType((*DAGTask)(nil), "github.com.argoproj.argo.v2.pkg.apis.workflow.v1alpha1.DAGTask")
+	proto.RegisterMapType((map[string]LifecycleHook)(nil), "github.com.argoproj.argo.v2.pkg.apis.workflow.v1alpha1.DAGTask.HooksEntry")
 	proto.RegisterType((*DAGTemplate)(nil), "github.com.argoproj.argo.v2.pkg.apis.workflow.v1alpha1.DAGTemplate")
+	proto.RegisterType((*DataSource)(nil), "github.com.argoproj.argo.v2.pkg.apis.workflow.v1alpha1.DataSource")
+	proto.RegisterType((*DataTemplate)(nil), "github.com.argoproj.argo.v2.pkg.apis.workflow.v1alpha1.DataTemplate")
 	proto.RegisterType((*Event)(nil), "github.com.argoproj.argo.v2.pkg.apis.workflow.v1alpha1.Event")
+	proto.RegisterType((*EventWorkflowSelector)(nil), "github.com.argoproj.argo.v2.pkg.apis.workflow.v1alpha1.EventWorkflowSelector")
+	proto.RegisterMapType((map[string]string)(nil), "github.com.argoproj.argo.v2.pkg.apis.workflow.v1alpha1.EventWorkflowSelector.LabelsEntry")
 	proto.RegisterType((*ExecutorConfig)(nil), "github.com.argoproj.argo.v2.pkg.apis.workflow.v1alpha1.ExecutorConfig")
 	proto.RegisterType((*GCSArtifact)(nil), "github.com.argoproj.argo.v2.pkg.apis.workflow.v1alpha1.GCSArtifact")
 	proto.RegisterType((*GCSBucket)(nil), "github.com.argoproj.argo.v2.pkg.apis.workflow.v1alpha1.GCSBucket")
@@ -2730,10 +3302,14 @@ func init() {
 	proto.RegisterType((*HDFSConfig)(nil), "github.com.argoproj.argo.v2.pkg.apis.workflow.v1alpha1.HDFSConfig")
 	proto.RegisterType((*HDFSKrbConfig)(nil), "github.com.argoproj.argo.v2.pkg.apis.workflow.v1alpha1.HDFSKrbConfig")
 	proto.RegisterType((*HTTPArtifact)(nil), "github.com.argoproj.argo.v2.pkg.apis.workflow.v1alpha1.HTTPArtifact")
+	proto.RegisterType((*HTTPHeader)(nil), "github.com.argoproj.argo.v2.pkg.apis.workflow.v1alpha1.HTTPHeader")
+	proto.RegisterType((*HTTPHeaderSource)(nil), "github.com.argoproj.argo.v2.pkg.apis.workflow.v1alpha1.HTTPHeaderSource")
+	proto.RegisterType((*HTTPTemplate)(nil), "github.com.argoproj.argo.v2.pkg.apis.workflow.v1alpha1.HTTPTemplate")
 	proto.RegisterType((*Header)(nil), "github.com.argoproj.argo.v2.pkg.apis.workflow.v1alpha1.Header")
 	proto.RegisterType((*Histogram)(nil), "github.com.argoproj.argo.v2.pkg.apis.workflow.v1alpha1.Histogram")
 	proto.RegisterType((*Inputs)(nil), "github.com.argoproj.argo.v2.pkg.apis.workflow.v1alpha1.Inputs")
 	proto.RegisterType((*Item)(nil), "github.com.argoproj.argo.v2.pkg.apis.workflow.v1alpha1.Item")
+	proto.RegisterType((*LifecycleHook)(nil), "github.com.argoproj.argo.v2.pkg.apis.workflow.v1alpha1.LifecycleHook")
 	proto.RegisterType((*Link)(nil), "github.com.argoproj.argo.v2.pkg.apis.workflow.v1alpha1.Link")
 	proto.RegisterType((*MemoizationStatus)(nil), "github.com.argoproj.argo.v2.pkg.apis.workflow.v1alpha1.MemoizationStatus")
 	proto.RegisterType((*Memoize)(nil), "github.com.argoproj.argo.v2.pkg.apis.workflow.v1alpha1.Memoize")
@@ -2758,20 +3334,24 @@ func init() {
 	proto.RegisterType((*Prometheus)(nil), "github.com.argoproj.argo.v2.pkg.apis.workflow.v1alpha1.Prometheus")
 	proto.RegisterType((*RawArtifact)(nil), "github.com.argoproj.argo.v2.pkg.apis.workflow.v1alpha1.RawArtifact")
 	proto.RegisterType((*ResourceTemplate)(nil), "github.com.argoproj.argo.v2.pkg.apis.workflow.v1alpha1.ResourceTemplate")
+	proto.RegisterType((*ResumeAction)(nil), "github.com.argoproj.argo.v2.pkg.apis.workflow.v1alpha1.ResumeAction")
 	proto.RegisterType((*RetryAffinity)(nil), "github.com.argoproj.argo.v2.pkg.apis.workflow.v1alpha1.RetryAffinity")
 	proto.RegisterType((*RetryNodeAntiAffinity)(nil), "github.com.argoproj.argo.v2.pkg.apis.workflow.v1alpha1.RetryNodeAntiAffinity")
 	proto.RegisterType((*RetryStrategy)(nil), "github.com.argoproj.argo.v2.pkg.apis.workflow.v1alpha1.RetryStrategy")
 	proto.RegisterType((*S3Artifact)(nil), "github.com.argoproj.argo.v2.pkg.apis.workflow.v1alpha1.S3Artifact")
 	proto.RegisterType((*S3Bucket)(nil), "github.com.argoproj.argo.v2.pkg.apis.workflow.v1alpha1.S3Bucket")
+	proto.RegisterType((*SQLCache)(nil), "github.com.argoproj.argo.v2.pkg.apis.workflow.v1alpha1.SQLCache")
 	proto.RegisterType((*ScriptTemplate)(nil), "github.com.argoproj.argo.v2.pkg.apis.workflow.v1alpha1.ScriptTemplate")
 	proto.RegisterType((*SemaphoreHolding)(nil), "github.com.argoproj.argo.v2.pkg.apis.workflow.v1alpha1.SemaphoreHolding")
 	proto.RegisterType((*SemaphoreRef)(nil), "github.com.argoproj.argo.v2.pkg.apis.workflow.v1alpha1.SemaphoreRef")
 	proto.RegisterType((*SemaphoreStatus)(nil), "github.com.argoproj.argo.v2.pkg.apis.workflow.v1alpha1.SemaphoreStatus")
 	proto.RegisterType((*Sequence)(nil), "github.com.argoproj.argo.v2.pkg.apis.workflow.v1alpha1.Sequence")
+	proto.RegisterType((*StopAction)(nil), "github.com.argoproj.argo.v2.pkg.apis.workflow.v1alpha1.StopAction")
 	proto.RegisterType((*Submit)(nil), "github.com.argoproj.argo.v2.pkg.apis.workflow.v1alpha1.Submit")
 	proto.RegisterType((*SubmitOpts)(nil), "github.com.argoproj.argo.v2.pkg.apis.workflow.v1alpha1.SubmitOpts")
 	proto.RegisterType((*SuppliedValueFrom)(nil), "github.com.argoproj.argo.v2.pkg.apis.workflow.v1alpha1.SuppliedValueFrom")
 	proto.RegisterType((*SuspendTemplate)(nil), "github.com.argoproj.argo.v2.pkg.apis.workflow.v1alpha1.SuspendTemplate")
+	proto.RegisterType((*SyncDatabaseRef)(nil), "github.com.argoproj.argo.v2.pkg.apis.workflow.v1alpha1.SyncDatabaseRef")
 	proto.RegisterType((*Synchronization)(nil), "github.com.argoproj.argo.v2.pkg.apis.workflow.v1alpha1.Synchronization")
 	proto.RegisterType((*SynchronizationStatus)(nil), "github.com.argoproj.argo.v2.pkg.apis.workflow.v1alpha1.SynchronizationStatus")
 	proto.RegisterType((*TTLStrategy)(nil), "github.com.argoproj.argo.v2.pkg.apis.workflow.v1alpha1.TTLStrategy")
@@ -2779,6 +3359,8 @@ func init() {
 	proto.RegisterType((*Template)(nil), "github.com.argoproj.argo.v2.pkg.apis.workflow.v1alpha1.Template")
 	proto.RegisterMapType((map[string]string)(nil), "github.com.argoproj.argo.v2.pkg.apis.workflow.v1alpha1.Template.NodeSelectorEntry")
 	proto.RegisterType((*TemplateRef)(nil), "github.com.argoproj.argo.v2.pkg.apis.workflow.v1alpha1.TemplateRef")
+	proto.RegisterType((*TerminateAction)(nil), "github.com.argoproj.argo.v2.pkg.apis.workflow.v1alpha1.TerminateAction")
+	proto.RegisterType((*TransformationStep)(nil), "github.com.argoproj.argo.v2.pkg.apis.workflow.v1alpha1.TransformationStep")
 	proto.RegisterType((*UserContainer)(nil), "github.com.argoproj.argo.v2.pkg.apis.workflow.v1alpha1.UserContainer")
 	proto.RegisterType((*ValueFrom)(nil), "github.com.argoproj.argo.v2.pkg.apis.workflow.v1alpha1.ValueFrom")
 	proto.RegisterType((*Version)(nil), "github.com.argoproj.argo.v2.pkg.apis.workflow.v1alpha1.Version")
@@ -2789,12 +3371,14 @@ func init() {
 	proto.RegisterType((*WorkflowEventBindingSpec)(nil), "github.com.argoproj.argo.v2.pkg.apis.workflow.v1alpha1.WorkflowEventBindingSpec")
 	proto.RegisterType((*WorkflowList)(nil), "github.com.argoproj.argo.v2.pkg.apis.workflow.v1alpha1.WorkflowList")
 	proto.RegisterType((*WorkflowSpec)(nil), "github.com.argoproj.argo.v2.pkg.apis.workflow.v1alpha1.WorkflowSpec")
+	proto.RegisterMapType((map[string]LifecycleHook)(nil), "github.com.argoproj.argo.v2.pkg.apis.workflow.v1alpha1.WorkflowSpec.HooksEntry")
 	proto.RegisterMapType((map[string]string)(nil), "github.com.argoproj.argo.v2.pkg.apis.workflow.v1alpha1.WorkflowSpec.NodeSelectorEntry")
 	proto.RegisterType((*WorkflowStatus)(nil), "github.com.argoproj.argo.v2.pkg.apis.workflow.v1alpha1.WorkflowStatus")
 	proto.RegisterMapType((Nodes)(nil), "github.com.argoproj.argo.v2.pkg.apis.workflow.v1alpha1.WorkflowStatus.NodesEntry")
 	proto.RegisterMapType((ResourcesDuration)(nil), "github.com.argoproj.argo.v2.pkg.apis.workflow.v1alpha1.WorkflowStatus.ResourcesDurationEntry")
 	proto.RegisterMapType((map[string]Template)(nil), "github.com.argoproj.argo.v2.pkg.apis.workflow.v1alpha1.WorkflowStatus.StoredTemplatesEntry")
 	proto.RegisterType((*WorkflowStep)(nil), "github.com.argoproj.argo.v2.pkg.apis.workflow.v1alpha1.WorkflowStep")
+	proto.RegisterMapType((map[string]LifecycleHook)(nil), "github.com.argoproj.argo.v2.pkg.apis.workflow.v1alpha1.WorkflowStep.HooksEntry")
 	proto.RegisterType((*WorkflowTemplate)(nil), "github.com.argoproj.argo.v2.pkg.apis.workflow.v1alpha1.WorkflowTemplate")
 	proto.RegisterType((*WorkflowTemplateList)(nil), "github.com.argoproj.argo.v2.pkg.apis.workflow.v1alpha1.WorkflowTemplateList")
 	proto.RegisterType((*WorkflowTemplateRef)(nil), "github.com.argoproj.argo.v2.pkg.apis.workflow.v1alpha1.WorkflowTemplateRef")
@@ -2807,481 +3391,550 @@ func init() {
 }
 
 var fileDescriptor_f89c80cffb38c82c = []byte{
-	// 7573 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x7d, 0x5d, 0x6c, 0x23, 0xd7,
-	0x75, 0xb0, 0x29, 0x89, 0x12, 0x79, 0x28, 0xad, 0xa4, 0xbb, 0x7f, 0xb4, 0x76, 0xbd, 0xda, 0x8c,
-	0x63, 0xc3, 0xfb, 0x7d, 0x8e, 0x14, 0xaf, 0xed, 0x7c, 0xfe, 0x3e, 0x7f, 0xb1, 0x2d, 0xea, 0x77,
-	0xbd, 0xfa, 0xf3, 0xa1, 0x76, 0xdd, 0x38, 0xee, 0xa2, 0x23, 0xf2, 0x8a, 0x9c, 0x15, 0x39, 0xc3,
-	0x9d, 0x19, 0x6a, 0x2d, 0x37, 0x49, 0x53, 0xa3, 0x69, 0x83, 0x20, 0x4d, 0x02, 0x14, 0x28, 0xd2,
-	0xa6, 0x28, 0xd2, 0xa2, 0x45, 0xfa, 0xd0, 0x02, 0xed, 0x43, 0x1f, 0xfa, 0x18, 0x20, 0x01, 0x92,
-	0x87, 0x02, 0x01, 0xf2, 0xd0, 0x00, 0x2d, 0x94, 0x44, 0xc9, 0x4b, 0x91, 0xa0, 0x45, 0x52, 0x14,
-	0x01, 0xb6, 0x05, 0x5a, 0xdc, 0xdf, 0xb9, 0x33, 0x1c, 0xee, 0x4a, 0xa4, 0x76, 0x1b, 0x20, 0x79,
-	0xe3, 0x9c, 0x73, 0xee, 0x39, 0xf7, 0xff, 0x9e, 0xbf, 0x7b, 0x09, 0x4b, 0x35, 0x27, 0xac, 0xb7,
-	0xb7, 0x67, 0x2a, 0x5e, 0x73, 0xd6, 0xf6, 0x6b, 0x5e, 0xcb, 0xf7, 0x6e, 0xf3, 0x1f, 0xb3, 0x7b,
-	0x57, 0x67, 0x5b, 0xbb, 0xb5, 0x59, 0xbb, 0xe5, 0x04, 0xb3, 0x77, 0x3d, 0x7f, 0x77, 0xa7, 0xe1,
-	0xdd, 0x9d, 0xdd, 0x7b, 0xce, 0x6e, 0xb4, 0xea, 0xf6, 0x73, 0xb3, 0x35, 0xea, 0x52, 0xdf, 0x0e,
-	0x69, 0x75, 0xa6, 0xe5, 0x7b, 0xa1, 0x47, 0x3e, 0x14, 0xf1, 0x99, 0x51, 0x7c, 0xf8, 0x8f, 0x99,
-	0xbd, 0xab, 0x33, 0xad, 0xdd, 0xda, 0x0c, 0xe3, 0x33, 0xa3, 0xf8, 0xcc, 0x28, 0x3e, 0x53, 0x1f,
-	0x30, 0xe4, 0xd7, 0xbc, 0x9a, 0x37, 0xcb, 0xd9, 0x6d, 0xb7, 0x77, 0xf8, 0x17, 0xff, 0xe0, 0xbf,
-	0x84, 0x98, 0x29, 0x6b, 0xf7, 0xa5, 0x60, 0xc6, 0xf1, 0x58, 0xad, 0x66, 0x2b, 0x9e, 0x4f, 0x67,
-	0xf7, 0x3a, 0xaa, 0x32, 0x75, 0xc5, 0xa0, 0x69, 0x79, 0x0d, 0xa7, 0xb2, 0x3f, 0xbb, 0xf7, 0xdc,
-	0x36, 0x0d, 0x3b, 0x6b, 0x3d, 0xf5, 0x42, 0x44, 0xda, 0xb4, 0x2b, 0x75, 0xc7, 0xa5, 0xfe, 0x7e,
-	0xd4, 0xea, 0x26, 0x0d, 0xed, 0x34, 0x01, 0xb3, 0xdd, 0x4a, 0xf9, 0x6d, 0x37, 0x74, 0x9a, 0xb4,
-	0xa3, 0xc0, 0x87, 0x1e, 0x54, 0x20, 0xa8, 0xd4, 0x69, 0xd3, 0xee, 0x28, 0xf7, 0x7c, 0xb7, 0x72,
-	0xed, 0xd0, 0x69, 0xcc, 0x3a, 0x6e, 0x18, 0x84, 0x7e, 0xb2, 0x90, 0xb5, 0x08, 0xc3, 0x73, 0x4d,
-	0xaf, 0xed, 0x86, 0xe4, 0x65, 0xc8, 0xee, 0xd9, 0x8d, 0x36, 0x2d, 0x66, 0x2e, 0x67, 0x9e, 0xc9,
-	0x97, 0x9e, 0xfa, 0xc6, 0xc1, 0xf4, 0x63, 0x87, 0x07, 0xd3, 0xd9, 0x9b, 0x0c, 0x78, 0xef, 0x60,
-	0xfa, 0x0c, 0x75, 0x2b, 0x5e, 0xd5, 0x71, 0x6b, 0xb3, 0xb7, 0x03, 0xcf, 0x9d, 0x59, 0x6f, 0x37,
-	0xb7, 0xa9, 0x8f, 0xa2, 0x8c, 0xf5, 0xb7, 0x03, 0x30, 0x3e, 0xe7, 0x57, 0xea, 0xce, 0x1e, 0x2d,
-	0x87, 0x8c, 0x7f, 0x6d, 0x9f, 0xdc, 0x82, 0xc1, 0xd0, 0xf6, 0x39, 0xbb, 0xc2, 0xd5, 0xf9, 0x99,
-	0xde, 0x86, 0x7c, 0x66, 0xcb, 0xf6, 0x15, 0xc7, 0xd2, 0xc8, 0xe1, 0xc1, 0xf4, 0xe0, 0x96, 0xed,
-	0x23, 0x63, 0x4c, 0xb6, 0x61, 0xc8, 0xf5, 0x5c, 0x5a, 0x1c, 0xe0, 0x02, 0x16, 0x7a, 0x15, 0xb0,
-	0xee, 0xb9, 0xba, 0xce, 0xa5, 0xdc, 0xe1, 0xc1, 0xf4, 0x10, 0x83, 0x20, 0xe7, 0xcd, 0xda, 0xf0,
-	0xae, 0xd3, 0x2a, 0x0e, 0xf6, 0xd7, 0x86, 0xb7, 0x9c, 0x56, 0xbc, 0x0d, 0x6f, 0x39, 0x2d, 0x64,
-	0x8c, 0xad, 0x7f, 0xcf, 0x40, 0x7e, 0xce, 0xaf, 0xb5, 0x9b, 0xd4, 0x0d, 0x03, 0xd2, 0x06, 0x68,
-	0xd9, 0xbe, 0xdd, 0xa4, 0x21, 0xf5, 0x83, 0x62, 0xe6, 0xf2, 0xe0, 0x33, 0x85, 0xab, 0x73, 0xbd,
-	0x0a, 0xdd, 0x54, 0x9c, 0x4a, 0x44, 0x0e, 0x25, 0x68, 0x50, 0x80, 0x86, 0x20, 0x72, 0x07, 0xf2,
-	0xb6, 0x1f, 0x3a, 0x3b, 0x76, 0x25, 0x0c, 0x8a, 0x03, 0x5c, 0xea, 0x6b, 0xbd, 0x4a, 0x9d, 0x93,
-	0x8c, 0x4a, 0x93, 0x52, 0x68, 0x5e, 0x41, 0x02, 0x8c, 0xa4, 0x58, 0xdf, 0x1e, 0x82, 0x9c, 0x42,
-	0x90, 0xcb, 0x30, 0xe4, 0xda, 0x4d, 0x35, 0xf1, 0x46, 0x65, 0xc1, 0xa1, 0x75, 0xbb, 0xc9, 0x86,
-	0xc1, 0x6e, 0x52, 0x46, 0xd1, 0xb2, 0xc3, 0x3a, 0x1f, 0x6a, 0x83, 0x62, 0xd3, 0x0e, 0xeb, 0xc8,
-	0x31, 0xe4, 0x22, 0x0c, 0x35, 0xbd, 0x2a, 0xe5, 0x23, 0x95, 0x15, 0xc3, 0xb8, 0xe6, 0x55, 0x29,
-	0x72, 0x28, 0x2b, 0xbf, 0xe3, 0x7b, 0xcd, 0xe2, 0x50, 0xbc, 0xfc, 0x92, 0xef, 0x35, 0x91, 0x63,
-	0xc8, 0xe7, 0x32, 0x30, 0xa1, 0xaa, 0xb7, 0xea, 0x55, 0xec, 0xd0, 0xf1, 0xdc, 0x62, 0x96, 0x0f,
-	0xfb, 0x4a, 0xbf, 0x7d, 0xa1, 0xf8, 0x95, 0x8a, 0x52, 0xf0, 0x44, 0x12, 0x83, 0x1d, 0xb2, 0xc9,
-	0x55, 0x80, 0x5a, 0xc3, 0xdb, 0xb6, 0x1b, 0xac, 0x1b, 0x8a, 0xc3, 0xbc, 0xe2, 0x7a, 0x20, 0x97,
-	0x35, 0x06, 0x0d, 0x2a, 0xe2, 0xc2, 0x88, 0x2d, 0x16, 0x61, 0x71, 0x84, 0x57, 0x7d, 0xb9, 0xf7,
-	0xaa, 0xc7, 0xd6, 0x72, 0xa9, 0x70, 0x78, 0x30, 0x3d, 0x22, 0x81, 0xa8, 0x84, 0x90, 0x67, 0x21,
-	0xe7, 0xb5, 0x58, 0x6d, 0xed, 0x46, 0x31, 0x77, 0x39, 0xf3, 0x4c, 0xae, 0x34, 0x21, 0x6b, 0x98,
-	0xdb, 0x90, 0x70, 0xd4, 0x14, 0xe4, 0x0a, 0x8c, 0x04, 0xed, 0x6d, 0x36, 0x66, 0xc5, 0x3c, 0x6f,
-	0xce, 0xb8, 0x24, 0x1e, 0x29, 0x0b, 0x30, 0x2a, 0x3c, 0x79, 0x11, 0x0a, 0x3e, 0xad, 0xb4, 0xfd,
-	0x80, 0xb2, 0x41, 0x2c, 0x02, 0xe7, 0x7d, 0x5a, 0x92, 0x17, 0x30, 0x42, 0xa1, 0x49, 0x67, 0xfd,
-	0xf3, 0x30, 0x74, 0x74, 0x2d, 0x79, 0x0e, 0x0a, 0xb2, 0xbe, 0xab, 0x5e, 0x2d, 0xe0, 0x93, 0x2c,
-	0x57, 0x1a, 0x67, 0x7c, 0xe6, 0x22, 0x30, 0x9a, 0x34, 0xe4, 0x2d, 0x18, 0x08, 0x9e, 0x97, 0xfb,
-	0x4a, 0xa9, 0xd7, 0x2e, 0x2c, 0x3f, 0xaf, 0xd7, 0xc2, 0xf0, 0xe1, 0xc1, 0xf4, 0x40, 0xf9, 0x79,
-	0x1c, 0x08, 0x9e, 0x67, 0x3b, 0x4a, 0xcd, 0x09, 0xfb, 0xdd, 0x51, 0x96, 0x9d, 0x50, 0x73, 0xe7,
-	0x3b, 0xca, 0xb2, 0x13, 0x22, 0x63, 0xcc, 0x76, 0xc5, 0x7a, 0x18, 0xb6, 0xf8, 0x54, 0xef, 0x63,
-	0x57, 0x5c, 0xd9, 0xda, 0xda, 0xd4, 0x12, 0xf8, 0x72, 0x62, 0x10, 0xe4, 0xbc, 0xc9, 0x27, 0x58,
-	0x97, 0x0a, 0x9c, 0xe7, 0xef, 0xcb, 0x65, 0x72, 0xbd, 0xdf, 0x65, 0xe2, 0xf9, 0xfb, 0x5a, 0xa2,
-	0x1c, 0x1f, 0x8d, 0x40, 0x53, 0x20, 0x6f, 0x63, 0x75, 0x27, 0xe0, 0xab, 0xa2, 0x9f, 0x36, 0x2e,
-	0x2c, 0x95, 0x13, 0x6d, 0x5c, 0x58, 0x2a, 0x23, 0xe7, 0xcd, 0xc6, 0xc9, 0xb7, 0xef, 0xca, 0x75,
-	0xd4, 0xf3, 0x38, 0xa1, 0x7d, 0x37, 0x3e, 0x4e, 0x68, 0xdf, 0x45, 0xc6, 0x98, 0xf1, 0xf7, 0x82,
-	0x80, 0x2f, 0x9b, 0x3e, 0xf8, 0x6f, 0x94, 0xcb, 0x71, 0xfe, 0x1b, 0xe5, 0x32, 0x32, 0xc6, 0x7c,
-	0x9e, 0x55, 0x02, 0xbe, 0xd2, 0xfa, 0x99, 0x67, 0xf3, 0x09, 0xfe, 0xcb, 0xf3, 0x65, 0x64, 0x8c,
-	0xad, 0x3b, 0x70, 0x56, 0x61, 0x90, 0xb6, 0xbc, 0xc0, 0xe1, 0xc3, 0x44, 0x77, 0xc8, 0x2c, 0xe4,
-	0x2b, 0x9e, 0xbb, 0xe3, 0xd4, 0xd6, 0xec, 0x96, 0xdc, 0xd2, 0xf5, 0x59, 0x30, 0xaf, 0x10, 0x18,
-	0xd1, 0x90, 0x27, 0x60, 0x70, 0x97, 0xee, 0xcb, 0xbd, 0xbd, 0x20, 0x49, 0x07, 0xaf, 0xd3, 0x7d,
-	0x64, 0xf0, 0xff, 0x97, 0xfb, 0xe2, 0x97, 0xa7, 0x1f, 0xfb, 0xe4, 0x3f, 0x5d, 0x7e, 0xcc, 0xfa,
-	0x8b, 0x01, 0xb8, 0x90, 0x2a, 0xb3, 0x1c, 0xda, 0x61, 0x3b, 0x20, 0x7f, 0x9a, 0x81, 0xb3, 0x76,
-	0x1a, 0x5e, 0xea, 0x20, 0x6b, 0xfd, 0xce, 0xd0, 0x18, 0xd3, 0xd2, 0x13, 0xb2, 0xaa, 0xe9, 0xfd,
-	0x80, 0xe9, 0x55, 0x61, 0xdd, 0xc3, 0x8e, 0xb4, 0xa0, 0x65, 0x57, 0xa8, 0x6c, 0xb3, 0xee, 0x9e,
-	0x75, 0x85, 0xc0, 0x88, 0x86, 0x6d, 0x9b, 0x55, 0xba, 0x63, 0xb7, 0x1b, 0x62, 0xd3, 0xc8, 0x45,
-	0xdb, 0xe6, 0x82, 0x00, 0xa3, 0xc2, 0x1b, 0x5d, 0xf5, 0xd5, 0x0c, 0x9c, 0x4e, 0x59, 0x57, 0xac,
-	0xaf, 0xdb, 0x7e, 0x43, 0x0e, 0x8b, 0xee, 0xeb, 0x1b, 0xb8, 0x8a, 0x0c, 0x4e, 0x3e, 0x93, 0x81,
-	0x71, 0x63, 0xa1, 0xcd, 0xb5, 0xe5, 0x99, 0xdb, 0xd7, 0x49, 0x12, 0x63, 0x57, 0x3a, 0x2f, 0x85,
-	0x8e, 0x27, 0x10, 0x98, 0x14, 0x6c, 0xfd, 0x43, 0x06, 0x92, 0x44, 0xc4, 0x86, 0x53, 0xed, 0x80,
-	0xfa, 0xac, 0x77, 0xca, 0xb4, 0xe2, 0xd3, 0x50, 0x0e, 0xed, 0x53, 0x33, 0x42, 0xf9, 0x65, 0xb5,
-	0x98, 0x61, 0xaa, 0xfe, 0xcc, 0xde, 0x73, 0x33, 0x82, 0xe2, 0x3a, 0xdd, 0x2f, 0xd3, 0x06, 0x65,
-	0x3c, 0x4a, 0xe4, 0xf0, 0x60, 0xfa, 0xd4, 0x8d, 0x18, 0x03, 0x4c, 0x30, 0x64, 0x22, 0x5a, 0x76,
-	0x10, 0xdc, 0xf5, 0xfc, 0xaa, 0x14, 0x31, 0x70, 0x6c, 0x11, 0x9b, 0x31, 0x06, 0x98, 0x60, 0x68,
-	0x7d, 0x2d, 0x03, 0x23, 0x25, 0xbb, 0xb2, 0xeb, 0xed, 0xec, 0xb0, 0x33, 0xb4, 0xda, 0xf6, 0x85,
-	0xbe, 0x21, 0x86, 0x45, 0x9f, 0xa1, 0x0b, 0x12, 0x8e, 0x9a, 0x82, 0x6c, 0xc1, 0xb0, 0xe8, 0x0e,
-	0x59, 0xa9, 0x0f, 0x1a, 0x95, 0xd2, 0x4a, 0x3f, 0x1f, 0x0e, 0xa6, 0xf4, 0xcf, 0x08, 0xa5, 0x7f,
-	0xe6, 0x9a, 0x1b, 0x6e, 0x30, 0x2d, 0xda, 0x71, 0x6b, 0x25, 0x38, 0x3c, 0x98, 0x1e, 0x5e, 0xe2,
-	0x3c, 0x50, 0xf2, 0x62, 0xc7, 0x6d, 0xd3, 0x7e, 0x47, 0x89, 0xe3, 0xd3, 0x2c, 0x1f, 0x1d, 0xb7,
-	0x6b, 0x11, 0x0a, 0x4d, 0x3a, 0xeb, 0x16, 0x64, 0xe7, 0xed, 0x4a, 0x9d, 0x92, 0x1b, 0xc9, 0x25,
-	0x5f, 0xb8, 0xfa, 0x4c, 0x5a, 0x6f, 0xe9, 0xe5, 0x6f, 0x76, 0xd8, 0x58, 0xb7, 0x8d, 0xc1, 0xfa,
-	0x51, 0x06, 0xce, 0xcf, 0x37, 0xda, 0x41, 0x48, 0xfd, 0x37, 0xe5, 0xbc, 0xda, 0xa2, 0xcd, 0x56,
-	0xc3, 0x0e, 0x29, 0xf9, 0x35, 0xc8, 0x31, 0x83, 0xab, 0x6a, 0x87, 0xb6, 0x94, 0xd8, 0xbd, 0x2b,
-	0xf8, 0xcc, 0x64, 0xd4, 0xac, 0x0e, 0x1b, 0xdb, 0xb7, 0x69, 0x25, 0x5c, 0xa3, 0xa1, 0x1d, 0xa9,
-	0x53, 0x11, 0x0c, 0x35, 0x57, 0xe2, 0xc2, 0x50, 0xd0, 0xa2, 0x15, 0xd9, 0xd1, 0xab, 0xbd, 0xce,
-	0xff, 0x64, 0xcd, 0xcb, 0x2d, 0x5a, 0x89, 0x34, 0x50, 0xf6, 0x85, 0x5c, 0x8e, 0xf5, 0x93, 0x0c,
-	0x5c, 0xe8, 0xd2, 0xda, 0x55, 0x27, 0x08, 0xc9, 0xdb, 0x1d, 0x2d, 0x9e, 0x39, 0x5a, 0x8b, 0x59,
-	0x69, 0xde, 0x5e, 0x3d, 0xb1, 0x14, 0xc4, 0x68, 0x6d, 0x08, 0x59, 0x27, 0xa4, 0x4d, 0xa5, 0xff,
-	0x6f, 0xf4, 0xda, 0xdc, 0x2e, 0x2d, 0x28, 0x8d, 0x29, 0x73, 0xf2, 0x1a, 0x93, 0x82, 0x42, 0x98,
-	0xf5, 0xcd, 0x0c, 0xb0, 0xa1, 0xaf, 0x3a, 0x52, 0x53, 0x1b, 0x0a, 0xf7, 0x5b, 0xca, 0x0e, 0x50,
-	0xdb, 0xeb, 0xd0, 0xd6, 0x7e, 0x8b, 0xd9, 0x9f, 0x63, 0x9a, 0x90, 0x01, 0x90, 0x93, 0x92, 0x5b,
-	0x30, 0x1c, 0xf0, 0xcd, 0x5f, 0x6e, 0xa5, 0x4b, 0xb2, 0xd0, 0xb0, 0x38, 0x12, 0xee, 0x1d, 0x4c,
-	0x1f, 0xc9, 0x68, 0x9f, 0xd1, 0xbc, 0x45, 0x39, 0x94, 0x5c, 0xd9, 0xe6, 0xdb, 0xa4, 0x41, 0x60,
-	0xd7, 0xa8, 0x5c, 0x15, 0x7a, 0xf3, 0x5d, 0x13, 0x60, 0x54, 0x78, 0xeb, 0x23, 0x00, 0xf3, 0x9e,
-	0x1b, 0x3a, 0x6e, 0x9b, 0x6e, 0xb8, 0xe4, 0x49, 0xc8, 0x52, 0xdf, 0xf7, 0x7c, 0xa9, 0x6f, 0xea,
-	0xe6, 0x2f, 0x32, 0x20, 0x0a, 0x1c, 0x79, 0x9a, 0xad, 0x66, 0xa7, 0x41, 0xab, 0xbc, 0xf6, 0xb9,
-	0xd2, 0x29, 0x55, 0xfb, 0x25, 0x0e, 0x45, 0x89, 0xb5, 0x66, 0x60, 0x64, 0x9e, 0xd9, 0xe8, 0xd4,
-	0x67, 0x7c, 0x4d, 0x2b, 0x7d, 0x2c, 0x66, 0xa5, 0x2b, 0x6b, 0x7c, 0x0b, 0xce, 0xce, 0xfb, 0x94,
-	0x4d, 0xb6, 0xe7, 0x4b, 0xed, 0xca, 0x2e, 0x0d, 0x85, 0x36, 0x1e, 0x90, 0x97, 0x61, 0xcc, 0xe3,
-	0x73, 0x7d, 0xd5, 0xab, 0xec, 0x3a, 0x6e, 0x4d, 0x9e, 0x28, 0x67, 0x25, 0x97, 0xb1, 0x0d, 0x13,
-	0x89, 0x71, 0x5a, 0xeb, 0x5b, 0x03, 0x30, 0x3a, 0xef, 0x7b, 0xae, 0x1a, 0xdb, 0x47, 0xb0, 0x06,
-	0x6f, 0xc7, 0xd6, 0x60, 0xcf, 0x86, 0x98, 0x59, 0xeb, 0x6e, 0xeb, 0x8f, 0xf8, 0x7a, 0x2a, 0x09,
-	0xdd, 0xfc, 0xf5, 0x13, 0x91, 0xc6, 0x39, 0x46, 0x03, 0x1b, 0x9f, 0x5e, 0xd6, 0x3f, 0x66, 0x60,
-	0xc2, 0x24, 0x7f, 0x04, 0x0b, 0xdd, 0x89, 0x2f, 0xf4, 0x85, 0x93, 0x68, 0x65, 0x97, 0xd5, 0xfd,
-	0x5f, 0xd9, 0x78, 0xeb, 0x58, 0x67, 0x33, 0x43, 0x7b, 0xf4, 0xae, 0x01, 0x90, 0x4d, 0x5c, 0xe8,
-	0x77, 0x7f, 0xe5, 0xe3, 0xfa, 0x7e, 0x59, 0x8f, 0x51, 0x13, 0x7a, 0x2f, 0xf1, 0x8d, 0x31, 0xf9,
-	0xec, 0x00, 0x0e, 0x2a, 0x75, 0x5a, 0x6d, 0x37, 0x94, 0x3e, 0xa6, 0xbb, 0xaf, 0x2c, 0xe1, 0xa8,
-	0x29, 0xc8, 0xdb, 0x30, 0x59, 0xf1, 0xdc, 0x4a, 0xdb, 0xf7, 0xa9, 0x5b, 0xd9, 0xdf, 0xe4, 0x0e,
-	0x43, 0xb9, 0x35, 0xcc, 0xc8, 0x62, 0x93, 0xf3, 0x49, 0x82, 0x7b, 0x69, 0x40, 0xec, 0x64, 0x24,
-	0x4c, 0xe4, 0xa0, 0x45, 0xdd, 0x2a, 0xb7, 0xdf, 0x72, 0xa6, 0x89, 0xcc, 0xc1, 0xa8, 0xf0, 0xe4,
-	0x06, 0x9c, 0x0f, 0x42, 0xa6, 0x32, 0xb9, 0xb5, 0x05, 0x6a, 0x57, 0x1b, 0x8e, 0xcb, 0x14, 0x18,
-	0xcf, 0xad, 0x06, 0xdc, 0x1e, 0x1b, 0x2c, 0x5d, 0x38, 0x3c, 0x98, 0x3e, 0x5f, 0x4e, 0x27, 0xc1,
-	0x6e, 0x65, 0xc9, 0x2d, 0x98, 0x0a, 0xda, 0x95, 0x0a, 0x0d, 0x82, 0x9d, 0x76, 0xe3, 0x75, 0x6f,
-	0x3b, 0x58, 0x71, 0x02, 0xa6, 0x7d, 0xad, 0x3a, 0x4d, 0x27, 0xe4, 0x06, 0x57, 0xb6, 0x74, 0xe9,
-	0xf0, 0x60, 0x7a, 0xaa, 0xdc, 0x95, 0x0a, 0xef, 0xc3, 0x81, 0x20, 0x9c, 0x13, 0x9b, 0x5a, 0x07,
-	0xef, 0x11, 0xce, 0x7b, 0xea, 0xf0, 0x60, 0xfa, 0xdc, 0x52, 0x2a, 0x05, 0x76, 0x29, 0xc9, 0x46,
-	0x30, 0x74, 0x9a, 0xf4, 0x5d, 0xcf, 0xa5, 0xdc, 0x9e, 0x32, 0x46, 0x70, 0x4b, 0xc2, 0x51, 0x53,
-	0x90, 0xdb, 0xd1, 0xfc, 0x63, 0x4b, 0x43, 0x5a, 0x48, 0xc7, 0xdf, 0xb9, 0xce, 0x1c, 0x1e, 0x4c,
-	0x4f, 0xbc, 0x69, 0x70, 0x62, 0xcb, 0x0b, 0x63, 0xbc, 0xad, 0x6f, 0x0e, 0x00, 0xe9, 0xdc, 0x0e,
-	0xc8, 0x75, 0x18, 0xb6, 0x2b, 0xa1, 0xb3, 0x47, 0xa5, 0x8f, 0xef, 0xc9, 0x34, 0x65, 0x49, 0x88,
-	0x42, 0xba, 0x43, 0xd9, 0x0c, 0xa1, 0xd1, 0x1e, 0x32, 0xc7, 0x8b, 0xa2, 0x64, 0x41, 0x3c, 0x98,
-	0x6c, 0xd8, 0x41, 0xa8, 0xe6, 0x6a, 0x95, 0x35, 0x59, 0x6e, 0x98, 0xff, 0xeb, 0x68, 0x8d, 0x62,
-	0x25, 0x4a, 0x67, 0xd9, 0xcc, 0x5d, 0x4d, 0x32, 0xc2, 0x4e, 0xde, 0xa4, 0x0d, 0x50, 0x51, 0xc7,
-	0x25, 0xdb, 0x2c, 0xfb, 0xf2, 0x52, 0xea, 0x83, 0x37, 0x3a, 0x09, 0x34, 0x28, 0x40, 0x43, 0x90,
-	0xf5, 0x9f, 0xc3, 0x30, 0xb2, 0x30, 0xb7, 0xbc, 0x65, 0x07, 0xbb, 0x47, 0xf0, 0x18, 0xb2, 0x39,
-	0x21, 0x75, 0x8f, 0xe4, 0xaa, 0x56, 0x3a, 0x09, 0x6a, 0x0a, 0xe2, 0x43, 0xde, 0x56, 0x5e, 0x58,
-	0xb9, 0xfd, 0xcf, 0xf5, 0x6e, 0xf0, 0x48, 0x46, 0xa6, 0x0b, 0x54, 0x82, 0x30, 0x12, 0x43, 0xf6,
-	0xa0, 0xa0, 0xe4, 0x33, 0x13, 0x75, 0xa8, 0x4f, 0x37, 0x79, 0xc4, 0x4a, 0x38, 0x4f, 0x0c, 0x00,
-	0x9a, 0x82, 0xc8, 0x0b, 0x30, 0x5a, 0xa5, 0x6c, 0x0b, 0xa1, 0x6e, 0xc5, 0xa1, 0x6c, 0xb7, 0x18,
-	0x64, 0xbd, 0xc3, 0x76, 0xcd, 0x05, 0x03, 0x8e, 0x31, 0x2a, 0xd2, 0x84, 0xfc, 0x5d, 0x27, 0xac,
-	0xf3, 0xfd, 0xbd, 0x38, 0xcc, 0xc7, 0xfc, 0xff, 0xf7, 0x5a, 0x57, 0xc6, 0x24, 0xea, 0x9c, 0x37,
-	0x15, 0x5b, 0x8c, 0x24, 0x30, 0x2b, 0x99, 0x7d, 0x70, 0x87, 0x35, 0xdf, 0x19, 0xf2, 0xf1, 0x02,
-	0x1c, 0x81, 0x11, 0x0d, 0xd9, 0x83, 0x51, 0xf6, 0x51, 0xa6, 0x77, 0xda, 0x6c, 0xb5, 0x48, 0xbf,
-	0x4a, 0xcf, 0x6e, 0x6c, 0xc5, 0x47, 0xf4, 0xcb, 0x9b, 0x06, 0x67, 0x8c, 0xc9, 0x61, 0x33, 0xf1,
-	0x6e, 0x9d, 0xba, 0xd2, 0xa3, 0xa9, 0x67, 0xe2, 0x9b, 0x75, 0xea, 0x22, 0xc7, 0x10, 0x9f, 0x2f,
-	0x17, 0xa9, 0x17, 0x72, 0x57, 0x66, 0x1f, 0x4e, 0xc5, 0x48, 0xc3, 0x2c, 0x9d, 0x92, 0x6b, 0x45,
-	0x7e, 0xa3, 0x21, 0x85, 0x29, 0x96, 0x9e, 0xbb, 0xf8, 0x8e, 0x13, 0x16, 0x0b, 0xbc, 0x5e, 0x7a,
-	0xef, 0xd8, 0xe0, 0x50, 0x94, 0x58, 0xe1, 0x5b, 0x60, 0xa3, 0x1c, 0x14, 0x47, 0xe3, 0xea, 0xad,
-	0x98, 0x0a, 0x01, 0x2a, 0xbc, 0xf5, 0xf5, 0x0c, 0x14, 0xd8, 0xf2, 0x53, 0x4b, 0xe6, 0x69, 0x18,
-	0x0e, 0x6d, 0xbf, 0x26, 0x2d, 0x70, 0x43, 0xc4, 0x16, 0x87, 0xa2, 0xc4, 0x92, 0x2a, 0x64, 0x43,
-	0x3b, 0xd8, 0x55, 0xfa, 0xc6, 0xab, 0xbd, 0xb6, 0x5c, 0x2e, 0xfd, 0x48, 0xd5, 0x60, 0x5f, 0x01,
-	0x0a, 0xe6, 0xe4, 0x19, 0xc8, 0xb1, 0xc3, 0x61, 0xc9, 0x0e, 0x94, 0x97, 0x64, 0x94, 0x2d, 0xf5,
-	0x25, 0x09, 0x43, 0x8d, 0xb5, 0x5e, 0x84, 0xec, 0xe2, 0x1e, 0x75, 0xf9, 0xa9, 0x11, 0x48, 0x1b,
-	0x34, 0x69, 0x78, 0x2b, 0xdb, 0x14, 0x35, 0x85, 0xf5, 0x36, 0x9c, 0x5a, 0x7c, 0x87, 0x56, 0xda,
-	0xa1, 0xe7, 0x0b, 0x5b, 0x95, 0xbc, 0x0e, 0x24, 0xa0, 0xfe, 0x9e, 0x53, 0xa1, 0x73, 0x95, 0x0a,
-	0xd3, 0xce, 0xd7, 0xa3, 0x1d, 0x69, 0x4a, 0x72, 0x22, 0xe5, 0x0e, 0x0a, 0x4c, 0x29, 0x65, 0x7d,
-	0x39, 0x03, 0x05, 0xc3, 0xd5, 0xc6, 0xf6, 0xa3, 0xda, 0x7c, 0x59, 0xe8, 0xee, 0x52, 0x41, 0x9a,
-	0xeb, 0xc3, 0x85, 0x27, 0x18, 0x45, 0x2b, 0x48, 0x83, 0x30, 0x12, 0xf3, 0x00, 0x37, 0x9c, 0xf5,
-	0x37, 0x19, 0x88, 0xca, 0xb1, 0xd1, 0xdf, 0x8e, 0x6a, 0x67, 0x8c, 0xbe, 0xe4, 0x2b, 0xb1, 0xe4,
-	0x63, 0x70, 0x3e, 0xde, 0x5c, 0x6e, 0xf9, 0x1f, 0xdf, 0xab, 0x22, 0x94, 0x99, 0x74, 0x4e, 0xd8,
-	0x4d, 0x84, 0x75, 0x13, 0xb2, 0xcb, 0x76, 0xbb, 0x46, 0x8f, 0x64, 0x35, 0xb1, 0x39, 0xe4, 0x53,
-	0xbb, 0x11, 0xaa, 0xf3, 0x53, 0xce, 0x21, 0x94, 0x30, 0xd4, 0x58, 0xeb, 0x2f, 0x87, 0xa0, 0x60,
-	0x78, 0xe0, 0xd9, 0x26, 0xe0, 0xd3, 0x96, 0x97, 0x3c, 0x8e, 0x90, 0xb6, 0x3c, 0xe4, 0x18, 0x36,
-	0xd9, 0x7c, 0xba, 0xe7, 0x04, 0x8e, 0xe7, 0x26, 0x8f, 0x23, 0x94, 0x70, 0xd4, 0x14, 0x64, 0x1a,
-	0xb2, 0x55, 0xda, 0x0a, 0xeb, 0x7c, 0x2a, 0x0f, 0x95, 0xf2, 0xac, 0xaa, 0x0b, 0x0c, 0x80, 0x02,
-	0xce, 0x08, 0x76, 0x68, 0x58, 0xa9, 0x17, 0x87, 0xf8, 0xe6, 0xcd, 0x09, 0x96, 0x18, 0x00, 0x05,
-	0x3c, 0xc5, 0x4f, 0x96, 0x7d, 0xf8, 0x7e, 0xb2, 0xe1, 0x13, 0xf6, 0x93, 0x91, 0x16, 0x9c, 0x0e,
-	0x82, 0xfa, 0xa6, 0xef, 0xec, 0xd9, 0x21, 0x8d, 0x66, 0xce, 0xc8, 0x71, 0xe4, 0x9c, 0x3f, 0x3c,
-	0x98, 0x3e, 0x5d, 0x2e, 0xaf, 0x24, 0xb9, 0x60, 0x1a, 0x6b, 0x52, 0x86, 0xb3, 0x8e, 0x1b, 0xd0,
-	0x4a, 0xdb, 0xa7, 0xd7, 0x6a, 0xae, 0xe7, 0xd3, 0x15, 0x2f, 0x60, 0xec, 0x64, 0x78, 0x4b, 0xbb,
-	0x7c, 0xaf, 0xa5, 0x11, 0x61, 0x7a, 0x59, 0xeb, 0xef, 0x33, 0x30, 0x6a, 0xc6, 0x1a, 0xc8, 0x1e,
-	0x40, 0x7d, 0x61, 0xa9, 0x2c, 0x36, 0x12, 0xb9, 0xbe, 0x4b, 0xfd, 0x44, 0x31, 0x04, 0xa7, 0x48,
-	0x85, 0x8a, 0x60, 0x68, 0x48, 0x3a, 0x42, 0x18, 0xf5, 0x49, 0xc8, 0xee, 0x78, 0x7e, 0x85, 0xca,
-	0x4d, 0x54, 0x2f, 0x94, 0x25, 0x06, 0x44, 0x81, 0xb3, 0x7e, 0x9c, 0x01, 0x43, 0x02, 0x79, 0x2f,
-	0x03, 0x63, 0x4c, 0xc8, 0x75, 0x7f, 0x3b, 0xd6, 0xa2, 0xc5, 0x7e, 0x5a, 0xa4, 0x99, 0x45, 0xce,
-	0x89, 0x18, 0x18, 0xe3, 0x22, 0xc9, 0xff, 0x86, 0xbc, 0x5d, 0xad, 0xfa, 0x34, 0x08, 0xa8, 0x38,
-	0x6a, 0xf2, 0xc2, 0xb1, 0x38, 0xa7, 0x80, 0x18, 0xe1, 0xd9, 0x6a, 0xac, 0x57, 0x77, 0x02, 0x36,
-	0xc1, 0xa5, 0xed, 0xa6, 0x57, 0x23, 0x13, 0xc2, 0xe0, 0xa8, 0x29, 0xac, 0xdf, 0x1d, 0x82, 0xb8,
-	0x6c, 0x52, 0x85, 0xf1, 0x5d, 0x7f, 0x7b, 0x9e, 0x3b, 0x3f, 0x7b, 0x71, 0x43, 0x9f, 0x3e, 0x3c,
-	0x98, 0x1e, 0xbf, 0x1e, 0xe7, 0x80, 0x49, 0x96, 0x52, 0xca, 0x75, 0xba, 0x1f, 0xda, 0xdb, 0xbd,
-	0xec, 0x99, 0x4a, 0x8a, 0xc9, 0x01, 0x93, 0x2c, 0xc9, 0x8b, 0x50, 0xd8, 0xf5, 0xb7, 0xd5, 0x5a,
-	0x4f, 0xfa, 0x7e, 0xaf, 0x47, 0x28, 0x34, 0xe9, 0x58, 0x17, 0xee, 0xfa, 0xdb, 0x6c, 0x6f, 0x54,
-	0x51, 0x75, 0xdd, 0x85, 0xd7, 0x25, 0x1c, 0x35, 0x05, 0x69, 0x01, 0xd9, 0x55, 0xbd, 0xa7, 0x5d,
-	0xbd, 0x72, 0x4b, 0x3a, 0xba, 0xa7, 0xf8, 0x1c, 0x3b, 0x51, 0xaf, 0x77, 0xf0, 0xc1, 0x14, 0xde,
-	0xe4, 0x23, 0x70, 0x7e, 0xd7, 0xdf, 0x96, 0x27, 0xc6, 0xa6, 0xef, 0xb8, 0x15, 0xa7, 0x15, 0x8b,
-	0xa5, 0x4f, 0xcb, 0xea, 0x9e, 0xbf, 0x9e, 0x4e, 0x86, 0xdd, 0xca, 0x5b, 0x5f, 0x64, 0xcb, 0xd9,
-	0x08, 0x8f, 0x3e, 0x28, 0xa8, 0xe2, 0xc0, 0x48, 0x9d, 0xda, 0x55, 0xea, 0x2b, 0x1d, 0xe8, 0x95,
-	0x9e, 0x17, 0x06, 0x67, 0x13, 0x29, 0x69, 0xe2, 0x3b, 0x40, 0xc5, 0xdf, 0xda, 0x80, 0x61, 0x01,
-	0x3b, 0x82, 0x85, 0xa4, 0xcf, 0xc4, 0x81, 0xfb, 0x78, 0x12, 0xbf, 0x94, 0x81, 0x3c, 0xb7, 0xb5,
-	0x6b, 0x4c, 0xc9, 0xd6, 0x45, 0x06, 0xef, 0x73, 0x8c, 0x3a, 0x30, 0x22, 0x0e, 0xff, 0x80, 0x9f,
-	0x4e, 0x7d, 0x34, 0x57, 0x24, 0x26, 0x45, 0xcd, 0x15, 0xba, 0x45, 0x80, 0x8a, 0xbf, 0xf5, 0xd3,
-	0x0c, 0x0c, 0x5f, 0x73, 0x5b, 0xed, 0x5f, 0xa8, 0xd4, 0x99, 0x35, 0x18, 0x62, 0x36, 0x52, 0x3c,
-	0x5f, 0x6b, 0xb4, 0xf4, 0x94, 0x99, 0xab, 0x55, 0x8c, 0xe7, 0x6a, 0xa1, 0x7d, 0x57, 0xb9, 0xab,
-	0x45, 0x19, 0x23, 0x52, 0xd8, 0x80, 0xa1, 0x55, 0xc7, 0xdd, 0x3d, 0xda, 0x84, 0x09, 0x2a, 0x5e,
-	0xab, 0x63, 0xc2, 0x94, 0x19, 0x10, 0x05, 0x4e, 0xad, 0x85, 0xc1, 0xf4, 0xb5, 0x60, 0xbd, 0x97,
-	0x81, 0xc9, 0x35, 0xda, 0xf4, 0x9c, 0x77, 0xed, 0xc8, 0xdb, 0xce, 0x0a, 0xd5, 0x9d, 0x50, 0xba,
-	0xca, 0x75, 0xa1, 0x15, 0x27, 0x44, 0x06, 0x7f, 0x80, 0x66, 0xca, 0x03, 0xce, 0x6c, 0xdb, 0x5c,
-	0x8f, 0xf6, 0xaf, 0x28, 0xe0, 0xac, 0x10, 0x18, 0xd1, 0x58, 0x7f, 0x9d, 0x81, 0x11, 0x51, 0x09,
-	0xaa, 0x78, 0x67, 0xba, 0xf0, 0xbe, 0x05, 0x59, 0x5e, 0x4e, 0xee, 0xbc, 0x1f, 0xee, 0xd9, 0x6e,
-	0x63, 0x4c, 0x84, 0x9e, 0xc6, 0x7f, 0xa2, 0x60, 0xcb, 0xf4, 0xe8, 0xa6, 0xfd, 0xce, 0x9c, 0x0e,
-	0x2f, 0x68, 0x3d, 0x7a, 0x8d, 0x43, 0x51, 0x62, 0xad, 0xdf, 0x1e, 0x84, 0x9c, 0xf2, 0x31, 0x91,
-	0x4f, 0x67, 0xa0, 0x60, 0xbb, 0xae, 0x17, 0xda, 0xc2, 0x05, 0x23, 0x66, 0xfb, 0x1b, 0xbd, 0xd6,
-	0x4d, 0xf1, 0x9d, 0x99, 0x8b, 0x78, 0x2e, 0xba, 0xa1, 0xbf, 0x1f, 0x1d, 0x03, 0x06, 0x06, 0x4d,
-	0xd1, 0x24, 0x84, 0xe1, 0x86, 0xbd, 0x4d, 0x1b, 0x6a, 0xf2, 0xaf, 0xf6, 0x5d, 0x89, 0x55, 0xce,
-	0x4e, 0xc8, 0xd7, 0xbd, 0x21, 0x80, 0x28, 0x65, 0x4d, 0xbd, 0x02, 0x13, 0xc9, 0xba, 0x92, 0x09,
-	0x63, 0x20, 0xc5, 0xd8, 0x9d, 0x89, 0x6d, 0x70, 0x6a, 0xe6, 0x0f, 0xbc, 0x94, 0x99, 0xfa, 0xbf,
-	0x50, 0x30, 0xc4, 0x1c, 0xa7, 0xa8, 0xf5, 0x06, 0x14, 0xd6, 0x68, 0xe8, 0x3b, 0x15, 0xce, 0xe0,
-	0x41, 0xd3, 0xe7, 0x48, 0x7b, 0xec, 0xc7, 0xd9, 0x6c, 0x64, 0x2c, 0x03, 0xe2, 0x03, 0xb4, 0x7c,
-	0xaf, 0x49, 0xc3, 0x3a, 0x6d, 0xab, 0x71, 0xed, 0x59, 0x31, 0xdc, 0xd4, 0x9c, 0x84, 0xaf, 0x20,
-	0xfa, 0x46, 0x43, 0x8a, 0x75, 0x05, 0xb2, 0x6b, 0xed, 0x90, 0xbe, 0xf3, 0xe0, 0x1d, 0xc0, 0xfa,
-	0x28, 0x8c, 0x72, 0xd2, 0x15, 0xaf, 0xc1, 0x36, 0x17, 0xd6, 0xbc, 0x26, 0xfb, 0x4e, 0x9a, 0x55,
-	0x9c, 0x08, 0x05, 0x8e, 0x4d, 0xf1, 0xba, 0xd7, 0xa8, 0x52, 0x5f, 0x76, 0x82, 0x1e, 0xd4, 0x15,
-	0x0e, 0x45, 0x89, 0xb5, 0xfe, 0x35, 0x03, 0x05, 0x5e, 0x50, 0x6e, 0x0a, 0x1e, 0x8c, 0xd4, 0x85,
-	0x1c, 0xd9, 0x11, 0x3d, 0x87, 0x08, 0xcc, 0x3a, 0x1b, 0x87, 0xa7, 0x00, 0xa0, 0x92, 0xc2, 0x04,
-	0xde, 0xb5, 0x9d, 0x90, 0x09, 0x1c, 0x78, 0x18, 0x02, 0xdf, 0x14, 0xcc, 0x51, 0x49, 0xb1, 0xbe,
-	0x3a, 0x01, 0xb0, 0xee, 0x55, 0xa9, 0x6c, 0xf0, 0x14, 0x0c, 0x38, 0x55, 0xd9, 0x95, 0x20, 0x0b,
-	0x0d, 0x5c, 0x5b, 0xc0, 0x01, 0xa7, 0xaa, 0xc7, 0x66, 0xa0, 0xeb, 0xee, 0xfc, 0x22, 0x14, 0xaa,
-	0x4e, 0xd0, 0x6a, 0xd8, 0xfb, 0xeb, 0x29, 0x7a, 0xdc, 0x42, 0x84, 0x42, 0x93, 0x8e, 0x3c, 0x2b,
-	0x63, 0xae, 0x42, 0x87, 0x2b, 0x26, 0x62, 0xae, 0x39, 0x56, 0x3d, 0x23, 0xdc, 0xfa, 0x12, 0x8c,
-	0x2a, 0x57, 0x22, 0x97, 0x92, 0xe5, 0xa5, 0xce, 0xa8, 0xa8, 0xcb, 0x96, 0x81, 0xc3, 0x18, 0x65,
-	0xd2, 0xdb, 0x39, 0xfc, 0xa8, 0xbc, 0x9d, 0x0b, 0x30, 0x11, 0x84, 0x9e, 0x4f, 0xab, 0x8a, 0xe2,
-	0xda, 0x42, 0x91, 0xc4, 0xda, 0x3a, 0x51, 0x4e, 0xe0, 0xb1, 0xa3, 0x04, 0xd9, 0x84, 0x33, 0x77,
-	0x13, 0x11, 0x6d, 0xde, 0xfe, 0xd3, 0x9c, 0xd3, 0x45, 0xc9, 0xe9, 0xcc, 0x9b, 0x29, 0x34, 0x98,
-	0x5a, 0x92, 0xbc, 0x0c, 0x63, 0xaa, 0x9a, 0xfc, 0xfc, 0x2c, 0x9e, 0xe1, 0xac, 0xb4, 0xb1, 0xb3,
-	0x65, 0x22, 0x31, 0x4e, 0x4b, 0x3e, 0x08, 0xd9, 0x56, 0xdd, 0x0e, 0xa8, 0xf4, 0x8c, 0x2a, 0x6f,
-	0x53, 0x76, 0x93, 0x01, 0xef, 0x1d, 0x4c, 0xe7, 0xd9, 0xb0, 0xf1, 0x0f, 0x14, 0x84, 0xe4, 0x2a,
-	0xc0, 0xb6, 0xd7, 0x76, 0xab, 0xb6, 0xbf, 0x7f, 0x6d, 0x41, 0x06, 0x49, 0xb4, 0x6e, 0x53, 0xd2,
-	0x18, 0x34, 0xa8, 0xcc, 0xd8, 0x77, 0xfe, 0xfe, 0xb1, 0x6f, 0xf2, 0x51, 0xc8, 0xf3, 0x80, 0x12,
-	0xad, 0xce, 0x85, 0xd2, 0xc5, 0x79, 0x9c, 0xd8, 0x83, 0x3e, 0xae, 0xcb, 0x8a, 0x09, 0x46, 0xfc,
-	0xc8, 0x2d, 0x80, 0x1d, 0xc7, 0x75, 0x82, 0x3a, 0xe7, 0x5e, 0x38, 0x36, 0x77, 0xdd, 0xce, 0x25,
-	0xcd, 0x05, 0x0d, 0x8e, 0xe4, 0x6d, 0x98, 0xa4, 0x41, 0xe8, 0x34, 0xed, 0x90, 0x56, 0x75, 0x0e,
-	0x4c, 0x91, 0xc7, 0xd0, 0x74, 0x48, 0x6f, 0x31, 0x49, 0x70, 0x2f, 0x0d, 0x88, 0x9d, 0x8c, 0xc8,
-	0x4b, 0x90, 0x6b, 0xf9, 0x5e, 0x8d, 0x59, 0x9e, 0xc5, 0xa9, 0xd8, 0x74, 0xc9, 0x6d, 0x4a, 0xf8,
-	0x3d, 0xe3, 0x37, 0x6a, 0x6a, 0xf2, 0x2f, 0x19, 0x98, 0xf4, 0x69, 0xe0, 0xb5, 0xfd, 0x0a, 0x0d,
-	0x74, 0xc5, 0xce, 0xf2, 0xad, 0xe9, 0x23, 0xbd, 0x67, 0xbb, 0xab, 0xfd, 0x66, 0x06, 0x93, 0xbc,
-	0xc5, 0xa1, 0x4b, 0x55, 0x9b, 0x3b, 0xf0, 0xf7, 0xd2, 0x80, 0xef, 0x7d, 0x77, 0x7a, 0xba, 0xf3,
-	0x9a, 0x85, 0x66, 0xce, 0x26, 0xfb, 0x67, 0xbe, 0x3b, 0x3d, 0xa1, 0xbe, 0xa3, 0xae, 0xea, 0x68,
-	0x1a, 0x3b, 0x4e, 0x5a, 0x5e, 0xf5, 0xda, 0xa6, 0xf4, 0x45, 0xeb, 0xe3, 0x64, 0x93, 0x01, 0x51,
-	0xe0, 0xc8, 0x33, 0x90, 0xab, 0xda, 0xb4, 0xe9, 0xb9, 0xb4, 0x5a, 0x1c, 0x8b, 0xbc, 0x74, 0x0b,
-	0x12, 0x86, 0x1a, 0x4b, 0xb6, 0x61, 0xd8, 0xe1, 0xc6, 0x41, 0xf1, 0x14, 0x9f, 0x33, 0x3d, 0xdb,
-	0x21, 0xc2, 0xc4, 0x10, 0x99, 0x53, 0xe2, 0x37, 0x4a, 0xce, 0x64, 0x07, 0x46, 0xbc, 0x76, 0xc8,
-	0x85, 0x8c, 0x73, 0x21, 0x3d, 0xfb, 0xb7, 0x37, 0x04, 0x1b, 0x91, 0x69, 0x2d, 0x3f, 0x50, 0x31,
-	0x67, 0xad, 0xae, 0xd4, 0x9d, 0x46, 0xd5, 0xa7, 0x6e, 0x71, 0x82, 0x7b, 0x37, 0x78, 0xab, 0xe7,
-	0x25, 0x0c, 0x35, 0x96, 0xfc, 0x1f, 0x18, 0xf3, 0xda, 0x21, 0x5f, 0xc6, 0x6c, 0xac, 0x83, 0xe2,
-	0x24, 0x27, 0x9f, 0xe4, 0xe9, 0x1d, 0x26, 0x02, 0xe3, 0x74, 0x6c, 0x6f, 0xaf, 0x7b, 0x41, 0xc8,
-	0x3e, 0xf8, 0xde, 0x76, 0x2e, 0xbe, 0xb7, 0xaf, 0x18, 0x38, 0x8c, 0x51, 0x92, 0xcf, 0x65, 0x60,
-	0xb2, 0x99, 0x54, 0xea, 0x8b, 0xe7, 0x79, 0x7f, 0x5c, 0xeb, 0x5d, 0x21, 0x4c, 0x30, 0x14, 0x11,
-	0xca, 0x0e, 0x30, 0x76, 0x8a, 0xe6, 0x89, 0xa0, 0xc1, 0xbe, 0x5b, 0xa9, 0xfb, 0x9e, 0x1b, 0xaf,
-	0xd4, 0xe3, 0xbc, 0x52, 0x6f, 0xf4, 0xb5, 0x7a, 0xd2, 0x18, 0x97, 0x1e, 0x3f, 0x3c, 0x98, 0x3e,
-	0x9b, 0x8a, 0xc2, 0xf4, 0xaa, 0x4c, 0x2d, 0xc0, 0xb9, 0xf4, 0x15, 0xf8, 0x20, 0x7d, 0x74, 0xd0,
-	0xd4, 0x47, 0x97, 0xe0, 0xf1, 0xae, 0x95, 0x62, 0x3b, 0xb8, 0xd2, 0x68, 0x32, 0xf1, 0x1d, 0xbc,
-	0x43, 0x17, 0x39, 0x05, 0xa3, 0xe6, 0x45, 0x18, 0x1e, 0x91, 0x30, 0x92, 0x8b, 0x89, 0x0f, 0x79,
-	0xaf, 0x7c, 0x42, 0x11, 0x89, 0x8d, 0x72, 0x47, 0x44, 0x42, 0x83, 0x30, 0x12, 0xf3, 0xa0, 0x88,
-	0xc4, 0x5f, 0x0d, 0x40, 0x54, 0x8e, 0x3c, 0x0b, 0x39, 0xea, 0x56, 0x5b, 0x9e, 0xe3, 0x86, 0xc9,
-	0x70, 0xce, 0xa2, 0x84, 0xa3, 0xa6, 0x30, 0xe2, 0x17, 0x03, 0xf7, 0x8d, 0x5f, 0x54, 0x61, 0xdc,
-	0xe6, 0xb9, 0x0c, 0x91, 0xf7, 0x79, 0xf0, 0xd8, 0x3e, 0xb8, 0xb9, 0x38, 0x07, 0x4c, 0xb2, 0x64,
-	0x52, 0x82, 0xa8, 0x28, 0x97, 0x32, 0x74, 0x6c, 0x29, 0xe5, 0x38, 0x07, 0x4c, 0xb2, 0xb4, 0xbe,
-	0x3a, 0x00, 0x6a, 0x63, 0xf9, 0xc5, 0x71, 0x97, 0x10, 0x0b, 0x86, 0x7d, 0x1a, 0xa8, 0xec, 0xe9,
-	0xbc, 0xd8, 0xc5, 0x91, 0x43, 0x50, 0x62, 0xd8, 0xee, 0x4a, 0xdf, 0x71, 0xc2, 0x79, 0xaf, 0xaa,
-	0x14, 0x61, 0xbe, 0xbb, 0x2e, 0x4a, 0x18, 0x6a, 0xac, 0xf5, 0x2e, 0x8c, 0xb1, 0xa6, 0x35, 0x1a,
-	0xb4, 0x51, 0x0e, 0x69, 0x2b, 0x20, 0x0e, 0x64, 0x03, 0xf6, 0xa3, 0x5f, 0x1b, 0x25, 0xca, 0x10,
-	0xa1, 0x2d, 0xc3, 0xb5, 0xc2, 0x58, 0xa3, 0x90, 0x60, 0x1d, 0x0c, 0x40, 0x5e, 0xf7, 0xeb, 0x11,
-	0xfc, 0x35, 0x57, 0xa3, 0xc4, 0x71, 0x31, 0xc9, 0x8b, 0x46, 0xd2, 0x38, 0xd3, 0x12, 0xe7, 0xdc,
-	0x7d, 0x91, 0x14, 0xac, 0x33, 0xc8, 0xc9, 0xb3, 0x71, 0x0f, 0xdf, 0x39, 0xd3, 0xa9, 0x64, 0xd0,
-	0x4b, 0x57, 0x9f, 0x0b, 0x79, 0xfe, 0x63, 0x49, 0xdd, 0xad, 0xea, 0x63, 0x12, 0xdd, 0x54, 0x8c,
-	0x84, 0xdf, 0x5e, 0x7f, 0x62, 0x24, 0x22, 0x71, 0x27, 0x2a, 0x7b, 0xa4, 0x3b, 0x51, 0x57, 0x60,
-	0x88, 0xba, 0xed, 0x26, 0xcf, 0x59, 0xc8, 0xf3, 0x33, 0x64, 0x68, 0xd1, 0x6d, 0x37, 0xe3, 0xed,
-	0xe1, 0x24, 0xd6, 0x12, 0x30, 0x55, 0x63, 0x79, 0x9e, 0x7c, 0x18, 0x72, 0x81, 0xdc, 0x08, 0x65,
-	0xff, 0xbe, 0x4f, 0x87, 0x86, 0x25, 0xfc, 0xde, 0xc1, 0xf4, 0x18, 0x27, 0x56, 0x00, 0xd4, 0x45,
-	0xac, 0xdf, 0x1b, 0x02, 0xc3, 0xd8, 0x3e, 0xc2, 0x48, 0xd5, 0x12, 0x5e, 0x94, 0xf9, 0x3e, 0xbc,
-	0x28, 0xca, 0x35, 0x21, 0x26, 0x7a, 0xdc, 0x71, 0xc2, 0xaa, 0x52, 0xa7, 0x8d, 0x96, 0x1c, 0x5d,
-	0x5d, 0x95, 0x15, 0xda, 0x68, 0x21, 0xc7, 0xe8, 0x7c, 0x86, 0xa1, 0xae, 0xf9, 0x0c, 0xb7, 0x20,
-	0x5b, 0xb3, 0xdb, 0x35, 0x2a, 0xdd, 0xf7, 0x3d, 0xbb, 0xc4, 0x78, 0x64, 0x56, 0xb8, 0xc4, 0xf8,
-	0x4f, 0x14, 0x6c, 0xd9, 0xa4, 0xaa, 0x2b, 0x8f, 0xb3, 0xb4, 0x13, 0x7b, 0x9e, 0x54, 0xda, 0x75,
-	0x2d, 0x26, 0x95, 0xfe, 0xc4, 0x48, 0x04, 0x53, 0xe1, 0x2a, 0x22, 0xb9, 0x56, 0x06, 0x16, 0x5f,
-	0xed, 0x3d, 0x39, 0x83, 0xb3, 0x11, 0x2a, 0x9c, 0xfc, 0x40, 0xc5, 0xdc, 0x9a, 0x85, 0x82, 0x71,
-	0x1b, 0x88, 0x75, 0xb4, 0xce, 0xf0, 0x34, 0x3a, 0x7a, 0xc1, 0x0e, 0x6d, 0xe4, 0x18, 0xeb, 0x4b,
-	0x83, 0xa0, 0xd5, 0x66, 0x33, 0xed, 0xc2, 0xae, 0x18, 0x97, 0x05, 0x62, 0x59, 0x61, 0x9e, 0x8b,
-	0x12, 0xcb, 0xec, 0xcb, 0x26, 0xf5, 0x6b, 0xfa, 0x40, 0x97, 0x5b, 0x80, 0xb6, 0x2f, 0xd7, 0x4c,
-	0x24, 0xc6, 0x69, 0xd9, 0x59, 0xda, 0xb4, 0x5d, 0x67, 0x87, 0x06, 0x61, 0x32, 0x3e, 0xb6, 0x26,
-	0xe1, 0xa8, 0x29, 0xc8, 0x32, 0x4c, 0x06, 0x34, 0xdc, 0xb8, 0xeb, 0x52, 0x5f, 0x67, 0xab, 0xc9,
-	0xf4, 0xc5, 0xc7, 0x95, 0x2d, 0x51, 0x4e, 0x12, 0x60, 0x67, 0x19, 0x6e, 0xab, 0x8b, 0xcc, 0x41,
-	0x9d, 0x02, 0x26, 0x17, 0x79, 0x64, 0xab, 0x27, 0xf0, 0xd8, 0x51, 0x82, 0x71, 0xd9, 0xb1, 0x9d,
-	0x46, 0xdb, 0xa7, 0x11, 0x97, 0xe1, 0x38, 0x97, 0xa5, 0x04, 0x1e, 0x3b, 0x4a, 0xf0, 0x08, 0x7b,
-	0xc3, 0xae, 0x05, 0xc5, 0x11, 0x23, 0xc2, 0xce, 0x00, 0x28, 0xe0, 0xd6, 0x9f, 0x64, 0x60, 0x0c,
-	0x69, 0xe8, 0xef, 0xcf, 0xed, 0x30, 0x5b, 0x32, 0xdc, 0x27, 0x9f, 0xcf, 0xc0, 0x84, 0xeb, 0x55,
-	0xe9, 0x9c, 0x1b, 0x3a, 0x0a, 0xd8, 0xef, 0xcd, 0x23, 0x2e, 0x61, 0x3d, 0xc1, 0x54, 0xa4, 0x1e,
-	0x26, 0xa1, 0xd8, 0x21, 0xdc, 0x3a, 0x0f, 0x67, 0x53, 0x19, 0x58, 0x9f, 0x1d, 0x94, 0x95, 0xd7,
-	0x43, 0xfe, 0x06, 0x64, 0x1b, 0x3c, 0x0d, 0x33, 0xd3, 0xe3, 0xbd, 0x12, 0xde, 0x43, 0x22, 0x4f,
-	0x53, 0x70, 0x22, 0x0b, 0x50, 0xf0, 0x99, 0x0c, 0x99, 0x24, 0x2b, 0x26, 0xa0, 0x15, 0x5d, 0xe2,
-	0xd4, 0xa8, 0x7b, 0xf1, 0x4f, 0x34, 0x8b, 0x91, 0x3b, 0x30, 0xb2, 0x2d, 0xae, 0xca, 0x48, 0xcd,
-	0xab, 0xe7, 0xe5, 0x29, 0x6f, 0xdc, 0xf0, 0x43, 0x4d, 0x5d, 0xbf, 0xb9, 0x17, 0xfd, 0x44, 0x25,
-	0x87, 0x78, 0x90, 0xb3, 0xd5, 0xf8, 0x0d, 0xf5, 0x17, 0xca, 0x8e, 0xcd, 0x10, 0xa1, 0x55, 0xe8,
-	0xf1, 0xd2, 0x42, 0xac, 0x2f, 0x65, 0x00, 0xa2, 0xeb, 0xa2, 0xc4, 0x85, 0x5c, 0xf0, 0x7c, 0x4c,
-	0xd5, 0xee, 0x3d, 0x8f, 0x4d, 0xf2, 0x31, 0x72, 0x9b, 0x24, 0x04, 0xb5, 0x8c, 0x07, 0xe9, 0xd9,
-	0x9f, 0xc9, 0x82, 0x2e, 0xf5, 0x90, 0xd4, 0xec, 0xa7, 0x99, 0x92, 0x56, 0x8b, 0xee, 0x1e, 0x69,
-	0x3a, 0xe4, 0x50, 0x94, 0x58, 0xa6, 0xa8, 0xa9, 0x14, 0x0b, 0xb9, 0xc3, 0xf0, 0x2e, 0x55, 0xd9,
-	0x18, 0xa8, 0xb1, 0x69, 0x8a, 0x7b, 0xf6, 0x91, 0x28, 0xee, 0xc3, 0x27, 0xae, 0xb8, 0x33, 0x33,
-	0xce, 0xf7, 0x1a, 0x74, 0x0e, 0xd7, 0xa5, 0xc3, 0x4f, 0x9b, 0x71, 0x28, 0xc0, 0xa8, 0xf0, 0xe4,
-	0x45, 0x28, 0xb4, 0x03, 0x5a, 0x5e, 0xb8, 0x3e, 0xef, 0xd3, 0x6a, 0x20, 0xb3, 0x56, 0xb4, 0x17,
-	0xf8, 0x46, 0x84, 0x42, 0x93, 0x8e, 0x7c, 0x25, 0x03, 0xc5, 0x0a, 0xbf, 0x31, 0x22, 0x06, 0xe6,
-	0xda, 0xce, 0xba, 0x17, 0x6e, 0xfa, 0x34, 0xa0, 0x6e, 0x28, 0x13, 0xa4, 0xd7, 0x7a, 0xbf, 0x28,
-	0x90, 0x72, 0x13, 0xa5, 0x74, 0xf1, 0xf0, 0x60, 0xba, 0x38, 0xdf, 0x45, 0x24, 0x76, 0xad, 0x8c,
-	0xf5, 0xe9, 0x0c, 0x9c, 0x2a, 0x57, 0x7c, 0xa7, 0x15, 0xea, 0x23, 0x71, 0x9d, 0xdf, 0x3e, 0x0b,
-	0x6d, 0xb6, 0x47, 0xc9, 0xf5, 0xf2, 0x44, 0x97, 0x9c, 0x02, 0x41, 0x14, 0xbb, 0x8f, 0x2a, 0x40,
-	0x18, 0xb1, 0x60, 0x93, 0x51, 0x1c, 0xba, 0xc9, 0x49, 0x5b, 0xe6, 0x50, 0x94, 0x58, 0xeb, 0x36,
-	0x4c, 0x94, 0x69, 0xd3, 0x6e, 0xd5, 0x79, 0xaa, 0x8f, 0x88, 0x21, 0xcc, 0x42, 0x3e, 0x50, 0xb0,
-	0xe4, 0xe5, 0x57, 0x4d, 0x8c, 0x11, 0x0d, 0x79, 0x4a, 0x44, 0x39, 0x54, 0x72, 0x40, 0x5e, 0x28,
-	0x0f, 0x22, 0x34, 0x12, 0xa0, 0xc2, 0x59, 0x77, 0x61, 0x34, 0x2a, 0x4e, 0x77, 0x48, 0x0d, 0xc6,
-	0x2b, 0x46, 0x8a, 0x44, 0x74, 0xc7, 0xf5, 0xe8, 0xd9, 0x14, 0x7c, 0xee, 0xcd, 0xc7, 0x99, 0x60,
-	0x92, 0xab, 0xf5, 0x1f, 0x19, 0x18, 0xd7, 0x92, 0xa5, 0x5b, 0x21, 0x48, 0x46, 0x66, 0x56, 0x7a,
-	0x4f, 0xb3, 0x8d, 0xf7, 0xdf, 0x7d, 0xa2, 0x33, 0x41, 0x32, 0x3a, 0xf3, 0x10, 0x84, 0x76, 0x78,
-	0x45, 0xfe, 0x7c, 0x00, 0x72, 0x3a, 0xd5, 0xf7, 0x0d, 0xc8, 0x72, 0x5d, 0xae, 0xbf, 0x23, 0x92,
-	0xeb, 0x85, 0x28, 0x38, 0x31, 0x96, 0xdc, 0xcf, 0xdd, 0xf3, 0x6d, 0xce, 0xbc, 0xb0, 0x12, 0x6d,
-	0x3f, 0x44, 0xc1, 0x89, 0x5c, 0x87, 0x41, 0xea, 0x56, 0xe5, 0x59, 0x79, 0x7c, 0x86, 0xfc, 0x92,
-	0xf7, 0xa2, 0x5b, 0x45, 0xc6, 0x85, 0x5f, 0x50, 0xf3, 0xfc, 0xa6, 0x1d, 0x4a, 0x7b, 0x20, 0xba,
-	0xa0, 0xc6, 0xa1, 0x28, 0xb1, 0xd6, 0xcf, 0x06, 0x60, 0xb8, 0xdc, 0xde, 0x66, 0xa7, 0xfe, 0x1f,
-	0x66, 0xe0, 0x74, 0x32, 0xe2, 0x11, 0x4d, 0xcf, 0xeb, 0x27, 0x75, 0x8d, 0x12, 0xe9, 0x4e, 0xe9,
-	0x82, 0xac, 0xcd, 0xe9, 0x14, 0x24, 0xa6, 0x55, 0x22, 0x76, 0x63, 0x6d, 0xf0, 0x21, 0xdd, 0x1a,
-	0x35, 0x6e, 0x12, 0x0c, 0x9c, 0xd4, 0x4d, 0x82, 0xb1, 0x6e, 0xb7, 0x08, 0xac, 0xaf, 0x0f, 0x01,
-	0x88, 0x9e, 0xdf, 0x68, 0x85, 0x47, 0xb1, 0x35, 0x5f, 0x82, 0x51, 0xf5, 0x06, 0xd0, 0x7a, 0x14,
-	0x51, 0xd4, 0x6e, 0xde, 0x65, 0x03, 0x87, 0x31, 0x4a, 0x66, 0x7d, 0x53, 0x37, 0xf4, 0xf7, 0xc5,
-	0xe1, 0x3f, 0x14, 0xb7, 0xbe, 0x17, 0x35, 0x06, 0x0d, 0x2a, 0x32, 0x13, 0xf3, 0x33, 0x89, 0xab,
-	0x06, 0xa7, 0xee, 0xe3, 0x20, 0x7a, 0x19, 0xc6, 0xf4, 0xd7, 0x92, 0xd3, 0x50, 0xc9, 0x5a, 0xda,
-	0x6c, 0xd9, 0x34, 0x91, 0x18, 0xa7, 0x25, 0xaf, 0xc0, 0xa9, 0x78, 0x26, 0xb0, 0x3c, 0x2e, 0xcf,
-	0xc9, 0xd2, 0xa7, 0xe2, 0x09, 0xc4, 0x98, 0xa0, 0x66, 0xb3, 0xbd, 0xea, 0xef, 0x63, 0xdb, 0x95,
-	0xe7, 0xa6, 0x9e, 0xed, 0x0b, 0x1c, 0x8a, 0x12, 0xcb, 0xba, 0x90, 0x95, 0xa4, 0xbe, 0x80, 0xf3,
-	0x03, 0x32, 0x17, 0x75, 0x61, 0xd9, 0xc0, 0x61, 0x8c, 0x92, 0x49, 0x90, 0x86, 0x3e, 0xc4, 0xd7,
-	0x53, 0xc2, 0x4e, 0x6f, 0xc1, 0x29, 0x2f, 0x6e, 0x4f, 0x89, 0xb0, 0xd7, 0x0b, 0x47, 0x9c, 0xad,
-	0xb1, 0xb2, 0x22, 0xd5, 0x36, 0x61, 0x7e, 0x25, 0xf8, 0x5b, 0xa7, 0x61, 0xb2, 0xdc, 0x6e, 0xb5,
-	0x1a, 0x0e, 0xad, 0x6a, 0xd7, 0x8b, 0xf5, 0x2a, 0x8c, 0xcb, 0x7b, 0x67, 0xfa, 0xb0, 0x3d, 0xd6,
-	0x75, 0x75, 0xeb, 0x87, 0xec, 0xf4, 0x88, 0xbb, 0xa6, 0xc9, 0x9d, 0xe4, 0x11, 0xd9, 0x87, 0xd7,
-	0xcc, 0x3c, 0x13, 0xc5, 0x22, 0x49, 0x3d, 0x64, 0x6f, 0xa9, 0x3c, 0x85, 0x3e, 0xb3, 0x78, 0x78,
-	0x5c, 0x5f, 0xec, 0xb9, 0x66, 0x8a, 0x83, 0xf5, 0x6f, 0x19, 0x48, 0xf7, 0xfd, 0x93, 0xb0, 0xb3,
-	0xb1, 0xcb, 0x7d, 0x37, 0x56, 0x86, 0x1c, 0xba, 0xb7, 0xb7, 0x1a, 0x6f, 0xef, 0x7c, 0x5f, 0xed,
-	0x95, 0xd2, 0x3a, 0x5b, 0xfd, 0xb3, 0x0c, 0x14, 0xb6, 0xb6, 0x56, 0xb5, 0x09, 0x89, 0x70, 0x2e,
-	0x10, 0xb7, 0x08, 0xe7, 0x76, 0x42, 0xea, 0xcf, 0x7b, 0xcd, 0x56, 0x83, 0xea, 0x89, 0x22, 0xaf,
-	0xf6, 0x95, 0x53, 0x29, 0xb0, 0x4b, 0x49, 0x72, 0x0d, 0x4e, 0x9b, 0x18, 0x69, 0xfe, 0xf3, 0x76,
-	0x65, 0x65, 0x6a, 0x77, 0x27, 0x1a, 0xd3, 0xca, 0x24, 0x59, 0x49, 0x1f, 0x80, 0x7c, 0x30, 0xaa,
-	0x83, 0x95, 0x44, 0x63, 0x5a, 0x19, 0x6b, 0x03, 0x0a, 0xc6, 0xb3, 0x64, 0xe4, 0x35, 0x98, 0xa8,
-	0x78, 0xcd, 0x96, 0x4f, 0x83, 0xc0, 0xf1, 0xdc, 0x55, 0xba, 0x47, 0x1b, 0xb2, 0xc9, 0xdc, 0x50,
-	0x9f, 0x4f, 0xe0, 0xb0, 0x83, 0xda, 0xfa, 0xf1, 0x05, 0xd0, 0xd7, 0xd2, 0x7e, 0x79, 0xb9, 0xad,
-	0x8f, 0x74, 0x8f, 0x1d, 0x1d, 0xf3, 0xcd, 0x9e, 0x48, 0xcc, 0x57, 0x6f, 0xd0, 0x89, 0xb8, 0xef,
-	0xed, 0x28, 0xee, 0x3b, 0x7c, 0x32, 0x71, 0x5f, 0xad, 0x84, 0x76, 0xc4, 0x7e, 0x3f, 0x9b, 0x81,
-	0x51, 0xd7, 0xab, 0x52, 0xa5, 0xb9, 0x73, 0x97, 0x54, 0xe1, 0x2a, 0xf6, 0xdb, 0x9b, 0x22, 0x9a,
-	0x29, 0x99, 0x8a, 0xd8, 0xbf, 0x3e, 0xc3, 0x4c, 0x14, 0xc6, 0xa4, 0x93, 0x25, 0xc3, 0x3b, 0x22,
-	0x6e, 0xd9, 0x5d, 0x4c, 0xb3, 0x39, 0x1e, 0xe4, 0xf4, 0x20, 0xae, 0xa1, 0x8b, 0xe5, 0xfb, 0xf3,
-	0x72, 0xa8, 0xe4, 0x41, 0xc3, 0x4d, 0xa9, 0xee, 0xe3, 0x46, 0x9a, 0x99, 0x05, 0xc3, 0x22, 0x35,
-	0x40, 0x3e, 0x27, 0xc6, 0xfd, 0xe3, 0x22, 0x6d, 0x00, 0x25, 0x86, 0xdc, 0x56, 0xd1, 0x9c, 0x02,
-	0xef, 0xe2, 0xc5, 0x7e, 0x22, 0x62, 0x3a, 0x46, 0x94, 0x1e, 0xce, 0x21, 0xaf, 0x9b, 0x66, 0xeb,
-	0xe8, 0x51, 0xcc, 0xd6, 0xb1, 0xae, 0x26, 0xeb, 0x6d, 0x18, 0x0e, 0xb8, 0x51, 0xcc, 0x53, 0x22,
-	0x0a, 0x57, 0x97, 0x7a, 0x3e, 0x63, 0x62, 0xa6, 0xb5, 0xe8, 0x23, 0x01, 0x43, 0x29, 0x81, 0xf8,
-	0x90, 0x53, 0xa9, 0x1b, 0x32, 0xb1, 0x62, 0xa5, 0x77, 0xef, 0x58, 0xdc, 0xbb, 0xad, 0x2e, 0x5c,
-	0x09, 0x28, 0x6a, 0x39, 0xe4, 0x16, 0x0c, 0x56, 0xed, 0x9a, 0x4c, 0xb1, 0x98, 0xef, 0xe7, 0x0a,
-	0xa1, 0x92, 0xc4, 0xed, 0x9c, 0x85, 0xb9, 0x65, 0x64, 0x8c, 0x89, 0x1b, 0xdd, 0xbb, 0x9f, 0xe8,
-	0xf3, 0x90, 0x8e, 0xeb, 0x4b, 0xc2, 0x9c, 0xef, 0xb8, 0xbc, 0xbf, 0x08, 0x23, 0x7b, 0x5e, 0xa3,
-	0xdd, 0x94, 0xe9, 0x19, 0x85, 0xab, 0x53, 0x69, 0x23, 0x7f, 0x93, 0x93, 0x44, 0x3b, 0x83, 0xf8,
-	0x0e, 0x50, 0x95, 0x25, 0x9f, 0xca, 0xc0, 0x29, 0xb6, 0x98, 0xf4, 0x9c, 0x08, 0x8a, 0xa4, 0xbf,
-	0x89, 0x7b, 0x23, 0x60, 0xc7, 0xaf, 0x9a, 0x70, 0x5a, 0x71, 0xbe, 0x16, 0x13, 0x82, 0x09, 0xa1,
-	0x24, 0x80, 0x5c, 0xe0, 0x54, 0x69, 0xc5, 0xf6, 0x83, 0xe2, 0xe9, 0x93, 0xac, 0x40, 0xe4, 0xb5,
-	0x94, 0xec, 0x51, 0x0b, 0x22, 0xbf, 0xc3, 0xdf, 0xaa, 0x92, 0x8f, 0xf6, 0xc9, 0x07, 0x1b, 0xcf,
-	0x9c, 0xf0, 0x83, 0x8d, 0xc2, 0x0b, 0x18, 0x17, 0x82, 0x49, 0xa9, 0xe4, 0x37, 0x33, 0x70, 0x56,
-	0x5c, 0xc6, 0x4f, 0xbe, 0xc4, 0x70, 0xb6, 0x47, 0x2b, 0x9c, 0x67, 0x93, 0xcc, 0xa5, 0xb1, 0xc4,
-	0x74, 0x49, 0xe4, 0x13, 0x30, 0xe6, 0x9b, 0x0e, 0x7d, 0x9e, 0xbe, 0xd3, 0xaf, 0xe3, 0x5a, 0x3f,
-	0xff, 0xc8, 0xb3, 0x87, 0x62, 0x20, 0x8c, 0x8b, 0x23, 0xcf, 0x41, 0xa1, 0x25, 0x37, 0x3d, 0x27,
-	0x68, 0xf2, 0xe4, 0x9f, 0x41, 0x71, 0x56, 0x6f, 0x46, 0x60, 0x34, 0x69, 0xc8, 0x0d, 0x28, 0x84,
-	0x5e, 0x83, 0xfa, 0x32, 0x8b, 0xbd, 0xc8, 0x27, 0xce, 0xa5, 0xb4, 0x85, 0xb0, 0xa5, 0xc9, 0x22,
-	0x5f, 0x66, 0x04, 0x0b, 0xd0, 0xe4, 0xc3, 0x4c, 0x48, 0xf5, 0x5a, 0x87, 0xcf, 0x2d, 0xdc, 0xc7,
-	0xe3, 0x26, 0x64, 0xd9, 0x44, 0x62, 0x9c, 0x96, 0x2c, 0xc3, 0x64, 0xcb, 0x77, 0x3c, 0xdf, 0x09,
-	0xf7, 0xe7, 0x1b, 0x76, 0x10, 0x70, 0x06, 0x22, 0x6d, 0x4f, 0xc7, 0xb2, 0x36, 0x93, 0x04, 0xd8,
-	0x59, 0x86, 0x3c, 0x03, 0x39, 0x05, 0x2c, 0x5e, 0xe0, 0xba, 0xe0, 0xa8, 0x48, 0xf9, 0x13, 0x30,
-	0xd4, 0xd8, 0x2e, 0xf7, 0x88, 0x2f, 0xf6, 0x72, 0x8f, 0x98, 0x54, 0xe1, 0xa2, 0xdd, 0x0e, 0x3d,
-	0x7e, 0x6f, 0x26, 0x5e, 0x64, 0xcb, 0xdb, 0xa5, 0x6e, 0xf1, 0x32, 0x3f, 0xf9, 0x2e, 0x1f, 0x1e,
-	0x4c, 0x5f, 0x9c, 0xbb, 0x0f, 0x1d, 0xde, 0x97, 0x0b, 0x69, 0x41, 0x8e, 0xca, 0xbb, 0xd0, 0xc5,
-	0xf7, 0xf5, 0x77, 0xde, 0xc4, 0xef, 0x54, 0xab, 0xb4, 0x0b, 0x01, 0x43, 0x2d, 0x85, 0x6c, 0x41,
-	0xa1, 0xee, 0x05, 0xe1, 0x5c, 0xc3, 0xb1, 0x03, 0x1a, 0x14, 0x9f, 0xe0, 0x53, 0x25, 0xf5, 0xb4,
-	0x5c, 0x51, 0x64, 0xd1, 0x4c, 0x59, 0x89, 0x4a, 0xa2, 0xc9, 0x86, 0x50, 0xee, 0xbd, 0x6f, 0xf3,
-	0x81, 0xf3, 0xdc, 0x90, 0xbe, 0x13, 0x16, 0x2f, 0xf1, 0xe6, 0x3c, 0x9d, 0xc6, 0x79, 0xd3, 0xab,
-	0x96, 0xe3, 0xd4, 0xda, 0x7d, 0x6f, 0x02, 0x31, 0xc9, 0x93, 0xbc, 0x04, 0xa3, 0x2d, 0xaf, 0x5a,
-	0x6e, 0xd1, 0xca, 0xa6, 0x1d, 0x56, 0xea, 0xc5, 0xe9, 0xb8, 0xc7, 0x65, 0xd3, 0xc0, 0x61, 0x8c,
-	0x92, 0xec, 0xc0, 0x48, 0x53, 0xdc, 0x0c, 0x28, 0x3e, 0xd9, 0x9f, 0x96, 0x29, 0x2f, 0x18, 0x88,
-	0xe3, 0x48, 0x7e, 0xa0, 0x62, 0x4e, 0xfe, 0x20, 0x03, 0xe3, 0x89, 0x24, 0xb5, 0xe2, 0xfb, 0xfb,
-	0x3c, 0x07, 0xe3, 0xec, 0x4a, 0x4f, 0xf3, 0xae, 0x8a, 0x03, 0xef, 0x75, 0x82, 0x30, 0x59, 0x0f,
-	0xd1, 0x07, 0xfc, 0xae, 0x4e, 0xf1, 0xa9, 0x7e, 0xfb, 0x80, 0xb3, 0x51, 0x7d, 0xc0, 0x3f, 0x50,
-	0x31, 0x27, 0x57, 0x60, 0x24, 0x74, 0x9a, 0xd4, 0x6b, 0x87, 0xc5, 0xa7, 0xe3, 0x41, 0x96, 0x2d,
-	0x01, 0x46, 0x85, 0x9f, 0x7a, 0x15, 0x26, 0x3b, 0x54, 0xe7, 0x63, 0x5d, 0x22, 0xf9, 0x1e, 0xb3,
-	0x9c, 0x0d, 0xab, 0xe5, 0xa4, 0x2d, 0xbe, 0x65, 0x98, 0x94, 0x6f, 0x85, 0x33, 0x5d, 0xaa, 0xd1,
-	0xd6, 0xaf, 0xfa, 0x19, 0x11, 0x79, 0x4c, 0x12, 0x60, 0x67, 0x19, 0x36, 0x75, 0x2b, 0xe2, 0x41,
-	0x37, 0x91, 0xa4, 0x3e, 0x14, 0xf7, 0x74, 0xcd, 0x1b, 0x38, 0x8c, 0x51, 0x5a, 0x5f, 0xc9, 0xc0,
-	0x58, 0xec, 0x2c, 0x3f, 0xf1, 0x30, 0xcd, 0x12, 0x90, 0xa6, 0xe3, 0xfb, 0x9e, 0x2f, 0xd4, 0xa2,
-	0x35, 0xb6, 0x3f, 0x05, 0xf2, 0xe2, 0x3e, 0xbf, 0x29, 0xba, 0xd6, 0x81, 0xc5, 0x94, 0x12, 0xd6,
-	0xa7, 0x07, 0x21, 0xca, 0x36, 0xd2, 0x57, 0xa4, 0x33, 0x5d, 0xaf, 0x48, 0x3f, 0x0b, 0xb9, 0xdb,
-	0x81, 0xe7, 0x6e, 0x46, 0x17, 0xa9, 0xf5, 0x50, 0xbc, 0x5e, 0xde, 0x58, 0xe7, 0x94, 0x9a, 0x82,
-	0x53, 0xdf, 0x59, 0x72, 0x1a, 0x61, 0xe7, 0x55, 0xe3, 0xd7, 0xdf, 0x10, 0x70, 0xd4, 0x14, 0xfc,
-	0xd5, 0xb8, 0x3d, 0xaa, 0x1d, 0x97, 0xd1, 0xab, 0x71, 0x0c, 0x88, 0x02, 0x47, 0x66, 0x21, 0xaf,
-	0xfd, 0x9e, 0xd2, 0x0d, 0xab, 0x7b, 0x4a, 0xfb, 0x47, 0x31, 0xa2, 0xe1, 0xea, 0x99, 0xf4, 0xed,
-	0x49, 0x6b, 0xf5, 0x5a, 0xef, 0xea, 0x6d, 0xc2, 0x47, 0x28, 0xb6, 0x6c, 0x05, 0x46, 0x2d, 0xc8,
-	0xcc, 0x3e, 0xcb, 0x1e, 0x31, 0xfb, 0xcc, 0xfa, 0xd4, 0x20, 0x8c, 0xdc, 0xa4, 0x3e, 0x7f, 0x03,
-	0xe1, 0x0a, 0x8c, 0xec, 0x89, 0x9f, 0xc9, 0xdc, 0x55, 0x49, 0x81, 0x0a, 0xcf, 0x3a, 0x64, 0xbb,
-	0xed, 0x34, 0xaa, 0x0b, 0xd1, 0xea, 0xd0, 0x1d, 0x52, 0x52, 0x08, 0x8c, 0x68, 0x58, 0x81, 0x1a,
-	0x53, 0x60, 0x9b, 0x4d, 0x27, 0x4c, 0xde, 0x18, 0x5c, 0x56, 0x08, 0x8c, 0x68, 0xc8, 0xd3, 0x30,
-	0x5c, 0x73, 0xc2, 0x2d, 0xbb, 0x96, 0x8c, 0x83, 0x2c, 0x73, 0x28, 0x4a, 0x2c, 0x77, 0xae, 0x3b,
-	0xe1, 0x96, 0x4f, 0xb9, 0x8f, 0xae, 0xe3, 0x7e, 0xcc, 0xb2, 0x81, 0xc3, 0x18, 0x25, 0xaf, 0x92,
-	0x27, 0x5b, 0x26, 0x9d, 0xde, 0x51, 0x95, 0x14, 0x02, 0x23, 0x1a, 0x36, 0xb1, 0x2a, 0x5e, 0xb3,
-	0xe5, 0x34, 0x64, 0xde, 0x92, 0x31, 0xb1, 0xe6, 0x25, 0x1c, 0x35, 0x05, 0xa3, 0x66, 0x5b, 0xc3,
-	0x8e, 0xe7, 0x37, 0x93, 0x4f, 0x64, 0x6d, 0x4a, 0x38, 0x6a, 0x0a, 0xeb, 0x26, 0x8c, 0x89, 0x25,
-	0x32, 0xdf, 0xb0, 0x9d, 0xe6, 0xf2, 0x3c, 0x59, 0xec, 0x48, 0x88, 0xbb, 0x92, 0x92, 0x10, 0x77,
-	0x36, 0x56, 0x28, 0x25, 0x31, 0xee, 0x6b, 0x03, 0x90, 0x7b, 0x84, 0xaf, 0x07, 0xee, 0xc4, 0x5e,
-	0x0f, 0x3c, 0x99, 0x17, 0xe6, 0xd2, 0x5e, 0x0e, 0x74, 0x13, 0x2f, 0x07, 0x2e, 0xf5, 0x9f, 0x04,
-	0x7a, 0xdf, 0x57, 0x03, 0x7f, 0x9c, 0x01, 0x7d, 0xd5, 0x88, 0xef, 0x0c, 0x25, 0xc7, 0xe5, 0x31,
-	0xd2, 0x87, 0xdf, 0xa5, 0x7e, 0xac, 0x4b, 0x37, 0xfb, 0x6d, 0xa8, 0x59, 0xfb, 0xae, 0x0f, 0xa3,
-	0xfe, 0x28, 0x03, 0xc5, 0xb4, 0x02, 0x8f, 0xe0, 0xb1, 0xc4, 0x3b, 0xf1, 0xc7, 0x12, 0x57, 0x4f,
-	0xb2, 0xbd, 0x5d, 0x1e, 0x4d, 0x3c, 0xec, 0xd2, 0x5a, 0xfe, 0x56, 0xe1, 0xb6, 0x3a, 0x1f, 0x32,
-	0xfd, 0x05, 0x32, 0x04, 0xe3, 0xf4, 0xe3, 0x65, 0x1b, 0x86, 0x03, 0x1e, 0x50, 0x94, 0x83, 0xfc,
-	0x4a, 0xef, 0x67, 0x05, 0xe3, 0x22, 0x7d, 0x48, 0xfc, 0x37, 0x4a, 0xce, 0xd6, 0xb7, 0x33, 0x30,
-	0xfa, 0x08, 0xdf, 0xbc, 0xa4, 0xf1, 0x61, 0x7c, 0xad, 0xdf, 0x61, 0xec, 0x32, 0x74, 0x7f, 0x77,
-	0x01, 0x62, 0x0f, 0x4d, 0x92, 0x3b, 0x90, 0x57, 0x3a, 0x99, 0x4a, 0x10, 0x7f, 0xad, 0x5f, 0xaf,
-	0x6d, 0x74, 0x2c, 0x28, 0x48, 0x80, 0x91, 0x94, 0x44, 0x90, 0x76, 0xe0, 0x48, 0x41, 0xda, 0xff,
-	0x89, 0x00, 0x41, 0xba, 0xd5, 0x3b, 0xf4, 0x50, 0xac, 0xde, 0x8b, 0x27, 0x6e, 0xf5, 0x3e, 0xf1,
-	0x48, 0xac, 0x5e, 0xc3, 0x4b, 0x98, 0xed, 0xc3, 0x4b, 0xf8, 0xeb, 0x70, 0x66, 0x2f, 0x3a, 0x98,
-	0xf5, 0xac, 0x91, 0xaf, 0xf8, 0x5d, 0x49, 0xb5, 0x75, 0x99, 0x92, 0x11, 0x84, 0xd4, 0x0d, 0x8d,
-	0x23, 0x3d, 0xba, 0xe7, 0x7a, 0x33, 0x85, 0x1d, 0xa6, 0x0a, 0x49, 0xfa, 0x85, 0x46, 0x8e, 0xe0,
-	0x17, 0xfa, 0xe3, 0xae, 0xcf, 0xf8, 0xe7, 0x1e, 0xc6, 0x33, 0xfe, 0x8f, 0x1f, 0xfb, 0x09, 0xff,
-	0xa7, 0x22, 0x6f, 0xb1, 0x08, 0xfd, 0xa7, 0x3b, 0x79, 0xbf, 0x90, 0x8c, 0xdb, 0x00, 0xef, 0xf0,
-	0x9b, 0x27, 0xa1, 0x87, 0x9c, 0x40, 0xec, 0xa6, 0xd0, 0x47, 0xec, 0x26, 0xe1, 0xba, 0x1b, 0x3d,
-	0x21, 0xd7, 0x9d, 0x0b, 0x13, 0x4e, 0xd3, 0xae, 0xd1, 0xcd, 0x76, 0xa3, 0x21, 0x72, 0x1f, 0x83,
-	0xe2, 0x18, 0xe7, 0x9d, 0x9a, 0xd6, 0xb6, 0xea, 0x55, 0xec, 0x46, 0xf2, 0x99, 0x54, 0x9d, 0xe4,
-	0x7d, 0x2d, 0xc1, 0x09, 0x3b, 0x78, 0xb3, 0xc9, 0xc9, 0x2f, 0x32, 0xd2, 0x90, 0xf5, 0x36, 0x8f,
-	0x66, 0xc8, 0xbf, 0x86, 0x59, 0x89, 0xc0, 0x68, 0xd2, 0x90, 0xeb, 0x90, 0xaf, 0xba, 0x81, 0x4c,
-	0x69, 0x1e, 0xe7, 0xdb, 0xd5, 0x07, 0xd8, 0x26, 0xb7, 0xb0, 0x5e, 0xd6, 0xc9, 0xcc, 0x17, 0x53,
-	0xee, 0xc3, 0x6a, 0x3c, 0x46, 0xe5, 0xc9, 0x1a, 0x67, 0x26, 0x1f, 0xcd, 0x12, 0x81, 0x87, 0xcb,
-	0x5d, 0x5c, 0x4f, 0x0b, 0xeb, 0xea, 0x91, 0xaf, 0x31, 0x29, 0x4e, 0xbe, 0x83, 0x15, 0x71, 0x30,
-	0x5e, 0x7d, 0x9c, 0xbc, 0xef, 0xab, 0x8f, 0x37, 0xe0, 0x7c, 0x18, 0x36, 0x62, 0xd1, 0x6e, 0x79,
-	0x1b, 0x9a, 0x5f, 0x8d, 0xcf, 0x8a, 0xd7, 0xf6, 0xb6, 0xb6, 0x56, 0xd3, 0x48, 0xb0, 0x5b, 0x59,
-	0x1e, 0xf3, 0x0d, 0x1b, 0xda, 0x01, 0x7d, 0xa9, 0xcf, 0x98, 0x6f, 0x94, 0x59, 0x20, 0x63, 0xbe,
-	0x11, 0x00, 0x4d, 0x41, 0x64, 0xa3, 0x9b, 0xf7, 0xfd, 0x34, 0xdf, 0x6c, 0x8e, 0xef, 0x4b, 0x37,
-	0x7d, 0xb7, 0x67, 0xee, 0xeb, 0xbb, 0xed, 0xf0, 0x35, 0x9f, 0x3d, 0x86, 0xaf, 0xf9, 0x16, 0xbf,
-	0xee, 0xbc, 0x3c, 0x2f, 0x5d, 0xf5, 0x3d, 0x2b, 0x73, 0xfc, 0x1a, 0x92, 0xc8, 0xcf, 0xe0, 0x3f,
-	0x51, 0xb0, 0x25, 0x9b, 0x70, 0xa6, 0xe5, 0x55, 0x3b, 0xbc, 0xd5, 0xdc, 0x37, 0x6f, 0x3c, 0x5a,
-	0xb0, 0x99, 0x42, 0x83, 0xa9, 0x25, 0xf9, 0x66, 0x1e, 0xc1, 0xf9, 0x1d, 0xf9, 0xac, 0xdc, 0xcc,
-	0x23, 0x30, 0x9a, 0x34, 0x49, 0xcf, 0xed, 0xe3, 0x0f, 0xcd, 0x73, 0x3b, 0xf5, 0x08, 0x3c, 0xb7,
-	0x17, 0x8e, 0xec, 0xb9, 0xfd, 0x38, 0x9c, 0x6e, 0x79, 0xd5, 0x05, 0x27, 0xf0, 0xdb, 0x3c, 0xe1,
-	0xb9, 0xd4, 0xae, 0xd6, 0x68, 0xc8, 0x5d, 0xbf, 0x85, 0xab, 0x57, 0xcd, 0x4a, 0x8a, 0xff, 0x1c,
-	0x9c, 0x91, 0xff, 0x39, 0xc8, 0x97, 0x7a, 0xa2, 0x14, 0x37, 0x8c, 0x78, 0x82, 0x4a, 0x0a, 0x12,
-	0xd3, 0xe4, 0x98, 0x8e, 0xe3, 0xcb, 0x0f, 0xd3, 0x71, 0xfc, 0x1a, 0xe4, 0x82, 0x7a, 0x3b, 0xac,
-	0x7a, 0x77, 0x5d, 0x1e, 0x09, 0xc8, 0xeb, 0x17, 0xd8, 0x73, 0x65, 0x09, 0xbf, 0x77, 0x30, 0x3d,
-	0xa1, 0x7e, 0x1b, 0x2e, 0x01, 0x09, 0x21, 0xbf, 0xdf, 0x25, 0x5d, 0xd4, 0x3a, 0xf9, 0x74, 0xd1,
-	0xf3, 0xc7, 0x4a, 0x15, 0x4d, 0xf3, 0x89, 0x3f, 0xf9, 0x73, 0xe2, 0x13, 0xff, 0x7c, 0x06, 0xc6,
-	0xf6, 0x4c, 0x5f, 0x8b, 0xf4, 0xd6, 0xf7, 0x1c, 0xed, 0x8b, 0x39, 0x6e, 0x4a, 0x16, 0xdb, 0xba,
-	0x62, 0xa0, 0x7b, 0x49, 0x00, 0xc6, 0xe5, 0x77, 0x86, 0x1f, 0x9f, 0x7a, 0xa4, 0xe1, 0xc7, 0xfe,
-	0x5d, 0xf2, 0x3f, 0x99, 0x84, 0x53, 0x89, 0x57, 0xda, 0x5f, 0x50, 0xaf, 0xac, 0x08, 0x8f, 0xd7,
-	0xa5, 0xe4, 0x2b, 0x2b, 0x63, 0x8a, 0x3e, 0xf6, 0xd2, 0x4a, 0xec, 0x29, 0x94, 0x81, 0x87, 0xfa,
-	0x14, 0xca, 0xe0, 0xa3, 0x79, 0x0a, 0x65, 0xe2, 0x61, 0x3c, 0x85, 0x32, 0x79, 0xac, 0xa7, 0x50,
-	0x8c, 0xa7, 0x68, 0x86, 0x1e, 0xf0, 0x14, 0xcd, 0x1c, 0x8c, 0xab, 0xf4, 0x3a, 0x2a, 0x5f, 0xc0,
-	0x10, 0x5e, 0x58, 0xfd, 0xc7, 0x53, 0xf3, 0x71, 0x34, 0x26, 0xe9, 0xc9, 0x6f, 0x40, 0xd6, 0xe5,
-	0x05, 0x87, 0xfb, 0x7b, 0x58, 0x2d, 0x3e, 0x9f, 0xb8, 0xca, 0x2e, 0x1f, 0x36, 0x53, 0x89, 0x15,
-	0x59, 0x0e, 0xbb, 0xa7, 0x7e, 0xa0, 0x90, 0x4b, 0xde, 0x86, 0xa2, 0xb7, 0xb3, 0xd3, 0xf0, 0xec,
-	0x6a, 0xf4, 0x5c, 0x8b, 0xf2, 0x0d, 0x8b, 0xc4, 0xe1, 0xcb, 0x92, 0x41, 0x71, 0xa3, 0x0b, 0x1d,
-	0x76, 0xe5, 0xc0, 0xec, 0xab, 0xf1, 0xf8, 0x0b, 0x47, 0x41, 0x31, 0xcf, 0x5b, 0xfa, 0xd1, 0x13,
-	0x6a, 0x69, 0xfc, 0x45, 0x25, 0xd9, 0x66, 0xdd, 0xff, 0x09, 0x2c, 0x26, 0x2b, 0x43, 0x7c, 0x38,
-	0xd7, 0x4a, 0x33, 0x40, 0x03, 0x99, 0xf9, 0x76, 0x3f, 0x33, 0x58, 0xad, 0xd2, 0x73, 0xa9, 0x26,
-	0x6c, 0x80, 0x5d, 0x38, 0x9b, 0x0f, 0xb9, 0xe4, 0x1e, 0xe6, 0x43, 0x2e, 0xf1, 0x3f, 0x4f, 0x18,
-	0x7b, 0x44, 0x7f, 0x9e, 0x40, 0x7e, 0x9a, 0xfa, 0x96, 0x90, 0xb0, 0xdb, 0x7e, 0xf5, 0x84, 0x46,
-	0xfd, 0xe7, 0xee, 0x3d, 0xa1, 0x3f, 0xca, 0xc0, 0x94, 0x98, 0x5b, 0x69, 0x7f, 0xc2, 0x25, 0x93,
-	0xd7, 0x4e, 0x26, 0x2c, 0xc0, 0x03, 0x8e, 0xe5, 0x98, 0x2c, 0xee, 0xc1, 0xbe, 0x8f, 0x7c, 0xf2,
-	0xd9, 0x14, 0x0d, 0x63, 0xbc, 0x3f, 0x0f, 0x47, 0xfa, 0xdb, 0x34, 0xa7, 0x0f, 0x8f, 0xa2, 0x54,
-	0xfc, 0x59, 0x57, 0xb7, 0x0b, 0xe1, 0x95, 0x2a, 0x9f, 0xa8, 0xdb, 0xc5, 0x7c, 0x36, 0xe7, 0x38,
-	0xce, 0x97, 0xa9, 0x8f, 0x89, 0x37, 0xf3, 0xba, 0x3e, 0xdd, 0xf8, 0x2b, 0xe6, 0x11, 0xdf, 0xc7,
-	0xeb, 0x89, 0xd1, 0xbe, 0x69, 0xbe, 0x1c, 0xf9, 0x5b, 0x19, 0x38, 0x93, 0xb6, 0xbb, 0xa5, 0x54,
-	0xe4, 0x66, 0xbc, 0x22, 0x7d, 0x3b, 0x7e, 0xcd, 0x6a, 0x9c, 0xcc, 0xdb, 0x41, 0x5f, 0x1e, 0x36,
-	0xfc, 0xd5, 0x21, 0x6d, 0xfd, 0x32, 0xf3, 0xbc, 0x8f, 0xcc, 0xf3, 0xd8, 0x1f, 0xa4, 0x64, 0x1f,
-	0xed, 0x1f, 0xa4, 0x0c, 0xf7, 0xf0, 0x07, 0x29, 0x23, 0x8f, 0xf8, 0x0f, 0x52, 0x72, 0x47, 0xfc,
-	0x83, 0x94, 0xfc, 0xcf, 0xd3, 0x1f, 0xa4, 0x58, 0x3f, 0xcc, 0xc0, 0xc4, 0x2f, 0xc0, 0x7f, 0x4f,
-	0xfe, 0xc0, 0x88, 0x28, 0x3f, 0xc2, 0x3f, 0x9d, 0x6c, 0xc6, 0xe3, 0x72, 0x2b, 0x27, 0xd5, 0xce,
-	0x2e, 0xf1, 0xb9, 0x3b, 0x90, 0x66, 0xfe, 0x1f, 0xed, 0xce, 0x64, 0x2c, 0x0d, 0x6a, 0xe0, 0xc8,
-	0x69, 0x50, 0xef, 0x0d, 0x74, 0x76, 0x2c, 0x3f, 0xfc, 0x3f, 0xf1, 0x10, 0xff, 0x05, 0xef, 0x4c,
-	0xda, 0xbf, 0xe0, 0x25, 0xfe, 0xf5, 0x2e, 0xf9, 0x2f, 0x68, 0x03, 0x0f, 0xf1, 0x5f, 0xd0, 0xc6,
-	0xa0, 0xf0, 0x96, 0xd3, 0xd2, 0xd6, 0xfc, 0xcc, 0x37, 0xbe, 0x7f, 0xe9, 0xb1, 0x6f, 0x7d, 0xff,
-	0xd2, 0x63, 0xdf, 0xf9, 0xfe, 0xa5, 0xc7, 0x3e, 0x79, 0x78, 0x29, 0xf3, 0x8d, 0xc3, 0x4b, 0x99,
-	0x6f, 0x1d, 0x5e, 0xca, 0x7c, 0xe7, 0xf0, 0x52, 0xe6, 0x7b, 0x87, 0x97, 0x32, 0x5f, 0xf8, 0xc1,
-	0xa5, 0xc7, 0xde, 0xca, 0xa9, 0xb6, 0xfd, 0x77, 0x00, 0x00, 0x00, 0xff, 0xff, 0x02, 0x50, 0x3d,
-	0xea, 0x75, 0x84, 0x00, 0x00,
+	// 8683 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x7d, 0x6d, 0x6c, 0x24, 0xd9,
+	0x71, 0xd8, 0xf5, 0x90, 0x43, 0x0e, 0x8b, 0xe4, 0x92, 0x7c, 0xfb, 0x35, 0xc7, 0xbb, 0x5b, 0xae,
+	0xfb, 0x7c, 0xe7, 0x5b, 0xe7, 0x4c, 0xea, 0xf6, 0x24, 0xe7, 0xa2, 0x8b, 0x75, 0xc7, 0x21, 0x97,
+	0xe4, 0xde, 0xf2, 0xeb, 0x6a, 0x78, 0x7b, 0xd1, 0xdd, 0x65, 0xe1, 0xe6, 0xcc, 0xe3, 0x4c, 0x2f,
+	0x67, 0xba, 0x67, 0xbb, 0x7b, 0xb8, 0xc7, 0x8b, 0x2d, 0x4b, 0x42, 0x1c, 0x09, 0x82, 0x23, 0x2b,
+	0x08, 0x90, 0x38, 0x51, 0x10, 0x38, 0x81, 0x02, 0xe7, 0x87, 0x03, 0x24, 0x01, 0xf2, 0x3f, 0x02,
+	0x6c, 0x40, 0x76, 0x10, 0x40, 0x41, 0x7e, 0xc4, 0x40, 0x02, 0xda, 0xa2, 0x13, 0x20, 0x88, 0x05,
+	0x07, 0x56, 0x90, 0x38, 0xd8, 0xfc, 0x48, 0xf0, 0x3e, 0xfb, 0x75, 0x4f, 0xcf, 0x2e, 0x39, 0xcd,
+	0xa5, 0x14, 0x5b, 0xff, 0x66, 0xaa, 0xea, 0x55, 0xbd, 0xef, 0x57, 0xaf, 0xaa, 0x5e, 0x35, 0xac,
+	0x34, 0xdc, 0xa8, 0xd9, 0xdd, 0x9d, 0xaf, 0xf9, 0xed, 0x05, 0x27, 0x68, 0xf8, 0x9d, 0xc0, 0xbf,
+	0xcf, 0x7f, 0x2c, 0x1c, 0xdc, 0x5c, 0xe8, 0xec, 0x37, 0x16, 0x9c, 0x8e, 0x1b, 0x2e, 0x3c, 0xf4,
+	0x83, 0xfd, 0xbd, 0x96, 0xff, 0x70, 0xe1, 0xe0, 0x35, 0xa7, 0xd5, 0x69, 0x3a, 0xaf, 0x2d, 0x34,
+	0xa8, 0x47, 0x03, 0x27, 0xa2, 0xf5, 0xf9, 0x4e, 0xe0, 0x47, 0x3e, 0xf9, 0xd9, 0x98, 0xcf, 0xbc,
+	0xe2, 0xc3, 0x7f, 0xcc, 0x1f, 0xdc, 0x9c, 0xef, 0xec, 0x37, 0xe6, 0x19, 0x9f, 0x79, 0xc5, 0x67,
+	0x5e, 0xf1, 0x99, 0xfd, 0x19, 0x43, 0x7e, 0xc3, 0x6f, 0xf8, 0x0b, 0x9c, 0xdd, 0x6e, 0x77, 0x8f,
+	0xff, 0xe3, 0x7f, 0xf8, 0x2f, 0x21, 0x66, 0xd6, 0xde, 0x7f, 0x23, 0x9c, 0x77, 0x7d, 0x56, 0xab,
+	0x85, 0x9a, 0x1f, 0xd0, 0x85, 0x83, 0x9e, 0xaa, 0xcc, 0xde, 0x30, 0x68, 0x3a, 0x7e, 0xcb, 0xad,
+	0x1d, 0x2e, 0x1c, 0xbc, 0xb6, 0x4b, 0xa3, 0xde, 0x5a, 0xcf, 0x7e, 0x3a, 0x26, 0x6d, 0x3b, 0xb5,
+	0xa6, 0xeb, 0xd1, 0xe0, 0x30, 0x6e, 0x75, 0x9b, 0x46, 0x4e, 0x96, 0x80, 0x85, 0x7e, 0xa5, 0x82,
+	0xae, 0x17, 0xb9, 0x6d, 0xda, 0x53, 0xe0, 0x67, 0x9f, 0x54, 0x20, 0xac, 0x35, 0x69, 0xdb, 0xe9,
+	0x29, 0xf7, 0x7a, 0xbf, 0x72, 0xdd, 0xc8, 0x6d, 0x2d, 0xb8, 0x5e, 0x14, 0x46, 0x41, 0xba, 0x90,
+	0x7d, 0x0b, 0x46, 0x16, 0xdb, 0x7e, 0xd7, 0x8b, 0xc8, 0x9b, 0x50, 0x3c, 0x70, 0x5a, 0x5d, 0x5a,
+	0xb6, 0xae, 0x5b, 0xaf, 0x8c, 0x55, 0x5e, 0xfa, 0xce, 0xd1, 0xdc, 0x33, 0xc7, 0x47, 0x73, 0xc5,
+	0xbb, 0x0c, 0xf8, 0xe8, 0x68, 0xee, 0x12, 0xf5, 0x6a, 0x7e, 0xdd, 0xf5, 0x1a, 0x0b, 0xf7, 0x43,
+	0xdf, 0x9b, 0xdf, 0xec, 0xb6, 0x77, 0x69, 0x80, 0xa2, 0x8c, 0xfd, 0xaf, 0x0a, 0x30, 0xb5, 0x18,
+	0xd4, 0x9a, 0xee, 0x01, 0xad, 0x46, 0x8c, 0x7f, 0xe3, 0x90, 0xdc, 0x83, 0xa1, 0xc8, 0x09, 0x38,
+	0xbb, 0xf1, 0x9b, 0x4b, 0xf3, 0x83, 0x0d, 0xf9, 0xfc, 0x8e, 0x13, 0x28, 0x8e, 0x95, 0xd1, 0xe3,
+	0xa3, 0xb9, 0xa1, 0x1d, 0x27, 0x40, 0xc6, 0x98, 0xec, 0xc2, 0xb0, 0xe7, 0x7b, 0xb4, 0x5c, 0xe0,
+	0x02, 0x96, 0x07, 0x15, 0xb0, 0xe9, 0x7b, 0xba, 0xce, 0x95, 0xd2, 0xf1, 0xd1, 0xdc, 0x30, 0x83,
+	0x20, 0xe7, 0xcd, 0xda, 0xf0, 0x89, 0xdb, 0x29, 0x0f, 0xe5, 0x6b, 0xc3, 0x07, 0x6e, 0x27, 0xd9,
+	0x86, 0x0f, 0xdc, 0x0e, 0x32, 0xc6, 0xf6, 0xff, 0xb4, 0x60, 0x6c, 0x31, 0x68, 0x74, 0xdb, 0xd4,
+	0x8b, 0x42, 0xd2, 0x05, 0xe8, 0x38, 0x81, 0xd3, 0xa6, 0x11, 0x0d, 0xc2, 0xb2, 0x75, 0x7d, 0xe8,
+	0x95, 0xf1, 0x9b, 0x8b, 0x83, 0x0a, 0xdd, 0x56, 0x9c, 0x2a, 0x44, 0x0e, 0x25, 0x68, 0x50, 0x88,
+	0x86, 0x20, 0xf2, 0x00, 0xc6, 0x9c, 0x20, 0x72, 0xf7, 0x9c, 0x5a, 0x14, 0x96, 0x0b, 0x5c, 0xea,
+	0xdb, 0x83, 0x4a, 0x5d, 0x94, 0x8c, 0x2a, 0x33, 0x52, 0xe8, 0x98, 0x82, 0x84, 0x18, 0x4b, 0xb1,
+	0x7f, 0x50, 0x84, 0x92, 0x42, 0x90, 0xeb, 0x30, 0xec, 0x39, 0x6d, 0x35, 0xf1, 0x26, 0x64, 0xc1,
+	0xe1, 0x4d, 0xa7, 0xcd, 0x86, 0xc1, 0x69, 0x53, 0x46, 0xd1, 0x71, 0xa2, 0x26, 0x1f, 0x6a, 0x83,
+	0x62, 0xdb, 0x89, 0x9a, 0xc8, 0x31, 0xe4, 0x79, 0x18, 0x6e, 0xfb, 0x75, 0xca, 0x47, 0xaa, 0x28,
+	0x86, 0x71, 0xc3, 0xaf, 0x53, 0xe4, 0x50, 0x56, 0x7e, 0x2f, 0xf0, 0xdb, 0xe5, 0xe1, 0x64, 0xf9,
+	0x95, 0xc0, 0x6f, 0x23, 0xc7, 0x90, 0xaf, 0x5b, 0x30, 0xad, 0xaa, 0xb7, 0xee, 0xd7, 0x9c, 0xc8,
+	0xf5, 0xbd, 0x72, 0x91, 0x0f, 0xfb, 0x5a, 0xde, 0xbe, 0x50, 0xfc, 0x2a, 0x65, 0x29, 0x78, 0x3a,
+	0x8d, 0xc1, 0x1e, 0xd9, 0xe4, 0x26, 0x40, 0xa3, 0xe5, 0xef, 0x3a, 0x2d, 0xd6, 0x0d, 0xe5, 0x11,
+	0x5e, 0x71, 0x3d, 0x90, 0xab, 0x1a, 0x83, 0x06, 0x15, 0xf1, 0x60, 0xd4, 0x11, 0x8b, 0xb0, 0x3c,
+	0xca, 0xab, 0xbe, 0x3a, 0x78, 0xd5, 0x13, 0x6b, 0xb9, 0x32, 0x7e, 0x7c, 0x34, 0x37, 0x2a, 0x81,
+	0xa8, 0x84, 0x90, 0x57, 0xa1, 0xe4, 0x77, 0x58, 0x6d, 0x9d, 0x56, 0xb9, 0x74, 0xdd, 0x7a, 0xa5,
+	0x54, 0x99, 0x96, 0x35, 0x2c, 0x6d, 0x49, 0x38, 0x6a, 0x0a, 0x72, 0x03, 0x46, 0xc3, 0xee, 0x2e,
+	0x1b, 0xb3, 0xf2, 0x18, 0x6f, 0xce, 0x94, 0x24, 0x1e, 0xad, 0x0a, 0x30, 0x2a, 0x3c, 0xf9, 0x0c,
+	0x8c, 0x07, 0xb4, 0xd6, 0x0d, 0x42, 0xca, 0x06, 0xb1, 0x0c, 0x9c, 0xf7, 0x45, 0x49, 0x3e, 0x8e,
+	0x31, 0x0a, 0x4d, 0x3a, 0x12, 0x00, 0xa8, 0x7e, 0x5c, 0x5d, 0x2a, 0x8f, 0xf3, 0x2e, 0xa8, 0xe4,
+	0x1d, 0xbd, 0xd5, 0xa5, 0xca, 0x05, 0xd6, 0xe7, 0xf1, 0x7f, 0x34, 0xa4, 0xb0, 0x56, 0xd5, 0x69,
+	0x8b, 0x46, 0xb4, 0x5e, 0x9e, 0xe0, 0xd5, 0xd4, 0xad, 0x5a, 0x16, 0x60, 0x54, 0x78, 0xfb, 0x35,
+	0x98, 0x54, 0x4c, 0x96, 0x9c, 0x5a, 0x93, 0x3e, 0x79, 0xe2, 0xdb, 0xdb, 0x60, 0xc8, 0x25, 0x15,
+	0x28, 0x85, 0x72, 0x44, 0x64, 0x99, 0x97, 0x55, 0x7f, 0xab, 0x91, 0x7a, 0x74, 0x34, 0x47, 0xe2,
+	0x12, 0x0a, 0x8a, 0xba, 0x9c, 0xfd, 0xef, 0x46, 0xa1, 0x67, 0xfa, 0x91, 0xd7, 0x60, 0x5c, 0x8e,
+	0xe9, 0xba, 0xdf, 0x08, 0x39, 0xef, 0x52, 0x65, 0x8a, 0xf5, 0xf5, 0x62, 0x0c, 0x46, 0x93, 0x86,
+	0x7c, 0x00, 0x85, 0xf0, 0x75, 0xb9, 0xf7, 0x0e, 0xdc, 0xc7, 0xd5, 0xd7, 0xf5, 0x7e, 0x31, 0x72,
+	0x7c, 0x34, 0x57, 0xa8, 0xbe, 0x8e, 0x85, 0xf0, 0x75, 0xb6, 0xeb, 0x36, 0xdc, 0x28, 0xef, 0xae,
+	0xbb, 0xea, 0x46, 0x9a, 0x3b, 0xdf, 0x75, 0x57, 0xdd, 0x08, 0x19, 0x63, 0x76, 0x72, 0x34, 0xa3,
+	0xa8, 0xc3, 0xb7, 0x83, 0x1c, 0x27, 0xc7, 0xda, 0xce, 0xce, 0xb6, 0x96, 0xc0, 0xb7, 0x1c, 0x06,
+	0x41, 0xce, 0x9b, 0x7c, 0x81, 0x75, 0xa9, 0xc0, 0xf9, 0xc1, 0xa1, 0xdc, 0x4a, 0xee, 0xe4, 0x9d,
+	0x8c, 0x7e, 0x70, 0xa8, 0x25, 0xca, 0xf1, 0xd1, 0x08, 0x34, 0x05, 0xf2, 0x36, 0xd6, 0xf7, 0x42,
+	0xbe, 0x73, 0xe4, 0x69, 0xe3, 0xf2, 0x4a, 0x35, 0xd5, 0xc6, 0xe5, 0x95, 0x2a, 0x72, 0xde, 0x6c,
+	0x9c, 0x02, 0xe7, 0xa1, 0xdc, 0x6b, 0x06, 0x1e, 0x27, 0x74, 0x1e, 0x26, 0xc7, 0x09, 0x9d, 0x87,
+	0xc8, 0x18, 0x33, 0xfe, 0x7e, 0x18, 0xf2, 0xad, 0x25, 0x07, 0xff, 0xad, 0x6a, 0x35, 0xc9, 0x7f,
+	0xab, 0x5a, 0x45, 0xc6, 0x98, 0xcf, 0xb3, 0x5a, 0xc8, 0x77, 0xa3, 0x3c, 0xf3, 0x6c, 0x29, 0xc5,
+	0x7f, 0x75, 0xa9, 0x8a, 0x8c, 0x31, 0xd9, 0x83, 0xa2, 0xf3, 0x49, 0x37, 0x10, 0x1b, 0xd8, 0xf8,
+	0xcd, 0x5b, 0x03, 0x8f, 0x3e, 0x63, 0xa2, 0x65, 0x8c, 0x31, 0xad, 0x8c, 0x83, 0x50, 0xb0, 0xb7,
+	0x1f, 0xc0, 0x65, 0x85, 0x45, 0xda, 0xf1, 0x43, 0x97, 0x4f, 0x07, 0xba, 0x47, 0x16, 0x60, 0xac,
+	0xe6, 0x7b, 0x7b, 0x6e, 0x63, 0xc3, 0xe9, 0xc8, 0x1d, 0x43, 0x9f, 0xcb, 0x4b, 0x0a, 0x81, 0x31,
+	0x0d, 0x79, 0x01, 0x86, 0xf6, 0xe9, 0xa1, 0x3c, 0x67, 0xc7, 0x25, 0xe9, 0xd0, 0x1d, 0x7a, 0x88,
+	0x0c, 0xfe, 0xd9, 0xd2, 0xaf, 0xfd, 0xfa, 0xdc, 0x33, 0x5f, 0xfc, 0x4f, 0xd7, 0x9f, 0xb1, 0xff,
+	0x69, 0x01, 0x9e, 0xcb, 0x94, 0x59, 0x8d, 0x9c, 0xa8, 0x1b, 0x92, 0x7f, 0x6c, 0xc1, 0x65, 0x27,
+	0x0b, 0x2f, 0xf5, 0xc1, 0x8d, 0xbc, 0x2b, 0x21, 0xc1, 0xb4, 0xf2, 0x82, 0xac, 0x6a, 0x76, 0x3f,
+	0x60, 0x76, 0x55, 0x58, 0xf7, 0xb0, 0x5d, 0x36, 0xec, 0x38, 0x35, 0x2a, 0xdb, 0xac, 0xbb, 0x67,
+	0x53, 0x21, 0x30, 0xa6, 0x11, 0x9b, 0xfd, 0x9e, 0xd3, 0x6d, 0x89, 0xcd, 0x29, 0xb1, 0xd9, 0x73,
+	0x30, 0x2a, 0xbc, 0xd1, 0x55, 0xdf, 0xb6, 0xe0, 0x62, 0xc6, 0xfa, 0x65, 0x7d, 0xdd, 0x0d, 0x5a,
+	0x72, 0x58, 0x74, 0x5f, 0xbf, 0x87, 0xeb, 0xc8, 0xe0, 0xe4, 0x6b, 0x16, 0x4c, 0x19, 0x0b, 0x7a,
+	0xb1, 0x2b, 0xf5, 0x9f, 0x5c, 0xa7, 0x7a, 0x82, 0x5d, 0xe5, 0xaa, 0x14, 0x3a, 0x95, 0x42, 0x60,
+	0x5a, 0xb0, 0xfd, 0x1f, 0x2c, 0x48, 0x13, 0x11, 0x07, 0x2e, 0x74, 0x43, 0x1a, 0xb0, 0xde, 0xa9,
+	0xd2, 0x5a, 0x40, 0x23, 0x39, 0xb4, 0x2f, 0xcd, 0x8b, 0x8b, 0x08, 0xab, 0xc5, 0x3c, 0xbb, 0x76,
+	0xcd, 0x1f, 0xbc, 0x36, 0x2f, 0x28, 0xee, 0xd0, 0xc3, 0x2a, 0x6d, 0x51, 0xc6, 0xa3, 0x42, 0x8e,
+	0x8f, 0xe6, 0x2e, 0xbc, 0x97, 0x60, 0x80, 0x29, 0x86, 0x4c, 0x44, 0xc7, 0x09, 0xc3, 0x87, 0x7e,
+	0x50, 0x97, 0x22, 0x0a, 0xa7, 0x16, 0xb1, 0x9d, 0x60, 0x80, 0x29, 0x86, 0xf6, 0xef, 0x5a, 0x30,
+	0x99, 0x58, 0x5f, 0xe4, 0xef, 0x58, 0x40, 0xf8, 0xba, 0xaa, 0xb4, 0xfc, 0xdd, 0x25, 0xdf, 0x8b,
+	0x1c, 0x76, 0x95, 0x92, 0x8d, 0x7b, 0x27, 0xd7, 0x1a, 0x4e, 0x70, 0xac, 0xcc, 0xca, 0xee, 0x27,
+	0xbd, 0x38, 0xcc, 0xa8, 0x01, 0x53, 0x17, 0x76, 0x5b, 0xfe, 0x6e, 0x5a, 0x0b, 0x66, 0x44, 0xc8,
+	0x31, 0xf6, 0xff, 0x2a, 0x40, 0x06, 0x33, 0xa6, 0xa7, 0x51, 0xaf, 0xde, 0xf1, 0x5d, 0x2f, 0x92,
+	0xd3, 0x4d, 0xeb, 0x69, 0xb7, 0x24, 0x1c, 0x35, 0x85, 0xdc, 0x34, 0x64, 0xab, 0x0b, 0x3d, 0x9b,
+	0x86, 0xac, 0x60, 0x4c, 0x43, 0x1a, 0x30, 0xed, 0xd4, 0x6a, 0xec, 0x12, 0xc9, 0x3b, 0x9f, 0x8f,
+	0xd3, 0xd0, 0x69, 0xc6, 0xe9, 0x12, 0xd7, 0x89, 0x53, 0x2c, 0xb0, 0x87, 0x29, 0x9b, 0x0e, 0xa1,
+	0x13, 0xee, 0xf8, 0xfb, 0xd4, 0x93, 0x62, 0x86, 0x4f, 0x3d, 0x1d, 0xaa, 0x8b, 0x55, 0x83, 0x01,
+	0xa6, 0x18, 0x32, 0xcd, 0xb3, 0x1b, 0xd2, 0xea, 0xf2, 0x9d, 0xa5, 0x80, 0xd6, 0x43, 0x7e, 0x6c,
+	0x1b, 0x9a, 0xe7, 0x7b, 0x31, 0x0a, 0x4d, 0x3a, 0xfb, 0xb7, 0x2c, 0x18, 0xad, 0x38, 0xb5, 0x7d,
+	0x7f, 0x6f, 0x8f, 0xf5, 0x76, 0xbd, 0x1b, 0x88, 0x1b, 0x44, 0xaa, 0xb7, 0x97, 0x25, 0x1c, 0x35,
+	0x05, 0xd9, 0x81, 0x11, 0xb1, 0xa8, 0xe4, 0xd4, 0xfe, 0x94, 0xd1, 0x16, 0x7d, 0x8d, 0xe7, 0x13,
+	0x8b, 0x5d, 0xe3, 0xe7, 0xc5, 0x35, 0x7e, 0xfe, 0xb6, 0x17, 0x6d, 0xb1, 0x7b, 0xb1, 0xeb, 0x35,
+	0x2a, 0x70, 0x7c, 0x34, 0x37, 0xb2, 0xc2, 0x79, 0xa0, 0xe4, 0xc5, 0x9a, 0xd1, 0x76, 0x3e, 0x56,
+	0xe2, 0xf8, 0x68, 0x8c, 0xc5, 0xcd, 0xd8, 0x88, 0x51, 0x68, 0xd2, 0xd9, 0xbf, 0x59, 0x80, 0xa2,
+	0x50, 0x4d, 0xdf, 0x4b, 0x9f, 0x1c, 0xe3, 0x37, 0x5f, 0xc9, 0xea, 0x65, 0x7d, 0x8a, 0x98, 0x1d,
+	0x3d, 0xd9, 0xf7, 0x7c, 0xf9, 0x10, 0x86, 0xc2, 0x07, 0x2d, 0xd9, 0xd4, 0x81, 0x2f, 0x99, 0xd5,
+	0x77, 0xd7, 0x79, 0x2d, 0xc5, 0x71, 0x5b, 0x7d, 0x77, 0x1d, 0x19, 0x57, 0xe2, 0x43, 0x49, 0xed,
+	0x5b, 0x72, 0xfe, 0xdd, 0xca, 0xbb, 0x53, 0x0a, 0x31, 0x13, 0x6c, 0xec, 0xf4, 0xc9, 0xa2, 0x85,
+	0xd8, 0xdf, 0x2a, 0xc0, 0xe5, 0xa5, 0xa6, 0xdb, 0xaa, 0xbf, 0x2f, 0x19, 0xec, 0xd0, 0x76, 0xa7,
+	0xe5, 0x44, 0x94, 0xed, 0x21, 0x17, 0x1f, 0xa6, 0x80, 0xf1, 0xe1, 0x37, 0xb0, 0x1a, 0xf8, 0x7e,
+	0x2f, 0xcb, 0xca, 0xd5, 0xe3, 0xa3, 0xb9, 0x8b, 0x19, 0x08, 0xcc, 0xaa, 0x00, 0x09, 0xd8, 0x5d,
+	0x5f, 0xda, 0x1b, 0xe4, 0x30, 0x2c, 0x0e, 0xde, 0x49, 0x92, 0x91, 0x79, 0xd9, 0x97, 0x20, 0x8c,
+	0xc5, 0xd8, 0x7f, 0x64, 0xc1, 0xd5, 0xa5, 0x56, 0x37, 0x8c, 0x68, 0xd0, 0xd3, 0x51, 0x3f, 0x0f,
+	0xa5, 0x36, 0x8d, 0x9c, 0xba, 0x13, 0x39, 0xb2, 0x73, 0xfa, 0x2f, 0x00, 0x5e, 0x0d, 0x46, 0xcd,
+	0x26, 0xde, 0xd6, 0xee, 0x7d, 0x5a, 0x8b, 0x36, 0x68, 0xe4, 0xc4, 0xd7, 0xe2, 0x18, 0x86, 0x9a,
+	0x2b, 0xf1, 0x60, 0x38, 0xec, 0xd0, 0x9a, 0x6c, 0xec, 0xfa, 0x59, 0x75, 0x7d, 0xb5, 0x43, 0x6b,
+	0xf1, 0x1e, 0xcc, 0xfe, 0x21, 0x97, 0x63, 0xff, 0x89, 0x05, 0xcf, 0xf5, 0x69, 0xed, 0xba, 0x1b,
+	0x46, 0xe4, 0xa3, 0x9e, 0x16, 0xcf, 0x9f, 0xac, 0xc5, 0xac, 0x34, 0x6f, 0xaf, 0xde, 0x4e, 0x14,
+	0xc4, 0x68, 0x6d, 0x04, 0x45, 0x37, 0xa2, 0x6d, 0x65, 0xc7, 0xd9, 0x1a, 0xb4, 0xb9, 0x7d, 0x5a,
+	0x50, 0x99, 0x54, 0x66, 0xc1, 0xdb, 0x4c, 0x0a, 0x0a, 0x61, 0xf6, 0xef, 0x58, 0xc0, 0xd6, 0x7b,
+	0xdd, 0x95, 0xb7, 0xc9, 0xe1, 0xe8, 0xb0, 0xa3, 0xae, 0xb5, 0x4a, 0x35, 0x1b, 0xde, 0x39, 0xec,
+	0xd0, 0x47, 0x47, 0x73, 0x93, 0x9a, 0x90, 0x01, 0x90, 0x93, 0x92, 0x7b, 0x30, 0x12, 0x72, 0xc5,
+	0x51, 0x1e, 0x38, 0x2b, 0xb2, 0xd0, 0x88, 0x50, 0x27, 0x1f, 0x1d, 0xcd, 0x9d, 0xc8, 0xf8, 0x3a,
+	0xaf, 0x79, 0x8b, 0x72, 0x28, 0xb9, 0x32, 0xc5, 0xad, 0x4d, 0xc3, 0xd0, 0x69, 0x50, 0xb9, 0x17,
+	0x6a, 0xc5, 0x6d, 0x43, 0x80, 0x51, 0xe1, 0xed, 0x2f, 0x15, 0x60, 0x52, 0x1f, 0x73, 0x9b, 0x7e,
+	0x9d, 0x92, 0x4d, 0xf3, 0x40, 0x14, 0x43, 0xf6, 0x42, 0x9f, 0xbd, 0x50, 0x9e, 0xec, 0x8f, 0x3f,
+	0x2f, 0x3f, 0x0d, 0x13, 0x75, 0xda, 0xa1, 0x5e, 0x9d, 0x7a, 0x35, 0x97, 0x8a, 0xa1, 0x1a, 0xab,
+	0x4c, 0x1f, 0x1f, 0xcd, 0x4d, 0x2c, 0x1b, 0x70, 0x4c, 0x50, 0x91, 0xfb, 0x30, 0xea, 0x77, 0xa3,
+	0x4e, 0x37, 0x0a, 0xe5, 0xe6, 0xf6, 0xd6, 0xc0, 0x17, 0x22, 0xc1, 0x26, 0xee, 0x03, 0x09, 0x40,
+	0x25, 0xc0, 0xfe, 0xbe, 0x05, 0x97, 0x74, 0xd5, 0xab, 0x34, 0xd2, 0xcb, 0xf5, 0x10, 0x40, 0xb7,
+	0x43, 0x59, 0x28, 0x07, 0xde, 0x64, 0x13, 0xbd, 0x1c, 0xaf, 0x62, 0x0d, 0x0e, 0xd1, 0x10, 0x46,
+	0x3e, 0x0f, 0x13, 0x07, 0x7e, 0xab, 0xdb, 0xa6, 0x1b, 0x4c, 0x29, 0x50, 0x13, 0x7c, 0x2e, 0x6b,
+	0x20, 0xee, 0xc6, 0x74, 0x95, 0x4b, 0x92, 0xed, 0x84, 0x01, 0x0c, 0x31, 0xc1, 0xca, 0xfe, 0x3c,
+	0x70, 0xa1, 0xae, 0xd7, 0xa5, 0x5b, 0x1e, 0x79, 0x11, 0x8a, 0x34, 0x08, 0xfc, 0x40, 0x9a, 0x41,
+	0xf4, 0x8c, 0xbf, 0xc5, 0x80, 0x28, 0x70, 0xe4, 0x65, 0x76, 0x6c, 0xbb, 0x2d, 0x5a, 0xe7, 0x13,
+	0xb6, 0x54, 0xb9, 0xa0, 0x26, 0xec, 0x0a, 0x87, 0xa2, 0xc4, 0xda, 0xf3, 0x30, 0xba, 0xc4, 0x84,
+	0xd0, 0x80, 0xf1, 0x35, 0x0d, 0xec, 0x93, 0x09, 0x03, 0xbb, 0x32, 0xa4, 0xef, 0xc0, 0xe5, 0xa5,
+	0x80, 0xb2, 0xfd, 0xe5, 0xf5, 0x4a, 0xb7, 0xb6, 0x4f, 0x23, 0x61, 0x48, 0x0b, 0xc9, 0x9b, 0x30,
+	0xe9, 0xf3, 0xed, 0x6d, 0xdd, 0xaf, 0xed, 0xbb, 0x5e, 0x43, 0x5e, 0x40, 0x2e, 0x4b, 0x2e, 0x93,
+	0x5b, 0x26, 0x12, 0x93, 0xb4, 0xf6, 0x77, 0x0b, 0x30, 0xb1, 0x14, 0xf8, 0x9e, 0x5a, 0xce, 0xe7,
+	0xb0, 0xed, 0xde, 0x4f, 0x6c, 0xbb, 0x03, 0xdb, 0x50, 0xcd, 0x5a, 0xf7, 0xdb, 0x72, 0x49, 0xa0,
+	0x77, 0x8f, 0xa1, 0x7c, 0x4a, 0x7a, 0x42, 0x1a, 0xe7, 0x18, 0x0f, 0x6c, 0x72, 0x47, 0xb1, 0xff,
+	0xa3, 0x05, 0xd3, 0x26, 0xf9, 0x39, 0xec, 0xed, 0x6e, 0x72, 0x6f, 0x5f, 0x3e, 0x8b, 0x56, 0xf6,
+	0xd9, 0xd0, 0xff, 0x6f, 0x31, 0xd9, 0x3a, 0xd6, 0xd9, 0xe4, 0xeb, 0x16, 0x4c, 0x3c, 0x34, 0x00,
+	0xb2, 0x89, 0xcb, 0x79, 0x8f, 0x54, 0x3e, 0xae, 0x3f, 0xa9, 0xd6, 0xa9, 0x09, 0x7d, 0x94, 0xfa,
+	0x8f, 0x09, 0xf9, 0x4c, 0xd3, 0x0e, 0x6b, 0x4d, 0x5a, 0xef, 0xb6, 0xd4, 0xf5, 0x5d, 0x77, 0x5f,
+	0x55, 0xc2, 0x51, 0x53, 0x90, 0x8f, 0x60, 0xa6, 0xe6, 0x7b, 0xb5, 0x6e, 0x10, 0x50, 0xaf, 0x76,
+	0xb8, 0xcd, 0x7d, 0x7d, 0xf2, 0x34, 0x98, 0x97, 0xc5, 0x66, 0x96, 0xd2, 0x04, 0x8f, 0xb2, 0x80,
+	0xd8, 0xcb, 0x48, 0x58, 0xb7, 0x43, 0xb6, 0x5f, 0xf3, 0x4b, 0x49, 0xc9, 0xb4, 0x6e, 0x73, 0x30,
+	0x2a, 0x3c, 0x79, 0x0f, 0xae, 0x86, 0x11, 0x53, 0x22, 0xbd, 0xc6, 0x32, 0x75, 0xea, 0x2d, 0xd7,
+	0x63, 0xf7, 0x5d, 0xdf, 0x93, 0xf7, 0x8d, 0xa1, 0xca, 0x73, 0xc7, 0x47, 0x73, 0x57, 0xab, 0xd9,
+	0x24, 0xd8, 0xaf, 0x2c, 0xb9, 0x07, 0xb3, 0x61, 0xb7, 0x56, 0xa3, 0x61, 0xb8, 0xd7, 0x6d, 0xbd,
+	0xe3, 0xef, 0x86, 0x6b, 0x6e, 0xc8, 0x2e, 0xeb, 0xeb, 0x6e, 0xdb, 0x8d, 0xb8, 0x1d, 0xb0, 0x58,
+	0xb9, 0x76, 0x7c, 0x34, 0x37, 0x5b, 0xed, 0x4b, 0x85, 0x8f, 0xe1, 0x40, 0x10, 0xae, 0x88, 0x4d,
+	0xad, 0x87, 0xf7, 0x28, 0xe7, 0x3d, 0x7b, 0x7c, 0x34, 0x77, 0x65, 0x25, 0x93, 0x02, 0xfb, 0x94,
+	0x64, 0x23, 0x18, 0xb9, 0x6d, 0xfa, 0x89, 0xef, 0x51, 0x6e, 0xe6, 0x33, 0x46, 0x70, 0x47, 0xc2,
+	0x51, 0x53, 0x90, 0xfb, 0xf1, 0xfc, 0x63, 0x4b, 0x43, 0x1a, 0xee, 0x4e, 0xbf, 0x73, 0xf1, 0xfb,
+	0xe6, 0xfb, 0x06, 0x27, 0xb6, 0xbc, 0x30, 0xc1, 0xdb, 0xfe, 0x9d, 0x02, 0x90, 0xde, 0xed, 0x80,
+	0xdc, 0x81, 0x11, 0xa7, 0x16, 0xb9, 0x07, 0x54, 0x1e, 0x7e, 0x2f, 0x66, 0x9d, 0x3f, 0x42, 0x14,
+	0xd2, 0x3d, 0xca, 0x66, 0x08, 0x8d, 0xf7, 0x90, 0x45, 0x5e, 0x14, 0x25, 0x0b, 0xe2, 0xc3, 0x4c,
+	0xcb, 0x09, 0x23, 0x35, 0x57, 0xeb, 0xac, 0xc9, 0x72, 0xc3, 0xfc, 0xe9, 0x93, 0x35, 0x8a, 0x95,
+	0xa8, 0x5c, 0x66, 0x33, 0x77, 0x3d, 0xcd, 0x08, 0x7b, 0x79, 0x93, 0x2e, 0x3f, 0xbe, 0x85, 0x86,
+	0xc4, 0x36, 0xcb, 0x5c, 0x0e, 0x46, 0xad, 0x6b, 0x25, 0x8e, 0x6e, 0xc9, 0x1c, 0x0d, 0x41, 0xf6,
+	0x1f, 0x97, 0x60, 0x74, 0x79, 0x71, 0x75, 0xc7, 0x09, 0xf7, 0x4f, 0xe0, 0xec, 0x63, 0x73, 0x42,
+	0xea, 0x1b, 0xe9, 0x55, 0xad, 0x6f, 0x32, 0x9a, 0x22, 0x79, 0xa1, 0x19, 0x3a, 0x97, 0x0b, 0x0d,
+	0x39, 0x80, 0xf1, 0xc8, 0xb8, 0xd4, 0x0d, 0xe7, 0xf4, 0x70, 0x1b, 0x97, 0x39, 0x6e, 0xd3, 0x37,
+	0x2f, 0x71, 0xa6, 0xa0, 0x1e, 0xc5, 0xb1, 0x78, 0x22, 0xc5, 0xb1, 0x0d, 0x63, 0x0f, 0xdd, 0xa8,
+	0xc9, 0xf7, 0xf7, 0xf2, 0x08, 0x1f, 0xf3, 0xbf, 0x3c, 0x68, 0x5d, 0x19, 0x93, 0xb8, 0x73, 0xde,
+	0x57, 0x6c, 0x31, 0x96, 0x40, 0x16, 0x84, 0x38, 0xee, 0x6b, 0xe6, 0x3b, 0xc3, 0x58, 0xb2, 0x00,
+	0x47, 0x60, 0x4c, 0x43, 0x0e, 0x60, 0x82, 0xfd, 0xa9, 0xd2, 0x07, 0x5d, 0xb6, 0x5a, 0xa4, 0xb9,
+	0x7f, 0x70, 0xe3, 0x80, 0xe4, 0x23, 0xfa, 0xe5, 0x7d, 0x83, 0x33, 0x26, 0xe4, 0xb0, 0x99, 0xf8,
+	0xb0, 0x49, 0x3d, 0xe9, 0x8c, 0xd4, 0x33, 0xf1, 0xfd, 0x26, 0xf5, 0x90, 0x63, 0x48, 0x20, 0xb4,
+	0x5d, 0xa1, 0x17, 0x4a, 0x23, 0x7e, 0x25, 0x8f, 0xb6, 0x2b, 0x38, 0x09, 0x7f, 0x62, 0xfc, 0x1f,
+	0x0d, 0x29, 0x4c, 0xb1, 0xf4, 0xbd, 0x5b, 0x1f, 0xbb, 0x11, 0xf7, 0x5f, 0x8e, 0xc5, 0x7b, 0xc7,
+	0x16, 0x87, 0xa2, 0xc4, 0x0a, 0x53, 0x34, 0x1b, 0xe5, 0x90, 0xfb, 0x1d, 0xc7, 0x4c, 0x53, 0x34,
+	0x07, 0xa3, 0xc2, 0x13, 0x1f, 0x8a, 0x4d, 0xdf, 0xdf, 0x0f, 0xcb, 0x93, 0x7c, 0xf0, 0x07, 0xd6,
+	0x8e, 0xe4, 0x12, 0x9e, 0x5f, 0x63, 0xcc, 0x6e, 0x79, 0x51, 0x70, 0x18, 0x6b, 0x0f, 0x1c, 0x86,
+	0x42, 0xce, 0xec, 0x2f, 0x01, 0xc4, 0x34, 0x64, 0x5a, 0xf8, 0x14, 0xf8, 0x82, 0xe7, 0x6e, 0x04,
+	0xf2, 0xa1, 0xd2, 0x84, 0x0b, 0xf9, 0xac, 0x34, 0xeb, 0xee, 0x1e, 0xad, 0x1d, 0xd6, 0x5a, 0x94,
+	0x49, 0x93, 0x1a, 0xf4, 0x67, 0x0b, 0x6f, 0x58, 0xf6, 0x6f, 0x5b, 0x30, 0xce, 0x6a, 0xab, 0x36,
+	0x89, 0x97, 0x61, 0x24, 0x72, 0x82, 0x06, 0x55, 0xe6, 0x4f, 0xdd, 0xa9, 0x3b, 0x1c, 0x8a, 0x12,
+	0x4b, 0xea, 0x50, 0x8c, 0x9c, 0x70, 0x5f, 0x69, 0x58, 0x6f, 0xe5, 0xec, 0xa9, 0xb8, 0x7b, 0xd8,
+	0xbf, 0x10, 0x05, 0x73, 0xf2, 0x0a, 0x94, 0xd8, 0x71, 0xb8, 0xe2, 0x84, 0xca, 0x8d, 0xc0, 0x0d,
+	0x4c, 0x2b, 0x12, 0x86, 0x1a, 0x6b, 0x7f, 0xc3, 0x02, 0x58, 0x76, 0x22, 0xa7, 0xea, 0x77, 0x83,
+	0x1a, 0x25, 0x5f, 0xb2, 0x60, 0x52, 0x19, 0x9f, 0xb6, 0x9d, 0xa8, 0x19, 0x4a, 0x0d, 0xec, 0xec,
+	0x22, 0x14, 0x66, 0xd8, 0x55, 0x62, 0xd1, 0x14, 0x81, 0x49, 0x89, 0xf6, 0x57, 0x0a, 0x30, 0xc1,
+	0xaa, 0xa4, 0xfb, 0xf6, 0x3e, 0x8c, 0x84, 0xbc, 0x7a, 0xb2, 0x32, 0x03, 0x2f, 0x90, 0xb8, 0xa1,
+	0x86, 0xd2, 0xcd, 0xff, 0xa3, 0x94, 0x40, 0xbe, 0x66, 0xc1, 0x85, 0x28, 0x70, 0xbc, 0x70, 0xcf,
+	0x0f, 0xda, 0xc2, 0xb4, 0x59, 0xc8, 0x37, 0xa7, 0x77, 0x12, 0xdc, 0xaa, 0x11, 0xed, 0x54, 0xae,
+	0x48, 0xe1, 0x17, 0x92, 0x38, 0x4c, 0x49, 0xb6, 0x3f, 0x03, 0xc5, 0x5b, 0x07, 0xd4, 0xe3, 0x4a,
+	0x4c, 0x28, 0x4d, 0x9f, 0x69, 0x83, 0xaf, 0x32, 0x89, 0xa2, 0xa6, 0xb0, 0xff, 0xb7, 0x05, 0x97,
+	0x79, 0x39, 0xad, 0x59, 0x48, 0xcc, 0x09, 0x8e, 0xc6, 0x2f, 0x59, 0x30, 0xd2, 0x72, 0x76, 0x69,
+	0x4b, 0xcd, 0xd0, 0xcf, 0x0f, 0xda, 0xee, 0xcc, 0x1a, 0xcc, 0xaf, 0x73, 0xde, 0x62, 0x69, 0xeb,
+	0x31, 0x10, 0x40, 0x94, 0x82, 0x67, 0xff, 0x12, 0x8c, 0x1b, 0x64, 0x19, 0xab, 0xfb, 0x92, 0xb9,
+	0xba, 0xc7, 0xcc, 0x65, 0xf9, 0x11, 0x5c, 0xb8, 0xf5, 0x31, 0xad, 0x75, 0x23, 0x3f, 0x10, 0xd6,
+	0x61, 0xf2, 0x0e, 0x90, 0x90, 0x06, 0x07, 0x6e, 0x8d, 0x4a, 0xf3, 0xff, 0x66, 0xdc, 0x01, 0xda,
+	0x3d, 0x52, 0xed, 0xa1, 0xc0, 0x8c, 0x52, 0xf6, 0xaf, 0x5b, 0x30, 0x6e, 0xf8, 0x62, 0x99, 0x66,
+	0xd0, 0x58, 0xaa, 0x8a, 0x5b, 0xb4, 0x9c, 0x9b, 0x8b, 0x39, 0x7c, 0xbc, 0x82, 0x51, 0x7c, 0x96,
+	0x69, 0x10, 0xc6, 0x62, 0x9e, 0xe0, 0x3f, 0xb5, 0xff, 0x85, 0x05, 0x71, 0x39, 0xb6, 0x2b, 0xed,
+	0xc6, 0xb5, 0x33, 0x76, 0x25, 0xc9, 0x57, 0x62, 0xc9, 0x2f, 0xc0, 0xd5, 0x64, 0x73, 0x63, 0x37,
+	0xcb, 0xa9, 0xdc, 0x61, 0xe2, 0x5a, 0x91, 0xcd, 0x09, 0xfb, 0x89, 0xb0, 0xef, 0x42, 0x71, 0xd5,
+	0xe9, 0x36, 0xe8, 0x89, 0xec, 0x17, 0x6c, 0x6f, 0x0b, 0xa8, 0xd3, 0x8a, 0x94, 0x26, 0x2b, 0xf7,
+	0x36, 0x94, 0x30, 0xd4, 0x58, 0xfb, 0x37, 0x87, 0x61, 0xdc, 0x08, 0xd1, 0x60, 0xb3, 0x3f, 0xa0,
+	0x1d, 0x3f, 0x3d, 0xfb, 0x91, 0x76, 0x7c, 0xe4, 0x18, 0xb6, 0xce, 0x02, 0x7a, 0xe0, 0x86, 0x62,
+	0xd9, 0x27, 0xd6, 0x19, 0x4a, 0x38, 0x6a, 0x0a, 0x32, 0x07, 0xc5, 0x3a, 0xed, 0x44, 0x4d, 0xbe,
+	0xc5, 0x0e, 0x0b, 0xaf, 0xf9, 0x32, 0x03, 0xa0, 0x80, 0x33, 0x82, 0x3d, 0x1a, 0xd5, 0x9a, 0xe5,
+	0x61, 0xae, 0x46, 0x71, 0x82, 0x15, 0x06, 0x40, 0x01, 0xcf, 0x70, 0x70, 0x16, 0x9f, 0xbe, 0x83,
+	0x73, 0xe4, 0x8c, 0x1d, 0x9c, 0xa4, 0x03, 0x17, 0xc3, 0xb0, 0xb9, 0x1d, 0xb8, 0x07, 0x4e, 0x44,
+	0xe3, 0x99, 0x33, 0x7a, 0x1a, 0x39, 0xdc, 0xc7, 0x50, 0xad, 0xae, 0xa5, 0xb9, 0x60, 0x16, 0x6b,
+	0x52, 0x85, 0xcb, 0xae, 0x17, 0xd2, 0x5a, 0x37, 0xa0, 0xb7, 0x1b, 0x9e, 0x1f, 0xd0, 0x35, 0x3f,
+	0x64, 0xec, 0x64, 0x8c, 0x98, 0xf6, 0xd5, 0xdf, 0xce, 0x22, 0xc2, 0xec, 0xb2, 0xf6, 0xbf, 0xb5,
+	0x60, 0xc2, 0x0c, 0x46, 0x21, 0x07, 0x00, 0xcd, 0xe5, 0x95, 0xaa, 0xd8, 0x48, 0xf2, 0x9e, 0x3d,
+	0x6b, 0x9a, 0x53, 0x7c, 0x99, 0x89, 0x61, 0x68, 0x48, 0x3a, 0x41, 0x2c, 0xe2, 0x8b, 0x50, 0xdc,
+	0xf3, 0xd9, 0x81, 0x38, 0x94, 0x34, 0x20, 0xae, 0x30, 0x20, 0x0a, 0x9c, 0xfd, 0x7d, 0x0b, 0x0c,
+	0x09, 0xe4, 0xcb, 0x16, 0x4c, 0x32, 0x21, 0x77, 0x82, 0xdd, 0x44, 0x8b, 0x6e, 0xe5, 0x69, 0x91,
+	0x66, 0x16, 0x9b, 0x09, 0x13, 0x60, 0x4c, 0x8a, 0x24, 0x7f, 0x01, 0xc6, 0x9c, 0x7a, 0x3d, 0xa0,
+	0x61, 0xa8, 0xad, 0xd2, 0xdc, 0x95, 0xb7, 0xa8, 0x80, 0x18, 0xe3, 0xd9, 0x6a, 0x6c, 0xd6, 0xf7,
+	0x42, 0x36, 0xc1, 0xa5, 0x15, 0x45, 0xaf, 0x46, 0x26, 0x84, 0xc1, 0x51, 0x53, 0xd8, 0x7f, 0x73,
+	0x18, 0x92, 0xb2, 0x49, 0x1d, 0xa6, 0xf6, 0x83, 0xdd, 0x25, 0xee, 0x61, 0x1b, 0x24, 0x7e, 0xe0,
+	0xe2, 0xf1, 0xd1, 0xdc, 0xd4, 0x9d, 0x24, 0x07, 0x4c, 0xb3, 0x94, 0x52, 0xee, 0xd0, 0xc3, 0xc8,
+	0xd9, 0x1d, 0x64, 0xcf, 0x54, 0x52, 0x4c, 0x0e, 0x98, 0x66, 0x49, 0x3e, 0x03, 0xe3, 0xfb, 0xc1,
+	0xae, 0x5a, 0xeb, 0x69, 0x77, 0xeb, 0x9d, 0x18, 0x85, 0x26, 0x1d, 0xeb, 0xc2, 0xfd, 0x60, 0x97,
+	0xed, 0x8d, 0x2a, 0x34, 0x55, 0x77, 0xe1, 0x1d, 0x09, 0x47, 0x4d, 0x41, 0x3a, 0x40, 0xf6, 0x55,
+	0xef, 0x69, 0xe7, 0xaa, 0xdc, 0x92, 0x4e, 0xee, 0x9b, 0xbd, 0xc2, 0x4e, 0xd4, 0x3b, 0x3d, 0x7c,
+	0x30, 0x83, 0x37, 0xf9, 0x3c, 0x5c, 0xdd, 0x0f, 0x76, 0xe5, 0x89, 0xb1, 0x1d, 0xb8, 0x5e, 0xcd,
+	0xed, 0x24, 0x02, 0x52, 0xe7, 0x64, 0x75, 0xaf, 0xde, 0xc9, 0x26, 0xc3, 0x7e, 0xe5, 0xed, 0x5f,
+	0x63, 0xcb, 0xd9, 0x88, 0x9f, 0x7b, 0x52, 0x34, 0x8c, 0x0b, 0xa3, 0x4d, 0xea, 0xd4, 0x69, 0xa0,
+	0x34, 0x9f, 0xcf, 0x0d, 0xbc, 0x30, 0x38, 0x9b, 0xf8, 0xba, 0x24, 0xfe, 0x87, 0xa8, 0xf8, 0xdb,
+	0xbf, 0xcb, 0x56, 0xe6, 0xce, 0xce, 0xb6, 0x40, 0x9c, 0x40, 0x2b, 0x7b, 0x31, 0xa1, 0xf0, 0xf4,
+	0x39, 0x18, 0xbb, 0x30, 0xc6, 0x7f, 0xac, 0x04, 0x7e, 0x5b, 0xda, 0x29, 0xd6, 0xf2, 0x04, 0x1e,
+	0x8a, 0xda, 0x49, 0x7d, 0x99, 0xaf, 0xd2, 0xbb, 0x8a, 0x3d, 0xc6, 0x92, 0x6c, 0x1f, 0xa6, 0xd3,
+	0xd4, 0xe4, 0x43, 0x98, 0x08, 0xd5, 0x44, 0x8f, 0x9d, 0xd2, 0x27, 0x5c, 0x10, 0xfc, 0x56, 0x5d,
+	0x35, 0x8a, 0x63, 0x82, 0x99, 0xfd, 0x5f, 0x0b, 0x62, 0x60, 0xcd, 0xbb, 0x57, 0x9b, 0x46, 0x4d,
+	0xbf, 0x9e, 0xd6, 0x72, 0x36, 0x38, 0x14, 0x25, 0x56, 0x4d, 0x80, 0x42, 0x9f, 0x09, 0xd0, 0x8e,
+	0x27, 0x80, 0xb0, 0x5b, 0x55, 0xf2, 0xf7, 0x5e, 0xff, 0x49, 0xc0, 0x63, 0x6d, 0xfc, 0xfa, 0x61,
+	0x3a, 0x62, 0xbc, 0xe2, 0xd7, 0x0f, 0x91, 0x63, 0xc8, 0x67, 0xe1, 0x02, 0xd3, 0x63, 0xfc, 0x6e,
+	0x94, 0x34, 0xde, 0xf2, 0x33, 0x79, 0x27, 0x81, 0xc1, 0x14, 0x25, 0x59, 0x86, 0x69, 0x69, 0x68,
+	0xd5, 0x16, 0x33, 0xb9, 0xa2, 0x74, 0x88, 0x78, 0x35, 0x85, 0xc7, 0x9e, 0x12, 0xf6, 0x16, 0x8c,
+	0x9c, 0xe9, 0x1c, 0xb5, 0xbf, 0x69, 0xc1, 0x18, 0x37, 0xcf, 0x36, 0x02, 0xa7, 0x1d, 0x17, 0x19,
+	0x7a, 0xcc, 0xb4, 0x76, 0x61, 0x54, 0x68, 0xa9, 0x21, 0x57, 0xa3, 0x72, 0xac, 0x4b, 0xf1, 0x0c,
+	0x25, 0x1e, 0x12, 0xa1, 0x04, 0x87, 0xa8, 0xf8, 0xdb, 0x3f, 0xb0, 0x60, 0xe4, 0xb6, 0xd7, 0xe9,
+	0xfe, 0xb9, 0x7a, 0x28, 0xb1, 0x01, 0xc3, 0xb7, 0x23, 0xda, 0x4e, 0xbe, 0xce, 0x99, 0xa8, 0xbc,
+	0x64, 0xbe, 0xcc, 0x29, 0x27, 0x5f, 0xe6, 0xa0, 0xf3, 0x50, 0x39, 0xb5, 0xe5, 0xdd, 0x2b, 0x8e,
+	0x45, 0xfc, 0x2f, 0x16, 0x4c, 0x26, 0xac, 0x26, 0x09, 0x6b, 0xab, 0x75, 0x3a, 0x6b, 0xeb, 0xf9,
+	0x84, 0x8f, 0x90, 0x9b, 0x00, 0xf4, 0xe3, 0x0e, 0xd3, 0x3a, 0xe2, 0x50, 0x26, 0x3d, 0x52, 0xb7,
+	0x34, 0x06, 0x0d, 0x2a, 0xbb, 0x05, 0xc3, 0xeb, 0xae, 0xb7, 0x7f, 0xb2, 0x85, 0x11, 0xd6, 0xfc,
+	0x4e, 0xcf, 0xc2, 0xa8, 0x32, 0x20, 0x0a, 0x9c, 0xda, 0x9b, 0x86, 0xb2, 0xf7, 0x26, 0xfb, 0xdf,
+	0x58, 0x30, 0xb3, 0x41, 0xdb, 0xbe, 0xfb, 0x89, 0x13, 0xc7, 0x1e, 0xb0, 0x42, 0x4d, 0x37, 0x92,
+	0x5e, 0x64, 0x5d, 0x68, 0xcd, 0x8d, 0x90, 0xc1, 0x9f, 0x70, 0x55, 0xe4, 0x51, 0x78, 0x4c, 0x8f,
+	0xd9, 0x8c, 0x15, 0x8a, 0x38, 0xaa, 0x40, 0x21, 0x30, 0xa6, 0x21, 0xab, 0xb2, 0xc0, 0xce, 0x61,
+	0x87, 0xca, 0x6d, 0xeb, 0x46, 0xa2, 0x80, 0x8c, 0xbf, 0xb8, 0x64, 0xd4, 0x54, 0xc3, 0x31, 0x2e,
+	0x6b, 0xff, 0x73, 0x0b, 0x46, 0x05, 0x0d, 0x55, 0x95, 0xb4, 0xfa, 0x54, 0xf2, 0x1e, 0x14, 0x79,
+	0x39, 0x39, 0x15, 0x7e, 0x6e, 0x60, 0xdb, 0x28, 0x0f, 0xb3, 0xe2, 0x37, 0x30, 0xfe, 0x13, 0x05,
+	0x5b, 0x7e, 0x76, 0x38, 0x1f, 0x2f, 0xea, 0xa8, 0x8d, 0xf8, 0xec, 0xe0, 0x50, 0x94, 0x58, 0xfb,
+	0x6f, 0x0c, 0x41, 0x49, 0xf9, 0x71, 0xc8, 0x57, 0x2d, 0x18, 0x77, 0x3c, 0xcf, 0x8f, 0x1c, 0xe1,
+	0xe6, 0x10, 0xdb, 0xc3, 0xbb, 0x83, 0xd6, 0x4d, 0xf1, 0x9d, 0x5f, 0x8c, 0x79, 0x0a, 0x0b, 0x89,
+	0x56, 0xf0, 0x0c, 0x0c, 0x9a, 0xa2, 0x49, 0x94, 0x32, 0xd7, 0xac, 0xe7, 0xae, 0xc4, 0x49, 0x2c,
+	0x34, 0x9f, 0x83, 0xe9, 0x74, 0x5d, 0x4f, 0x63, 0xa6, 0xc9, 0x63, 0xe1, 0x79, 0x17, 0xc6, 0x37,
+	0x68, 0x14, 0xb8, 0x35, 0xce, 0xe0, 0x49, 0xd3, 0xe7, 0x44, 0x87, 0xd2, 0x2f, 0xb2, 0xd9, 0xc8,
+	0x58, 0x86, 0x24, 0x00, 0xe8, 0x04, 0x3e, 0xd3, 0x17, 0x68, 0x57, 0x8d, 0xeb, 0xc0, 0x6a, 0xc0,
+	0xb6, 0xe6, 0x24, 0xec, 0xf1, 0xf1, 0x7f, 0x34, 0xa4, 0xd8, 0x37, 0xa0, 0xb8, 0xd1, 0x8d, 0xe8,
+	0xc7, 0x27, 0x78, 0xac, 0xf3, 0x21, 0x4c, 0x70, 0xd2, 0x35, 0xbf, 0xc5, 0x76, 0x63, 0xd6, 0xbc,
+	0x36, 0xfb, 0x9f, 0x36, 0x98, 0x70, 0x22, 0x14, 0x38, 0x36, 0xc5, 0x9b, 0x7e, 0xab, 0xae, 0x43,
+	0x6d, 0xf5, 0xa0, 0xae, 0x71, 0x28, 0x4a, 0xac, 0xfd, 0xdf, 0x2d, 0x18, 0xe7, 0x05, 0xe5, 0xee,
+	0xe2, 0xc3, 0x68, 0x53, 0xc8, 0x91, 0x1d, 0x31, 0xb0, 0x1b, 0xde, 0xac, 0xb3, 0xa1, 0x11, 0x09,
+	0x00, 0x2a, 0x29, 0x4c, 0xe0, 0x43, 0xc7, 0x8d, 0x98, 0xc0, 0xc2, 0xd3, 0x10, 0xf8, 0xbe, 0x60,
+	0x8e, 0x4a, 0x8a, 0xfd, 0xed, 0x69, 0x80, 0x4d, 0xbf, 0x4e, 0x65, 0x83, 0x67, 0xa1, 0xe0, 0x2a,
+	0x1d, 0x12, 0x64, 0xa1, 0xc2, 0xed, 0x65, 0x2c, 0xb8, 0x75, 0x3d, 0x36, 0x85, 0xbe, 0xdb, 0xfc,
+	0x67, 0x60, 0xbc, 0xee, 0x86, 0x9d, 0x96, 0x73, 0xb8, 0x99, 0x71, 0x43, 0x5b, 0x8e, 0x51, 0x68,
+	0xd2, 0x91, 0x57, 0x65, 0x28, 0xdb, 0x70, 0x42, 0x39, 0x53, 0xa1, 0x6c, 0x25, 0x56, 0x3d, 0x23,
+	0x8a, 0xed, 0x0d, 0x98, 0x50, 0x27, 0x25, 0x97, 0x52, 0xe4, 0xa5, 0x74, 0x04, 0xd2, 0x8e, 0x81,
+	0xc3, 0x04, 0x65, 0xda, 0xa3, 0x38, 0x72, 0x5e, 0x1e, 0x45, 0xa6, 0x88, 0x46, 0x7e, 0x40, 0xeb,
+	0x8a, 0xe2, 0xf6, 0x72, 0x99, 0xa4, 0x14, 0xd1, 0x14, 0x1e, 0x7b, 0x4a, 0x90, 0x6d, 0xb8, 0x94,
+	0x8e, 0x35, 0xe5, 0xed, 0xbf, 0xc8, 0x39, 0x3d, 0x2f, 0x39, 0x5d, 0x7a, 0x3f, 0x83, 0x06, 0x33,
+	0x4b, 0x92, 0x37, 0x61, 0x52, 0x55, 0x93, 0x1f, 0xc4, 0xe5, 0x4b, 0x9c, 0x95, 0x36, 0x63, 0xec,
+	0x98, 0x48, 0x4c, 0xd2, 0x92, 0x4f, 0x41, 0xb1, 0xd3, 0x74, 0x42, 0x2a, 0xbd, 0x8f, 0xca, 0x8e,
+	0x5c, 0xdc, 0x66, 0xc0, 0x47, 0x47, 0x73, 0x63, 0x6c, 0xd8, 0xf8, 0x1f, 0x14, 0x84, 0x4c, 0xc5,
+	0xd8, 0xf5, 0xbb, 0x5e, 0xdd, 0x09, 0x0e, 0x6f, 0x2f, 0xcb, 0x40, 0x04, 0xad, 0x62, 0x54, 0x34,
+	0x06, 0x0d, 0x2a, 0x33, 0xa4, 0x70, 0xec, 0xf1, 0x21, 0x85, 0xe4, 0x43, 0x18, 0xe3, 0x41, 0x1b,
+	0xb4, 0xbe, 0x18, 0x49, 0x37, 0xe2, 0x69, 0xfc, 0xfb, 0xfa, 0xdc, 0xaf, 0x2a, 0x26, 0x18, 0xf3,
+	0x23, 0xf7, 0x00, 0xf6, 0x5c, 0xcf, 0x0d, 0x9b, 0x9c, 0xfb, 0xf8, 0xa9, 0xb9, 0xeb, 0x76, 0xae,
+	0x68, 0x2e, 0x68, 0x70, 0x24, 0x1f, 0xc1, 0x0c, 0x0d, 0x23, 0xb7, 0xed, 0x44, 0xb4, 0xae, 0x03,
+	0xca, 0xcb, 0xfc, 0xaa, 0xa3, 0xc3, 0x66, 0x6e, 0xa5, 0x09, 0x1e, 0x65, 0x01, 0xb1, 0x97, 0x11,
+	0x79, 0x03, 0x4a, 0x9d, 0xc0, 0x6f, 0x30, 0xbd, 0xad, 0x3c, 0x9b, 0x98, 0x2e, 0xa5, 0x6d, 0x09,
+	0x7f, 0x64, 0xfc, 0x46, 0x4d, 0x4d, 0xfe, 0xd8, 0x82, 0x99, 0x80, 0x0a, 0xc7, 0x50, 0xa8, 0x2b,
+	0x76, 0x39, 0x9f, 0x5b, 0x24, 0xde, 0x6f, 0xe6, 0x31, 0xcd, 0x5b, 0x1c, 0xba, 0x54, 0xb5, 0xb9,
+	0x07, 0xff, 0x28, 0x0b, 0xf8, 0xe5, 0xdf, 0x9f, 0x9b, 0xeb, 0xcd, 0x42, 0xa0, 0x99, 0xb3, 0xc9,
+	0xfe, 0xb5, 0xdf, 0x9f, 0x9b, 0x56, 0xff, 0xe3, 0xae, 0xea, 0x69, 0x1a, 0x3b, 0x4e, 0x3a, 0x7e,
+	0xfd, 0xf6, 0xb6, 0xf4, 0xf7, 0xea, 0xe3, 0x64, 0x9b, 0x01, 0x51, 0xe0, 0xc8, 0x2b, 0x50, 0xaa,
+	0x3b, 0xb4, 0xed, 0x7b, 0xb4, 0x5e, 0x9e, 0x8c, 0xed, 0xef, 0xcb, 0x12, 0x86, 0x1a, 0x4b, 0x76,
+	0x61, 0xc4, 0xe5, 0xb7, 0xa9, 0xf2, 0x05, 0x3e, 0x67, 0x06, 0xbe, 0xb8, 0x89, 0x3b, 0x99, 0x78,
+	0x86, 0x20, 0x7e, 0xa3, 0xe4, 0x4c, 0xf6, 0xe2, 0x98, 0xd5, 0xa9, 0xb3, 0x89, 0x59, 0x1d, 0xcf,
+	0x8a, 0x57, 0x65, 0xad, 0xae, 0x35, 0xdd, 0x56, 0x3d, 0xa0, 0x5e, 0x79, 0x9a, 0xdb, 0x2d, 0x79,
+	0xab, 0x97, 0x24, 0x0c, 0x35, 0x96, 0xfc, 0x45, 0x98, 0xf4, 0xbb, 0x11, 0x5f, 0xc6, 0x6c, 0xac,
+	0xc3, 0xf2, 0x0c, 0x27, 0xe7, 0x7e, 0xcf, 0x2d, 0x13, 0x81, 0x49, 0x3a, 0xb6, 0xb7, 0x37, 0xfd,
+	0x30, 0x62, 0x7f, 0xf8, 0xde, 0x76, 0x25, 0xb9, 0xb7, 0xaf, 0x19, 0x38, 0x4c, 0x50, 0x92, 0xaf,
+	0x5b, 0x30, 0xd3, 0x4e, 0xdf, 0x0e, 0xca, 0x57, 0x79, 0x7f, 0xdc, 0x1e, 0x5c, 0x21, 0x4c, 0x31,
+	0x14, 0x51, 0x40, 0x3d, 0x60, 0xec, 0x15, 0xcd, 0xdf, 0xe6, 0x85, 0x87, 0x5e, 0xad, 0x19, 0xf8,
+	0x5e, 0xb2, 0x52, 0xcf, 0xf2, 0x4a, 0xbd, 0x9b, 0x6b, 0xf5, 0x64, 0x31, 0xae, 0x3c, 0x7b, 0x7c,
+	0x34, 0x77, 0x39, 0x13, 0x85, 0xd9, 0x55, 0x99, 0x5d, 0x86, 0x2b, 0xd9, 0x2b, 0xf0, 0x49, 0xfa,
+	0xe8, 0x90, 0xa9, 0x8f, 0xae, 0xc0, 0xb3, 0x7d, 0x2b, 0xc5, 0x76, 0x70, 0xa5, 0xd1, 0x58, 0xc9,
+	0x1d, 0xbc, 0x47, 0x17, 0xb9, 0x00, 0x13, 0x66, 0x9e, 0x08, 0xee, 0x6b, 0x34, 0xde, 0x95, 0xb2,
+	0x7b, 0xb1, 0x5f, 0x3d, 0x23, 0x5f, 0xe3, 0x56, 0xb5, 0xc7, 0xd7, 0xa8, 0x41, 0x18, 0x8b, 0x79,
+	0x92, 0xaf, 0xf1, 0x9f, 0x15, 0x20, 0x2e, 0x77, 0xca, 0x27, 0x60, 0xb1, 0x67, 0xb2, 0xf0, 0x58,
+	0xcf, 0x64, 0x1d, 0xa6, 0x1c, 0x6e, 0x94, 0x1a, 0xf0, 0xe1, 0x17, 0xb7, 0xae, 0x2f, 0x26, 0x39,
+	0x60, 0x9a, 0x25, 0x93, 0x12, 0xc6, 0x45, 0x4f, 0xff, 0xee, 0x8b, 0x4b, 0xa9, 0x26, 0x39, 0x60,
+	0x9a, 0xa5, 0xfd, 0xed, 0x02, 0xa8, 0x8d, 0xe5, 0xcf, 0x8f, 0x7d, 0x89, 0xd8, 0x30, 0x12, 0xd0,
+	0x50, 0x3d, 0x68, 0x1d, 0x13, 0xbb, 0x38, 0x72, 0x08, 0x4a, 0x0c, 0xdb, 0x5d, 0xe9, 0xc7, 0x6e,
+	0xb4, 0xe4, 0xd7, 0x95, 0x22, 0xcc, 0x77, 0xd7, 0x5b, 0x12, 0x86, 0x1a, 0x6b, 0x7f, 0x02, 0x93,
+	0xac, 0x69, 0xad, 0x16, 0x6d, 0x55, 0x23, 0xda, 0x09, 0x89, 0x0b, 0xc5, 0x90, 0xfd, 0xc8, 0x7b,
+	0x47, 0x89, 0xa3, 0x30, 0x69, 0xc7, 0xb0, 0xd1, 0x30, 0xd6, 0x28, 0x24, 0xd8, 0x47, 0x05, 0x18,
+	0xd3, 0xfd, 0x7a, 0x02, 0xc3, 0xcf, 0xcd, 0xf8, 0x2d, 0xaf, 0x98, 0xe4, 0x65, 0xe3, 0x1d, 0x2f,
+	0xd3, 0x12, 0x17, 0xbd, 0x43, 0xf1, 0xc2, 0x4e, 0x3f, 0xea, 0x25, 0xaf, 0x26, 0x4d, 0xa2, 0x57,
+	0x4c, 0x2b, 0x9c, 0x41, 0x2f, 0x6d, 0xa3, 0x9e, 0x69, 0xf2, 0x1f, 0xce, 0xb7, 0x29, 0x68, 0xe3,
+	0x7e, 0x7f, 0x5b, 0x7f, 0x2a, 0x65, 0x48, 0xf1, 0x44, 0x29, 0x43, 0x6e, 0xc0, 0x30, 0xf5, 0xba,
+	0x6d, 0x1e, 0x17, 0x38, 0xc6, 0xcf, 0x90, 0xe1, 0x5b, 0x5e, 0xb7, 0x9d, 0x6c, 0x0f, 0x27, 0xb1,
+	0x57, 0x80, 0xa9, 0x1a, 0xab, 0x4b, 0xe4, 0xe7, 0x7a, 0xd2, 0x50, 0xfc, 0x44, 0x46, 0x1a, 0x8a,
+	0x49, 0x4e, 0x9c, 0x91, 0x81, 0xe2, 0x6f, 0x0f, 0x83, 0x71, 0xd9, 0x3e, 0xc1, 0x48, 0x35, 0x52,
+	0x56, 0x94, 0xa5, 0x1c, 0x56, 0x14, 0x65, 0x9a, 0x10, 0x13, 0x3d, 0x69, 0x38, 0x61, 0x55, 0x69,
+	0xd2, 0x56, 0x47, 0x8e, 0xae, 0xae, 0xca, 0x1a, 0x6d, 0x75, 0x90, 0x63, 0x74, 0xcc, 0xe0, 0x70,
+	0xdf, 0x98, 0xc1, 0x7b, 0x50, 0x6c, 0x38, 0xdd, 0x06, 0x95, 0x8e, 0xb9, 0x81, 0x4d, 0x62, 0x3c,
+	0xe6, 0x42, 0x98, 0xc4, 0xf8, 0x4f, 0x14, 0x6c, 0xd9, 0xa4, 0x6a, 0x2a, 0x13, 0xbd, 0xbc, 0x27,
+	0x0e, 0x3c, 0xa9, 0xb4, 0xad, 0x5f, 0x4c, 0x2a, 0xfd, 0x17, 0x63, 0x11, 0x4c, 0x85, 0xab, 0x89,
+	0x07, 0x2c, 0x32, 0x64, 0xe0, 0xad, 0xc1, 0x03, 0x20, 0x39, 0x1b, 0xa1, 0xc2, 0xc9, 0x3f, 0xa8,
+	0x98, 0xdb, 0x0b, 0x30, 0x6e, 0x24, 0x82, 0x60, 0x1d, 0xad, 0x5f, 0x51, 0x18, 0x1d, 0xbd, 0xec,
+	0x44, 0x0e, 0x72, 0x8c, 0xfd, 0xcd, 0x21, 0xd0, 0x6a, 0xb3, 0xe9, 0x6c, 0x72, 0x6a, 0xc6, 0xcb,
+	0xdb, 0x44, 0xe4, 0xb5, 0xef, 0xa1, 0xc4, 0xb2, 0xfb, 0x65, 0x9b, 0x06, 0x0d, 0x7d, 0xa0, 0xcb,
+	0x2d, 0x40, 0xdf, 0x2f, 0x37, 0x4c, 0x24, 0x26, 0x69, 0xd9, 0x59, 0xda, 0x76, 0x3c, 0x77, 0x8f,
+	0x86, 0x51, 0xda, 0xf3, 0xbd, 0x21, 0xe1, 0xa8, 0x29, 0xc8, 0x2a, 0xcc, 0x84, 0x34, 0xda, 0x7a,
+	0xe8, 0xd1, 0x40, 0x47, 0x84, 0xcb, 0x27, 0x02, 0xcf, 0xaa, 0xbb, 0x44, 0x35, 0x4d, 0x80, 0xbd,
+	0x65, 0x32, 0x9d, 0x46, 0xc5, 0xd3, 0x3a, 0x8d, 0x18, 0x97, 0x3d, 0xc7, 0x6d, 0x75, 0x03, 0xda,
+	0xd7, 0xf5, 0xb4, 0x92, 0xc2, 0x63, 0x4f, 0x09, 0x1e, 0x3b, 0xd3, 0x72, 0x1a, 0x61, 0x79, 0xd4,
+	0x88, 0x9d, 0x61, 0x00, 0x14, 0x70, 0xfb, 0x7b, 0x05, 0x98, 0x60, 0xc7, 0x48, 0x9b, 0x8a, 0x9e,
+	0x27, 0x5f, 0x80, 0x31, 0x35, 0x25, 0xc2, 0xbc, 0x39, 0x20, 0x32, 0x83, 0xd7, 0x8c, 0x28, 0x63,
+	0x25, 0x07, 0x63, 0x91, 0x6c, 0x18, 0x3c, 0xbf, 0x4e, 0x57, 0x5c, 0xda, 0xaa, 0xab, 0x22, 0x72,
+	0xd4, 0xf5, 0x30, 0x6c, 0xa6, 0x09, 0xb0, 0xb7, 0x0c, 0xf9, 0x8a, 0x05, 0xd3, 0xe2, 0xde, 0x11,
+	0x9f, 0xe2, 0x79, 0x43, 0xe9, 0x63, 0x15, 0x41, 0x0f, 0xc2, 0x56, 0x4a, 0x04, 0xf6, 0x08, 0xb5,
+	0xff, 0x91, 0x05, 0x93, 0x48, 0xa3, 0xe0, 0x70, 0x71, 0x8f, 0xdd, 0xd7, 0xa3, 0x43, 0xf2, 0xab,
+	0x16, 0x4c, 0xb3, 0x1a, 0x2f, 0x7a, 0x91, 0xab, 0x80, 0x79, 0x3b, 0x9b, 0x4b, 0xd8, 0x4c, 0x31,
+	0x15, 0x4f, 0x28, 0xd2, 0x50, 0xec, 0x11, 0x6e, 0x5f, 0x85, 0xcb, 0x99, 0x0c, 0xec, 0x7f, 0x3d,
+	0x24, 0x2b, 0xaf, 0x97, 0xd5, 0xbb, 0x50, 0x6c, 0xf1, 0xe7, 0x24, 0xd6, 0x80, 0x0f, 0xe1, 0xf9,
+	0x2c, 0x14, 0xef, 0x4d, 0x04, 0x27, 0xb2, 0x0c, 0xe3, 0x01, 0x93, 0x21, 0x1f, 0xfb, 0x88, 0xe1,
+	0xb6, 0xe3, 0x3c, 0x52, 0x1a, 0xf5, 0x28, 0xf9, 0x17, 0xcd, 0x62, 0xe4, 0x01, 0x8c, 0xee, 0x8a,
+	0xb7, 0xfd, 0x79, 0x5f, 0x5e, 0xca, 0x14, 0x01, 0x5c, 0x71, 0x50, 0xf9, 0x02, 0x1e, 0xc5, 0x3f,
+	0x51, 0xc9, 0xe1, 0x4f, 0xd9, 0xd5, 0xf8, 0x0d, 0xe7, 0x0b, 0x04, 0x4a, 0xcc, 0x10, 0xf9, 0x94,
+	0x5d, 0x8d, 0x97, 0x16, 0x92, 0x72, 0xb2, 0x15, 0x4f, 0xe4, 0x64, 0xfb, 0xa6, 0x05, 0x10, 0x67,
+	0x70, 0x22, 0x1e, 0x94, 0xc2, 0xd7, 0x13, 0x57, 0xa0, 0xc1, 0x63, 0xf8, 0x25, 0x1f, 0x23, 0x90,
+	0x56, 0x42, 0x50, 0xcb, 0x78, 0xd2, 0xfd, 0xe7, 0x6b, 0x45, 0xd0, 0xa5, 0x9e, 0xd2, 0xf5, 0xe7,
+	0x65, 0xa6, 0x3c, 0x37, 0x62, 0xaf, 0xa4, 0xa6, 0x43, 0x0e, 0x45, 0x89, 0x65, 0x0a, 0xb4, 0x0a,
+	0x6a, 0x93, 0x3b, 0x3f, 0x1f, 0x06, 0x15, 0xff, 0x86, 0x1a, 0x9b, 0x75, 0xa1, 0x2a, 0x9e, 0xcb,
+	0x85, 0x6a, 0xe4, 0xcc, 0x2f, 0x54, 0xec, 0x7a, 0x1d, 0xf8, 0x2d, 0xba, 0x88, 0x9b, 0xd2, 0x10,
+	0xab, 0xaf, 0xd7, 0x28, 0xc0, 0xa8, 0xf0, 0xe9, 0xac, 0x1b, 0xa5, 0x93, 0x65, 0xdd, 0x20, 0xbf,
+	0x61, 0x41, 0xb9, 0xc6, 0x5f, 0xcb, 0x8a, 0x81, 0xb9, 0xbd, 0xb7, 0xe9, 0x47, 0xdb, 0x01, 0x0d,
+	0xa9, 0x17, 0xc9, 0xc7, 0x61, 0x1b, 0x83, 0x3f, 0x92, 0xcc, 0x78, 0x85, 0x5b, 0x79, 0xfe, 0xf8,
+	0x68, 0xae, 0xbc, 0xd4, 0x47, 0x24, 0xf6, 0xad, 0x8c, 0xfd, 0x2a, 0x94, 0x54, 0xd2, 0x8a, 0x13,
+	0x38, 0x92, 0xbe, 0x6a, 0xc1, 0x85, 0x6a, 0x2d, 0x70, 0x3b, 0xf1, 0xc3, 0xeb, 0xb3, 0x7e, 0x83,
+	0xfe, 0xb2, 0x8e, 0xda, 0x4f, 0x4d, 0xf1, 0x64, 0xc4, 0xbd, 0x7d, 0x1f, 0xa6, 0xab, 0xb4, 0xed,
+	0x74, 0x9a, 0x3c, 0x14, 0x53, 0x78, 0x82, 0x16, 0x60, 0x2c, 0x54, 0xb0, 0x74, 0x56, 0x29, 0x4d,
+	0x8c, 0x31, 0x0d, 0x79, 0x49, 0xf8, 0xaa, 0x54, 0xf0, 0xd6, 0x98, 0x50, 0x01, 0x85, 0x83, 0x2b,
+	0x44, 0x85, 0xb3, 0xff, 0x9b, 0x05, 0x13, 0x71, 0x79, 0xba, 0x47, 0x1a, 0x30, 0x55, 0x33, 0x62,
+	0xd8, 0xe2, 0x58, 0xa5, 0x93, 0x87, 0xbb, 0xf1, 0xa9, 0xba, 0x94, 0x64, 0x82, 0x69, 0xae, 0xe4,
+	0x01, 0x94, 0x98, 0x4e, 0xb9, 0xeb, 0x84, 0x34, 0x6f, 0x8e, 0xa5, 0xea, 0xa1, 0x57, 0x5b, 0x96,
+	0xbc, 0x90, 0xee, 0x29, 0xf3, 0xab, 0x04, 0x68, 0x31, 0xf6, 0xff, 0xb1, 0x60, 0x4a, 0x37, 0x56,
+	0x1a, 0xa4, 0xc2, 0xb4, 0x4f, 0x6f, 0x6d, 0xf0, 0x47, 0x50, 0xc9, 0x31, 0x7b, 0x8c, 0x5f, 0x2f,
+	0x4c, 0xfb, 0xf5, 0x9e, 0x82, 0xd0, 0x1e, 0x7b, 0xda, 0x3f, 0x29, 0x40, 0x49, 0x3f, 0xc4, 0x7a,
+	0x17, 0x8a, 0xfc, 0x16, 0x90, 0xef, 0xe0, 0xe7, 0x37, 0x0a, 0x14, 0x9c, 0x18, 0x4b, 0xee, 0x21,
+	0x19, 0x38, 0xa9, 0xce, 0x98, 0xb0, 0x2f, 0x38, 0x41, 0x84, 0x82, 0x13, 0xb9, 0x03, 0x43, 0xd4,
+	0xab, 0x4b, 0x0d, 0xe0, 0xf4, 0x0c, 0x79, 0xaa, 0x9a, 0x5b, 0x5e, 0x1d, 0x19, 0x17, 0x9e, 0x3e,
+	0x80, 0x3f, 0x24, 0x91, 0x37, 0xc9, 0x38, 0x7d, 0x00, 0x87, 0xa2, 0xc4, 0xda, 0x5f, 0x2c, 0x00,
+	0x54, 0x23, 0xbf, 0xf3, 0x67, 0x4d, 0x89, 0x3e, 0x45, 0x3e, 0x8e, 0x3f, 0x2d, 0xc0, 0x48, 0xb5,
+	0xbb, 0xcb, 0xd4, 0xb9, 0xbf, 0x7f, 0x7e, 0x59, 0x75, 0x9e, 0x93, 0xf5, 0x39, 0x79, 0x66, 0x1d,
+	0x33, 0xa5, 0xc2, 0xd0, 0x53, 0xca, 0x64, 0xf3, 0x34, 0x82, 0xaf, 0x26, 0xfb, 0xe6, 0xed, 0xf9,
+	0xed, 0x61, 0x00, 0xd1, 0xf3, 0x5b, 0x9d, 0xe8, 0x24, 0x86, 0x9a, 0x37, 0x60, 0x42, 0xe5, 0x97,
+	0xde, 0x8c, 0xdd, 0xf1, 0xda, 0x47, 0xb2, 0x6a, 0xe0, 0x30, 0x41, 0xc9, 0xd5, 0x4f, 0x2f, 0x0a,
+	0x0e, 0x85, 0x86, 0x36, 0x9c, 0x52, 0x3f, 0x35, 0x06, 0x0d, 0x2a, 0x32, 0x9f, 0x30, 0xd2, 0x8a,
+	0xb7, 0xb0, 0x17, 0x1e, 0x63, 0x5d, 0x7d, 0x13, 0x26, 0xf5, 0xbf, 0x15, 0xb7, 0xa5, 0x62, 0x98,
+	0xf5, 0x9d, 0x7f, 0xdb, 0x44, 0x62, 0x92, 0x96, 0x7c, 0x0e, 0x2e, 0x24, 0x1f, 0xc8, 0x48, 0x9d,
+	0x46, 0x3f, 0x16, 0x4b, 0xbe, 0xab, 0xc1, 0x14, 0x35, 0x5b, 0xf0, 0xf5, 0xe0, 0x10, 0xbb, 0x9e,
+	0x54, 0x6e, 0xf4, 0x82, 0x5f, 0xe6, 0x50, 0x94, 0x58, 0xd6, 0x85, 0xac, 0x24, 0x0d, 0x04, 0x9c,
+	0x6b, 0x31, 0xa5, 0xb8, 0x0b, 0xab, 0x06, 0x0e, 0x13, 0x94, 0x4c, 0x82, 0xb4, 0x92, 0x41, 0x72,
+	0x4b, 0x49, 0x19, 0xb9, 0x3a, 0x70, 0xc1, 0x4f, 0x1a, 0x23, 0x84, 0xcf, 0xf8, 0xd3, 0x27, 0x9c,
+	0xad, 0x89, 0xb2, 0x22, 0xda, 0x35, 0x65, 0xbb, 0x48, 0xf1, 0xb7, 0x2f, 0xc2, 0x4c, 0xb5, 0xdb,
+	0xe9, 0xb4, 0x5c, 0x5a, 0xd7, 0x76, 0x4b, 0xfb, 0x2d, 0x98, 0x92, 0x89, 0x11, 0xb4, 0x8e, 0x73,
+	0xaa, 0xc4, 0x69, 0xf6, 0xa7, 0x60, 0x2a, 0x75, 0xd6, 0x3e, 0x21, 0xdc, 0x88, 0x5d, 0x39, 0xa7,
+	0x52, 0x9e, 0x20, 0xf2, 0x20, 0xad, 0xcb, 0xe4, 0x30, 0x52, 0x9b, 0xba, 0x8b, 0x58, 0x56, 0x99,
+	0xda, 0xd0, 0x3d, 0x15, 0x16, 0x94, 0x33, 0x68, 0x8e, 0x87, 0xd1, 0x88, 0x83, 0x2a, 0x11, 0x51,
+	0x14, 0x01, 0x68, 0x61, 0xca, 0x32, 0x71, 0x36, 0x6d, 0xe2, 0xab, 0x4b, 0x43, 0x42, 0x34, 0xe4,
+	0x90, 0x3a, 0x8c, 0x72, 0xf1, 0x54, 0x05, 0x02, 0xe7, 0x6c, 0x17, 0x57, 0x11, 0x37, 0x04, 0x47,
+	0x54, 0xac, 0xed, 0xff, 0x61, 0x41, 0xb6, 0x1b, 0x91, 0x44, 0xbd, 0x03, 0xb9, 0x9a, 0xbb, 0xd1,
+	0xd2, 0x7b, 0xd9, 0x7f, 0x2c, 0xeb, 0xc9, 0xb1, 0x5c, 0xca, 0xd5, 0x66, 0x29, 0xad, 0x67, 0x44,
+	0xed, 0x3f, 0xb5, 0x60, 0x7c, 0x67, 0x67, 0x5d, 0x5b, 0x4a, 0x10, 0xae, 0x84, 0x22, 0x92, 0x7c,
+	0x71, 0x2f, 0xa2, 0xc1, 0x92, 0xdf, 0xee, 0xb4, 0xa8, 0x5e, 0x36, 0x32, 0x13, 0x47, 0x35, 0x93,
+	0x02, 0xfb, 0x94, 0x24, 0xb7, 0xe1, 0xa2, 0x89, 0x91, 0x96, 0x44, 0xde, 0xae, 0xa2, 0x7c, 0xff,
+	0xd5, 0x8b, 0xc6, 0xac, 0x32, 0x69, 0x56, 0xd2, 0x9c, 0x28, 0x53, 0xb3, 0xf7, 0xb0, 0x92, 0x68,
+	0xcc, 0x2a, 0x63, 0x6f, 0xc1, 0xb8, 0xf1, 0x01, 0x00, 0xf2, 0x36, 0x4c, 0xd7, 0xfc, 0xb6, 0x32,
+	0x40, 0xac, 0xd3, 0x03, 0xda, 0x92, 0x4d, 0xe6, 0xf6, 0xa8, 0xa5, 0x14, 0x0e, 0x7b, 0xa8, 0xed,
+	0x7f, 0x39, 0x07, 0x3a, 0xae, 0xf9, 0xc7, 0xb9, 0x28, 0x72, 0x44, 0x8e, 0xed, 0xe9, 0xf0, 0x91,
+	0xe2, 0x99, 0x84, 0x8f, 0xe8, 0xe3, 0x2a, 0x15, 0x42, 0x62, 0xa4, 0x3d, 0x1b, 0x79, 0xca, 0x69,
+	0xcf, 0xc8, 0xaf, 0x58, 0x30, 0xc1, 0x74, 0x55, 0xad, 0xda, 0x8e, 0xf2, 0x9d, 0x0c, 0xf3, 0xf6,
+	0xa6, 0x08, 0x8c, 0x90, 0x4c, 0x45, 0x18, 0x91, 0x3e, 0xd1, 0x4d, 0x14, 0x26, 0xa4, 0x93, 0x15,
+	0xc3, 0x08, 0x28, 0x92, 0x62, 0x3c, 0x9f, 0x75, 0xef, 0x7d, 0xa2, 0x6d, 0xcf, 0x33, 0x34, 0xd3,
+	0xb1, 0x7c, 0x86, 0x39, 0x15, 0x87, 0x6c, 0x78, 0x3c, 0x54, 0xfa, 0x9c, 0x58, 0x4f, 0xb5, 0x61,
+	0x44, 0x44, 0x19, 0xc9, 0xc4, 0xfd, 0xdc, 0xd5, 0x26, 0x22, 0x90, 0x50, 0x62, 0xc8, 0x7d, 0xe5,
+	0x18, 0x1e, 0xcf, 0x97, 0x43, 0x2e, 0xe1, 0x6e, 0xce, 0xf6, 0x0c, 0x93, 0x77, 0x4c, 0xdb, 0xc9,
+	0xc4, 0x49, 0x6c, 0x27, 0x93, 0x7d, 0xed, 0x26, 0xf7, 0x61, 0x24, 0xe4, 0x96, 0x19, 0x1e, 0x5d,
+	0x35, 0x7e, 0x73, 0x65, 0xe0, 0x33, 0x26, 0x61, 0xdf, 0x11, 0x7d, 0x24, 0x60, 0x28, 0x25, 0x90,
+	0x00, 0x4a, 0x2a, 0x0a, 0x4c, 0xc6, 0x68, 0xad, 0x0d, 0x6e, 0x04, 0x4e, 0x3a, 0xca, 0xd4, 0xab,
+	0x6c, 0x01, 0x45, 0x2d, 0x87, 0xdc, 0x83, 0xa1, 0xba, 0xd3, 0x90, 0xd1, 0x5a, 0x4b, 0x79, 0xf2,
+	0x5f, 0x28, 0x49, 0xfc, 0xe2, 0xbb, 0xbc, 0xb8, 0x8a, 0x8c, 0x31, 0xf1, 0xe2, 0x34, 0x59, 0xd3,
+	0x39, 0x0f, 0xe9, 0xa4, 0xf6, 0x28, 0x14, 0x86, 0x9e, 0x5c, 0x5b, 0x2a, 0xd5, 0xff, 0x4f, 0xe5,
+	0x4f, 0xf5, 0xaf, 0x25, 0xa5, 0x53, 0xfd, 0x7f, 0xd9, 0x82, 0x89, 0x9a, 0x91, 0x2d, 0xb1, 0xfc,
+	0x4a, 0xbe, 0x54, 0xa3, 0x59, 0x99, 0x17, 0xc5, 0xbb, 0x3b, 0x13, 0x83, 0x09, 0x99, 0xac, 0xa1,
+	0x7c, 0x81, 0xdf, 0xc8, 0xd7, 0x50, 0x33, 0xb5, 0x87, 0x68, 0x68, 0xec, 0x72, 0x25, 0x0f, 0xa1,
+	0xa4, 0x4a, 0x94, 0x7f, 0x3a, 0xa7, 0x79, 0x35, 0x2b, 0x6d, 0xae, 0x98, 0x95, 0x0a, 0x8a, 0x5a,
+	0x18, 0xb9, 0x05, 0xa3, 0x22, 0x61, 0xa3, 0x88, 0xd7, 0x1b, 0xbf, 0x39, 0xdb, 0x3f, 0xed, 0x63,
+	0xbc, 0xbf, 0x8b, 0xff, 0x21, 0xaa, 0xb2, 0xe4, 0x97, 0x2d, 0xb8, 0xc0, 0xb6, 0xc4, 0x38, 0xc3,
+	0x64, 0x99, 0xe4, 0xdb, 0x7e, 0xde, 0x0b, 0x99, 0x12, 0xa5, 0xb6, 0x0d, 0x7d, 0x19, 0xbc, 0x9d,
+	0x10, 0x82, 0x29, 0xa1, 0x24, 0x84, 0x52, 0xe8, 0xd6, 0x69, 0xcd, 0x09, 0xc2, 0xf2, 0xc5, 0xb3,
+	0xac, 0x40, 0xec, 0x2e, 0x91, 0xec, 0x51, 0x0b, 0x22, 0x5f, 0xe1, 0xf9, 0xe4, 0xe5, 0x07, 0x3c,
+	0xe4, 0x07, 0x6e, 0x2e, 0x9d, 0x71, 0xfa, 0x18, 0xe1, 0x7e, 0x48, 0x0a, 0xc1, 0xb4, 0x54, 0xf2,
+	0x25, 0x0b, 0x2e, 0x8b, 0x0c, 0x68, 0xe9, 0xf4, 0x77, 0x97, 0x07, 0x34, 0xae, 0xf1, 0xf0, 0xc2,
+	0xc5, 0x2c, 0x96, 0x98, 0x2d, 0x89, 0x7c, 0x01, 0x26, 0x03, 0xd3, 0xfb, 0xc8, 0xe3, 0x39, 0xf3,
+	0x7a, 0xd9, 0xf4, 0xe7, 0x72, 0x78, 0x38, 0x69, 0x02, 0x84, 0x49, 0x71, 0xe4, 0x35, 0x18, 0xef,
+	0xc8, 0xa3, 0xcb, 0x0d, 0xdb, 0x3c, 0x1a, 0x74, 0x48, 0x68, 0x5c, 0xdb, 0x31, 0x18, 0x4d, 0x1a,
+	0xf2, 0x1e, 0x8c, 0x47, 0x7e, 0x8b, 0x06, 0xf2, 0x59, 0x53, 0x99, 0x4f, 0x9c, 0x6b, 0x59, 0x0b,
+	0x61, 0x47, 0x93, 0xc5, 0x4e, 0x94, 0x18, 0x16, 0xa2, 0xc9, 0x87, 0xbc, 0x09, 0x93, 0x2a, 0x45,
+	0x62, 0xc0, 0xad, 0x36, 0xcf, 0x26, 0xcd, 0x22, 0x55, 0x13, 0x89, 0x49, 0x5a, 0xb2, 0x0a, 0x33,
+	0x9d, 0xc0, 0xf5, 0x03, 0x37, 0x3a, 0x5c, 0x6a, 0x39, 0x61, 0xc8, 0x19, 0xcc, 0x26, 0x0d, 0x82,
+	0xdb, 0x69, 0x02, 0xec, 0x2d, 0x43, 0x5e, 0x81, 0x92, 0x02, 0x96, 0x9f, 0xe3, 0x1a, 0xfd, 0x84,
+	0x88, 0x01, 0x17, 0x30, 0xd4, 0xd8, 0x3e, 0x29, 0x63, 0x9e, 0x1f, 0x24, 0x65, 0x0c, 0xa9, 0xc3,
+	0xf3, 0x4e, 0x37, 0xf2, 0xf9, 0xcb, 0xd3, 0x64, 0x11, 0x9e, 0x13, 0xbe, 0x7c, 0x9d, 0xeb, 0x2f,
+	0xd7, 0x8f, 0x8f, 0xe6, 0x9e, 0x5f, 0x7c, 0x0c, 0x1d, 0x3e, 0x96, 0x0b, 0xe9, 0x40, 0x89, 0xca,
+	0xb4, 0x37, 0xe5, 0x9f, 0xc8, 0xa7, 0x35, 0x24, 0xd3, 0xe7, 0xa8, 0x38, 0x3c, 0x01, 0x43, 0x2d,
+	0x85, 0xec, 0xc0, 0x78, 0xd3, 0x0f, 0xa3, 0xc5, 0x96, 0xeb, 0x84, 0x34, 0x2c, 0xbf, 0xc0, 0xa7,
+	0x4a, 0xa6, 0xce, 0xb3, 0xa6, 0xc8, 0xe2, 0x99, 0xb2, 0x16, 0x97, 0x44, 0x93, 0x0d, 0xa1, 0xdc,
+	0x6d, 0xd8, 0xe5, 0x03, 0xe7, 0x7b, 0x11, 0xfd, 0x38, 0x2a, 0x5f, 0xe3, 0xcd, 0x79, 0x39, 0x8b,
+	0xf3, 0xb6, 0x5f, 0xaf, 0x26, 0xa9, 0xb5, 0xdf, 0xd0, 0x04, 0x62, 0x9a, 0x27, 0x79, 0x03, 0x26,
+	0x3a, 0x7e, 0xbd, 0xda, 0xa1, 0xb5, 0x6d, 0x27, 0xaa, 0x35, 0xcb, 0x73, 0x49, 0x2b, 0xe2, 0xb6,
+	0x81, 0xc3, 0x04, 0x25, 0xd9, 0x83, 0xd1, 0xb6, 0x78, 0x2a, 0x56, 0x7e, 0x31, 0xdf, 0x5d, 0x41,
+	0xbe, 0x38, 0x93, 0x56, 0x08, 0xf1, 0x07, 0x15, 0x73, 0xf2, 0xf7, 0x2c, 0x98, 0x4a, 0x45, 0x2d,
+	0x97, 0x7f, 0x32, 0xbf, 0xdb, 0xc8, 0x60, 0x57, 0x79, 0x99, 0x77, 0x55, 0x12, 0xf8, 0xa8, 0x17,
+	0x84, 0xe9, 0x7a, 0x88, 0x3e, 0xe0, 0x8f, 0x37, 0xcb, 0x2f, 0xe5, 0xed, 0x03, 0xce, 0x46, 0xf5,
+	0x01, 0xff, 0x83, 0x8a, 0x39, 0xb9, 0x01, 0xa3, 0xf2, 0x51, 0x7b, 0xf9, 0xe5, 0xa4, 0x05, 0x5f,
+	0xbe, 0x7d, 0x47, 0x85, 0x9f, 0x7d, 0x0b, 0x66, 0x7a, 0x2e, 0x40, 0xa7, 0x7a, 0x55, 0xf8, 0x07,
+	0x16, 0x98, 0x17, 0xd1, 0x33, 0xbf, 0xb7, 0xaf, 0xc2, 0x8c, 0xfc, 0xb6, 0x22, 0xd3, 0x88, 0x5b,
+	0x5d, 0xfd, 0xcd, 0x04, 0x23, 0x44, 0x0b, 0xd3, 0x04, 0xd8, 0x5b, 0x86, 0x4d, 0xdd, 0x9a, 0x48,
+	0x9c, 0x2e, 0x5e, 0x2d, 0x0d, 0x27, 0xad, 0xb7, 0x4b, 0x06, 0x0e, 0x13, 0x94, 0xf6, 0xdf, 0xb2,
+	0x60, 0x6a, 0x87, 0x06, 0x6d, 0xd7, 0x73, 0xa2, 0x1f, 0x91, 0x90, 0x29, 0x7b, 0x0d, 0x48, 0x6f,
+	0x7a, 0xb4, 0x54, 0xa4, 0x88, 0x75, 0xa2, 0x48, 0x91, 0xdf, 0xb0, 0x60, 0x32, 0xa1, 0xa9, 0x9c,
+	0xb9, 0x3f, 0x7b, 0x05, 0x48, 0xdb, 0x0d, 0x02, 0x3f, 0xb8, 0x9b, 0xcc, 0x11, 0xce, 0xfa, 0x9f,
+	0xa7, 0x3c, 0xd9, 0xe8, 0xc1, 0x62, 0x46, 0x09, 0xfb, 0xab, 0x43, 0x10, 0x07, 0xd7, 0xea, 0x5c,
+	0x3f, 0x56, 0xdf, 0x5c, 0x3f, 0xaf, 0x42, 0xe9, 0x7e, 0xe8, 0x7b, 0xdb, 0x71, 0x46, 0x20, 0x3d,
+	0xd1, 0xde, 0xa9, 0x6e, 0x6d, 0x72, 0x4a, 0x4d, 0xc1, 0xa9, 0x1f, 0xac, 0xb8, 0xad, 0xa8, 0x37,
+	0x67, 0xce, 0x3b, 0xef, 0x0a, 0x38, 0x6a, 0x0a, 0x9e, 0x88, 0x9c, 0x0d, 0x9b, 0x74, 0x35, 0xc4,
+	0x89, 0xc8, 0x19, 0x10, 0x05, 0x8e, 0x2c, 0xc0, 0x98, 0xf6, 0x54, 0x48, 0xc7, 0x89, 0xee, 0x29,
+	0xed, 0xd1, 0xc0, 0x98, 0x86, 0x2b, 0x9f, 0xd2, 0x1a, 0x2f, 0x2d, 0x2a, 0xb7, 0x07, 0xbf, 0x82,
+	0xa5, 0xac, 0xfa, 0xe2, 0x40, 0x52, 0x60, 0xd4, 0x82, 0xcc, 0x60, 0xeb, 0xe2, 0x09, 0x83, 0xad,
+	0xed, 0x5f, 0x1e, 0x82, 0xd1, 0xbb, 0x34, 0xe0, 0xc9, 0xbc, 0x6e, 0xc0, 0xe8, 0x81, 0xf8, 0x99,
+	0x7e, 0xaa, 0x21, 0x29, 0x50, 0xe1, 0x59, 0x87, 0xec, 0x76, 0xdd, 0x56, 0x7d, 0x39, 0x5e, 0xfb,
+	0xba, 0x43, 0x2a, 0x0a, 0x81, 0x31, 0x0d, 0x2b, 0xd0, 0x60, 0xea, 0x79, 0xbb, 0xed, 0x46, 0xe9,
+	0x97, 0xf6, 0xab, 0x0a, 0x81, 0x31, 0x0d, 0x79, 0x19, 0x46, 0x1a, 0x6e, 0xb4, 0xe3, 0x34, 0xd2,
+	0xce, 0xdb, 0x55, 0x0e, 0x45, 0x89, 0xe5, 0xee, 0x30, 0x37, 0xda, 0x09, 0x28, 0xb7, 0x23, 0xf7,
+	0x3c, 0x07, 0x5d, 0x35, 0x70, 0x98, 0xa0, 0xe4, 0x55, 0xf2, 0x65, 0xcb, 0xa4, 0x9b, 0x2a, 0xae,
+	0x92, 0x42, 0x60, 0x4c, 0xc3, 0x26, 0x56, 0xcd, 0x6f, 0x77, 0xdc, 0x96, 0x0c, 0xd3, 0x35, 0x26,
+	0xd6, 0x92, 0x84, 0xa3, 0xa6, 0x60, 0xd4, 0x6c, 0xe3, 0x63, 0xeb, 0x3a, 0x9d, 0x75, 0x79, 0x5b,
+	0xc2, 0x51, 0x53, 0xd8, 0x77, 0x61, 0x52, 0x2c, 0x91, 0xa5, 0x96, 0xe3, 0xb6, 0x57, 0x97, 0xc8,
+	0xad, 0x9e, 0xf8, 0xef, 0x1b, 0x19, 0xf1, 0xdf, 0x97, 0x13, 0x85, 0x32, 0xe2, 0xc0, 0x7f, 0xab,
+	0x00, 0xa5, 0x73, 0x4c, 0x48, 0xbf, 0x97, 0x48, 0x48, 0x7f, 0x36, 0x49, 0xcb, 0xb3, 0x92, 0xd1,
+	0x7b, 0xa9, 0x64, 0xf4, 0x2b, 0xf9, 0xdf, 0x3c, 0x3c, 0x36, 0x11, 0xfd, 0xf7, 0x2d, 0xd0, 0x2f,
+	0x6b, 0xf9, 0xce, 0x50, 0x71, 0x3d, 0x1e, 0xd8, 0xf1, 0xf4, 0xbb, 0x34, 0x48, 0x74, 0xe9, 0x76,
+	0xde, 0x86, 0x9a, 0xb5, 0xef, 0xfb, 0x79, 0x95, 0x3f, 0xb2, 0xa0, 0x9c, 0x55, 0xe0, 0x1c, 0xf2,
+	0xef, 0x3f, 0x48, 0xe6, 0xdf, 0x5f, 0x3f, 0xcb, 0xf6, 0xf6, 0xc9, 0xc3, 0xff, 0xad, 0xe1, 0xec,
+	0xd6, 0xf2, 0xf4, 0xf7, 0xbb, 0xea, 0x7c, 0xb0, 0xf2, 0x39, 0x12, 0x05, 0xe3, 0xec, 0xe3, 0x65,
+	0x17, 0x46, 0x42, 0x1e, 0x02, 0x20, 0x07, 0xf9, 0x73, 0x83, 0x9f, 0x15, 0x8c, 0x8b, 0xb4, 0x73,
+	0xf2, 0xdf, 0x28, 0x39, 0x93, 0xa6, 0x78, 0x83, 0x24, 0x9f, 0xe5, 0xe7, 0x58, 0x9b, 0x66, 0xc0,
+	0x79, 0xfc, 0x92, 0xa9, 0x4d, 0x51, 0xf2, 0x27, 0x3f, 0x0f, 0xc3, 0x61, 0xe4, 0xab, 0x0f, 0x7f,
+	0x0e, 0xfe, 0xd9, 0x52, 0x1d, 0x91, 0x23, 0x4c, 0x64, 0xec, 0x3f, 0x72, 0xce, 0x24, 0x82, 0xb1,
+	0x48, 0xa9, 0x71, 0xd2, 0x33, 0xb2, 0x3a, 0xb8, 0xfb, 0x20, 0xa1, 0x0f, 0x0a, 0xab, 0xb4, 0x06,
+	0x62, 0x2c, 0xc8, 0xfe, 0xf7, 0x16, 0x4c, 0x9c, 0xe3, 0x87, 0x28, 0x68, 0x72, 0x21, 0xbc, 0x9d,
+	0x77, 0x21, 0xf4, 0x9b, 0xfc, 0xd7, 0x20, 0xf1, 0xf5, 0x07, 0xf2, 0x80, 0x75, 0xae, 0xd0, 0xd9,
+	0xd5, 0x8b, 0xb2, 0xb7, 0xf3, 0xfa, 0x66, 0xe2, 0x83, 0x55, 0x41, 0x42, 0x8c, 0xa5, 0xa4, 0x02,
+	0x53, 0x0a, 0x27, 0x0a, 0x4c, 0xf9, 0x61, 0xb8, 0x01, 0xb3, 0xad, 0x22, 0xc3, 0x4f, 0xc5, 0x2a,
+	0xf2, 0xfc, 0x99, 0x5b, 0x45, 0x5e, 0x38, 0x17, 0xab, 0x88, 0x61, 0x45, 0x2e, 0xe6, 0xb0, 0x22,
+	0xff, 0x35, 0xb8, 0x74, 0x10, 0xab, 0x36, 0x7a, 0xd6, 0xc8, 0xd4, 0xfa, 0x37, 0x32, 0x6d, 0x21,
+	0x4c, 0x4d, 0x0b, 0x23, 0xea, 0x45, 0x86, 0x52, 0x14, 0x27, 0xc6, 0xb8, 0x9b, 0xc1, 0x0e, 0x33,
+	0x85, 0xa4, 0xed, 0x86, 0xa3, 0x27, 0xb0, 0x1b, 0xfe, 0xc3, 0xbe, 0x9f, 0x62, 0x2d, 0x3d, 0x8d,
+	0x4f, 0xb1, 0x3e, 0x7b, 0xea, 0xcf, 0xb0, 0xbe, 0x14, 0xfb, 0x84, 0x44, 0xb8, 0x53, 0xb6, 0x2b,
+	0xe7, 0x1b, 0x69, 0xef, 0x2c, 0xf0, 0x0e, 0xbf, 0x7b, 0x16, 0x9a, 0xdc, 0x19, 0x78, 0x68, 0xc7,
+	0x73, 0x78, 0x68, 0x53, 0xa6, 0xdd, 0x89, 0x33, 0x32, 0xed, 0x7a, 0x30, 0xed, 0xb6, 0x9d, 0x06,
+	0xdd, 0xee, 0xb6, 0x5a, 0x22, 0x28, 0x5f, 0x7d, 0x03, 0x20, 0x33, 0x80, 0x7a, 0xdd, 0xaf, 0x39,
+	0xad, 0xf4, 0xb7, 0x4b, 0xf4, 0x83, 0xa4, 0xdb, 0x29, 0x4e, 0xd8, 0xc3, 0x9b, 0x4d, 0x4e, 0x9e,
+	0xf9, 0x80, 0x46, 0xac, 0xb7, 0xb9, 0xcf, 0x52, 0x7e, 0x46, 0x7c, 0x2d, 0x06, 0xa3, 0x49, 0x43,
+	0xee, 0xc0, 0x58, 0xdd, 0x0b, 0xe5, 0xfb, 0x9c, 0x29, 0xbe, 0x5d, 0xfd, 0x0c, 0xdb, 0xe4, 0x96,
+	0x37, 0xab, 0xfa, 0x65, 0xce, 0xf3, 0x19, 0x09, 0x34, 0x34, 0x1e, 0xe3, 0xf2, 0x64, 0x83, 0x33,
+	0x93, 0xf9, 0x73, 0x85, 0x7b, 0xf1, 0x7a, 0x1f, 0xd3, 0xe4, 0xf2, 0xa6, 0xca, 0xf7, 0x3b, 0x29,
+	0xc5, 0xc9, 0x94, 0xb8, 0x31, 0x07, 0xe3, 0x53, 0x0c, 0x33, 0x8f, 0xfd, 0x14, 0xc3, 0x7b, 0x70,
+	0x35, 0x8a, 0x5a, 0x89, 0x98, 0x16, 0x99, 0x3e, 0x85, 0xe7, 0xd2, 0x29, 0x8a, 0xc4, 0xdb, 0x3b,
+	0x3b, 0xeb, 0x59, 0x24, 0xd8, 0xaf, 0x2c, 0x8f, 0xec, 0x88, 0x5a, 0xda, 0x41, 0x71, 0x2d, 0x67,
+	0x64, 0x47, 0x1c, 0x3f, 0x24, 0x23, 0x3b, 0x62, 0x00, 0x9a, 0x82, 0xc8, 0x56, 0x3f, 0xef, 0xcc,
+	0x45, 0xbe, 0xd9, 0x9c, 0xde, 0xd7, 0x62, 0xda, 0xf6, 0x2f, 0x3d, 0xd6, 0xb6, 0xdf, 0xe3, 0x8b,
+	0xb8, 0x7c, 0x0a, 0x5f, 0xc4, 0x3d, 0x9e, 0x1f, 0x65, 0x75, 0x49, 0xba, 0x72, 0x06, 0x56, 0x87,
+	0xf9, 0xbb, 0x65, 0x11, 0x85, 0xc5, 0x7f, 0xa2, 0x60, 0x4b, 0xb6, 0xe1, 0x52, 0xc7, 0xaf, 0xf7,
+	0x78, 0x33, 0xb8, 0xef, 0xc6, 0xc8, 0x72, 0xb4, 0x9d, 0x41, 0x83, 0x99, 0x25, 0xf9, 0x66, 0x1e,
+	0xc3, 0x79, 0x52, 0x9d, 0xa2, 0xdc, 0xcc, 0x63, 0x30, 0x9a, 0x34, 0x69, 0xcb, 0xfe, 0xb3, 0x4f,
+	0xcd, 0xb2, 0x3f, 0x7b, 0x0e, 0x96, 0xfd, 0xe7, 0x4e, 0x6c, 0xd9, 0xff, 0x45, 0xb8, 0xd8, 0xf1,
+	0xeb, 0xcb, 0x6e, 0x18, 0x74, 0xf9, 0x4b, 0x9c, 0x4a, 0xb7, 0xde, 0xa0, 0x11, 0x77, 0x0d, 0x8c,
+	0xdf, 0xbc, 0x69, 0x56, 0xb2, 0xc3, 0xb7, 0x82, 0xf9, 0x83, 0xd7, 0x76, 0x69, 0x24, 0x06, 0x33,
+	0x5d, 0x8a, 0x5f, 0x2d, 0x79, 0x18, 0x5a, 0x06, 0x12, 0xb3, 0xe4, 0x98, 0x8e, 0x85, 0xeb, 0x4f,
+	0xd3, 0xb1, 0xf0, 0x36, 0x94, 0xc2, 0x66, 0x37, 0xaa, 0xfb, 0x0f, 0x3d, 0xee, 0x29, 0x1a, 0xd3,
+	0x9f, 0x45, 0x2b, 0x55, 0x25, 0xfc, 0xd1, 0xd1, 0xdc, 0xb4, 0xfa, 0x6d, 0x18, 0x55, 0x24, 0xa4,
+	0xef, 0x87, 0x67, 0xed, 0x1f, 0xf6, 0x87, 0x67, 0xb3, 0x7c, 0x26, 0x2f, 0xfe, 0x88, 0xf8, 0x4c,
+	0x7e, 0xd5, 0x82, 0xc9, 0x03, 0xd3, 0x5a, 0x25, 0xbd, 0x39, 0x03, 0x7b, 0x83, 0x13, 0xa6, 0xaf,
+	0x8a, 0xcd, 0xb6, 0xae, 0x04, 0xe8, 0x51, 0x1a, 0x80, 0x49, 0xf9, 0xbd, 0xee, 0xe9, 0x97, 0xce,
+	0xd7, 0x3d, 0x1d, 0x00, 0x28, 0x5d, 0x6d, 0x75, 0x89, 0x3b, 0x78, 0x72, 0x5c, 0x97, 0x17, 0x35,
+	0x27, 0x11, 0x41, 0x1c, 0xff, 0x47, 0x43, 0x0a, 0x89, 0xd4, 0x67, 0x8a, 0x7e, 0x2a, 0xdf, 0xa7,
+	0x6b, 0x13, 0x7a, 0xdd, 0x13, 0xbf, 0x55, 0x94, 0xd7, 0x39, 0xf5, 0xc3, 0xff, 0xd8, 0xd1, 0x9f,
+	0xcc, 0xc0, 0x85, 0xd4, 0x57, 0xea, 0x3e, 0xad, 0x32, 0xe0, 0x09, 0xf3, 0xec, 0xb5, 0x74, 0x06,
+	0xbc, 0x49, 0x45, 0x9f, 0xc8, 0x82, 0x97, 0x48, 0x53, 0x57, 0x78, 0xaa, 0x69, 0xea, 0x86, 0xce,
+	0x27, 0x4d, 0xdd, 0xf4, 0xd3, 0x48, 0x53, 0x37, 0x73, 0xaa, 0x34, 0x75, 0xc6, 0x4b, 0xa7, 0xe1,
+	0x27, 0xa4, 0x09, 0x5c, 0x84, 0x29, 0x15, 0xaf, 0x4c, 0x65, 0x76, 0x32, 0xe1, 0x32, 0xb8, 0x2a,
+	0x8b, 0x4c, 0x2d, 0x25, 0xd1, 0x98, 0xa6, 0x27, 0xbf, 0x04, 0x45, 0x8f, 0x17, 0x1c, 0xc9, 0x97,
+	0xf4, 0x36, 0x39, 0x9f, 0xf8, 0xed, 0x48, 0xae, 0x22, 0x15, 0xe3, 0x54, 0xe4, 0xb0, 0x47, 0xea,
+	0x07, 0x0a, 0xb9, 0xe4, 0x23, 0x28, 0xfb, 0x7b, 0x7b, 0x2d, 0xdf, 0xa9, 0xc7, 0xa9, 0xf4, 0x94,
+	0x23, 0x43, 0xbc, 0x4b, 0xb9, 0x2e, 0x19, 0x94, 0xb7, 0xfa, 0xd0, 0x61, 0x5f, 0x0e, 0xec, 0x2a,
+	0x3b, 0x95, 0xcc, 0x3e, 0x19, 0x96, 0xc7, 0x78, 0x4b, 0x3f, 0x3c, 0xa3, 0x96, 0x26, 0xb3, 0x5d,
+	0xca, 0x36, 0xeb, 0xfe, 0x4f, 0x61, 0x31, 0x5d, 0x19, 0x12, 0xc0, 0x95, 0x4e, 0xd6, 0x5d, 0x3f,
+	0x94, 0xa1, 0xc4, 0x8f, 0xb3, 0x38, 0xa8, 0x55, 0x7a, 0x25, 0xd3, 0x5a, 0x10, 0x62, 0x1f, 0xce,
+	0x66, 0x92, 0xbd, 0xd2, 0xd3, 0x4c, 0xb2, 0x97, 0xfc, 0x78, 0xe4, 0xe4, 0x39, 0x7d, 0x3c, 0x92,
+	0xfc, 0x20, 0x33, 0xcf, 0xa3, 0xb8, 0x22, 0xff, 0xd5, 0x33, 0x1a, 0xf5, 0x1f, 0xb9, 0x5c, 0x8f,
+	0xff, 0xc0, 0x82, 0x59, 0x31, 0xb7, 0xb2, 0xbe, 0x3b, 0x2f, 0xa3, 0x81, 0xcf, 0xc6, 0x87, 0xc5,
+	0xbd, 0xe3, 0xd5, 0x84, 0x2c, 0xee, 0x6e, 0x79, 0x8c, 0x7c, 0xf2, 0x2b, 0x19, 0xca, 0xdc, 0x54,
+	0x3e, 0x63, 0x52, 0x76, 0xde, 0xc0, 0x8b, 0xc7, 0x27, 0xd1, 0xdf, 0xbe, 0xd5, 0xd7, 0xc2, 0x45,
+	0x78, 0xa5, 0xaa, 0x67, 0x6a, 0xe1, 0x32, 0x53, 0x1a, 0x9e, 0xc6, 0xce, 0x35, 0xfb, 0x0b, 0x22,
+	0x9f, 0x71, 0x5f, 0x4d, 0xe1, 0xaf, 0x24, 0x35, 0x85, 0x4a, 0xfe, 0x24, 0xa6, 0xa6, 0x9e, 0xf2,
+	0xd7, 0x2d, 0xb8, 0x94, 0xb5, 0xbb, 0x65, 0x54, 0xe4, 0x6e, 0xb2, 0x22, 0xb9, 0x6d, 0xec, 0x66,
+	0x35, 0xce, 0x26, 0xaf, 0xe3, 0xdf, 0x2d, 0x19, 0xae, 0x81, 0x88, 0x76, 0x7e, 0xfc, 0x94, 0x27,
+	0xc7, 0x53, 0x9e, 0xc4, 0x07, 0x62, 0x8b, 0xe7, 0xfb, 0x81, 0xd8, 0x91, 0x01, 0x3e, 0x10, 0x3b,
+	0x7a, 0xce, 0x1f, 0x88, 0x2d, 0x9d, 0xf0, 0x03, 0xb1, 0x63, 0x3f, 0x52, 0x1f, 0x88, 0xd5, 0xd7,
+	0xa9, 0x89, 0x33, 0xba, 0x4e, 0x45, 0xb4, 0xf3, 0xff, 0xc1, 0xa7, 0x5f, 0xff, 0xb3, 0x05, 0xd3,
+	0xe9, 0x33, 0xeb, 0x1c, 0x42, 0x21, 0xbc, 0x44, 0x28, 0xc4, 0xfa, 0x59, 0xd9, 0x59, 0xfa, 0x86,
+	0x41, 0xfc, 0xa1, 0x11, 0xf5, 0xa1, 0x88, 0xcf, 0xc1, 0xf3, 0xdb, 0x4e, 0x7a, 0x7e, 0xd7, 0xce,
+	0xaa, 0x9d, 0x7d, 0x3c, 0xc0, 0x0f, 0x20, 0xcb, 0xc0, 0x74, 0xb2, 0x4c, 0x04, 0x89, 0x40, 0xcc,
+	0xc2, 0x89, 0x03, 0x31, 0xbf, 0x5c, 0xe8, 0xed, 0x58, 0xae, 0xf3, 0x7c, 0x21, 0xfe, 0xf8, 0x3c,
+	0xd7, 0xc1, 0xac, 0x33, 0xd4, 0xc1, 0x74, 0xc5, 0x12, 0x1a, 0x58, 0x42, 0x5e, 0xcf, 0xc7, 0xef,
+	0x0b, 0x4f, 0xf1, 0xe3, 0xf7, 0x93, 0x30, 0xfe, 0x81, 0xdb, 0xd1, 0xf6, 0xa2, 0xf9, 0xef, 0x7c,
+	0xef, 0xda, 0x33, 0xdf, 0xfd, 0xde, 0xb5, 0x67, 0x7e, 0xef, 0x7b, 0xd7, 0x9e, 0xf9, 0xe2, 0xf1,
+	0x35, 0xeb, 0x3b, 0xc7, 0xd7, 0xac, 0xef, 0x1e, 0x5f, 0xb3, 0x7e, 0xef, 0xf8, 0x9a, 0xf5, 0x07,
+	0xc7, 0xd7, 0xac, 0x6f, 0xfc, 0xe1, 0xb5, 0x67, 0x3e, 0x28, 0xa9, 0xb6, 0xfd, 0xbf, 0x00, 0x00,
+	0x00, 0xff, 0xff, 0x1e, 0x12, 0x04, 0x65, 0x27, 0x9e, 0x00, 0x00,
 }
 
 func (m *Amount) Marshal() (dAtA []byte, err error) {
@@ -3443,6 +4096,26 @@ func (m *Artifact) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	var l int
 	_ = l
 	i--
+	if m.Deleted {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x60
+	if m.ArtifactGC != nil {
+		{
+			size, err := m.ArtifactGC.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x5a
+	}
+	i--
 	if m.RecurseMode {
 		dAtA[i] = 1
 	} else {
@@ -3513,6 +4186,62 @@ func (m *Artifact) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ArtifactCache) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ArtifactCache) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ArtifactCache) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.Name)
+	copy(dAtA[i:], m.Name)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ArtifactGC) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ArtifactGC) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ArtifactGC) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.Strategy)
+	copy(dAtA[i:], m.Strategy)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Strategy)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *ArtifactLocation) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -3533,6 +4262,18 @@ func (m *ArtifactLocation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Azure != nil {
+		{
+			size, err := m.Azure.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x52
+	}
 	if m.GCS != nil {
 		{
 			size, err := m.GCS.MarshalToSizedBuffer(dAtA[:i])
@@ -3806,6 +4547,109 @@ func (m *ArtifactoryAuth) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *AzureArtifact) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AzureArtifact) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AzureArtifact) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.Blob)
+	copy(dAtA[i:], m.Blob)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Blob)))
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.AzureBlobContainer.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *AzureBlobContainer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AzureBlobContainer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AzureBlobContainer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i--
+	if m.UseSDKCreds {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x28
+	if m.SASTokenSecret != nil {
+		{
+			size, err := m.SASTokenSecret.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.AccountKeySecret != nil {
+		{
+			size, err := m.AccountKeySecret.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	i -= len(m.Container)
+	copy(dAtA[i:], m.Container)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Container)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Endpoint)
+	copy(dAtA[i:], m.Endpoint)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Endpoint)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *Backoff) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -3871,6 +4715,30 @@ func (m *Cache) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Artifact != nil {
+		{
+			size, err := m.Artifact.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.SQL != nil {
+		{
+			size, err := m.SQL.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
 	if m.ConfigMap != nil {
 		{
 			size, err := m.ConfigMap.MarshalToSizedBuffer(dAtA[:i])
@@ -3886,7 +4754,7 @@ func (m *Cache) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *ClusterWorkflowTemplate) Marshal() (dAtA []byte, err error) {
+func (m *ChildWorkflowTemplate) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3896,18 +4764,18 @@ func (m *ClusterWorkflowTemplate) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ClusterWorkflowTemplate) MarshalTo(dAtA []byte) (int, error) {
+func (m *ChildWorkflowTemplate) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ClusterWorkflowTemplate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ChildWorkflowTemplate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
 	{
-		size, err := m.Spec.MarshalToSizedBuffer(dAtA[:i])
+		size, err := m.Arguments.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
@@ -3916,10 +4784,55 @@ func (m *ClusterWorkflowTemplate) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	}
 	i--
 	dAtA[i] = 0x12
-	{
-		size, err := m.ObjectMeta.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+	if m.WorkflowTemplateRef != nil {
+		{
+			size, err := m.WorkflowTemplateRef.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ClusterWorkflowTemplate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ClusterWorkflowTemplate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ClusterWorkflowTemplate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Spec.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.ObjectMeta.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
 		i -= size
 		i = encodeVarintGenerated(dAtA, i, uint64(size))
@@ -4014,6 +4927,109 @@ func (m *Condition) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ContainerNode) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ContainerNode) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ContainerNode) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Outputs.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Dependencies) > 0 {
+		for iNdEx := len(m.Dependencies) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Dependencies[iNdEx])
+			copy(dAtA[i:], m.Dependencies[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.Dependencies[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.Container.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ContainerSetTemplate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ContainerSetTemplate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ContainerSetTemplate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.VolumeMounts) > 0 {
+		for iNdEx := len(m.VolumeMounts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.VolumeMounts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Containers) > 0 {
+		for iNdEx := len(m.Containers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Containers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *ContinueOn) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -4378,6 +5394,35 @@ func (m *DAGTask) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Hooks) > 0 {
+		keysForHooks := make([]string, 0, len(m.Hooks))
+		for k := range m.Hooks {
+			keysForHooks = append(keysForHooks, string(k))
+		}
+		github_com_gogo_protobuf_sortkeys.Strings(keysForHooks)
+		for iNdEx := len(keysForHooks) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.Hooks[string(keysForHooks[iNdEx])]
+			baseI := i
+			{
+				size, err := (&v).MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+			i -= len(keysForHooks[iNdEx])
+			copy(dAtA[i:], keysForHooks[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(keysForHooks[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintGenerated(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
 	i -= len(m.Depends)
 	copy(dAtA[i:], m.Depends)
 	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Depends)))
@@ -4532,7 +5577,7 @@ func (m *DAGTemplate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *Event) Marshal() (dAtA []byte, err error) {
+func (m *DataSource) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4542,53 +5587,32 @@ func (m *Event) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Event) MarshalTo(dAtA []byte) (int, error) {
+func (m *DataSource) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Event) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *DataSource) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	i -= len(m.Selector)
-	copy(dAtA[i:], m.Selector)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Selector)))
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
-}
-
-func (m *ExecutorConfig) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if m.ArtifactPaths != nil {
+		{
+			size, err := m.ArtifactPaths.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return dAtA[:n], nil
-}
-
-func (m *ExecutorConfig) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *ExecutorConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	i -= len(m.ServiceAccountName)
-	copy(dAtA[i:], m.ServiceAccountName)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.ServiceAccountName)))
-	i--
-	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *GCSArtifact) Marshal() (dAtA []byte, err error) {
+func (m *DataTemplate) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4598,23 +5622,32 @@ func (m *GCSArtifact) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *GCSArtifact) MarshalTo(dAtA []byte) (int, error) {
+func (m *DataTemplate) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *GCSArtifact) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *DataTemplate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	i -= len(m.Key)
-	copy(dAtA[i:], m.Key)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Key)))
-	i--
-	dAtA[i] = 0x12
+	if len(m.Transformation) > 0 {
+		for iNdEx := len(m.Transformation) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Transformation[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	{
-		size, err := m.GCSBucket.MarshalToSizedBuffer(dAtA[:i])
+		size, err := m.Source.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
@@ -4626,7 +5659,7 @@ func (m *GCSArtifact) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *GCSBucket) Marshal() (dAtA []byte, err error) {
+func (m *Event) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4636,37 +5669,25 @@ func (m *GCSBucket) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *GCSBucket) MarshalTo(dAtA []byte) (int, error) {
+func (m *Event) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *GCSBucket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Event) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.ServiceAccountKeySecret != nil {
-		{
-			size, err := m.ServiceAccountKeySecret.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintGenerated(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	i -= len(m.Bucket)
-	copy(dAtA[i:], m.Bucket)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Bucket)))
+	i -= len(m.Selector)
+	copy(dAtA[i:], m.Selector)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Selector)))
 	i--
 	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *Gauge) Marshal() (dAtA []byte, err error) {
+func (m *EventWorkflowSelector) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4676,28 +5697,186 @@ func (m *Gauge) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Gauge) MarshalTo(dAtA []byte) (int, error) {
+func (m *EventWorkflowSelector) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Gauge) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EventWorkflowSelector) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Realtime != nil {
-		i--
-		if *m.Realtime {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+	if len(m.Labels) > 0 {
+		keysForLabels := make([]string, 0, len(m.Labels))
+		for k := range m.Labels {
+			keysForLabels = append(keysForLabels, string(k))
 		}
-		i--
-		dAtA[i] = 0x10
-	}
-	i -= len(m.Value)
-	copy(dAtA[i:], m.Value)
+		github_com_gogo_protobuf_sortkeys.Strings(keysForLabels)
+		for iNdEx := len(keysForLabels) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.Labels[string(keysForLabels[iNdEx])]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintGenerated(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(keysForLabels[iNdEx])
+			copy(dAtA[i:], keysForLabels[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(keysForLabels[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintGenerated(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	i -= len(m.Name)
+	copy(dAtA[i:], m.Name)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ExecutorConfig) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExecutorConfig) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExecutorConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.ServiceAccountName)
+	copy(dAtA[i:], m.ServiceAccountName)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.ServiceAccountName)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *GCSArtifact) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GCSArtifact) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GCSArtifact) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.Key)
+	copy(dAtA[i:], m.Key)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Key)))
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.GCSBucket.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *GCSBucket) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GCSBucket) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GCSBucket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ServiceAccountKeySecret != nil {
+		{
+			size, err := m.ServiceAccountKeySecret.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	i -= len(m.Bucket)
+	copy(dAtA[i:], m.Bucket)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Bucket)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *Gauge) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Gauge) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Gauge) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Realtime != nil {
+		i--
+		if *m.Realtime {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	i -= len(m.Value)
+	copy(dAtA[i:], m.Value)
 	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Value)))
 	i--
 	dAtA[i] = 0xa
@@ -5004,7 +6183,7 @@ func (m *HTTPArtifact) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *Header) Marshal() (dAtA []byte, err error) {
+func (m *HTTPHeader) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5014,16 +6193,28 @@ func (m *Header) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Header) MarshalTo(dAtA []byte) (int, error) {
+func (m *HTTPHeader) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Header) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *HTTPHeader) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if m.ValueFrom != nil {
+		{
+			size, err := m.ValueFrom.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
 	i -= len(m.Value)
 	copy(dAtA[i:], m.Value)
 	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Value)))
@@ -5037,7 +6228,7 @@ func (m *Header) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *Histogram) Marshal() (dAtA []byte, err error) {
+func (m *HTTPHeaderSource) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5047,39 +6238,32 @@ func (m *Histogram) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Histogram) MarshalTo(dAtA []byte) (int, error) {
+func (m *HTTPHeaderSource) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Histogram) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *HTTPHeaderSource) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Buckets) > 0 {
-		for iNdEx := len(m.Buckets) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Buckets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGenerated(dAtA, i, uint64(size))
+	if m.SecretKeyRef != nil {
+		{
+			size, err := m.SecretKeyRef.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
 			}
-			i--
-			dAtA[i] = 0x22
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0xa
 	}
-	i -= len(m.Value)
-	copy(dAtA[i:], m.Value)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Value)))
-	i--
-	dAtA[i] = 0x1a
 	return len(dAtA) - i, nil
 }
 
-func (m *Inputs) Marshal() (dAtA []byte, err error) {
+func (m *HTTPTemplate) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5089,34 +6273,35 @@ func (m *Inputs) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Inputs) MarshalTo(dAtA []byte) (int, error) {
+func (m *HTTPTemplate) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Inputs) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *HTTPTemplate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Artifacts) > 0 {
-		for iNdEx := len(m.Artifacts) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Artifacts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGenerated(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
+	i -= len(m.SuccessCondition)
+	copy(dAtA[i:], m.SuccessCondition)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.SuccessCondition)))
+	i--
+	dAtA[i] = 0x32
+	if m.TimeoutSeconds != nil {
+		i = encodeVarintGenerated(dAtA, i, uint64(*m.TimeoutSeconds))
+		i--
+		dAtA[i] = 0x28
 	}
-	if len(m.Parameters) > 0 {
-		for iNdEx := len(m.Parameters) - 1; iNdEx >= 0; iNdEx-- {
+	i -= len(m.Body)
+	copy(dAtA[i:], m.Body)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Body)))
+	i--
+	dAtA[i] = 0x22
+	if len(m.Headers) > 0 {
+		for iNdEx := len(m.Headers) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Parameters[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Headers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -5124,13 +6309,23 @@ func (m *Inputs) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenerated(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0xa
+			dAtA[i] = 0x1a
 		}
 	}
+	i -= len(m.URL)
+	copy(dAtA[i:], m.URL)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.URL)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Method)
+	copy(dAtA[i:], m.Method)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Method)))
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *Item) Marshal() (dAtA []byte, err error) {
+func (m *Header) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5140,26 +6335,195 @@ func (m *Item) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Item) MarshalTo(dAtA []byte) (int, error) {
+func (m *Header) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Item) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Header) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Value != nil {
-		i -= len(m.Value)
-		copy(dAtA[i:], m.Value)
-		i = encodeVarintGenerated(dAtA, i, uint64(len(m.Value)))
+	i -= len(m.Value)
+	copy(dAtA[i:], m.Value)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Value)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Name)
+	copy(dAtA[i:], m.Name)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *Histogram) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Histogram) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Histogram) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Buckets) > 0 {
+		for iNdEx := len(m.Buckets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Buckets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	i -= len(m.Value)
+	copy(dAtA[i:], m.Value)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Value)))
+	i--
+	dAtA[i] = 0x1a
+	return len(dAtA) - i, nil
+}
+
+func (m *Inputs) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Inputs) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Inputs) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Artifacts) > 0 {
+		for iNdEx := len(m.Artifacts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Artifacts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Parameters) > 0 {
+		for iNdEx := len(m.Parameters) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Parameters[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Item) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Item) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Item) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Value != nil {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintGenerated(dAtA, i, uint64(len(m.Value)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
+func (m *LifecycleHook) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LifecycleHook) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LifecycleHook) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.Expression)
+	copy(dAtA[i:], m.Expression)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Expression)))
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.Arguments.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Template)
+	copy(dAtA[i:], m.Template)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Template)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *Link) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -5218,6 +6582,11 @@ func (m *MemoizationStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	i -= len(m.CacheType)
+	copy(dAtA[i:], m.CacheType)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.CacheType)))
+	i--
+	dAtA[i] = 0x22
 	i -= len(m.CacheName)
 	copy(dAtA[i:], m.CacheName)
 	i = encodeVarintGenerated(dAtA, i, uint64(len(m.CacheName)))
@@ -6310,6 +7679,58 @@ func (m *ResourceTemplate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ResumeAction) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ResumeAction) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ResumeAction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.OutputParameters) > 0 {
+		for iNdEx := len(m.OutputParameters) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.OutputParameters[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	i -= len(m.NodeFieldSelector)
+	copy(dAtA[i:], m.NodeFieldSelector)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.NodeFieldSelector)))
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Workflows.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *RetryAffinity) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -6388,6 +7809,11 @@ func (m *RetryStrategy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	i -= len(m.Expression)
+	copy(dAtA[i:], m.Expression)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Expression)))
+	i--
+	dAtA[i] = 0x2a
 	if m.Affinity != nil {
 		{
 			size, err := m.Affinity.MarshalToSizedBuffer(dAtA[:i])
@@ -6567,6 +7993,34 @@ func (m *S3Bucket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *SQLCache) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SQLCache) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SQLCache) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.Name)
+	copy(dAtA[i:], m.Name)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *ScriptTemplate) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -6662,6 +8116,18 @@ func (m *SemaphoreRef) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Database != nil {
+		{
+			size, err := m.Database.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
 	if m.ConfigMapKeyRef != nil {
 		{
 			size, err := m.ConfigMapKeyRef.MarshalToSizedBuffer(dAtA[:i])
@@ -6792,7 +8258,7 @@ func (m *Sequence) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *Submit) Marshal() (dAtA []byte, err error) {
+func (m *StopAction) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6802,21 +8268,64 @@ func (m *Submit) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Submit) MarshalTo(dAtA []byte) (int, error) {
+func (m *StopAction) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Submit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *StopAction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size, err := m.ObjectMeta.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
+	i -= len(m.Message)
+	copy(dAtA[i:], m.Message)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Message)))
+	i--
+	dAtA[i] = 0x1a
+	i -= len(m.NodeFieldSelector)
+	copy(dAtA[i:], m.NodeFieldSelector)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.NodeFieldSelector)))
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Workflows.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *Submit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Submit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Submit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.ObjectMeta.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
 		i -= size
 		i = encodeVarintGenerated(dAtA, i, uint64(size))
 	}
@@ -6988,6 +8497,34 @@ func (m *SuspendTemplate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *SyncDatabaseRef) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SyncDatabaseRef) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SyncDatabaseRef) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.Key)
+	copy(dAtA[i:], m.Key)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Key)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *Synchronization) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -7008,6 +8545,34 @@ func (m *Synchronization) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Mutexes) > 0 {
+		for iNdEx := len(m.Mutexes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Mutexes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Semaphores) > 0 {
+		for iNdEx := len(m.Semaphores) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Semaphores[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if m.Mutex != nil {
 		{
 			size, err := m.Mutex.MarshalToSizedBuffer(dAtA[:i])
@@ -7168,6 +8733,62 @@ func (m *Template) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Workflow != nil {
+		{
+			size, err := m.Workflow.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xd2
+	}
+	if m.Data != nil {
+		{
+			size, err := m.Data.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xca
+	}
+	if m.ContainerSet != nil {
+		{
+			size, err := m.ContainerSet.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xc2
+	}
+	if m.HTTP != nil {
+		{
+			size, err := m.HTTP.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xba
+	}
 	i -= len(m.Timeout)
 	copy(dAtA[i:], m.Timeout)
 	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Timeout)))
@@ -7657,6 +9278,67 @@ func (m *TemplateRef) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *TerminateAction) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TerminateAction) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TerminateAction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Workflows.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *TransformationStep) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TransformationStep) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TransformationStep) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.Expression)
+	copy(dAtA[i:], m.Expression)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Expression)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *UserContainer) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -8021,6 +9703,42 @@ func (m *WorkflowEventBindingSpec) MarshalToSizedBuffer(dAtA []byte) (int, error
 	_ = i
 	var l int
 	_ = l
+	if m.Terminate != nil {
+		{
+			size, err := m.Terminate.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Stop != nil {
+		{
+			size, err := m.Stop.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Resume != nil {
+		{
+			size, err := m.Resume.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
 	if m.Submit != nil {
 		{
 			size, err := m.Submit.MarshalToSizedBuffer(dAtA[:i])
@@ -8113,6 +9831,51 @@ func (m *WorkflowSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Hooks) > 0 {
+		keysForHooks := make([]string, 0, len(m.Hooks))
+		for k := range m.Hooks {
+			keysForHooks = append(keysForHooks, string(k))
+		}
+		github_com_gogo_protobuf_sortkeys.Strings(keysForHooks)
+		for iNdEx := len(keysForHooks) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.Hooks[string(keysForHooks[iNdEx])]
+			baseI := i
+			{
+				size, err := (&v).MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+			i -= len(keysForHooks[iNdEx])
+			copy(dAtA[i:], keysForHooks[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(keysForHooks[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintGenerated(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0xba
+		}
+	}
+	if m.ArtifactGC != nil {
+		{
+			size, err := m.ArtifactGC.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xb2
+	}
 	if m.RetryStrategy != nil {
 		{
 			size, err := m.RetryStrategy.MarshalToSizedBuffer(dAtA[:i])
@@ -8784,11 +10547,40 @@ func (m *WorkflowStep) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	i -= len(m.OnExit)
-	copy(dAtA[i:], m.OnExit)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.OnExit)))
-	i--
-	dAtA[i] = 0x5a
+	if len(m.Hooks) > 0 {
+		keysForHooks := make([]string, 0, len(m.Hooks))
+		for k := range m.Hooks {
+			keysForHooks = append(keysForHooks, string(k))
+		}
+		github_com_gogo_protobuf_sortkeys.Strings(keysForHooks)
+		for iNdEx := len(keysForHooks) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.Hooks[string(keysForHooks[iNdEx])]
+			baseI := i
+			{
+				size, err := (&v).MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+			i -= len(keysForHooks[iNdEx])
+			copy(dAtA[i:], keysForHooks[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(keysForHooks[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintGenerated(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	i -= len(m.OnExit)
+	copy(dAtA[i:], m.OnExit)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.OnExit)))
+	i--
+	dAtA[i] = 0x5a
 	if m.ContinueOn != nil {
 		{
 			size, err := m.ContinueOn.MarshalToSizedBuffer(dAtA[:i])
@@ -9157,6 +10949,33 @@ func (m *Artifact) Size() (n int) {
 	l = len(m.SubPath)
 	n += 1 + l + sovGenerated(uint64(l))
 	n += 2
+	if m.ArtifactGC != nil {
+		l = m.ArtifactGC.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	n += 2
+	return n
+}
+
+func (m *ArtifactCache) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *ArtifactGC) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Strategy)
+	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
@@ -9201,6 +11020,10 @@ func (m *ArtifactLocation) Size() (n int) {
 		l = m.GCS.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	if m.Azure != nil {
+		l = m.Azure.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -9261,6 +11084,41 @@ func (m *ArtifactoryAuth) Size() (n int) {
 	return n
 }
 
+func (m *AzureArtifact) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.AzureBlobContainer.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Blob)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *AzureBlobContainer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Endpoint)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Container)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.AccountKeySecret != nil {
+		l = m.AccountKeySecret.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.SASTokenSecret != nil {
+		l = m.SASTokenSecret.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	n += 2
+	return n
+}
+
 func (m *Backoff) Size() (n int) {
 	if m == nil {
 		return 0
@@ -9288,6 +11146,29 @@ func (m *Cache) Size() (n int) {
 		l = m.ConfigMap.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	if m.SQL != nil {
+		l = m.SQL.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.Artifact != nil {
+		l = m.Artifact.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
+func (m *ChildWorkflowTemplate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.WorkflowTemplateRef != nil {
+		l = m.WorkflowTemplateRef.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	l = m.Arguments.Size()
+	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
@@ -9336,6 +11217,46 @@ func (m *Condition) Size() (n int) {
 	return n
 }
 
+func (m *ContainerNode) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Container.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Dependencies) > 0 {
+		for _, s := range m.Dependencies {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	l = m.Outputs.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *ContainerSetTemplate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Containers) > 0 {
+		for _, e := range m.Containers {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if len(m.VolumeMounts) > 0 {
+		for _, e := range m.VolumeMounts {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *ContinueOn) Size() (n int) {
 	if m == nil {
 		return 0
@@ -9500,6 +11421,15 @@ func (m *DAGTask) Size() (n int) {
 	n += 1 + l + sovGenerated(uint64(l))
 	l = len(m.Depends)
 	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Hooks) > 0 {
+		for k, v := range m.Hooks {
+			_ = k
+			_ = v
+			l = v.Size()
+			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + l + sovGenerated(uint64(l))
+			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
+		}
+	}
 	return n
 }
 
@@ -9523,6 +11453,36 @@ func (m *DAGTemplate) Size() (n int) {
 	return n
 }
 
+func (m *DataSource) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ArtifactPaths != nil {
+		l = m.ArtifactPaths.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
+func (m *DataTemplate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Source.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Transformation) > 0 {
+		for _, e := range m.Transformation {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *Event) Size() (n int) {
 	if m == nil {
 		return 0
@@ -9534,6 +11494,25 @@ func (m *Event) Size() (n int) {
 	return n
 }
 
+func (m *EventWorkflowSelector) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Labels) > 0 {
+		for k, v := range m.Labels {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + len(v) + sovGenerated(uint64(len(v)))
+			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
+		}
+	}
+	return n
+}
+
 func (m *ExecutorConfig) Size() (n int) {
 	if m == nil {
 		return 0
@@ -9699,6 +11678,62 @@ func (m *HTTPArtifact) Size() (n int) {
 	return n
 }
 
+func (m *HTTPHeader) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Value)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.ValueFrom != nil {
+		l = m.ValueFrom.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
+func (m *HTTPHeaderSource) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SecretKeyRef != nil {
+		l = m.SecretKeyRef.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
+func (m *HTTPTemplate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Method)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.URL)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Headers) > 0 {
+		for _, e := range m.Headers {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	l = len(m.Body)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.TimeoutSeconds != nil {
+		n += 1 + sovGenerated(uint64(*m.TimeoutSeconds))
+	}
+	l = len(m.SuccessCondition)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
 func (m *Header) Size() (n int) {
 	if m == nil {
 		return 0
@@ -9763,6 +11798,21 @@ func (m *Item) Size() (n int) {
 	return n
 }
 
+func (m *LifecycleHook) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Template)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.Arguments.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Expression)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
 func (m *Link) Size() (n int) {
 	if m == nil {
 		return 0
@@ -9789,6 +11839,8 @@ func (m *MemoizationStatus) Size() (n int) {
 	n += 1 + l + sovGenerated(uint64(l))
 	l = len(m.CacheName)
 	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.CacheType)
+	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
@@ -10202,6 +12254,25 @@ func (m *ResourceTemplate) Size() (n int) {
 	return n
 }
 
+func (m *ResumeAction) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Workflows.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.NodeFieldSelector)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.OutputParameters) > 0 {
+		for _, e := range m.OutputParameters {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *RetryAffinity) Size() (n int) {
 	if m == nil {
 		return 0
@@ -10244,6 +12315,8 @@ func (m *RetryStrategy) Size() (n int) {
 		l = m.Affinity.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	l = len(m.Expression)
+	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
@@ -10293,20 +12366,31 @@ func (m *S3Bucket) Size() (n int) {
 	return n
 }
 
-func (m *ScriptTemplate) Size() (n int) {
+func (m *SQLCache) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = m.Container.Size()
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Source)
+	l = len(m.Name)
 	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
-func (m *SemaphoreHolding) Size() (n int) {
+func (m *ScriptTemplate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Container.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Source)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *SemaphoreHolding) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -10333,6 +12417,10 @@ func (m *SemaphoreRef) Size() (n int) {
 		l = m.ConfigMapKeyRef.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	if m.Database != nil {
+		l = m.Database.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -10380,6 +12468,21 @@ func (m *Sequence) Size() (n int) {
 	return n
 }
 
+func (m *StopAction) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Workflows.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.NodeFieldSelector)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Message)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
 func (m *Submit) Size() (n int) {
 	if m == nil {
 		return 0
@@ -10450,6 +12553,17 @@ func (m *SuspendTemplate) Size() (n int) {
 	return n
 }
 
+func (m *SyncDatabaseRef) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Key)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
 func (m *Synchronization) Size() (n int) {
 	if m == nil {
 		return 0
@@ -10464,6 +12578,18 @@ func (m *Synchronization) Size() (n int) {
 		l = m.Mutex.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	if len(m.Semaphores) > 0 {
+		for _, e := range m.Semaphores {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if len(m.Mutexes) > 0 {
+		for _, e := range m.Mutexes {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -10658,6 +12784,22 @@ func (m *Template) Size() (n int) {
 	}
 	l = len(m.Timeout)
 	n += 2 + l + sovGenerated(uint64(l))
+	if m.HTTP != nil {
+		l = m.HTTP.Size()
+		n += 2 + l + sovGenerated(uint64(l))
+	}
+	if m.ContainerSet != nil {
+		l = m.ContainerSet.Size()
+		n += 2 + l + sovGenerated(uint64(l))
+	}
+	if m.Data != nil {
+		l = m.Data.Size()
+		n += 2 + l + sovGenerated(uint64(l))
+	}
+	if m.Workflow != nil {
+		l = m.Workflow.Size()
+		n += 2 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -10676,6 +12818,28 @@ func (m *TemplateRef) Size() (n int) {
 	return n
 }
 
+func (m *TerminateAction) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Workflows.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *TransformationStep) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Expression)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
 func (m *UserContainer) Size() (n int) {
 	if m == nil {
 		return 0
@@ -10810,6 +12974,18 @@ func (m *WorkflowEventBindingSpec) Size() (n int) {
 		l = m.Submit.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	if m.Resume != nil {
+		l = m.Resume.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.Stop != nil {
+		l = m.Stop.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.Terminate != nil {
+		l = m.Terminate.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -10976,6 +13152,19 @@ func (m *WorkflowSpec) Size() (n int) {
 		l = m.RetryStrategy.Size()
 		n += 2 + l + sovGenerated(uint64(l))
 	}
+	if m.ArtifactGC != nil {
+		l = m.ArtifactGC.Size()
+		n += 2 + l + sovGenerated(uint64(l))
+	}
+	if len(m.Hooks) > 0 {
+		for k, v := range m.Hooks {
+			_ = k
+			_ = v
+			l = v.Size()
+			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + l + sovGenerated(uint64(l))
+			n += mapEntrySize + 2 + sovGenerated(uint64(mapEntrySize))
+		}
+	}
 	return n
 }
 
@@ -11093,6 +13282,15 @@ func (m *WorkflowStep) Size() (n int) {
 	}
 	l = len(m.OnExit)
 	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Hooks) > 0 {
+		for k, v := range m.Hooks {
+			_ = k
+			_ = v
+			l = v.Size()
+			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + l + sovGenerated(uint64(l))
+			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
+		}
+	}
 	return n
 }
 
@@ -11226,6 +13424,28 @@ func (this *Artifact) String() string {
 		`Optional:` + fmt.Sprintf("%v", this.Optional) + `,`,
 		`SubPath:` + fmt.Sprintf("%v", this.SubPath) + `,`,
 		`RecurseMode:` + fmt.Sprintf("%v", this.RecurseMode) + `,`,
+		`ArtifactGC:` + strings.Replace(this.ArtifactGC.String(), "ArtifactGC", "ArtifactGC", 1) + `,`,
+		`Deleted:` + fmt.Sprintf("%v", this.Deleted) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ArtifactCache) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ArtifactCache{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ArtifactGC) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ArtifactGC{`,
+		`Strategy:` + fmt.Sprintf("%v", this.Strategy) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -11244,6 +13464,7 @@ func (this *ArtifactLocation) String() string {
 		`Raw:` + strings.Replace(this.Raw.String(), "RawArtifact", "RawArtifact", 1) + `,`,
 		`OSS:` + strings.Replace(this.OSS.String(), "OSSArtifact", "OSSArtifact", 1) + `,`,
 		`GCS:` + strings.Replace(this.GCS.String(), "GCSArtifact", "GCSArtifact", 1) + `,`,
+		`Azure:` + strings.Replace(this.Azure.String(), "AzureArtifact", "AzureArtifact", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -11270,6 +13491,31 @@ func (this *ArtifactoryAuth) String() string {
 	}, "")
 	return s
 }
+func (this *AzureArtifact) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&AzureArtifact{`,
+		`AzureBlobContainer:` + strings.Replace(strings.Replace(this.AzureBlobContainer.String(), "AzureBlobContainer", "AzureBlobContainer", 1), `&`, ``, 1) + `,`,
+		`Blob:` + fmt.Sprintf("%v", this.Blob) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *AzureBlobContainer) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&AzureBlobContainer{`,
+		`Endpoint:` + fmt.Sprintf("%v", this.Endpoint) + `,`,
+		`Container:` + fmt.Sprintf("%v", this.Container) + `,`,
+		`AccountKeySecret:` + strings.Replace(fmt.Sprintf("%v", this.AccountKeySecret), "SecretKeySelector", "v1.SecretKeySelector", 1) + `,`,
+		`SASTokenSecret:` + strings.Replace(fmt.Sprintf("%v", this.SASTokenSecret), "SecretKeySelector", "v1.SecretKeySelector", 1) + `,`,
+		`UseSDKCreds:` + fmt.Sprintf("%v", this.UseSDKCreds) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *Backoff) String() string {
 	if this == nil {
 		return "nil"
@@ -11288,6 +13534,19 @@ func (this *Cache) String() string {
 	}
 	s := strings.Join([]string{`&Cache{`,
 		`ConfigMap:` + strings.Replace(fmt.Sprintf("%v", this.ConfigMap), "ConfigMapKeySelector", "v1.ConfigMapKeySelector", 1) + `,`,
+		`SQL:` + strings.Replace(this.SQL.String(), "SQLCache", "SQLCache", 1) + `,`,
+		`Artifact:` + strings.Replace(this.Artifact.String(), "ArtifactCache", "ArtifactCache", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ChildWorkflowTemplate) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ChildWorkflowTemplate{`,
+		`WorkflowTemplateRef:` + strings.Replace(this.WorkflowTemplateRef.String(), "WorkflowTemplateRef", "WorkflowTemplateRef", 1) + `,`,
+		`Arguments:` + strings.Replace(strings.Replace(this.Arguments.String(), "Arguments", "Arguments", 1), `&`, ``, 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -11331,6 +13590,39 @@ func (this *Condition) String() string {
 	}, "")
 	return s
 }
+func (this *ContainerNode) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ContainerNode{`,
+		`Container:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Container), "Container", "v1.Container", 1), `&`, ``, 1) + `,`,
+		`Dependencies:` + fmt.Sprintf("%v", this.Dependencies) + `,`,
+		`Outputs:` + strings.Replace(strings.Replace(this.Outputs.String(), "Outputs", "Outputs", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ContainerSetTemplate) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForContainers := "[]ContainerNode{"
+	for _, f := range this.Containers {
+		repeatedStringForContainers += strings.Replace(strings.Replace(f.String(), "ContainerNode", "ContainerNode", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForContainers += "}"
+	repeatedStringForVolumeMounts := "[]VolumeMount{"
+	for _, f := range this.VolumeMounts {
+		repeatedStringForVolumeMounts += fmt.Sprintf("%v", f) + ","
+	}
+	repeatedStringForVolumeMounts += "}"
+	s := strings.Join([]string{`&ContainerSetTemplate{`,
+		`Containers:` + repeatedStringForContainers + `,`,
+		`VolumeMounts:` + repeatedStringForVolumeMounts + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *ContinueOn) String() string {
 	if this == nil {
 		return "nil"
@@ -11439,6 +13731,16 @@ func (this *DAGTask) String() string {
 		repeatedStringForWithItems += fmt.Sprintf("%v", f) + ","
 	}
 	repeatedStringForWithItems += "}"
+	keysForHooks := make([]string, 0, len(this.Hooks))
+	for k := range this.Hooks {
+		keysForHooks = append(keysForHooks, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForHooks)
+	mapStringForHooks := "map[string]LifecycleHook{"
+	for _, k := range keysForHooks {
+		mapStringForHooks += fmt.Sprintf("%v: %v,", k, this.Hooks[k])
+	}
+	mapStringForHooks += "}"
 	s := strings.Join([]string{`&DAGTask{`,
 		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
 		`Template:` + fmt.Sprintf("%v", this.Template) + `,`,
@@ -11452,6 +13754,7 @@ func (this *DAGTask) String() string {
 		`ContinueOn:` + strings.Replace(this.ContinueOn.String(), "ContinueOn", "ContinueOn", 1) + `,`,
 		`OnExit:` + fmt.Sprintf("%v", this.OnExit) + `,`,
 		`Depends:` + fmt.Sprintf("%v", this.Depends) + `,`,
+		`Hooks:` + mapStringForHooks + `,`,
 		`}`,
 	}, "")
 	return s
@@ -11473,6 +13776,32 @@ func (this *DAGTemplate) String() string {
 	}, "")
 	return s
 }
+func (this *DataSource) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DataSource{`,
+		`ArtifactPaths:` + strings.Replace(this.ArtifactPaths.String(), "ArtifactLocation", "ArtifactLocation", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DataTemplate) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForTransformation := "[]TransformationStep{"
+	for _, f := range this.Transformation {
+		repeatedStringForTransformation += strings.Replace(strings.Replace(f.String(), "TransformationStep", "TransformationStep", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForTransformation += "}"
+	s := strings.Join([]string{`&DataTemplate{`,
+		`Source:` + strings.Replace(strings.Replace(this.Source.String(), "DataSource", "DataSource", 1), `&`, ``, 1) + `,`,
+		`Transformation:` + repeatedStringForTransformation + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *Event) String() string {
 	if this == nil {
 		return "nil"
@@ -11483,6 +13812,27 @@ func (this *Event) String() string {
 	}, "")
 	return s
 }
+func (this *EventWorkflowSelector) String() string {
+	if this == nil {
+		return "nil"
+	}
+	keysForLabels := make([]string, 0, len(this.Labels))
+	for k := range this.Labels {
+		keysForLabels = append(keysForLabels, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForLabels)
+	mapStringForLabels := "map[string]string{"
+	for _, k := range keysForLabels {
+		mapStringForLabels += fmt.Sprintf("%v: %v,", k, this.Labels[k])
+	}
+	mapStringForLabels += "}"
+	s := strings.Join([]string{`&EventWorkflowSelector{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`Labels:` + mapStringForLabels + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *ExecutorConfig) String() string {
 	if this == nil {
 		return "nil"
@@ -11598,6 +13948,48 @@ func (this *HTTPArtifact) String() string {
 	}, "")
 	return s
 }
+func (this *HTTPHeader) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&HTTPHeader{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`Value:` + fmt.Sprintf("%v", this.Value) + `,`,
+		`ValueFrom:` + strings.Replace(this.ValueFrom.String(), "HTTPHeaderSource", "HTTPHeaderSource", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *HTTPHeaderSource) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&HTTPHeaderSource{`,
+		`SecretKeyRef:` + strings.Replace(fmt.Sprintf("%v", this.SecretKeyRef), "SecretKeySelector", "v1.SecretKeySelector", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *HTTPTemplate) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForHeaders := "[]HTTPHeader{"
+	for _, f := range this.Headers {
+		repeatedStringForHeaders += strings.Replace(strings.Replace(f.String(), "HTTPHeader", "HTTPHeader", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForHeaders += "}"
+	s := strings.Join([]string{`&HTTPTemplate{`,
+		`Method:` + fmt.Sprintf("%v", this.Method) + `,`,
+		`URL:` + fmt.Sprintf("%v", this.URL) + `,`,
+		`Headers:` + repeatedStringForHeaders + `,`,
+		`Body:` + fmt.Sprintf("%v", this.Body) + `,`,
+		`TimeoutSeconds:` + valueToStringGenerated(this.TimeoutSeconds) + `,`,
+		`SuccessCondition:` + fmt.Sprintf("%v", this.SuccessCondition) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *Header) String() string {
 	if this == nil {
 		return "nil"
@@ -11646,6 +14038,18 @@ func (this *Inputs) String() string {
 	}, "")
 	return s
 }
+func (this *LifecycleHook) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&LifecycleHook{`,
+		`Template:` + fmt.Sprintf("%v", this.Template) + `,`,
+		`Arguments:` + strings.Replace(strings.Replace(this.Arguments.String(), "Arguments", "Arguments", 1), `&`, ``, 1) + `,`,
+		`Expression:` + fmt.Sprintf("%v", this.Expression) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *Link) String() string {
 	if this == nil {
 		return "nil"
@@ -11666,6 +14070,7 @@ func (this *MemoizationStatus) String() string {
 		`Hit:` + fmt.Sprintf("%v", this.Hit) + `,`,
 		`Key:` + fmt.Sprintf("%v", this.Key) + `,`,
 		`CacheName:` + fmt.Sprintf("%v", this.CacheName) + `,`,
+		`CacheType:` + fmt.Sprintf("%v", this.CacheType) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -11979,6 +14384,23 @@ func (this *ResourceTemplate) String() string {
 	}, "")
 	return s
 }
+func (this *ResumeAction) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForOutputParameters := "[]Parameter{"
+	for _, f := range this.OutputParameters {
+		repeatedStringForOutputParameters += strings.Replace(strings.Replace(f.String(), "Parameter", "Parameter", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForOutputParameters += "}"
+	s := strings.Join([]string{`&ResumeAction{`,
+		`Workflows:` + strings.Replace(strings.Replace(this.Workflows.String(), "EventWorkflowSelector", "EventWorkflowSelector", 1), `&`, ``, 1) + `,`,
+		`NodeFieldSelector:` + fmt.Sprintf("%v", this.NodeFieldSelector) + `,`,
+		`OutputParameters:` + repeatedStringForOutputParameters + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *RetryAffinity) String() string {
 	if this == nil {
 		return "nil"
@@ -12007,6 +14429,7 @@ func (this *RetryStrategy) String() string {
 		`RetryPolicy:` + fmt.Sprintf("%v", this.RetryPolicy) + `,`,
 		`Backoff:` + strings.Replace(this.Backoff.String(), "Backoff", "Backoff", 1) + `,`,
 		`Affinity:` + strings.Replace(this.Affinity.String(), "RetryAffinity", "RetryAffinity", 1) + `,`,
+		`Expression:` + fmt.Sprintf("%v", this.Expression) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -12040,6 +14463,16 @@ func (this *S3Bucket) String() string {
 	}, "")
 	return s
 }
+func (this *SQLCache) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SQLCache{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *ScriptTemplate) String() string {
 	if this == nil {
 		return "nil"
@@ -12068,6 +14501,7 @@ func (this *SemaphoreRef) String() string {
 	}
 	s := strings.Join([]string{`&SemaphoreRef{`,
 		`ConfigMapKeyRef:` + strings.Replace(fmt.Sprintf("%v", this.ConfigMapKeyRef), "ConfigMapKeySelector", "v1.ConfigMapKeySelector", 1) + `,`,
+		`Database:` + strings.Replace(this.Database.String(), "SyncDatabaseRef", "SyncDatabaseRef", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -12106,6 +14540,18 @@ func (this *Sequence) String() string {
 	}, "")
 	return s
 }
+func (this *StopAction) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&StopAction{`,
+		`Workflows:` + strings.Replace(strings.Replace(this.Workflows.String(), "EventWorkflowSelector", "EventWorkflowSelector", 1), `&`, ``, 1) + `,`,
+		`NodeFieldSelector:` + fmt.Sprintf("%v", this.NodeFieldSelector) + `,`,
+		`Message:` + fmt.Sprintf("%v", this.Message) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *Submit) String() string {
 	if this == nil {
 		return "nil"
@@ -12156,13 +14602,35 @@ func (this *SuspendTemplate) String() string {
 	}, "")
 	return s
 }
-func (this *Synchronization) String() string {
+func (this *SyncDatabaseRef) String() string {
 	if this == nil {
 		return "nil"
 	}
-	s := strings.Join([]string{`&Synchronization{`,
+	s := strings.Join([]string{`&SyncDatabaseRef{`,
+		`Key:` + fmt.Sprintf("%v", this.Key) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Synchronization) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForSemaphores := "[]*SemaphoreRef{"
+	for _, f := range this.Semaphores {
+		repeatedStringForSemaphores += strings.Replace(f.String(), "SemaphoreRef", "SemaphoreRef", 1) + ","
+	}
+	repeatedStringForSemaphores += "}"
+	repeatedStringForMutexes := "[]*Mutex{"
+	for _, f := range this.Mutexes {
+		repeatedStringForMutexes += strings.Replace(f.String(), "Mutex", "Mutex", 1) + ","
+	}
+	repeatedStringForMutexes += "}"
+	s := strings.Join([]string{`&Synchronization{`,
 		`Semaphore:` + strings.Replace(this.Semaphore.String(), "SemaphoreRef", "SemaphoreRef", 1) + `,`,
 		`Mutex:` + strings.Replace(this.Mutex.String(), "Mutex", "Mutex", 1) + `,`,
+		`Semaphores:` + repeatedStringForSemaphores + `,`,
+		`Mutexes:` + repeatedStringForMutexes + `,`,
 		`}`,
 	}, "")
 	return s
@@ -12282,6 +14750,10 @@ func (this *Template) String() string {
 		`Synchronization:` + strings.Replace(this.Synchronization.String(), "Synchronization", "Synchronization", 1) + `,`,
 		`Memoize:` + strings.Replace(this.Memoize.String(), "Memoize", "Memoize", 1) + `,`,
 		`Timeout:` + fmt.Sprintf("%v", this.Timeout) + `,`,
+		`HTTP:` + strings.Replace(this.HTTP.String(), "HTTPTemplate", "HTTPTemplate", 1) + `,`,
+		`ContainerSet:` + strings.Replace(this.ContainerSet.String(), "ContainerSetTemplate", "ContainerSetTemplate", 1) + `,`,
+		`Data:` + strings.Replace(this.Data.String(), "DataTemplate", "DataTemplate", 1) + `,`,
+		`Workflow:` + strings.Replace(this.Workflow.String(), "ChildWorkflowTemplate", "ChildWorkflowTemplate", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -12299,6 +14771,26 @@ func (this *TemplateRef) String() string {
 	}, "")
 	return s
 }
+func (this *TerminateAction) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&TerminateAction{`,
+		`Workflows:` + strings.Replace(strings.Replace(this.Workflows.String(), "EventWorkflowSelector", "EventWorkflowSelector", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *TransformationStep) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&TransformationStep{`,
+		`Expression:` + fmt.Sprintf("%v", this.Expression) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *UserContainer) String() string {
 	if this == nil {
 		return "nil"
@@ -12399,6 +14891,9 @@ func (this *WorkflowEventBindingSpec) String() string {
 	s := strings.Join([]string{`&WorkflowEventBindingSpec{`,
 		`Event:` + strings.Replace(strings.Replace(this.Event.String(), "Event", "Event", 1), `&`, ``, 1) + `,`,
 		`Submit:` + strings.Replace(this.Submit.String(), "Submit", "Submit", 1) + `,`,
+		`Resume:` + strings.Replace(this.Resume.String(), "ResumeAction", "ResumeAction", 1) + `,`,
+		`Stop:` + strings.Replace(this.Stop.String(), "StopAction", "StopAction", 1) + `,`,
+		`Terminate:` + strings.Replace(this.Terminate.String(), "TerminateAction", "TerminateAction", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -12463,6 +14958,16 @@ func (this *WorkflowSpec) String() string {
 		mapStringForNodeSelector += fmt.Sprintf("%v: %v,", k, this.NodeSelector[k])
 	}
 	mapStringForNodeSelector += "}"
+	keysForHooks := make([]string, 0, len(this.Hooks))
+	for k := range this.Hooks {
+		keysForHooks = append(keysForHooks, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForHooks)
+	mapStringForHooks := "map[string]LifecycleHook{"
+	for _, k := range keysForHooks {
+		mapStringForHooks += fmt.Sprintf("%v: %v,", k, this.Hooks[k])
+	}
+	mapStringForHooks += "}"
 	s := strings.Join([]string{`&WorkflowSpec{`,
 		`Templates:` + repeatedStringForTemplates + `,`,
 		`Entrypoint:` + fmt.Sprintf("%v", this.Entrypoint) + `,`,
@@ -12501,6 +15006,8 @@ func (this *WorkflowSpec) String() string {
 		`Synchronization:` + strings.Replace(this.Synchronization.String(), "Synchronization", "Synchronization", 1) + `,`,
 		`VolumeClaimGC:` + strings.Replace(this.VolumeClaimGC.String(), "VolumeClaimGC", "VolumeClaimGC", 1) + `,`,
 		`RetryStrategy:` + strings.Replace(this.RetryStrategy.String(), "RetryStrategy", "RetryStrategy", 1) + `,`,
+		`ArtifactGC:` + strings.Replace(this.ArtifactGC.String(), "ArtifactGC", "ArtifactGC", 1) + `,`,
+		`Hooks:` + mapStringForHooks + `,`,
 		`}`,
 	}, "")
 	return s
@@ -12580,6 +15087,16 @@ func (this *WorkflowStep) String() string {
 		repeatedStringForWithItems += fmt.Sprintf("%v", f) + ","
 	}
 	repeatedStringForWithItems += "}"
+	keysForHooks := make([]string, 0, len(this.Hooks))
+	for k := range this.Hooks {
+		keysForHooks = append(keysForHooks, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForHooks)
+	mapStringForHooks := "map[string]LifecycleHook{"
+	for _, k := range keysForHooks {
+		mapStringForHooks += fmt.Sprintf("%v: %v,", k, this.Hooks[k])
+	}
+	mapStringForHooks += "}"
 	s := strings.Join([]string{`&WorkflowStep{`,
 		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
 		`Template:` + fmt.Sprintf("%v", this.Template) + `,`,
@@ -12591,6 +15108,7 @@ func (this *WorkflowStep) String() string {
 		`When:` + fmt.Sprintf("%v", this.When) + `,`,
 		`ContinueOn:` + strings.Replace(this.ContinueOn.String(), "ContinueOn", "ContinueOn", 1) + `,`,
 		`OnExit:` + fmt.Sprintf("%v", this.OnExit) + `,`,
+		`Hooks:` + mapStringForHooks + `,`,
 		`}`,
 	}, "")
 	return s
@@ -13346,6 +15864,232 @@ func (m *Artifact) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.RecurseMode = bool(v != 0)
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ArtifactGC", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ArtifactGC == nil {
+				m.ArtifactGC = &ArtifactGC{}
+			}
+			if err := m.ArtifactGC.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deleted", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Deleted = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ArtifactCache) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ArtifactCache: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ArtifactCache: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ArtifactGC) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ArtifactGC: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ArtifactGC: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Strategy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Strategy = ArtifactGCStrategy(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -13708,17 +16452,53 @@ func (m *ArtifactLocation) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthGenerated
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Azure", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Azure == nil {
+				m.Azure = &AzureArtifact{}
+			}
+			if err := m.Azure.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGenerated
 			}
 			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
@@ -14230,7 +17010,7 @@ func (m *ArtifactoryAuth) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Backoff) Unmarshal(dAtA []byte) error {
+func (m *AzureArtifact) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14253,47 +17033,15 @@ func (m *Backoff) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Backoff: wiretype end group for non-group")
+			return fmt.Errorf("proto: AzureArtifact: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Backoff: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AzureArtifact: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Duration = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Factor", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AzureBlobContainer", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14320,16 +17068,13 @@ func (m *Backoff) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Factor == nil {
-				m.Factor = &intstr.IntOrString{}
-			}
-			if err := m.Factor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.AzureBlobContainer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxDuration", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Blob", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -14357,7 +17102,7 @@ func (m *Backoff) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MaxDuration = string(dAtA[iNdEx:postIndex])
+			m.Blob = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -14383,7 +17128,7 @@ func (m *Backoff) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Cache) Unmarshal(dAtA []byte) error {
+func (m *AzureBlobContainer) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14406,17 +17151,17 @@ func (m *Cache) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Cache: wiretype end group for non-group")
+			return fmt.Errorf("proto: AzureBlobContainer: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Cache: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AzureBlobContainer: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConfigMap", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Endpoint", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -14426,84 +17171,59 @@ func (m *Cache) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ConfigMap == nil {
-				m.ConfigMap = &v1.ConfigMapKeySelector{}
-			}
-			if err := m.ConfigMap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Endpoint = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Container", wireType)
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthGenerated
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ClusterWorkflowTemplate) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ClusterWorkflowTemplate: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ClusterWorkflowTemplate: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Container = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountKeySecret", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14530,13 +17250,16 @@ func (m *ClusterWorkflowTemplate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.AccountKeySecret == nil {
+				m.AccountKeySecret = &v1.SecretKeySelector{}
+			}
+			if err := m.AccountKeySecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SASTokenSecret", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14563,10 +17286,33 @@ func (m *ClusterWorkflowTemplate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.SASTokenSecret == nil {
+				m.SASTokenSecret = &v1.SecretKeySelector{}
+			}
+			if err := m.SASTokenSecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UseSDKCreds", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.UseSDKCreds = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -14591,7 +17337,7 @@ func (m *ClusterWorkflowTemplate) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ClusterWorkflowTemplateList) Unmarshal(dAtA []byte) error {
+func (m *Backoff) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14614,17 +17360,17 @@ func (m *ClusterWorkflowTemplateList) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ClusterWorkflowTemplateList: wiretype end group for non-group")
+			return fmt.Errorf("proto: Backoff: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ClusterWorkflowTemplateList: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Backoff: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -14634,28 +17380,27 @@ func (m *ClusterWorkflowTemplateList) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Duration = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Factor", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14682,36 +17427,70 @@ func (m *ClusterWorkflowTemplateList) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Items = append(m.Items, ClusterWorkflowTemplate{})
-			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if m.Factor == nil {
+				m.Factor = &intstr.IntOrString{}
 			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
+			if err := m.Factor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxDuration", wireType)
 			}
-			iNdEx += skippy
-		}
-	}
-
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MaxDuration = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
 	if iNdEx > l {
 		return io.ErrUnexpectedEOF
 	}
 	return nil
 }
-func (m *Condition) Unmarshal(dAtA []byte) error {
+func (m *Cache) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14734,17 +17513,17 @@ func (m *Condition) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Condition: wiretype end group for non-group")
+			return fmt.Errorf("proto: Cache: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Condition: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Cache: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConfigMap", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -14754,29 +17533,33 @@ func (m *Condition) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Type = ConditionType(dAtA[iNdEx:postIndex])
+			if m.ConfigMap == nil {
+				m.ConfigMap = &v1.ConfigMapKeySelector{}
+			}
+			if err := m.ConfigMap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SQL", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -14786,29 +17569,33 @@ func (m *Condition) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Status = k8s_io_apimachinery_pkg_apis_meta_v1.ConditionStatus(dAtA[iNdEx:postIndex])
+			if m.SQL == nil {
+				m.SQL = &SQLCache{}
+			}
+			if err := m.SQL.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Artifact", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -14818,23 +17605,27 @@ func (m *Condition) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Message = string(dAtA[iNdEx:postIndex])
+			if m.Artifact == nil {
+				m.Artifact = &ArtifactCache{}
+			}
+			if err := m.Artifact.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -14860,7 +17651,7 @@ func (m *Condition) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ContinueOn) Unmarshal(dAtA []byte) error {
+func (m *ChildWorkflowTemplate) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14883,17 +17674,17 @@ func (m *ContinueOn) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ContinueOn: wiretype end group for non-group")
+			return fmt.Errorf("proto: ChildWorkflowTemplate: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ContinueOn: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ChildWorkflowTemplate: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowTemplateRef", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -14903,17 +17694,33 @@ func (m *ContinueOn) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Error = bool(v != 0)
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.WorkflowTemplateRef == nil {
+				m.WorkflowTemplateRef = &WorkflowTemplateRef{}
+			}
+			if err := m.WorkflowTemplateRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Failed", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Arguments", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -14923,12 +17730,25 @@ func (m *ContinueOn) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Failed = bool(v != 0)
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Arguments.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -14953,7 +17773,7 @@ func (m *ContinueOn) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Counter) Unmarshal(dAtA []byte) error {
+func (m *ClusterWorkflowTemplate) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14976,17 +17796,17 @@ func (m *Counter) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Counter: wiretype end group for non-group")
+			return fmt.Errorf("proto: ClusterWorkflowTemplate: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Counter: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ClusterWorkflowTemplate: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -14996,82 +17816,30 @@ func (m *Counter) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
+			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *CreateS3BucketOptions) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: CreateS3BucketOptions: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CreateS3BucketOptions: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectLocking", wireType)
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -15081,12 +17849,25 @@ func (m *CreateS3BucketOptions) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.ObjectLocking = bool(v != 0)
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -15111,7 +17892,7 @@ func (m *CreateS3BucketOptions) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CronWorkflow) Unmarshal(dAtA []byte) error {
+func (m *ClusterWorkflowTemplateList) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -15134,15 +17915,15 @@ func (m *CronWorkflow) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CronWorkflow: wiretype end group for non-group")
+			return fmt.Errorf("proto: ClusterWorkflowTemplateList: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CronWorkflow: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ClusterWorkflowTemplateList: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -15169,46 +17950,13 @@ func (m *CronWorkflow) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -15235,7 +17983,8 @@ func (m *CronWorkflow) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Items = append(m.Items, ClusterWorkflowTemplate{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -15263,7 +18012,7 @@ func (m *CronWorkflow) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CronWorkflowList) Unmarshal(dAtA []byte) error {
+func (m *Condition) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -15286,17 +18035,17 @@ func (m *CronWorkflowList) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CronWorkflowList: wiretype end group for non-group")
+			return fmt.Errorf("proto: Condition: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CronWorkflowList: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Condition: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -15306,30 +18055,29 @@ func (m *CronWorkflowList) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Type = ConditionType(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -15339,25 +18087,55 @@ func (m *CronWorkflowList) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Items = append(m.Items, CronWorkflow{})
-			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Status = k8s_io_apimachinery_pkg_apis_meta_v1.ConditionStatus(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.Message = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -15383,7 +18161,7 @@ func (m *CronWorkflowList) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CronWorkflowSpec) Unmarshal(dAtA []byte) error {
+func (m *ContainerNode) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -15406,15 +18184,15 @@ func (m *CronWorkflowSpec) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CronWorkflowSpec: wiretype end group for non-group")
+			return fmt.Errorf("proto: ContainerNode: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CronWorkflowSpec: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ContainerNode: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowSpec", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Container", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -15441,13 +18219,13 @@ func (m *CronWorkflowSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.WorkflowSpec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Container.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Schedule", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Dependencies", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -15475,13 +18253,13 @@ func (m *CronWorkflowSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Schedule = string(dAtA[iNdEx:postIndex])
+			m.Dependencies = append(m.Dependencies, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConcurrencyPolicy", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Outputs", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -15491,69 +18269,83 @@ func (m *CronWorkflowSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ConcurrencyPolicy = ConcurrencyPolicy(dAtA[iNdEx:postIndex])
+			if err := m.Outputs.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Suspend", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			m.Suspend = bool(v != 0)
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartingDeadlineSeconds", wireType)
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			var v int64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			m.StartingDeadlineSeconds = &v
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SuccessfulJobsHistoryLimit", wireType)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ContainerSetTemplate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
 			}
-			var v int32
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ContainerSetTemplate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ContainerSetTemplate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Containers", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -15563,17 +18355,31 @@ func (m *CronWorkflowSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.SuccessfulJobsHistoryLimit = &v
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FailedJobsHistoryLimit", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			var v int32
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Containers = append(m.Containers, ContainerNode{})
+			if err := m.Containers[len(m.Containers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VolumeMounts", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -15583,65 +18389,13 @@ func (m *CronWorkflowSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.FailedJobsHistoryLimit = &v
-		case 8:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Timezone", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Timezone = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 9:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowMetadata", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
 			}
 			postIndex := iNdEx + msglen
 			if postIndex < 0 {
@@ -15650,10 +18404,8 @@ func (m *CronWorkflowSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.WorkflowMetadata == nil {
-				m.WorkflowMetadata = &v11.ObjectMeta{}
-			}
-			if err := m.WorkflowMetadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.VolumeMounts = append(m.VolumeMounts, v1.VolumeMount{})
+			if err := m.VolumeMounts[len(m.VolumeMounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -15681,7 +18433,7 @@ func (m *CronWorkflowSpec) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CronWorkflowStatus) Unmarshal(dAtA []byte) error {
+func (m *ContinueOn) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -15704,17 +18456,17 @@ func (m *CronWorkflowStatus) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CronWorkflowStatus: wiretype end group for non-group")
+			return fmt.Errorf("proto: ContinueOn: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CronWorkflowStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ContinueOn: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Active", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -15724,31 +18476,17 @@ func (m *CronWorkflowStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Active = append(m.Active, v1.ObjectReference{})
-			if err := m.Active[len(m.Active)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			m.Error = bool(v != 0)
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastScheduledTime", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Failed", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -15758,33 +18496,70 @@ func (m *CronWorkflowStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			m.Failed = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.LastScheduledTime == nil {
-				m.LastScheduledTime = &v11.Time{}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Counter) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
 			}
-			if err := m.LastScheduledTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			iNdEx = postIndex
-		case 3:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Counter: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Counter: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Conditions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -15794,25 +18569,23 @@ func (m *CronWorkflowStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Conditions = append(m.Conditions, Condition{})
-			if err := m.Conditions[len(m.Conditions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Value = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -15838,7 +18611,7 @@ func (m *CronWorkflowStatus) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DAGTask) Unmarshal(dAtA []byte) error {
+func (m *CreateS3BucketOptions) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -15861,17 +18634,17 @@ func (m *DAGTask) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DAGTask: wiretype end group for non-group")
+			return fmt.Errorf("proto: CreateS3BucketOptions: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DAGTask: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CreateS3BucketOptions: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectLocking", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -15881,29 +18654,70 @@ func (m *DAGTask) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			m.ObjectLocking = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CronWorkflow) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CronWorkflow: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CronWorkflow: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Template", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -15913,27 +18727,28 @@ func (m *DAGTask) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Template = string(dAtA[iNdEx:postIndex])
+			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Arguments", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -15960,13 +18775,13 @@ func (m *DAGTask) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Arguments.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TemplateRef", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -15993,18 +18808,68 @@ func (m *DAGTask) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.TemplateRef == nil {
-				m.TemplateRef = &TemplateRef{}
-			}
-			if err := m.TemplateRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CronWorkflowList) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CronWorkflowList: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CronWorkflowList: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Dependencies", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -16014,27 +18879,28 @@ func (m *DAGTask) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Dependencies = append(m.Dependencies, string(dAtA[iNdEx:postIndex]))
+			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 6:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WithItems", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -16061,16 +18927,69 @@ func (m *DAGTask) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.WithItems = append(m.WithItems, Item{})
-			if err := m.WithItems[len(m.WithItems)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Items = append(m.Items, CronWorkflow{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CronWorkflowSpec) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CronWorkflowSpec: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CronWorkflowSpec: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WithParam", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowSpec", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -16080,29 +18999,30 @@ func (m *DAGTask) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.WithParam = string(dAtA[iNdEx:postIndex])
+			if err := m.WorkflowSpec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 8:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WithSequence", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Schedule", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -16112,31 +19032,27 @@ func (m *DAGTask) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.WithSequence == nil {
-				m.WithSequence = &Sequence{}
-			}
-			if err := m.WithSequence.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Schedule = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 9:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field When", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConcurrencyPolicy", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -16164,13 +19080,13 @@ func (m *DAGTask) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.When = string(dAtA[iNdEx:postIndex])
+			m.ConcurrencyPolicy = ConcurrencyPolicy(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 10:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContinueOn", wireType)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Suspend", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -16180,33 +19096,57 @@ func (m *DAGTask) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
+			m.Suspend = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartingDeadlineSeconds", wireType)
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			var v int64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if m.ContinueOn == nil {
-				m.ContinueOn = &ContinueOn{}
+			m.StartingDeadlineSeconds = &v
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SuccessfulJobsHistoryLimit", wireType)
 			}
-			if err := m.ContinueOn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
-		case 11:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OnExit", wireType)
+			m.SuccessfulJobsHistoryLimit = &v
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FailedJobsHistoryLimit", wireType)
 			}
-			var stringLen uint64
+			var v int32
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -16216,14 +19156,1657 @@ func (m *DAGTask) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
+			m.FailedJobsHistoryLimit = &v
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timezone", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Timezone = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowMetadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.WorkflowMetadata == nil {
+				m.WorkflowMetadata = &v11.ObjectMeta{}
+			}
+			if err := m.WorkflowMetadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CronWorkflowStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CronWorkflowStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CronWorkflowStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Active", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Active = append(m.Active, v1.ObjectReference{})
+			if err := m.Active[len(m.Active)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastScheduledTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LastScheduledTime == nil {
+				m.LastScheduledTime = &v11.Time{}
+			}
+			if err := m.LastScheduledTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Conditions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Conditions = append(m.Conditions, Condition{})
+			if err := m.Conditions[len(m.Conditions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DAGTask) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DAGTask: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DAGTask: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Template", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Template = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Arguments", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Arguments.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TemplateRef", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TemplateRef == nil {
+				m.TemplateRef = &TemplateRef{}
+			}
+			if err := m.TemplateRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Dependencies", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Dependencies = append(m.Dependencies, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithItems", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WithItems = append(m.WithItems, Item{})
+			if err := m.WithItems[len(m.WithItems)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithParam", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WithParam = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithSequence", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.WithSequence == nil {
+				m.WithSequence = &Sequence{}
+			}
+			if err := m.WithSequence.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field When", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.When = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContinueOn", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ContinueOn == nil {
+				m.ContinueOn = &ContinueOn{}
+			}
+			if err := m.ContinueOn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OnExit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OnExit = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Depends", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Depends = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hooks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Hooks == nil {
+				m.Hooks = make(map[string]LifecycleHook)
+			}
+			var mapkey string
+			mapvalue := &LifecycleHook{}
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &LifecycleHook{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Hooks[mapkey] = *mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DAGTemplate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DAGTemplate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DAGTemplate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Target", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Target = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tasks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tasks = append(m.Tasks, DAGTask{})
+			if err := m.Tasks[len(m.Tasks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FailFast", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			b := bool(v != 0)
+			m.FailFast = &b
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DataSource) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DataSource: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DataSource: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ArtifactPaths", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ArtifactPaths == nil {
+				m.ArtifactPaths = &ArtifactLocation{}
+			}
+			if err := m.ArtifactPaths.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DataTemplate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DataTemplate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DataTemplate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Source.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Transformation", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Transformation = append(m.Transformation, TransformationStep{})
+			if err := m.Transformation[len(m.Transformation)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Event) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Event: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Event: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Selector", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Selector = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventWorkflowSelector) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventWorkflowSelector: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventWorkflowSelector: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Labels == nil {
+				m.Labels = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Labels[mapkey] = mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ExecutorConfig) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExecutorConfig: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExecutorConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ServiceAccountName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ServiceAccountName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GCSArtifact) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GCSArtifact: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GCSArtifact: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GCSBucket", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.GCSBucket.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
 			}
 			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
@@ -16232,11 +20815,185 @@ func (m *DAGTask) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OnExit = string(dAtA[iNdEx:postIndex])
+			m.Key = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 12:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GCSBucket) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GCSBucket: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GCSBucket: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bucket", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Bucket = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ServiceAccountKeySecret", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ServiceAccountKeySecret == nil {
+				m.ServiceAccountKeySecret = &v1.SecretKeySelector{}
+			}
+			if err := m.ServiceAccountKeySecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Gauge) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Gauge: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Gauge: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Depends", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -16264,8 +21021,29 @@ func (m *DAGTask) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Depends = string(dAtA[iNdEx:postIndex])
+			m.Value = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Realtime", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			b := bool(v != 0)
+			m.Realtime = &b
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -16290,7 +21068,7 @@ func (m *DAGTask) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DAGTemplate) Unmarshal(dAtA []byte) error {
+func (m *GitArtifact) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -16313,15 +21091,15 @@ func (m *DAGTemplate) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DAGTemplate: wiretype end group for non-group")
+			return fmt.Errorf("proto: GitArtifact: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DAGTemplate: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GitArtifact: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Target", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -16349,13 +21127,13 @@ func (m *DAGTemplate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Target = string(dAtA[iNdEx:postIndex])
+			m.Repo = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tasks", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -16365,31 +21143,29 @@ func (m *DAGTemplate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Tasks = append(m.Tasks, DAGTask{})
-			if err := m.Tasks[len(m.Tasks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Revision = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FailFast", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Depth", wireType)
 			}
-			var v int
+			var v uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -16399,69 +21175,15 @@ func (m *DAGTemplate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				v |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			b := bool(v != 0)
-			m.FailFast = &b
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Event) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Event: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Event: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Depth = &v
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Selector", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Fetch", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -16489,66 +21211,85 @@ func (m *Event) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Selector = string(dAtA[iNdEx:postIndex])
+			m.Fetch = append(m.Fetch, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UsernameSecret", wireType)
 			}
-			if skippy < 0 {
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if (iNdEx + skippy) < 0 {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ExecutorConfig) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
+			if m.UsernameSecret == nil {
+				m.UsernameSecret = &v1.SecretKeySelector{}
 			}
-			if iNdEx >= l {
+			if err := m.UsernameSecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PasswordSecret", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if m.PasswordSecret == nil {
+				m.PasswordSecret = &v1.SecretKeySelector{}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ExecutorConfig: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ExecutorConfig: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			if err := m.PasswordSecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ServiceAccountName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SSHPrivateKeySecret", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -16558,24 +21299,48 @@ func (m *ExecutorConfig) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ServiceAccountName = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			if m.SSHPrivateKeySecret == nil {
+				m.SSHPrivateKeySecret = &v1.SecretKeySelector{}
+			}
+			if err := m.SSHPrivateKeySecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InsecureIgnoreHostKey", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.InsecureIgnoreHostKey = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -16600,7 +21365,7 @@ func (m *ExecutorConfig) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GCSArtifact) Unmarshal(dAtA []byte) error {
+func (m *HDFSArtifact) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -16623,15 +21388,15 @@ func (m *GCSArtifact) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GCSArtifact: wiretype end group for non-group")
+			return fmt.Errorf("proto: HDFSArtifact: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GCSArtifact: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: HDFSArtifact: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GCSBucket", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HDFSConfig", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -16658,13 +21423,13 @@ func (m *GCSArtifact) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.GCSBucket.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.HDFSConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -16692,8 +21457,28 @@ func (m *GCSArtifact) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Key = string(dAtA[iNdEx:postIndex])
+			m.Path = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Force", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Force = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -16718,7 +21503,7 @@ func (m *GCSArtifact) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GCSBucket) Unmarshal(dAtA []byte) error {
+func (m *HDFSConfig) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -16741,17 +21526,17 @@ func (m *GCSBucket) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GCSBucket: wiretype end group for non-group")
+			return fmt.Errorf("proto: HDFSConfig: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GCSBucket: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: HDFSConfig: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Bucket", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HDFSKrbConfig", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -16761,29 +21546,30 @@ func (m *GCSBucket) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Bucket = string(dAtA[iNdEx:postIndex])
+			if err := m.HDFSKrbConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ServiceAccountKeySecret", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -16793,84 +21579,27 @@ func (m *GCSBucket) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ServiceAccountKeySecret == nil {
-				m.ServiceAccountKeySecret = &v1.SecretKeySelector{}
-			}
-			if err := m.ServiceAccountKeySecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Addresses = append(m.Addresses, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Gauge) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Gauge: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Gauge: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HDFSUser", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -16898,29 +21627,8 @@ func (m *Gauge) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = string(dAtA[iNdEx:postIndex])
+			m.HDFSUser = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Realtime", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			b := bool(v != 0)
-			m.Realtime = &b
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -16945,7 +21653,7 @@ func (m *Gauge) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GitArtifact) Unmarshal(dAtA []byte) error {
+func (m *HDFSKrbConfig) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -16968,17 +21676,17 @@ func (m *GitArtifact) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GitArtifact: wiretype end group for non-group")
+			return fmt.Errorf("proto: HDFSKrbConfig: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GitArtifact: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: HDFSKrbConfig: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field KrbCCacheSecret", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -16988,29 +21696,33 @@ func (m *GitArtifact) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Repo = string(dAtA[iNdEx:postIndex])
+			if m.KrbCCacheSecret == nil {
+				m.KrbCCacheSecret = &v1.SecretKeySelector{}
+			}
+			if err := m.KrbCCacheSecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field KrbKeytabSecret", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -17020,47 +21732,31 @@ func (m *GitArtifact) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Revision = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Depth", wireType)
+			if m.KrbKeytabSecret == nil {
+				m.KrbKeytabSecret = &v1.SecretKeySelector{}
 			}
-			var v uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if err := m.KrbKeytabSecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.Depth = &v
-		case 4:
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Fetch", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field KrbUsername", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -17088,49 +21784,13 @@ func (m *GitArtifact) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Fetch = append(m.Fetch, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UsernameSecret", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.UsernameSecret == nil {
-				m.UsernameSecret = &v1.SecretKeySelector{}
-			}
-			if err := m.UsernameSecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.KrbUsername = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PasswordSecret", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field KrbRealm", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -17140,31 +21800,27 @@ func (m *GitArtifact) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.PasswordSecret == nil {
-				m.PasswordSecret = &v1.SecretKeySelector{}
-			}
-			if err := m.PasswordSecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.KrbRealm = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SSHPrivateKeySecret", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field KrbConfigConfigMap", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -17191,18 +21847,18 @@ func (m *GitArtifact) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SSHPrivateKeySecret == nil {
-				m.SSHPrivateKeySecret = &v1.SecretKeySelector{}
+			if m.KrbConfigConfigMap == nil {
+				m.KrbConfigConfigMap = &v1.ConfigMapKeySelector{}
 			}
-			if err := m.SSHPrivateKeySecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.KrbConfigConfigMap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InsecureIgnoreHostKey", wireType)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KrbServicePrincipalName", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -17212,12 +21868,24 @@ func (m *GitArtifact) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.InsecureIgnoreHostKey = bool(v != 0)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KrbServicePrincipalName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -17242,7 +21910,7 @@ func (m *GitArtifact) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *HDFSArtifact) Unmarshal(dAtA []byte) error {
+func (m *HTTPArtifact) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -17265,17 +21933,17 @@ func (m *HDFSArtifact) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: HDFSArtifact: wiretype end group for non-group")
+			return fmt.Errorf("proto: HTTPArtifact: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: HDFSArtifact: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: HTTPArtifact: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HDFSConfig", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -17285,30 +21953,29 @@ func (m *HDFSArtifact) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.HDFSConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.URL = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Headers", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -17318,44 +21985,26 @@ func (m *HDFSArtifact) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Path = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Force", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			m.Headers = append(m.Headers, Header{})
+			if err := m.Headers[len(m.Headers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.Force = bool(v != 0)
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -17380,7 +22029,7 @@ func (m *HDFSArtifact) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *HDFSConfig) Unmarshal(dAtA []byte) error {
+func (m *HTTPHeader) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -17403,17 +22052,17 @@ func (m *HDFSConfig) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: HDFSConfig: wiretype end group for non-group")
+			return fmt.Errorf("proto: HTTPHeader: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: HDFSConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: HTTPHeader: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HDFSKrbConfig", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -17423,28 +22072,27 @@ func (m *HDFSConfig) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.HDFSKrbConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -17472,13 +22120,13 @@ func (m *HDFSConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Addresses = append(m.Addresses, string(dAtA[iNdEx:postIndex]))
+			m.Value = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HDFSUser", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValueFrom", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -17488,23 +22136,27 @@ func (m *HDFSConfig) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.HDFSUser = string(dAtA[iNdEx:postIndex])
+			if m.ValueFrom == nil {
+				m.ValueFrom = &HTTPHeaderSource{}
+			}
+			if err := m.ValueFrom.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -17530,7 +22182,7 @@ func (m *HDFSConfig) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *HDFSKrbConfig) Unmarshal(dAtA []byte) error {
+func (m *HTTPHeaderSource) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -17553,15 +22205,15 @@ func (m *HDFSKrbConfig) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: HDFSKrbConfig: wiretype end group for non-group")
+			return fmt.Errorf("proto: HTTPHeaderSource: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: HDFSKrbConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: HTTPHeaderSource: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KrbCCacheSecret", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SecretKeyRef", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -17588,18 +22240,71 @@ func (m *HDFSKrbConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.KrbCCacheSecret == nil {
-				m.KrbCCacheSecret = &v1.SecretKeySelector{}
+			if m.SecretKeyRef == nil {
+				m.SecretKeyRef = &v1.SecretKeySelector{}
 			}
-			if err := m.KrbCCacheSecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.SecretKeyRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HTTPTemplate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HTTPTemplate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HTTPTemplate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KrbKeytabSecret", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Method", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -17609,33 +22314,61 @@ func (m *HDFSKrbConfig) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.KrbKeytabSecret == nil {
-				m.KrbKeytabSecret = &v1.SecretKeySelector{}
+			m.Method = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
 			}
-			if err := m.KrbKeytabSecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
 			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.URL = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KrbUsername", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Headers", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -17645,27 +22378,29 @@ func (m *HDFSKrbConfig) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.KrbUsername = string(dAtA[iNdEx:postIndex])
+			m.Headers = append(m.Headers, HTTPHeader{})
+			if err := m.Headers[len(m.Headers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KrbRealm", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Body", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -17693,13 +22428,13 @@ func (m *HDFSKrbConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.KrbRealm = string(dAtA[iNdEx:postIndex])
+			m.Body = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KrbConfigConfigMap", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutSeconds", wireType)
 			}
-			var msglen int
+			var v int64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -17709,31 +22444,15 @@ func (m *HDFSKrbConfig) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.KrbConfigConfigMap == nil {
-				m.KrbConfigConfigMap = &v1.ConfigMapKeySelector{}
-			}
-			if err := m.KrbConfigConfigMap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			m.TimeoutSeconds = &v
 		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KrbServicePrincipalName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SuccessCondition", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -17761,7 +22480,7 @@ func (m *HDFSKrbConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.KrbServicePrincipalName = string(dAtA[iNdEx:postIndex])
+			m.SuccessCondition = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -17787,7 +22506,7 @@ func (m *HDFSKrbConfig) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *HTTPArtifact) Unmarshal(dAtA []byte) error {
+func (m *Header) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -17810,15 +22529,15 @@ func (m *HTTPArtifact) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: HTTPArtifact: wiretype end group for non-group")
+			return fmt.Errorf("proto: Header: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: HTTPArtifact: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Header: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -17846,13 +22565,13 @@ func (m *HTTPArtifact) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.URL = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Headers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -17862,25 +22581,23 @@ func (m *HTTPArtifact) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Headers = append(m.Headers, Header{})
-			if err := m.Headers[len(m.Headers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Value = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -17906,7 +22623,7 @@ func (m *HTTPArtifact) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Header) Unmarshal(dAtA []byte) error {
+func (m *Histogram) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -17929,15 +22646,15 @@ func (m *Header) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Header: wiretype end group for non-group")
+			return fmt.Errorf("proto: Histogram: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Header: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Histogram: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -17965,13 +22682,13 @@ func (m *Header) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Value = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Buckets", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -17981,23 +22698,25 @@ func (m *Header) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = string(dAtA[iNdEx:postIndex])
+			m.Buckets = append(m.Buckets, Amount{})
+			if err := m.Buckets[len(m.Buckets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -18023,7 +22742,7 @@ func (m *Header) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Histogram) Unmarshal(dAtA []byte) error {
+func (m *Inputs) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -18046,17 +22765,17 @@ func (m *Histogram) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Histogram: wiretype end group for non-group")
+			return fmt.Errorf("proto: Inputs: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Histogram: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Inputs: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 3:
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Parameters", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -18066,27 +22785,29 @@ func (m *Histogram) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = string(dAtA[iNdEx:postIndex])
+			m.Parameters = append(m.Parameters, Parameter{})
+			if err := m.Parameters[len(m.Parameters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Buckets", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Artifacts", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -18113,8 +22834,8 @@ func (m *Histogram) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Buckets = append(m.Buckets, Amount{})
-			if err := m.Buckets[len(m.Buckets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Artifacts = append(m.Artifacts, Artifact{})
+			if err := m.Artifacts[len(m.Artifacts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -18142,7 +22863,7 @@ func (m *Histogram) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Inputs) Unmarshal(dAtA []byte) error {
+func (m *Item) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -18165,51 +22886,17 @@ func (m *Inputs) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Inputs: wiretype end group for non-group")
+			return fmt.Errorf("proto: Item: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Inputs: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Item: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Parameters", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Parameters = append(m.Parameters, Parameter{})
-			if err := m.Parameters[len(m.Parameters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Artifacts", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -18219,24 +22906,24 @@ func (m *Inputs) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Artifacts = append(m.Artifacts, Artifact{})
-			if err := m.Artifacts[len(m.Artifacts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Value = append(m.Value[:0], dAtA[iNdEx:postIndex]...)
+			if m.Value == nil {
+				m.Value = []byte{}
 			}
 			iNdEx = postIndex
 		default:
@@ -18263,7 +22950,7 @@ func (m *Inputs) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Item) Unmarshal(dAtA []byte) error {
+func (m *LifecycleHook) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -18273,30 +22960,95 @@ func (m *Item) Unmarshal(dAtA []byte) error {
 			if shift >= 64 {
 				return ErrIntOverflowGenerated
 			}
-			if iNdEx >= l {
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LifecycleHook: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LifecycleHook: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Template", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Template = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Arguments", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.Arguments.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Item: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Item: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Expression", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -18306,25 +23058,23 @@ func (m *Item) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = append(m.Value[:0], dAtA[iNdEx:postIndex]...)
-			if m.Value == nil {
-				m.Value = []byte{}
-			}
+			m.Expression = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -18612,6 +23362,38 @@ func (m *MemoizationStatus) Unmarshal(dAtA []byte) error {
 			}
 			m.CacheName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CacheType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CacheType = MemoizationCacheType(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -22283,6 +27065,158 @@ func (m *ResourceTemplate) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ResumeAction) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ResumeAction: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ResumeAction: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Workflows", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Workflows.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeFieldSelector", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NodeFieldSelector = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutputParameters", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OutputParameters = append(m.OutputParameters, Parameter{})
+			if err := m.OutputParameters[len(m.OutputParameters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *RetryAffinity) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -22587,12 +27521,44 @@ func (m *RetryStrategy) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Affinity == nil {
-				m.Affinity = &RetryAffinity{}
-			}
-			if err := m.Affinity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			if m.Affinity == nil {
+				m.Affinity = &RetryAffinity{}
+			}
+			if err := m.Affinity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expression", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Expression = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -22920,7 +27886,269 @@ func (m *S3Bucket) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SecretKeySecret", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SecretKeySecret", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SecretKeySecret == nil {
+				m.SecretKeySecret = &v1.SecretKeySelector{}
+			}
+			if err := m.SecretKeySecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RoleARN", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RoleARN = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UseSDKCreds", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.UseSDKCreds = bool(v != 0)
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreateBucketIfNotPresent", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CreateBucketIfNotPresent == nil {
+				m.CreateBucketIfNotPresent = &CreateS3BucketOptions{}
+			}
+			if err := m.CreateBucketIfNotPresent.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SQLCache) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SQLCache: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SQLCache: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ScriptTemplate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ScriptTemplate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ScriptTemplate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Container", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -22947,16 +28175,13 @@ func (m *S3Bucket) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SecretKeySecret == nil {
-				m.SecretKeySecret = &v1.SecretKeySelector{}
-			}
-			if err := m.SecretKeySecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Container.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RoleARN", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -22984,13 +28209,66 @@ func (m *S3Bucket) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RoleARN = string(dAtA[iNdEx:postIndex])
+			m.Source = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UseSDKCreds", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			var v int
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SemaphoreHolding) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SemaphoreHolding: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SemaphoreHolding: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Semaphore", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -23000,17 +28278,29 @@ func (m *S3Bucket) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.UseSDKCreds = bool(v != 0)
-		case 9:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Semaphore = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreateBucketIfNotPresent", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Holders", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -23020,27 +28310,23 @@ func (m *S3Bucket) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.CreateBucketIfNotPresent == nil {
-				m.CreateBucketIfNotPresent = &CreateS3BucketOptions{}
-			}
-			if err := m.CreateBucketIfNotPresent.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Holders = append(m.Holders, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -23066,7 +28352,7 @@ func (m *S3Bucket) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ScriptTemplate) Unmarshal(dAtA []byte) error {
+func (m *SemaphoreRef) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -23089,15 +28375,15 @@ func (m *ScriptTemplate) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ScriptTemplate: wiretype end group for non-group")
+			return fmt.Errorf("proto: SemaphoreRef: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ScriptTemplate: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SemaphoreRef: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Container", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConfigMapKeyRef", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -23124,15 +28410,18 @@ func (m *ScriptTemplate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Container.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.ConfigMapKeyRef == nil {
+				m.ConfigMapKeyRef = &v1.ConfigMapKeySelector{}
+			}
+			if err := m.ConfigMapKeyRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Database", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -23142,23 +28431,27 @@ func (m *ScriptTemplate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Source = string(dAtA[iNdEx:postIndex])
+			if m.Database == nil {
+				m.Database = &SyncDatabaseRef{}
+			}
+			if err := m.Database.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -23184,7 +28477,7 @@ func (m *ScriptTemplate) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SemaphoreHolding) Unmarshal(dAtA []byte) error {
+func (m *SemaphoreStatus) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -23207,17 +28500,17 @@ func (m *SemaphoreHolding) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SemaphoreHolding: wiretype end group for non-group")
+			return fmt.Errorf("proto: SemaphoreStatus: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SemaphoreHolding: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SemaphoreStatus: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Semaphore", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Holding", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -23227,29 +28520,31 @@ func (m *SemaphoreHolding) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Semaphore = string(dAtA[iNdEx:postIndex])
+			m.Holding = append(m.Holding, SemaphoreHolding{})
+			if err := m.Holding[len(m.Holding)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Holders", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Waiting", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -23259,23 +28554,25 @@ func (m *SemaphoreHolding) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Holders = append(m.Holders, string(dAtA[iNdEx:postIndex]))
+			m.Waiting = append(m.Waiting, SemaphoreHolding{})
+			if err := m.Waiting[len(m.Waiting)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -23301,7 +28598,7 @@ func (m *SemaphoreHolding) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SemaphoreRef) Unmarshal(dAtA []byte) error {
+func (m *Sequence) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -23324,15 +28621,15 @@ func (m *SemaphoreRef) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SemaphoreRef: wiretype end group for non-group")
+			return fmt.Errorf("proto: Sequence: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SemaphoreRef: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Sequence: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConfigMapKeyRef", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -23359,69 +28656,52 @@ func (m *SemaphoreRef) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ConfigMapKeyRef == nil {
-				m.ConfigMapKeyRef = &v1.ConfigMapKeySelector{}
+			if m.Count == nil {
+				m.Count = &intstr.IntOrString{}
 			}
-			if err := m.ConfigMapKeyRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Count.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Start", wireType)
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthGenerated
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *SemaphoreStatus) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if m.Start == nil {
+				m.Start = &intstr.IntOrString{}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: SemaphoreStatus: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SemaphoreStatus: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			if err := m.Start.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Holding", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field End", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -23448,16 +28728,18 @@ func (m *SemaphoreStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Holding = append(m.Holding, SemaphoreHolding{})
-			if err := m.Holding[len(m.Holding)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.End == nil {
+				m.End = &intstr.IntOrString{}
+			}
+			if err := m.End.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Waiting", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Format", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -23467,25 +28749,23 @@ func (m *SemaphoreStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Waiting = append(m.Waiting, SemaphoreHolding{})
-			if err := m.Waiting[len(m.Waiting)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Format = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -23511,7 +28791,7 @@ func (m *SemaphoreStatus) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Sequence) Unmarshal(dAtA []byte) error {
+func (m *StopAction) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -23534,15 +28814,15 @@ func (m *Sequence) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Sequence: wiretype end group for non-group")
+			return fmt.Errorf("proto: StopAction: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Sequence: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: StopAction: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Workflows", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -23569,18 +28849,15 @@ func (m *Sequence) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Count == nil {
-				m.Count = &intstr.IntOrString{}
-			}
-			if err := m.Count.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Workflows.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Start", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeFieldSelector", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -23590,67 +28867,27 @@ func (m *Sequence) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Start == nil {
-				m.Start = &intstr.IntOrString{}
-			}
-			if err := m.Start.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.NodeFieldSelector = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field End", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.End == nil {
-				m.End = &intstr.IntOrString{}
-			}
-			if err := m.End.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Format", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -23678,7 +28915,7 @@ func (m *Sequence) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Format = string(dAtA[iNdEx:postIndex])
+			m.Message = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -24350,6 +29587,91 @@ func (m *SuspendTemplate) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *SyncDatabaseRef) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SyncDatabaseRef: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SyncDatabaseRef: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *Synchronization) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -24411,13 +29733,83 @@ func (m *Synchronization) Unmarshal(dAtA []byte) error {
 			if m.Semaphore == nil {
 				m.Semaphore = &SemaphoreRef{}
 			}
-			if err := m.Semaphore.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Semaphore.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mutex", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Mutex == nil {
+				m.Mutex = &Mutex{}
+			}
+			if err := m.Mutex.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Semaphores", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Semaphores = append(m.Semaphores, &SemaphoreRef{})
+			if err := m.Semaphores[len(m.Semaphores)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Mutex", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Mutexes", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -24444,10 +29836,8 @@ func (m *Synchronization) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Mutex == nil {
-				m.Mutex = &Mutex{}
-			}
-			if err := m.Mutex.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Mutexes = append(m.Mutexes, &Mutex{})
+			if err := m.Mutexes[len(m.Mutexes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -25233,7 +30623,255 @@ func (m *Template) Unmarshal(dAtA []byte) error {
 			m.Daemon = &b
 		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Steps", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Steps", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Steps = append(m.Steps, ParallelSteps{})
+			if err := m.Steps[len(m.Steps)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Container", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Container == nil {
+				m.Container = &v1.Container{}
+			}
+			if err := m.Container.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Script", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Script == nil {
+				m.Script = &ScriptTemplate{}
+			}
+			if err := m.Script.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Resource", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Resource == nil {
+				m.Resource = &ResourceTemplate{}
+			}
+			if err := m.Resource.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DAG", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DAG == nil {
+				m.DAG = &DAGTemplate{}
+			}
+			if err := m.DAG.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Suspend", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Suspend == nil {
+				m.Suspend = &SuspendTemplate{}
+			}
+			if err := m.Suspend.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Volumes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Volumes = append(m.Volumes, v1.Volume{})
+			if err := m.Volumes[len(m.Volumes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InitContainers", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -25260,14 +30898,14 @@ func (m *Template) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Steps = append(m.Steps, ParallelSteps{})
-			if err := m.Steps[len(m.Steps)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.InitContainers = append(m.InitContainers, UserContainer{})
+			if err := m.InitContainers[len(m.InitContainers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 12:
+		case 19:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Container", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sidecars", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -25294,16 +30932,14 @@ func (m *Template) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Container == nil {
-				m.Container = &v1.Container{}
-			}
-			if err := m.Container.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Sidecars = append(m.Sidecars, UserContainer{})
+			if err := m.Sidecars[len(m.Sidecars)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 13:
+		case 20:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Script", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ArchiveLocation", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -25330,16 +30966,16 @@ func (m *Template) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Script == nil {
-				m.Script = &ScriptTemplate{}
+			if m.ArchiveLocation == nil {
+				m.ArchiveLocation = &ArtifactLocation{}
 			}
-			if err := m.Script.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ArchiveLocation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 14:
+		case 21:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Resource", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ActiveDeadlineSeconds", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -25366,16 +31002,16 @@ func (m *Template) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Resource == nil {
-				m.Resource = &ResourceTemplate{}
+			if m.ActiveDeadlineSeconds == nil {
+				m.ActiveDeadlineSeconds = &intstr.IntOrString{}
 			}
-			if err := m.Resource.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ActiveDeadlineSeconds.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 15:
+		case 22:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DAG", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RetryStrategy", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -25402,16 +31038,36 @@ func (m *Template) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.DAG == nil {
-				m.DAG = &DAGTemplate{}
+			if m.RetryStrategy == nil {
+				m.RetryStrategy = &RetryStrategy{}
 			}
-			if err := m.DAG.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.RetryStrategy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 16:
+		case 23:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Parallelism", wireType)
+			}
+			var v int64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Parallelism = &v
+		case 24:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Suspend", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Tolerations", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -25438,18 +31094,16 @@ func (m *Template) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Suspend == nil {
-				m.Suspend = &SuspendTemplate{}
-			}
-			if err := m.Suspend.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Tolerations = append(m.Tolerations, v1.Toleration{})
+			if err := m.Tolerations[len(m.Tolerations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 17:
+		case 25:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Volumes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SchedulerName", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -25459,31 +31113,29 @@ func (m *Template) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Volumes = append(m.Volumes, v1.Volume{})
-			if err := m.Volumes[len(m.Volumes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.SchedulerName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 18:
+		case 26:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InitContainers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PriorityClassName", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -25493,31 +31145,49 @@ func (m *Template) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.InitContainers = append(m.InitContainers, UserContainer{})
-			if err := m.InitContainers[len(m.InitContainers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.PriorityClassName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 19:
+		case 27:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Priority", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Priority = &v
+		case 28:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sidecars", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ServiceAccountName", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -25527,29 +31197,27 @@ func (m *Template) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sidecars = append(m.Sidecars, UserContainer{})
-			if err := m.Sidecars[len(m.Sidecars)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ServiceAccountName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 20:
+		case 29:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ArchiveLocation", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HostAliases", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -25576,16 +31244,14 @@ func (m *Template) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ArchiveLocation == nil {
-				m.ArchiveLocation = &ArtifactLocation{}
-			}
-			if err := m.ArchiveLocation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.HostAliases = append(m.HostAliases, v1.HostAlias{})
+			if err := m.HostAliases[len(m.HostAliases)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 21:
+		case 30:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ActiveDeadlineSeconds", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SecurityContext", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -25612,18 +31278,18 @@ func (m *Template) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ActiveDeadlineSeconds == nil {
-				m.ActiveDeadlineSeconds = &intstr.IntOrString{}
+			if m.SecurityContext == nil {
+				m.SecurityContext = &v1.PodSecurityContext{}
 			}
-			if err := m.ActiveDeadlineSeconds.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.SecurityContext.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 22:
+		case 31:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RetryStrategy", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PodSpecPatch", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -25633,33 +31299,29 @@ func (m *Template) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.RetryStrategy == nil {
-				m.RetryStrategy = &RetryStrategy{}
-			}
-			if err := m.RetryStrategy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.PodSpecPatch = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 23:
+		case 32:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Parallelism", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AutomountServiceAccountToken", wireType)
 			}
-			var v int64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -25669,15 +31331,16 @@ func (m *Template) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Parallelism = &v
-		case 24:
+			b := bool(v != 0)
+			m.AutomountServiceAccountToken = &b
+		case 33:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tolerations", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Executor", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -25704,16 +31367,18 @@ func (m *Template) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Tolerations = append(m.Tolerations, v1.Toleration{})
-			if err := m.Tolerations[len(m.Tolerations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Executor == nil {
+				m.Executor = &ExecutorConfig{}
+			}
+			if err := m.Executor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 25:
+		case 35:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SchedulerName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Metrics", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -25723,29 +31388,33 @@ func (m *Template) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SchedulerName = string(dAtA[iNdEx:postIndex])
+			if m.Metrics == nil {
+				m.Metrics = &Metrics{}
+			}
+			if err := m.Metrics.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 26:
+		case 36:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PriorityClassName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Synchronization", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -25755,29 +31424,33 @@ func (m *Template) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PriorityClassName = string(dAtA[iNdEx:postIndex])
+			if m.Synchronization == nil {
+				m.Synchronization = &Synchronization{}
+			}
+			if err := m.Synchronization.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 27:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Priority", wireType)
+		case 37:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Memoize", wireType)
 			}
-			var v int32
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -25787,15 +31460,31 @@ func (m *Template) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Priority = &v
-		case 28:
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Memoize == nil {
+				m.Memoize = &Memoize{}
+			}
+			if err := m.Memoize.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 38:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ServiceAccountName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Timeout", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -25823,11 +31512,11 @@ func (m *Template) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ServiceAccountName = string(dAtA[iNdEx:postIndex])
+			m.Timeout = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 29:
+		case 39:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HostAliases", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HTTP", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -25854,14 +31543,16 @@ func (m *Template) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.HostAliases = append(m.HostAliases, v1.HostAlias{})
-			if err := m.HostAliases[len(m.HostAliases)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.HTTP == nil {
+				m.HTTP = &HTTPTemplate{}
+			}
+			if err := m.HTTP.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 30:
+		case 40:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SecurityContext", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContainerSet", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -25888,18 +31579,18 @@ func (m *Template) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SecurityContext == nil {
-				m.SecurityContext = &v1.PodSecurityContext{}
+			if m.ContainerSet == nil {
+				m.ContainerSet = &ContainerSetTemplate{}
 			}
-			if err := m.SecurityContext.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ContainerSet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 31:
+		case 41:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PodSpecPatch", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -25909,48 +31600,31 @@ func (m *Template) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PodSpecPatch = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 32:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AutomountServiceAccountToken", wireType)
+			if m.Data == nil {
+				m.Data = &DataTemplate{}
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if err := m.Data.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			b := bool(v != 0)
-			m.AutomountServiceAccountToken = &b
-		case 33:
+			iNdEx = postIndex
+		case 42:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Executor", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Workflow", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -25977,18 +31651,71 @@ func (m *Template) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Executor == nil {
-				m.Executor = &ExecutorConfig{}
+			if m.Workflow == nil {
+				m.Workflow = &ChildWorkflowTemplate{}
 			}
-			if err := m.Executor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Workflow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 35:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TemplateRef) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TemplateRef: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TemplateRef: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Metrics", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -25998,33 +31725,29 @@ func (m *Template) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Metrics == nil {
-				m.Metrics = &Metrics{}
-			}
-			if err := m.Metrics.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 36:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Synchronization", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Template", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -26034,33 +31757,49 @@ func (m *Template) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Synchronization == nil {
-				m.Synchronization = &Synchronization{}
+			m.Template = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RuntimeResolution", wireType)
 			}
-			if err := m.Synchronization.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
-		case 37:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Memoize", wireType)
+			m.RuntimeResolution = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClusterScope", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -26070,33 +31809,70 @@ func (m *Template) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			m.ClusterScope = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Memoize == nil {
-				m.Memoize = &Memoize{}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TerminateAction) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
 			}
-			if err := m.Memoize.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			iNdEx = postIndex
-		case 38:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TerminateAction: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TerminateAction: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Timeout", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Workflows", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -26106,23 +31882,24 @@ func (m *Template) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Timeout = string(dAtA[iNdEx:postIndex])
+			if err := m.Workflows.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -26148,7 +31925,7 @@ func (m *Template) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TemplateRef) Unmarshal(dAtA []byte) error {
+func (m *TransformationStep) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -26171,47 +31948,15 @@ func (m *TemplateRef) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TemplateRef: wiretype end group for non-group")
+			return fmt.Errorf("proto: TransformationStep: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TemplateRef: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TransformationStep: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Name = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Template", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Expression", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -26239,48 +31984,8 @@ func (m *TemplateRef) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Template = string(dAtA[iNdEx:postIndex])
+			m.Expression = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RuntimeResolution", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.RuntimeResolution = bool(v != 0)
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClusterScope", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.ClusterScope = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -27577,6 +33282,114 @@ func (m *WorkflowEventBindingSpec) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Resume", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Resume == nil {
+				m.Resume = &ResumeAction{}
+			}
+			if err := m.Resume.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stop", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Stop == nil {
+				m.Stop = &StopAction{}
+			}
+			if err := m.Stop.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Terminate", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Terminate == nil {
+				m.Terminate = &TerminateAction{}
+			}
+			if err := m.Terminate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -28849,18 +34662,90 @@ func (m *WorkflowSpec) Unmarshal(dAtA []byte) error {
 			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Shutdown = ShutdownStrategy(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 34:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowTemplateRef", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.WorkflowTemplateRef == nil {
+				m.WorkflowTemplateRef = &WorkflowTemplateRef{}
+			}
+			if err := m.WorkflowTemplateRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 35:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Synchronization", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Shutdown = ShutdownStrategy(dAtA[iNdEx:postIndex])
+			if m.Synchronization == nil {
+				m.Synchronization = &Synchronization{}
+			}
+			if err := m.Synchronization.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 34:
+		case 36:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowTemplateRef", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VolumeClaimGC", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -28887,16 +34772,16 @@ func (m *WorkflowSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.WorkflowTemplateRef == nil {
-				m.WorkflowTemplateRef = &WorkflowTemplateRef{}
+			if m.VolumeClaimGC == nil {
+				m.VolumeClaimGC = &VolumeClaimGC{}
 			}
-			if err := m.WorkflowTemplateRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.VolumeClaimGC.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 35:
+		case 37:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Synchronization", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RetryStrategy", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -28923,16 +34808,16 @@ func (m *WorkflowSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Synchronization == nil {
-				m.Synchronization = &Synchronization{}
+			if m.RetryStrategy == nil {
+				m.RetryStrategy = &RetryStrategy{}
 			}
-			if err := m.Synchronization.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.RetryStrategy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 36:
+		case 38:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VolumeClaimGC", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ArtifactGC", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -28959,16 +34844,16 @@ func (m *WorkflowSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.VolumeClaimGC == nil {
-				m.VolumeClaimGC = &VolumeClaimGC{}
+			if m.ArtifactGC == nil {
+				m.ArtifactGC = &ArtifactGC{}
 			}
-			if err := m.VolumeClaimGC.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ArtifactGC.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 37:
+		case 39:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RetryStrategy", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Hooks", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -28995,12 +34880,105 @@ func (m *WorkflowSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.RetryStrategy == nil {
-				m.RetryStrategy = &RetryStrategy{}
+			if m.Hooks == nil {
+				m.Hooks = make(map[string]LifecycleHook)
 			}
-			if err := m.RetryStrategy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var mapkey string
+			mapvalue := &LifecycleHook{}
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &LifecycleHook{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
 			}
+			m.Hooks[mapkey] = *mapvalue
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -30271,6 +36249,135 @@ func (m *WorkflowStep) Unmarshal(dAtA []byte) error {
 			}
 			m.OnExit = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hooks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Hooks == nil {
+				m.Hooks = make(map[string]LifecycleHook)
+			}
+			var mapkey string
+			mapvalue := &LifecycleHook{}
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &LifecycleHook{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Hooks[mapkey] = *mapvalue
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -17,16 +17,23 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1.ArchiveStrategy":             schema_pkg_apis_workflow_v1alpha1_ArchiveStrategy(ref),
 		"github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1.Arguments":                   schema_pkg_apis_workflow_v1alpha1_Arguments(ref),
 		"github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1.Artifact":                    schema_pkg_apis_workflow_v1alpha1_Artifact(ref),
+		"github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1.ArtifactCache":               schema_pkg_apis_workflow_v1alpha1_ArtifactCache(ref),
+		"github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1.ArtifactGC":                  schema_pkg_apis_workflow_v1alpha1_ArtifactGC(ref),
 		"github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1.ArtifactLocation":            schema_pkg_apis_workflow_v1alpha1_ArtifactLocation(ref),
 		"github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1.ArtifactRepositoryRef":       schema_pkg_apis_workflow_v1alpha1_ArtifactRepositoryRef(ref),
 		"github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1.ArtifactRepositoryRefStatus": schema_pkg_apis_workflow_v1alpha1_ArtifactRepositoryRefStatus(ref),
 		"github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1.ArtifactoryArtifact":         schema_pkg_apis_workflow_v1alpha1_ArtifactoryArtifact(ref),
 		"github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1.ArtifactoryAuth":             schema_pkg_apis_workflow_v1alpha1_ArtifactoryAuth(ref),
+		"github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1.AzureArtifact":               schema_pkg_apis_workflow_v1alpha1_AzureArtifact(ref),
+		"github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1.AzureBlobContainer":          schema_pkg_apis_workflow_v1alpha1_AzureBlobContainer(ref),
 		"github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1.Backoff":                     schema_pkg_apis_workflow_v1alpha1_Backoff(ref),
 		"github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1.Cache":                       schema_pkg_apis_workflow_v1alpha1_Cache(ref),
+		"github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1.ChildWorkflowTemplate":       schema_pkg_apis_workflow_v1alpha1_ChildWorkflowTemplate(ref),
 		"github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1.ClusterWorkflowTemplate":     schema_pkg_apis_workflow_v1alpha1_ClusterWorkflowTemplate(ref),
 		"github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1.ClusterWorkflowTemplateList": schema_pkg_apis_workflow_v1alpha1_ClusterWorkflowTemplateList(ref),
 		"github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1.Condition":                   schema_pkg_apis_workflow_v1alpha1_Condition(ref),
+		"github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1.ContainerNode":               schema_pkg_apis_workflow_v1alpha1_ContainerNode(ref),
+		"github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1.ContainerSetTemplate":        schema_pkg_apis_workflow_v1alpha1_ContainerSetTemplate(ref),
 		"github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1.ContinueOn":                  schema_pkg_apis_workflow_v1alpha1_ContinueOn(ref),
 		"github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1.Counter":                     schema_pkg_apis_workflow_v1alpha1_Counter(ref),
 		"github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1.CreateS3BucketOptions":       schema_pkg_apis_workflow_v1alpha1_CreateS3BucketOptions(ref),
@@ -36,7 +43,10 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1.CronWorkflowStatus":          schema_pkg_apis_workflow_v1alpha1_CronWorkflowStatus(ref),
 		"github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1.DAGTask":                     schema_pkg_apis_workflow_v1alpha1_DAGTask(ref),
 		"github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1.DAGTemplate":                 schema_pkg_apis_workflow_v1alpha1_DAGTemplate(ref),
+		"github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1.DataSource":                  schema_pkg_apis_workflow_v1alpha1_DataSource(ref),
+		"github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1.DataTemplate":                schema_pkg_apis_workflow_v1alpha1_DataTemplate(ref),
 		"github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1.Event":                       schema_pkg_apis_workflow_v1alpha1_Event(ref),
+		"github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1.EventWorkflowSelector":       schema_pkg_apis_workflow_v1alpha1_EventWorkflowSelector(ref),
 		"github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1.ExecutorConfig":              schema_pkg_apis_workflow_v1alpha1_ExecutorConfig(ref),
 		"github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1.GCSArtifact":                 schema_pkg_apis_workflow_v1alpha1_GCSArtifact(ref),
 		"github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1.GCSBucket":                   schema_pkg_apis_workflow_v1alpha1_GCSBucket(ref),
@@ -46,10 +56,14 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1.HDFSConfig":                  schema_pkg_apis_workflow_v1alpha1_HDFSConfig(ref),
 		"github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1.HDFSKrbConfig":               schema_pkg_apis_workflow_v1alpha1_HDFSKrbConfig(ref),
 		"github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1.HTTPArtifact":                schema_pkg_apis_workflow_v1alpha1_HTTPArtifact(ref),
+		"github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1.HTTPHeader":                  schema_pkg_apis_workflow_v1alpha1_HTTPHeader(ref),
+		"github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1.HTTPHeaderSource":            schema_pkg_apis_workflow_v1alpha1_HTTPHeaderSource(ref),
+		"github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1.HTTPTemplate":                schema_pkg_apis_workflow_v1alpha1_HTTPTemplate(ref),
 		"github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1.Header":                      schema_pkg_apis_workflow_v1alpha1_Header(ref),
 		"github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1.Histogram":                   schema_pkg_apis_workflow_v1alpha1_Histogram(ref),
 		"github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1.Inputs":                      schema_pkg_apis_workflow_v1alpha1_Inputs(ref),
 		"github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1.Item":                        schema_pkg_apis_workflow_v1alpha1_Item(ref),
+		"github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1.LifecycleHook":               schema_pkg_apis_workflow_v1alpha1_LifecycleHook(ref),
 		"github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1.Link":                        schema_pkg_apis_workflow_v1alpha1_Link(ref),
 		"github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1.MemoizationStatus":           schema_pkg_apis_workflow_v1alpha1_MemoizationStatus(ref),
 		"github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1.Memoize":                     schema_pkg_apis_workflow_v1alpha1_Memoize(ref),
@@ -71,26 +85,32 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1.Prometheus":                  schema_pkg_apis_workflow_v1alpha1_Prometheus(ref),
 		"github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1.RawArtifact":                 schema_pkg_apis_workflow_v1alpha1_RawArtifact(ref),
 		"github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1.ResourceTemplate":            schema_pkg_apis_workflow_v1alpha1_ResourceTemplate(ref),
+		"github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1.ResumeAction":                schema_pkg_apis_workflow_v1alpha1_ResumeAction(ref),
 		"github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1.RetryAffinity":               schema_pkg_apis_workflow_v1alpha1_RetryAffinity(ref),
 		"github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1.RetryNodeAntiAffinity":       schema_pkg_apis_workflow_v1alpha1_RetryNodeAntiAffinity(ref),
 		"github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1.RetryStrategy":               schema_pkg_apis_workflow_v1alpha1_RetryStrategy(ref),
 		"github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1.S3Artifact":                  schema_pkg_apis_workflow_v1alpha1_S3Artifact(ref),
 		"github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1.S3Bucket":                    schema_pkg_apis_workflow_v1alpha1_S3Bucket(ref),
+		"github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1.SQLCache":                    schema_pkg_apis_workflow_v1alpha1_SQLCache(ref),
 		"github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1.ScriptTemplate":              schema_pkg_apis_workflow_v1alpha1_ScriptTemplate(ref),
 		"github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1.SemaphoreHolding":            schema_pkg_apis_workflow_v1alpha1_SemaphoreHolding(ref),
 		"github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1.SemaphoreRef":                schema_pkg_apis_workflow_v1alpha1_SemaphoreRef(ref),
 		"github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1.SemaphoreStatus":             schema_pkg_apis_workflow_v1alpha1_SemaphoreStatus(ref),
 		"github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1.Sequence":                    schema_pkg_apis_workflow_v1alpha1_Sequence(ref),
+		"github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1.StopAction":                  schema_pkg_apis_workflow_v1alpha1_StopAction(ref),
 		"github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1.Submit":                      schema_pkg_apis_workflow_v1alpha1_Submit(ref),
 		"github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1.SubmitOpts":                  schema_pkg_apis_workflow_v1alpha1_SubmitOpts(ref),
 		"github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1.SuppliedValueFrom":           schema_pkg_apis_workflow_v1alpha1_SuppliedValueFrom(ref),
 		"github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1.SuspendTemplate":             schema_pkg_apis_workflow_v1alpha1_SuspendTemplate(ref),
+		"github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1.SyncDatabaseRef":             schema_pkg_apis_workflow_v1alpha1_SyncDatabaseRef(ref),
 		"github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1.Synchronization":             schema_pkg_apis_workflow_v1alpha1_Synchronization(ref),
 		"github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1.SynchronizationStatus":       schema_pkg_apis_workflow_v1alpha1_SynchronizationStatus(ref),
 		"github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1.TTLStrategy":                 schema_pkg_apis_workflow_v1alpha1_TTLStrategy(ref),
 		"github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1.TarStrategy":                 schema_pkg_apis_workflow_v1alpha1_TarStrategy(ref),
 		"github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1.Template":                    schema_pkg_apis_workflow_v1alpha1_Template(ref),
 		"github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1.TemplateRef":                 schema_pkg_apis_workflow_v1alpha1_TemplateRef(ref),
+		"github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1.TerminateAction":             schema_pkg_apis_workflow_v1alpha1_TerminateAction(ref),
+		"github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1.TransformationStep":          schema_pkg_apis_workflow_v1alpha1_TransformationStep(ref),
 		"github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1.UserContainer":               schema_pkg_apis_workflow_v1alpha1_UserContainer(ref),
 		"github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1.ValueFrom":                   schema_pkg_apis_workflow_v1alpha1_ValueFrom(ref),
 		"github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1.Version":                     schema_pkg_apis_workflow_v1alpha1_Version(ref),
@@ -299,6 +319,12 @@ func schema_pkg_apis_workflow_v1alpha1_Artifact(ref common.ReferenceCallback) co
 							Ref:         ref("github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1.GCSArtifact"),
 						},
 					},
+					"azure": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Azure contains Azure Blob Storage artifact location details",
+							Ref:         ref("github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1.AzureArtifact"),
+						},
+					},
 					"globalName": {
 						SchemaProps: spec.SchemaProps{
 							Description: "GlobalName exports an output artifact to the global scope, making it available as '{{workflow.outputs.artifacts.XXXX}} and in workflow.status.outputs.artifacts",
@@ -333,12 +359,67 @@ func schema_pkg_apis_workflow_v1alpha1_Artifact(ref common.ReferenceCallback) co
 							Format:      "",
 						},
 					},
+					"artifactGC": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ArtifactGC describes the strategy to use when deleting this output artifact, overriding the workflow's strategy",
+							Ref:         ref("github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1.ArtifactGC"),
+						},
+					},
+					"deleted": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Deleted is set by the controller once the artifact has been garbage collected",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
 				},
 				Required: []string{"name"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1.ArchiveStrategy", "github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1.ArtifactoryArtifact", "github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1.GCSArtifact", "github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1.GitArtifact", "github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1.HDFSArtifact", "github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1.HTTPArtifact", "github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1.OSSArtifact", "github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1.RawArtifact", "github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1.S3Artifact"},
+			"github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1.ArchiveStrategy", "github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1.ArtifactGC", "github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1.ArtifactoryArtifact", "github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1.AzureArtifact", "github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1.GCSArtifact", "github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1.GitArtifact", "github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1.HDFSArtifact", "github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1.HTTPArtifact", "github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1.OSSArtifact", "github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1.RawArtifact", "github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1.S3Artifact"},
+	}
+}
+
+func schema_pkg_apis_workflow_v1alpha1_ArtifactCache(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ArtifactCache is a memoization cache stored in the default artifact repository, under the \"memoization/{name}\" key",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name of the cache",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"name"},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_workflow_v1alpha1_ArtifactGC(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ArtifactGC describes how to delete output artifacts",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"strategy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Strategy is the strategy to use. One of \"OnWorkflowCompletion\", \"OnWorkflowDeletion\", \"Never\"",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
 	}
 }
 
@@ -404,11 +485,17 @@ func schema_pkg_apis_workflow_v1alpha1_ArtifactLocation(ref common.ReferenceCall
 							Ref:         ref("github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1.GCSArtifact"),
 						},
 					},
+					"azure": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Azure contains Azure Blob Storage artifact location details",
+							Ref:         ref("github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1.AzureArtifact"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1.ArtifactoryArtifact", "github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1.GCSArtifact", "github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1.GitArtifact", "github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1.HDFSArtifact", "github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1.HTTPArtifact", "github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1.OSSArtifact", "github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1.RawArtifact", "github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1.S3Artifact"},
+			"github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1.ArtifactoryArtifact", "github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1.AzureArtifact", "github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1.GCSArtifact", "github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1.GitArtifact", "github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1.HDFSArtifact", "github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1.HTTPArtifact", "github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1.OSSArtifact", "github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1.RawArtifact", "github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1.S3Artifact"},
 	}
 }
 
@@ -541,6 +628,111 @@ func schema_pkg_apis_workflow_v1alpha1_ArtifactoryAuth(ref common.ReferenceCallb
 	}
 }
 
+func schema_pkg_apis_workflow_v1alpha1_AzureArtifact(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "AzureArtifact is the location of an Azure Blob Storage artifact",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"endpoint": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Endpoint is the service url associated with an account. It is most likely \"https://<ACCOUNT_NAME>.blob.core.windows.net\"",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"container": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Container is the container where resources will be stored",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"accountKeySecret": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AccountKeySecret is the secret selector to the Azure Blob Storage account access key",
+							Ref:         ref("k8s.io/api/core/v1.SecretKeySelector"),
+						},
+					},
+					"sasTokenSecret": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SASTokenSecret is the secret selector to a shared access signature token for the container",
+							Ref:         ref("k8s.io/api/core/v1.SecretKeySelector"),
+						},
+					},
+					"useSDKCreds": {
+						SchemaProps: spec.SchemaProps{
+							Description: "UseSDKCreds tells the driver to figure out credentials based on the environment, e.g. workload identity or a managed identity",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"blob": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Blob is the blob name (i.e., path) in the container where the artifact resides",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"blob"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/api/core/v1.SecretKeySelector"},
+	}
+}
+
+func schema_pkg_apis_workflow_v1alpha1_AzureBlobContainer(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "AzureBlobContainer contains the access information for interfacing with an Azure Blob Storage container",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"endpoint": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Endpoint is the service url associated with an account. It is most likely \"https://<ACCOUNT_NAME>.blob.core.windows.net\"",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"container": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Container is the container where resources will be stored",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"accountKeySecret": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AccountKeySecret is the secret selector to the Azure Blob Storage account access key",
+							Ref:         ref("k8s.io/api/core/v1.SecretKeySelector"),
+						},
+					},
+					"sasTokenSecret": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SASTokenSecret is the secret selector to a shared access signature token for the container",
+							Ref:         ref("k8s.io/api/core/v1.SecretKeySelector"),
+						},
+					},
+					"useSDKCreds": {
+						SchemaProps: spec.SchemaProps{
+							Description: "UseSDKCreds tells the driver to figure out credentials based on the environment, e.g. workload identity or a managed identity",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/api/core/v1.SecretKeySelector"},
+	}
+}
+
 func schema_pkg_apis_workflow_v1alpha1_Backoff(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -580,7 +772,7 @@ func schema_pkg_apis_workflow_v1alpha1_Cache(ref common.ReferenceCallback) commo
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "Cache is the configuration for the type of cache to be used",
+				Description: "Cache is the configuration for the type of cache to be used. Exactly one of the types must be set.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"configMap": {
@@ -589,12 +781,52 @@ func schema_pkg_apis_workflow_v1alpha1_Cache(ref common.ReferenceCallback) commo
 							Ref:         ref("k8s.io/api/core/v1.ConfigMapKeySelector"),
 						},
 					},
+					"sql": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SQL sets a cache stored in the workflow controller's persistence database",
+							Ref:         ref("github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1.SQLCache"),
+						},
+					},
+					"artifact": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Artifact sets a cache stored in the workflow controller's default artifact repository",
+							Ref:         ref("github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1.ArtifactCache"),
+						},
+					},
 				},
-				Required: []string{"configMap"},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/api/core/v1.ConfigMapKeySelector"},
+			"github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1.ArtifactCache", "github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1.SQLCache", "k8s.io/api/core/v1.ConfigMapKeySelector"},
+	}
+}
+
+func schema_pkg_apis_workflow_v1alpha1_ChildWorkflowTemplate(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ChildWorkflowTemplate is a template subtype to run a child workflow from a WorkflowTemplate or a ClusterWorkflowTemplate. The child workflow is created and tracked by the controller, and is owned by the parent workflow, like its pods. Its global outputs are the outputs of the node.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"workflowTemplateRef": {
+						SchemaProps: spec.SchemaProps{
+							Description: "WorkflowTemplateRef is the reference to the WorkflowTemplate or ClusterWorkflowTemplate of the child workflow",
+							Ref:         ref("github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1.WorkflowTemplateRef"),
+						},
+					},
+					"arguments": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Arguments are the arguments of the child workflow",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1.Arguments"),
+						},
+					},
+				},
+				Required: []string{"workflowTemplateRef"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1.Arguments", "github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1.WorkflowTemplateRef"},
 	}
 }
 
@@ -709,16 +941,328 @@ func schema_pkg_apis_workflow_v1alpha1_Condition(ref common.ReferenceCallback) c
 							Format:      "",
 						},
 					},
-					"message": {
+					"message": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Message is the condition message",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_workflow_v1alpha1_ContainerNode(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ContainerNode is a container of a container set",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name of the container specified as a DNS_LABEL. Each container in a pod must have a unique name (DNS_LABEL). Cannot be updated.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"image": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Docker image name. More info: https://kubernetes.io/docs/concepts/containers/images This field is optional to allow higher level config management to default or override container images in workload controllers like Deployments and StatefulSets.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"command": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Entrypoint array. Not executed within a shell. The docker image's ENTRYPOINT is used if this is not provided. Variable references $(VAR_NAME) are expanded using the container's environment. If a variable cannot be resolved, the reference in the input string will be unchanged. The $(VAR_NAME) syntax can be escaped with a double $$, ie: $$(VAR_NAME). Escaped references will never be expanded, regardless of whether the variable exists or not. Cannot be updated. More info: https://kubernetes.io/docs/tasks/inject-data-application/define-command-argument-container/#running-a-command-in-a-shell",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"args": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Arguments to the entrypoint. The docker image's CMD is used if this is not provided. Variable references $(VAR_NAME) are expanded using the container's environment. If a variable cannot be resolved, the reference in the input string will be unchanged. The $(VAR_NAME) syntax can be escaped with a double $$, ie: $$(VAR_NAME). Escaped references will never be expanded, regardless of whether the variable exists or not. Cannot be updated. More info: https://kubernetes.io/docs/tasks/inject-data-application/define-command-argument-container/#running-a-command-in-a-shell",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"workingDir": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Container's working directory. If not specified, the container runtime's default will be used, which might be configured in the container image. Cannot be updated.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"ports": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-map-keys": []interface{}{
+									"containerPort",
+									"protocol",
+								},
+								"x-kubernetes-list-type":       "map",
+								"x-kubernetes-patch-merge-key": "containerPort",
+								"x-kubernetes-patch-strategy":  "merge",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "List of ports to expose from the container. Exposing a port here gives the system additional information about the network connections a container uses, but is primarily informational. Not specifying a port here DOES NOT prevent that port from being exposed. Any port which is listening on the default \"0.0.0.0\" address inside a container will be accessible from the network. Cannot be updated.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("k8s.io/api/core/v1.ContainerPort"),
+									},
+								},
+							},
+						},
+					},
+					"envFrom": {
+						SchemaProps: spec.SchemaProps{
+							Description: "List of sources to populate environment variables in the container. The keys defined within a source must be a C_IDENTIFIER. All invalid keys will be reported as an event when the container is starting. When a key exists in multiple sources, the value associated with the last source will take precedence. Values defined by an Env with a duplicate key will take precedence. Cannot be updated.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("k8s.io/api/core/v1.EnvFromSource"),
+									},
+								},
+							},
+						},
+					},
+					"env": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-patch-merge-key": "name",
+								"x-kubernetes-patch-strategy":  "merge",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "List of environment variables to set in the container. Cannot be updated.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("k8s.io/api/core/v1.EnvVar"),
+									},
+								},
+							},
+						},
+					},
+					"resources": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Compute Resources required by this container. Cannot be updated. More info: https://kubernetes.io/docs/concepts/configuration/manage-compute-resources-container/",
+							Default:     map[string]interface{}{},
+							Ref:         ref("k8s.io/api/core/v1.ResourceRequirements"),
+						},
+					},
+					"volumeMounts": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-patch-merge-key": "mountPath",
+								"x-kubernetes-patch-strategy":  "merge",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Pod volumes to mount into the container's filesystem. Cannot be updated.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("k8s.io/api/core/v1.VolumeMount"),
+									},
+								},
+							},
+						},
+					},
+					"volumeDevices": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-patch-merge-key": "devicePath",
+								"x-kubernetes-patch-strategy":  "merge",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "volumeDevices is the list of block devices to be used by the container.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("k8s.io/api/core/v1.VolumeDevice"),
+									},
+								},
+							},
+						},
+					},
+					"livenessProbe": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Periodic probe of container liveness. Container will be restarted if the probe fails. Cannot be updated. More info: https://kubernetes.io/docs/concepts/workloads/pods/pod-lifecycle#container-probes",
+							Ref:         ref("k8s.io/api/core/v1.Probe"),
+						},
+					},
+					"readinessProbe": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Periodic probe of container service readiness. Container will be removed from service endpoints if the probe fails. Cannot be updated. More info: https://kubernetes.io/docs/concepts/workloads/pods/pod-lifecycle#container-probes",
+							Ref:         ref("k8s.io/api/core/v1.Probe"),
+						},
+					},
+					"startupProbe": {
+						SchemaProps: spec.SchemaProps{
+							Description: "StartupProbe indicates that the Pod has successfully initialized. If specified, no other probes are executed until this completes successfully. If this probe fails, the Pod will be restarted, just as if the livenessProbe failed. This can be used to provide different probe parameters at the beginning of a Pod's lifecycle, when it might take a long time to load data or warm a cache, than during steady-state operation. This cannot be updated. This is a beta feature enabled by the StartupProbe feature flag. More info: https://kubernetes.io/docs/concepts/workloads/pods/pod-lifecycle#container-probes",
+							Ref:         ref("k8s.io/api/core/v1.Probe"),
+						},
+					},
+					"lifecycle": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Actions that the management system should take in response to container lifecycle events. Cannot be updated.",
+							Ref:         ref("k8s.io/api/core/v1.Lifecycle"),
+						},
+					},
+					"terminationMessagePath": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Optional: Path at which the file to which the container's termination message will be written is mounted into the container's filesystem. Message written is intended to be brief final status, such as an assertion failure message. Will be truncated by the node if greater than 4096 bytes. The total message length across all containers will be limited to 12kb. Defaults to /dev/termination-log. Cannot be updated.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"terminationMessagePolicy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Indicate how the termination message should be populated. File will use the contents of terminationMessagePath to populate the container status message on both success and failure. FallbackToLogsOnError will use the last chunk of container log output if the termination message file is empty and the container exited with an error. The log output is limited to 2048 bytes or 80 lines, whichever is smaller. Defaults to File. Cannot be updated.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"imagePullPolicy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Image pull policy. One of Always, Never, IfNotPresent. Defaults to Always if :latest tag is specified, or IfNotPresent otherwise. Cannot be updated. More info: https://kubernetes.io/docs/concepts/containers/images#updating-images",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"securityContext": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Security options the pod should run with. More info: https://kubernetes.io/docs/concepts/policy/security-context/ More info: https://kubernetes.io/docs/tasks/configure-pod-container/security-context/",
+							Ref:         ref("k8s.io/api/core/v1.SecurityContext"),
+						},
+					},
+					"stdin": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Whether this container should allocate a buffer for stdin in the container runtime. If this is not set, reads from stdin in the container will always result in EOF. Default is false.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"stdinOnce": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Whether the container runtime should close the stdin channel after it has been opened by a single attach. When stdin is true the stdin stream will remain open across multiple attach sessions. If stdinOnce is set to true, stdin is opened on container start, is empty until the first client attaches to stdin, and then remains open and accepts data until the client disconnects, at which time stdin is closed and remains closed until the container is restarted. If this flag is false, a container processes that reads from stdin will never receive an EOF. Default is false",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"tty": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Whether this container should allocate a TTY for itself, also requires 'stdin' to be true. Default is false.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"dependencies": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Dependencies are the names of the containers that must succeed before this container starts",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"outputs": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Outputs are the output parameters and artifacts of the container, collected from it once all the containers have completed. They are the outputs of the container's node.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1.Outputs"),
+						},
+					},
+				},
+				Required: []string{"name"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1.Outputs", "k8s.io/api/core/v1.ContainerPort", "k8s.io/api/core/v1.EnvFromSource", "k8s.io/api/core/v1.EnvVar", "k8s.io/api/core/v1.Lifecycle", "k8s.io/api/core/v1.Probe", "k8s.io/api/core/v1.ResourceRequirements", "k8s.io/api/core/v1.SecurityContext", "k8s.io/api/core/v1.VolumeDevice", "k8s.io/api/core/v1.VolumeMount"},
+	}
+}
+
+func schema_pkg_apis_workflow_v1alpha1_ContainerSetTemplate(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ContainerSetTemplate is a template subtype to run several containers in a single pod. The containers start once their dependencies have succeeded, and share the volumes mounted by `volumeMounts`.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"containers": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Containers to run. One of them must be named \"main\": the outputs of the template are collected from it.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1.ContainerNode"),
+									},
+								},
+							},
+						},
+					},
+					"volumeMounts": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Message is the condition message",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "VolumeMounts are mounted in all the containers of the set, typically an emptyDir volume used as a workspace",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("k8s.io/api/core/v1.VolumeMount"),
+									},
+								},
+							},
 						},
 					},
 				},
+				Required: []string{"containers"},
 			},
 		},
+		Dependencies: []string{
+			"github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1.ContainerNode", "k8s.io/api/core/v1.VolumeMount"},
 	}
 }
 
@@ -1119,12 +1663,27 @@ func schema_pkg_apis_workflow_v1alpha1_DAGTask(ref common.ReferenceCallback) com
 							Format:      "",
 						},
 					},
+					"hooks": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Hooks are templates, keyed by name, which are run while the task is running or once it completed, once their expression evaluates to true",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1.LifecycleHook"),
+									},
+								},
+							},
+						},
+					},
 				},
 				Required: []string{"name"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1.Arguments", "github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1.ContinueOn", "github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1.Item", "github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1.Sequence", "github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1.TemplateRef"},
+			"github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1.Arguments", "github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1.ContinueOn", "github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1.Item", "github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1.LifecycleHook", "github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1.Sequence", "github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1.TemplateRef"},
 	}
 }
 
@@ -1178,6 +1737,64 @@ func schema_pkg_apis_workflow_v1alpha1_DAGTemplate(ref common.ReferenceCallback)
 	}
 }
 
+func schema_pkg_apis_workflow_v1alpha1_DataSource(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "DataSource is the source of the data of a data template",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"artifactPaths": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ArtifactPaths is an artifact location, the data is the list of the keys of the objects under it. The location may only have a key, to list a key of the artifact repository.",
+							Ref:         ref("github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1.ArtifactLocation"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1.ArtifactLocation"},
+	}
+}
+
+func schema_pkg_apis_workflow_v1alpha1_DataTemplate(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "DataTemplate is a template subtype to source data and transform it, step by step. It is run by the executor, in place of a user container. The transformed data is the `outputs.result`, e.g. to fan out over it with `withParam`.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"source": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Source of the data",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1.DataSource"),
+						},
+					},
+					"transformation": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Transformation is the list of steps transforming the data, each one is applied to the output of the previous one",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1.TransformationStep"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"source"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1.DataSource", "github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1.TransformationStep"},
+	}
+}
+
 func schema_pkg_apis_workflow_v1alpha1_Event(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -1199,6 +1816,42 @@ func schema_pkg_apis_workflow_v1alpha1_Event(ref common.ReferenceCallback) commo
 	}
 }
 
+func schema_pkg_apis_workflow_v1alpha1_EventWorkflowSelector(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "EventWorkflowSelector selects the running workflows, in the binding's namespace, that an event acts on. At least one of the name or labels must be specified.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is an expression that evaluates to the name of the workflow, e.g. `payload.workflow`",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"labels": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Labels the workflows must have, the values are expressions, e.g. `{\"approval-id\": \"payload.id\"}`",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
 func schema_pkg_apis_workflow_v1alpha1_ExecutorConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -1651,6 +2304,130 @@ func schema_pkg_apis_workflow_v1alpha1_HTTPArtifact(ref common.ReferenceCallback
 	}
 }
 
+func schema_pkg_apis_workflow_v1alpha1_HTTPHeader(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "HTTPHeader is a header of an HTTP request",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name of the header",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"value": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Value of the header",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"valueFrom": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ValueFrom is the source of the header's value, if it is not in value",
+							Ref:         ref("github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1.HTTPHeaderSource"),
+						},
+					},
+				},
+				Required: []string{"name"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1.HTTPHeaderSource"},
+	}
+}
+
+func schema_pkg_apis_workflow_v1alpha1_HTTPHeaderSource(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "HTTPHeaderSource is the source of the value of an HTTP header",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"secretKeyRef": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SecretKeyRef is a key of a secret in the workflow's namespace",
+							Ref:         ref("k8s.io/api/core/v1.SecretKeySelector"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/api/core/v1.SecretKeySelector"},
+	}
+}
+
+func schema_pkg_apis_workflow_v1alpha1_HTTPTemplate(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "HTTPTemplate is a template subtype to send an HTTP request. The request is sent by the controller, so no pod is created. The response body is the `outputs.result` and the response status code is the `statusCode` output parameter.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"method": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Method is the HTTP method of the request, defaults to GET",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"url": {
+						SchemaProps: spec.SchemaProps{
+							Description: "URL of the request",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"headers": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Headers of the request",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1.HTTPHeader"),
+									},
+								},
+							},
+						},
+					},
+					"body": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Body of the request",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"timeoutSeconds": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TimeoutSeconds is the timeout of the request, defaults to 30 seconds",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"successCondition": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SuccessCondition is an expression (https://github.com/antonmedv/expr) that is evaluated against the response to decide whether the node succeeded, e.g. `response.statusCode == 201`. Defaults to any 2xx status code.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"url"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1.HTTPHeader"},
+	}
+}
+
 func schema_pkg_apis_workflow_v1alpha1_Header(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -1754,35 +2531,74 @@ func schema_pkg_apis_workflow_v1alpha1_Inputs(ref common.ReferenceCallback) comm
 							},
 						},
 						SchemaProps: spec.SchemaProps{
-							Description: "Artifact are a list of artifacts passed as inputs",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1.Artifact"),
-									},
-								},
-							},
+							Description: "Artifact are a list of artifacts passed as inputs",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1.Artifact"),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1.Artifact", "github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1.Parameter"},
+	}
+}
+
+func schema_pkg_apis_workflow_v1alpha1_Item(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "Item expands a single workflow step into multiple parallel steps The value of Item can be a map, string, bool, or number",
+				Type:        Item{}.OpenAPISchemaType(),
+				Format:      Item{}.OpenAPISchemaFormat(),
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_workflow_v1alpha1_LifecycleHook(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "LifecycleHook is a template which is run once its expression evaluates to true, e.g. when a step starts running or fails. It is run at most once.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"template": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Template is the name of the template to execute by the hook",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"arguments": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Arguments hold arguments to the template",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1.Arguments"),
+						},
+					},
+					"expression": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Expression is a condition, e.g. `steps.train.status == \"Failed\"`, which runs the hook once it evaluates to true",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
 				},
+				Required: []string{"template", "expression"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1.Artifact", "github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1.Parameter"},
-	}
-}
-
-func schema_pkg_apis_workflow_v1alpha1_Item(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "Item expands a single workflow step into multiple parallel steps The value of Item can be a map, string, bool, or number",
-				Type:        Item{}.OpenAPISchemaType(),
-				Format:      Item{}.OpenAPISchemaFormat(),
-			},
-		},
+			"github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1.Arguments"},
 	}
 }
 
@@ -1861,6 +2677,13 @@ func schema_pkg_apis_workflow_v1alpha1_MemoizationStatus(ref common.ReferenceCal
 							Format:      "",
 						},
 					},
+					"cacheType": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CacheType is the type of the cache that was used, empty for a ConfigMap cache",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 				},
 				Required: []string{"hit", "key", "cacheName"},
 			},
@@ -2779,6 +3602,49 @@ func schema_pkg_apis_workflow_v1alpha1_ResourceTemplate(ref common.ReferenceCall
 	}
 }
 
+func schema_pkg_apis_workflow_v1alpha1_ResumeAction(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"workflows": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Workflows selects the workflows to resume",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1.EventWorkflowSelector"),
+						},
+					},
+					"nodeFieldSelector": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NodeFieldSelector selects the suspended nodes to resume, e.g. `displayName=approve`, if empty then all are resumed",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"outputParameters": {
+						SchemaProps: spec.SchemaProps{
+							Description: "OutputParameters set on the suspended nodes, extracted from the event using `valueFrom.event`. Requires a node field selector.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1.Parameter"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"workflows"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1.EventWorkflowSelector", "github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1.Parameter"},
+	}
+}
+
 func schema_pkg_apis_workflow_v1alpha1_RetryAffinity(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -2842,6 +3708,13 @@ func schema_pkg_apis_workflow_v1alpha1_RetryStrategy(ref common.ReferenceCallbac
 							Ref:         ref("github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1.RetryAffinity"),
 						},
 					},
+					"expression": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Expression is a condition expression for when a node will be retried. If it evaluates to false, the node will not be retried and the retry strategy will be ignored. It can reference {{lastRetry.exitCode}}, {{lastRetry.status}}, {{lastRetry.duration}} and {{lastRetry.message}}.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 				},
 			},
 		},
@@ -3009,6 +3882,28 @@ func schema_pkg_apis_workflow_v1alpha1_S3Bucket(ref common.ReferenceCallback) co
 	}
 }
 
+func schema_pkg_apis_workflow_v1alpha1_SQLCache(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "SQLCache is a memoization cache stored in the persistence database",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name of the cache",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"name"},
+			},
+		},
+	}
+}
+
 func schema_pkg_apis_workflow_v1alpha1_ScriptTemplate(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -3315,11 +4210,17 @@ func schema_pkg_apis_workflow_v1alpha1_SemaphoreRef(ref common.ReferenceCallback
 							Ref:         ref("k8s.io/api/core/v1.ConfigMapKeySelector"),
 						},
 					},
+					"database": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Database is a semaphore whose limit and holders are stored in the persistence database, so it can be shared by workflow controllers with different instance IDs, or in different clusters",
+							Ref:         ref("github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1.SyncDatabaseRef"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/api/core/v1.ConfigMapKeySelector"},
+			"github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1.SyncDatabaseRef", "k8s.io/api/core/v1.ConfigMapKeySelector"},
 	}
 }
 
@@ -3405,6 +4306,42 @@ func schema_pkg_apis_workflow_v1alpha1_Sequence(ref common.ReferenceCallback) co
 	}
 }
 
+func schema_pkg_apis_workflow_v1alpha1_StopAction(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"workflows": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Workflows selects the workflows to stop",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1.EventWorkflowSelector"),
+						},
+					},
+					"nodeFieldSelector": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NodeFieldSelector selects the suspended nodes to fail, if empty then the workflows are stopped",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"message": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Message is an expression for the message set on the failed nodes, e.g. `\"rejected by \" + payload.user`",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"workflows"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1.EventWorkflowSelector"},
+	}
+}
+
 func schema_pkg_apis_workflow_v1alpha1_Submit(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -3563,6 +4500,28 @@ func schema_pkg_apis_workflow_v1alpha1_SuspendTemplate(ref common.ReferenceCallb
 	}
 }
 
+func schema_pkg_apis_workflow_v1alpha1_SyncDatabaseRef(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "SyncDatabaseRef is a reference to a semaphore in the persistence database",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"key": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Key is the name of the semaphore in the database",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"key"},
+			},
+		},
+	}
+}
+
 func schema_pkg_apis_workflow_v1alpha1_Synchronization(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -3582,6 +4541,32 @@ func schema_pkg_apis_workflow_v1alpha1_Synchronization(ref common.ReferenceCallb
 							Ref:         ref("github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1.Mutex"),
 						},
 					},
+					"semaphores": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Semaphores holds more Semaphore configurations. Every semaphore and mutex is acquired, or none are.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1.SemaphoreRef"),
+									},
+								},
+							},
+						},
+					},
+					"mutexes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Mutexes holds more Mutex lock details. Every semaphore and mutex is acquired, or none are.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1.Mutex"),
+									},
+								},
+							},
+						},
+					},
 				},
 			},
 		},
@@ -3800,6 +4785,30 @@ func schema_pkg_apis_workflow_v1alpha1_Template(ref common.ReferenceCallback) co
 							Ref:         ref("github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1.SuspendTemplate"),
 						},
 					},
+					"http": {
+						SchemaProps: spec.SchemaProps{
+							Description: "HTTP template subtype which sends an HTTP request from the controller, without creating a pod",
+							Ref:         ref("github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1.HTTPTemplate"),
+						},
+					},
+					"containerSet": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ContainerSet template subtype which runs several containers, ordered by their dependencies, in a single pod",
+							Ref:         ref("github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1.ContainerSetTemplate"),
+						},
+					},
+					"data": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Data template subtype which sources data, such as the keys of the objects under an artifact location, and transforms it without user code",
+							Ref:         ref("github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1.DataTemplate"),
+						},
+					},
+					"workflow": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Workflow template subtype which runs a child workflow from a WorkflowTemplate or a ClusterWorkflowTemplate",
+							Ref:         ref("github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1.ChildWorkflowTemplate"),
+						},
+					},
 					"volumes": {
 						VendorExtensible: spec.VendorExtensible{
 							Extensions: spec.Extensions{
@@ -4009,7 +5018,7 @@ func schema_pkg_apis_workflow_v1alpha1_Template(ref common.ReferenceCallback) co
 			},
 		},
 		Dependencies: []string{
-			"github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1.Arguments", "github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1.ArtifactLocation", "github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1.DAGTemplate", "github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1.ExecutorConfig", "github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1.Inputs", "github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1.Memoize", "github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1.Metadata", "github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1.Metrics", "github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1.Outputs", "github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1.ParallelSteps", "github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1.ResourceTemplate", "github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1.RetryStrategy", "github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1.ScriptTemplate", "github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1.SuspendTemplate", "github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1.Synchronization", "github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1.TemplateRef", "github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1.UserContainer", "k8s.io/api/core/v1.Affinity", "k8s.io/api/core/v1.Container", "k8s.io/api/core/v1.HostAlias", "k8s.io/api/core/v1.PodSecurityContext", "k8s.io/api/core/v1.Toleration", "k8s.io/api/core/v1.Volume", "k8s.io/apimachinery/pkg/util/intstr.IntOrString"},
+			"github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1.Arguments", "github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1.ArtifactLocation", "github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1.ChildWorkflowTemplate", "github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1.ContainerSetTemplate", "github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1.DAGTemplate", "github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1.DataTemplate", "github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1.ExecutorConfig", "github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1.HTTPTemplate", "github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1.Inputs", "github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1.Memoize", "github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1.Metadata", "github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1.Metrics", "github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1.Outputs", "github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1.ParallelSteps", "github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1.ResourceTemplate", "github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1.RetryStrategy", "github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1.ScriptTemplate", "github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1.SuspendTemplate", "github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1.Synchronization", "github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1.TemplateRef", "github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1.UserContainer", "k8s.io/api/core/v1.Affinity", "k8s.io/api/core/v1.Container", "k8s.io/api/core/v1.HostAlias", "k8s.io/api/core/v1.PodSecurityContext", "k8s.io/api/core/v1.Toleration", "k8s.io/api/core/v1.Volume", "k8s.io/apimachinery/pkg/util/intstr.IntOrString"},
 	}
 }
 
@@ -4054,6 +5063,50 @@ func schema_pkg_apis_workflow_v1alpha1_TemplateRef(ref common.ReferenceCallback)
 	}
 }
 
+func schema_pkg_apis_workflow_v1alpha1_TerminateAction(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"workflows": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Workflows selects the workflows to terminate",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1.EventWorkflowSelector"),
+						},
+					},
+				},
+				Required: []string{"workflows"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1.EventWorkflowSelector"},
+	}
+}
+
+func schema_pkg_apis_workflow_v1alpha1_TransformationStep(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "TransformationStep is a step of the transformation of the data of a data template",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"expression": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Expression (https://github.com/antonmedv/expr) evaluated against `data`, the output of the previous step, e.g. `filter(data, {# endsWith \".csv\"})`, `map(data, {\"prefix/\" + #})` or `batch(data, 10)`",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"expression"},
+			},
+		},
+	}
+}
+
 func schema_pkg_apis_workflow_v1alpha1_UserContainer(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -4617,12 +5670,30 @@ func schema_pkg_apis_workflow_v1alpha1_WorkflowEventBindingSpec(ref common.Refer
 							Ref:         ref("github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1.Submit"),
 						},
 					},
+					"resume": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Resume resumes the selected workflows, e.g. to approve a suspended step",
+							Ref:         ref("github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1.ResumeAction"),
+						},
+					},
+					"stop": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Stop stops the selected workflows, or fails their suspended nodes",
+							Ref:         ref("github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1.StopAction"),
+						},
+					},
+					"terminate": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Terminate terminates the selected workflows",
+							Ref:         ref("github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1.TerminateAction"),
+						},
+					},
 				},
 				Required: []string{"event"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1.Event", "github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1.Submit"},
+			"github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1.Event", "github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1.ResumeAction", "github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1.StopAction", "github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1.Submit", "github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1.TerminateAction"},
 	}
 }
 
@@ -5014,11 +6085,32 @@ func schema_pkg_apis_workflow_v1alpha1_WorkflowSpec(ref common.ReferenceCallback
 							Ref:         ref("github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1.RetryStrategy"),
 						},
 					},
+					"artifactGC": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ArtifactGC describes the strategy to use when deleting the output artifacts of the workflow, unless the artifact has its own strategy",
+							Ref:         ref("github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1.ArtifactGC"),
+						},
+					},
+					"hooks": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Hooks are templates, keyed by name, which are run while the workflow is running, once their expression evaluates to true",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1.LifecycleHook"),
+									},
+								},
+							},
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1.Arguments", "github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1.ArtifactRepositoryRef", "github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1.ExecutorConfig", "github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1.Metrics", "github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1.PodGC", "github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1.RetryStrategy", "github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1.Synchronization", "github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1.TTLStrategy", "github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1.Template", "github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1.VolumeClaimGC", "github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1.WorkflowTemplateRef", "k8s.io/api/core/v1.Affinity", "k8s.io/api/core/v1.HostAlias", "k8s.io/api/core/v1.LocalObjectReference", "k8s.io/api/core/v1.PersistentVolumeClaim", "k8s.io/api/core/v1.PodDNSConfig", "k8s.io/api/core/v1.PodSecurityContext", "k8s.io/api/core/v1.Toleration", "k8s.io/api/core/v1.Volume", "k8s.io/api/policy/v1beta1.PodDisruptionBudgetSpec"},
+			"github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1.Arguments", "github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1.ArtifactGC", "github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1.ArtifactRepositoryRef", "github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1.ExecutorConfig", "github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1.LifecycleHook", "github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1.Metrics", "github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1.PodGC", "github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1.RetryStrategy", "github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1.Synchronization", "github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1.TTLStrategy", "github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1.Template", "github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1.VolumeClaimGC", "github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1.WorkflowTemplateRef", "k8s.io/api/core/v1.Affinity", "k8s.io/api/core/v1.HostAlias", "k8s.io/api/core/v1.LocalObjectReference", "k8s.io/api/core/v1.PersistentVolumeClaim", "k8s.io/api/core/v1.PodDNSConfig", "k8s.io/api/core/v1.PodSecurityContext", "k8s.io/api/core/v1.Toleration", "k8s.io/api/core/v1.Volume", "k8s.io/api/policy/v1beta1.PodDisruptionBudgetSpec"},
 	}
 }
 
@@ -5272,11 +6364,26 @@ func schema_pkg_apis_workflow_v1alpha1_WorkflowStep(ref common.ReferenceCallback
 							Format:      "",
 						},
 					},
+					"hooks": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Hooks are templates, keyed by name, which are run while the step is running or once it completed, once their expression evaluates to true",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1.LifecycleHook"),
+									},
+								},
+							},
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1.Arguments", "github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1.ContinueOn", "github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1.Item", "github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1.Sequence", "github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1.TemplateRef"},
+			"github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1.Arguments", "github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1.ContinueOn", "github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1.Item", "github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1.LifecycleHook", "github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1.Sequence", "github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1.TemplateRef"},
 	}
 }
 
@@ -5737,6 +6844,27 @@ func schema_pkg_apis_workflow_v1alpha1_WorkflowTemplateSpec(ref common.Reference
 							Ref:         ref("github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1.RetryStrategy"),
 						},
 					},
+					"artifactGC": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ArtifactGC describes the strategy to use when deleting the output artifacts of the workflow, unless the artifact has its own strategy",
+							Ref:         ref("github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1.ArtifactGC"),
+						},
+					},
+					"hooks": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Hooks are templates, keyed by name, which are run while the workflow is running, once their expression evaluates to true",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1.LifecycleHook"),
+									},
+								},
+							},
+						},
+					},
 					"workflowMetadata": {
 						SchemaProps: spec.SchemaProps{
 							Description: "WorkflowMetadata contains some metadata of the workflow to be refer",
@@ -5747,7 +6875,7 @@ func schema_pkg_apis_workflow_v1alpha1_WorkflowTemplateSpec(ref common.Reference
 			},
 		},
 		Dependencies: []string{
-			"github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1.Arguments", "github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1.ArtifactRepositoryRef", "github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1.ExecutorConfig", "github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1.Metrics", "github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1.PodGC", "github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1.RetryStrategy", "github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1.Synchronization", "github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1.TTLStrategy", "github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1.Template", "github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1.VolumeClaimGC", "github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1.WorkflowTemplateRef", "k8s.io/api/core/v1.Affinity", "k8s.io/api/core/v1.HostAlias", "k8s.io/api/core/v1.LocalObjectReference", "k8s.io/api/core/v1.PersistentVolumeClaim", "k8s.io/api/core/v1.PodDNSConfig", "k8s.io/api/core/v1.PodSecurityContext", "k8s.io/api/core/v1.Toleration", "k8s.io/api/core/v1.Volume", "k8s.io/api/policy/v1beta1.PodDisruptionBudgetSpec", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+			"github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1.Arguments", "github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1.ArtifactGC", "github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1.ArtifactRepositoryRef", "github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1.ExecutorConfig", "github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1.LifecycleHook", "github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1.Metrics", "github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1.PodGC", "github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1.RetryStrategy", "github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1.Synchronization", "github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1.TTLStrategy", "github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1.Template", "github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1.VolumeClaimGC", "github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1.WorkflowTemplateRef", "k8s.io/api/core/v1.Affinity", "k8s.io/api/core/v1.HostAlias", "k8s.io/api/core/v1.LocalObjectReference", "k8s.io/api/core/v1.PersistentVolumeClaim", "k8s.io/api/core/v1.PodDNSConfig", "k8s.io/api/core/v1.PodSecurityContext", "k8s.io/api/core/v1.Toleration", "k8s.io/api/core/v1.Volume", "k8s.io/api/policy/v1beta1.PodDisruptionBudgetSpec", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
 	}
 }
 
@@ -25,13 +25,17 @@ type TemplateType string
 
 // Possible template types
 const (
-	TemplateTypeContainer TemplateType = "Container"
-	TemplateTypeSteps     TemplateType = "Steps"
-	TemplateTypeScript    TemplateType = "Script"
-	TemplateTypeResource  TemplateType = "Resource"
-	TemplateTypeDAG       TemplateType = "DAG"
-	TemplateTypeSuspend   TemplateType = "Suspend"
-	TemplateTypeUnknown   TemplateType = "Unknown"
+	TemplateTypeContainer    TemplateType = "Container"
+	TemplateTypeSteps        TemplateType = "Steps"
+	TemplateTypeScript       TemplateType = "Script"
+	TemplateTypeResource     TemplateType = "Resource"
+	TemplateTypeDAG          TemplateType = "DAG"
+	TemplateTypeSuspend      TemplateType = "Suspend"
+	TemplateTypeHTTP         TemplateType = "HTTP"
+	TemplateTypeContainerSet TemplateType = "ContainerSet"
+	TemplateTypeData         TemplateType = "Data"
+	TemplateTypeWorkflow     TemplateType = "Workflow"
+	TemplateTypeUnknown      TemplateType = "Unknown"
 )
 
 // NodePhase is a label for the condition of a node at the current time.
@@ -68,6 +72,9 @@ const (
 	NodeTypeRetry     NodeType = "Retry"
 	NodeTypeSkipped   NodeType = "Skipped"
 	NodeTypeSuspend   NodeType = "Suspend"
+	NodeTypeHTTP      NodeType = "HTTP"
+	NodeTypeContainer NodeType = "Container"
+	NodeTypeWorkflow  NodeType = "Workflow"
 )
 
 // PodGCStrategy is the strategy when to delete completed pods for GC.
@@ -89,6 +96,16 @@ const (
 	VolumeClaimGCOnSuccess    VolumeClaimGCStrategy = "OnWorkflowSuccess"
 )
 
+// ArtifactGCStrategy is the strategy when to delete artifacts for GC.
+type ArtifactGCStrategy string
+
+// ArtifactGCStrategy
+const (
+	ArtifactGCOnWorkflowCompletion ArtifactGCStrategy = "OnWorkflowCompletion"
+	ArtifactGCOnWorkflowDeletion   ArtifactGCStrategy = "OnWorkflowDeletion"
+	ArtifactGCNever                ArtifactGCStrategy = "Never"
+)
+
 // Workflow is the definition of a workflow resource
 // +genclient
 // +genclient:noStatus
@@ -351,6 +368,14 @@ type WorkflowSpec struct {
 
 	// RetryStrategy for all templates in the workflow.
 	RetryStrategy *RetryStrategy `json:"retryStrategy,omitempty" protobuf:"bytes,37,opt,name=retryStrategy"`
+
+	// ArtifactGC describes the strategy to use when deleting the output artifacts of the workflow,
+	// unless the artifact has its own strategy
+	ArtifactGC *ArtifactGC `json:"artifactGC,omitempty" protobuf:"bytes,38,opt,name=artifactGC"`
+
+	// Hooks are templates, keyed by name, which are run while the workflow is running, once their expression
+	// evaluates to true
+	Hooks map[string]LifecycleHook `json:"hooks,omitempty" protobuf:"bytes,39,rep,name=hooks"`
 }
 
 // GetVolumeClaimGC returns the VolumeClaimGC that was defined in the workflow spec.  If none was provided, a default value is returned.
@@ -382,32 +407,26 @@ func (wfs WorkflowSpec) GetTTLStrategy() *TTLStrategy {
 func (wf *Workflow) GetSemaphoreKeys() []string {
 	keyMap := make(map[string]bool)
 	namespace := wf.Namespace
+	addKeys := func(s *Synchronization) {
+		if s == nil {
+			return
+		}
+		for _, configMapRef := range s.getSemaphoreConfigMapRefs() {
+			key := fmt.Sprintf("%s/%s", namespace, configMapRef.Name)
+			keyMap[key] = true
+		}
+	}
 	var templates []Template
 	if wf.Spec.WorkflowTemplateRef == nil {
 		templates = wf.Spec.Templates
-		if wf.Spec.Synchronization != nil {
-			if configMapRef := wf.Spec.Synchronization.getSemaphoreConfigMapRef(); configMapRef != nil {
-				key := fmt.Sprintf("%s/%s", namespace, configMapRef.Name)
-				keyMap[key] = true
-			}
-		}
+		addKeys(wf.Spec.Synchronization)
 	} else if wf.Status.StoredWorkflowSpec != nil {
 		templates = wf.Status.StoredWorkflowSpec.Templates
-		if wf.Status.StoredWorkflowSpec.Synchronization != nil {
-			if configMapRef := wf.Status.StoredWorkflowSpec.Synchronization.getSemaphoreConfigMapRef(); configMapRef != nil {
-				key := fmt.Sprintf("%s/%s", namespace, configMapRef.Name)
-				keyMap[key] = true
-			}
-		}
+		addKeys(wf.Status.StoredWorkflowSpec.Synchronization)
 	}
 
 	for _, tmpl := range templates {
-		if tmpl.Synchronization != nil {
-			if configMapRef := tmpl.Synchronization.getSemaphoreConfigMapRef(); configMapRef != nil {
-				key := fmt.Sprintf("%s/%s", namespace, configMapRef.Name)
-				keyMap[key] = true
-			}
-		}
+		addKeys(tmpl.Synchronization)
 	}
 	var semaphoreKeys []string
 	for key := range keyMap {
@@ -545,6 +564,19 @@ type Template struct {
 	// Suspend template subtype which can suspend a workflow when reaching the step
 	Suspend *SuspendTemplate `json:"suspend,omitempty" protobuf:"bytes,16,opt,name=suspend"`
 
+	// HTTP template subtype which sends an HTTP request from the controller, without creating a pod
+	HTTP *HTTPTemplate `json:"http,omitempty" protobuf:"bytes,39,opt,name=http"`
+
+	// ContainerSet template subtype which runs several containers, ordered by their dependencies, in a single pod
+	ContainerSet *ContainerSetTemplate `json:"containerSet,omitempty" protobuf:"bytes,40,opt,name=containerSet"`
+
+	// Data template subtype which sources data, such as the keys of the objects under an artifact location, and
+	// transforms it without user code
+	Data *DataTemplate `json:"data,omitempty" protobuf:"bytes,41,opt,name=data"`
+
+	// Workflow template subtype which runs a child workflow from a WorkflowTemplate or a ClusterWorkflowTemplate
+	Workflow *ChildWorkflowTemplate `json:"workflow,omitempty" protobuf:"bytes,42,opt,name=workflow"`
+
 	// Volumes is a list of volumes that can be mounted by containers in a template.
 	// +patchStrategy=merge
 	// +patchMergeKey=name
@@ -782,6 +814,26 @@ type Artifact struct {
 
 	// If mode is set, apply the permission recursively into the artifact if it is a folder
 	RecurseMode bool `json:"recurseMode,omitempty" protobuf:"varint,10,opt,name=recurseMode"`
+
+	// ArtifactGC describes the strategy to use when deleting this output artifact, overriding the workflow's strategy
+	ArtifactGC *ArtifactGC `json:"artifactGC,omitempty" protobuf:"bytes,11,opt,name=artifactGC"`
+
+	// Deleted is set by the controller once the artifact has been garbage collected
+	Deleted bool `json:"deleted,omitempty" protobuf:"varint,12,opt,name=deleted"`
+}
+
+// ArtifactGC describes how to delete output artifacts
+type ArtifactGC struct {
+	// Strategy is the strategy to use. One of "OnWorkflowCompletion", "OnWorkflowDeletion", "Never"
+	Strategy ArtifactGCStrategy `json:"strategy,omitempty" protobuf:"bytes,1,opt,name=strategy,casttype=ArtifactGCStrategy"`
+}
+
+// GetStrategy returns the ArtifactGCStrategy to use, which defaults to "Never"
+func (agc *ArtifactGC) GetStrategy() ArtifactGCStrategy {
+	if agc == nil || agc.Strategy == "" {
+		return ArtifactGCNever
+	}
+	return agc.Strategy
 }
 
 // PodGC describes how to delete completed pods as they complete
@@ -866,6 +918,9 @@ type ArtifactLocation struct {
 
 	// GCS contains GCS artifact location details
 	GCS *GCSArtifact `json:"gcs,omitempty" protobuf:"bytes,9,opt,name=gcs"`
+
+	// Azure contains Azure Blob Storage artifact location details
+	Azure *AzureArtifact `json:"azure,omitempty" protobuf:"bytes,10,opt,name=azure"`
 }
 
 func (a *ArtifactLocation) Get() ArtifactLocationType {
@@ -873,6 +928,8 @@ func (a *ArtifactLocation) Get() ArtifactLocationType {
 		return nil
 	} else if a.Artifactory != nil {
 		return a.Artifactory
+	} else if a.Azure != nil {
+		return a.Azure
 	} else if a.Git != nil {
 		return a.Git
 	} else if a.GCS != nil {
@@ -897,6 +954,8 @@ func (a *ArtifactLocation) SetType(x ArtifactLocationType) error {
 	switch v := x.(type) {
 	case *ArtifactoryArtifact:
 		a.Artifactory = &ArtifactoryArtifact{}
+	case *AzureArtifact:
+		a.Azure = &AzureArtifact{}
 	case *GCSArtifact:
 		a.GCS = &GCSArtifact{}
 	case *HDFSArtifact:
@@ -1082,6 +1141,10 @@ type WorkflowStep struct {
 	// template, irrespective of the success, failure, or error of the
 	// primary template.
 	OnExit string `json:"onExit,omitempty" protobuf:"bytes,11,opt,name=onExit"`
+
+	// Hooks are templates, keyed by name, which are run while the step is running or once it completed, once
+	// their expression evaluates to true
+	Hooks map[string]LifecycleHook `json:"hooks,omitempty" protobuf:"bytes,12,rep,name=hooks"`
 }
 
 var _ TemplateReferenceHolder = &WorkflowStep{}
@@ -1098,6 +1161,19 @@ func (step *WorkflowStep) ShouldExpand() bool {
 	return len(step.WithItems) != 0 || step.WithParam != "" || step.WithSequence != nil
 }
 
+// LifecycleHook is a template which is run once its expression evaluates to true, e.g. when a step starts running
+// or fails. It is run at most once.
+type LifecycleHook struct {
+	// Template is the name of the template to execute by the hook
+	Template string `json:"template" protobuf:"bytes,1,opt,name=template"`
+
+	// Arguments hold arguments to the template
+	Arguments Arguments `json:"arguments,omitempty" protobuf:"bytes,2,opt,name=arguments"`
+
+	// Expression is a condition, e.g. `steps.train.status == "Failed"`, which runs the hook once it evaluates to true
+	Expression string `json:"expression" protobuf:"bytes,3,opt,name=expression"`
+}
+
 // Sequence expands a workflow step into numeric range
 type Sequence struct {
 	// Count is number of elements in the sequence (default: 0). Not to be used with end
@@ -1133,13 +1209,37 @@ type Synchronization struct {
 	Semaphore *SemaphoreRef `json:"semaphore,omitempty" protobuf:"bytes,1,opt,name=semaphore"`
 	// Mutex holds the Mutex lock details
 	Mutex *Mutex `json:"mutex,omitempty" protobuf:"bytes,2,opt,name=mutex"`
+	// Semaphores holds more Semaphore configurations. Every semaphore and mutex is acquired, or none are.
+	Semaphores []*SemaphoreRef `json:"semaphores,omitempty" protobuf:"bytes,3,rep,name=semaphores"`
+	// Mutexes holds more Mutex lock details. Every semaphore and mutex is acquired, or none are.
+	Mutexes []*Mutex `json:"mutexes,omitempty" protobuf:"bytes,4,rep,name=mutexes"`
+}
+
+// GetLocks returns a Synchronization for each of the semaphores and mutexes, in the order they should be acquired.
+// Each has a single semaphore or mutex.
+func (s *Synchronization) GetLocks() []*Synchronization {
+	var locks []*Synchronization
+	for _, semaphore := range append([]*SemaphoreRef{s.Semaphore}, s.Semaphores...) {
+		if semaphore != nil {
+			locks = append(locks, &Synchronization{Semaphore: semaphore})
+		}
+	}
+	for _, mutex := range append([]*Mutex{s.Mutex}, s.Mutexes...) {
+		if mutex != nil {
+			locks = append(locks, &Synchronization{Mutex: mutex})
+		}
+	}
+	return locks
 }
 
-func (s *Synchronization) getSemaphoreConfigMapRef() *apiv1.ConfigMapKeySelector {
-	if s.Semaphore != nil && s.Semaphore.ConfigMapKeyRef != nil {
-		return s.Semaphore.ConfigMapKeyRef
+func (s *Synchronization) getSemaphoreConfigMapRefs() []*apiv1.ConfigMapKeySelector {
+	var refs []*apiv1.ConfigMapKeySelector
+	for _, lock := range s.GetLocks() {
+		if lock.Semaphore != nil && lock.Semaphore.ConfigMapKeyRef != nil {
+			refs = append(refs, lock.Semaphore.ConfigMapKeyRef)
+		}
 	}
-	return nil
+	return refs
 }
 
 type SynchronizationType string
@@ -1163,6 +1263,15 @@ func (s *Synchronization) GetType() SynchronizationType {
 type SemaphoreRef struct {
 	// ConfigMapKeyRef is configmap selector for Semaphore configuration
 	ConfigMapKeyRef *apiv1.ConfigMapKeySelector `json:"configMapKeyRef,omitempty" protobuf:"bytes,1,opt,name=configMapKeyRef"`
+	// Database is a semaphore whose limit and holders are stored in the persistence database, so it can be shared by
+	// workflow controllers with different instance IDs, or in different clusters
+	Database *SyncDatabaseRef `json:"database,omitempty" protobuf:"bytes,2,opt,name=database"`
+}
+
+// SyncDatabaseRef is a reference to a semaphore in the persistence database
+type SyncDatabaseRef struct {
+	// Key is the name of the semaphore in the database
+	Key string `json:"key" protobuf:"bytes,1,opt,name=key"`
 }
 
 // Mutex holds Mutex configuration
@@ -1397,6 +1506,11 @@ type RetryStrategy struct {
 
 	// Affinity prevents running workflow's step on the same host
 	Affinity *RetryAffinity `json:"affinity,omitempty" protobuf:"bytes,4,opt,name=affinity"`
+
+	// Expression is a condition expression for when a node will be retried. If it evaluates to false, the node will not
+	// be retried and the retry strategy will be ignored. It can reference {{lastRetry.exitCode}}, {{lastRetry.status}},
+	// {{lastRetry.duration}} and {{lastRetry.message}}.
+	Expression string `json:"expression,omitempty" protobuf:"bytes,5,opt,name=expression"`
 }
 
 // The amount of requested resource * the duration that request was used.
@@ -1521,6 +1635,10 @@ const (
 	ConditionTypeSpecError ConditionType = "SpecError"
 	// ConditionTypeMetricsError is an error during metric emission
 	ConditionTypeMetricsError ConditionType = "MetricsError"
+	// ConditionTypeArtifactGCError is an error deleting the workflow's artifacts
+	ConditionTypeArtifactGCError ConditionType = "ArtifactGCError"
+	// ConditionTypeResourceQuotaExceeded signifies a pod cannot be created because the namespace's resource quota is exceeded
+	ConditionTypeResourceQuotaExceeded ConditionType = "ResourceQuotaExceeded"
 )
 
 type Condition struct {
@@ -2098,6 +2216,45 @@ func (o *OSSArtifact) HasLocation() bool {
 	return o != nil && o.Bucket != "" && o.Endpoint != "" && o.Key != ""
 }
 
+// AzureBlobContainer contains the access information for interfacing with an Azure Blob Storage container
+type AzureBlobContainer struct {
+	// Endpoint is the service url associated with an account. It is most likely "https://<ACCOUNT_NAME>.blob.core.windows.net"
+	Endpoint string `json:"endpoint,omitempty" protobuf:"bytes,1,opt,name=endpoint"`
+
+	// Container is the container where resources will be stored
+	Container string `json:"container,omitempty" protobuf:"bytes,2,opt,name=container"`
+
+	// AccountKeySecret is the secret selector to the Azure Blob Storage account access key
+	AccountKeySecret *apiv1.SecretKeySelector `json:"accountKeySecret,omitempty" protobuf:"bytes,3,opt,name=accountKeySecret"`
+
+	// SASTokenSecret is the secret selector to a shared access signature token for the container
+	SASTokenSecret *apiv1.SecretKeySelector `json:"sasTokenSecret,omitempty" protobuf:"bytes,4,opt,name=sasTokenSecret"`
+
+	// UseSDKCreds tells the driver to figure out credentials based on the environment, e.g. workload identity or a managed identity
+	UseSDKCreds bool `json:"useSDKCreds,omitempty" protobuf:"varint,5,opt,name=useSDKCreds"`
+}
+
+// AzureArtifact is the location of an Azure Blob Storage artifact
+type AzureArtifact struct {
+	AzureBlobContainer `json:",inline" protobuf:"bytes,1,opt,name=azureBlobContainer"`
+
+	// Blob is the blob name (i.e., path) in the container where the artifact resides
+	Blob string `json:"blob" protobuf:"bytes,2,opt,name=blob"`
+}
+
+func (a *AzureArtifact) GetKey() (string, error) {
+	return a.Blob, nil
+}
+
+func (a *AzureArtifact) SetKey(key string) error {
+	a.Blob = key
+	return nil
+}
+
+func (a *AzureArtifact) HasLocation() bool {
+	return a != nil && a.Endpoint != "" && a.Container != "" && a.Blob != ""
+}
+
 // ExecutorConfig holds configurations of an executor container.
 type ExecutorConfig struct {
 	// ServiceAccountName specifies the service account name of the executor container.
@@ -2164,13 +2321,25 @@ func (tmpl *Template) GetType() TemplateType {
 	if tmpl.Suspend != nil {
 		return TemplateTypeSuspend
 	}
+	if tmpl.HTTP != nil {
+		return TemplateTypeHTTP
+	}
+	if tmpl.ContainerSet != nil {
+		return TemplateTypeContainerSet
+	}
+	if tmpl.Data != nil {
+		return TemplateTypeData
+	}
+	if tmpl.Workflow != nil {
+		return TemplateTypeWorkflow
+	}
 	return TemplateTypeUnknown
 }
 
 // IsPodType returns whether or not the template is a pod type
 func (tmpl *Template) IsPodType() bool {
 	switch tmpl.GetType() {
-	case TemplateTypeContainer, TemplateTypeScript, TemplateTypeResource:
+	case TemplateTypeContainer, TemplateTypeScript, TemplateTypeResource, TemplateTypeContainerSet, TemplateTypeData:
 		return true
 	}
 	return false
@@ -2179,7 +2348,7 @@ func (tmpl *Template) IsPodType() bool {
 // IsLeaf returns whether or not the template is a leaf
 func (tmpl *Template) IsLeaf() bool {
 	switch tmpl.GetType() {
-	case TemplateTypeContainer, TemplateTypeScript, TemplateTypeResource:
+	case TemplateTypeContainer, TemplateTypeScript, TemplateTypeResource, TemplateTypeContainerSet, TemplateTypeData:
 		return true
 	}
 	return false
@@ -2245,6 +2414,10 @@ type DAGTask struct {
 
 	// Depends are name of other targets which this depends on
 	Depends string `json:"depends,omitempty" protobuf:"bytes,12,opt,name=depends"`
+
+	// Hooks are templates, keyed by name, which are run while the task is running or once it completed, once
+	// their expression evaluates to true
+	Hooks map[string]LifecycleHook `json:"hooks,omitempty" protobuf:"bytes,13,rep,name=hooks"`
 }
 
 var _ TemplateReferenceHolder = &DAGTask{}
@@ -2267,6 +2440,114 @@ type SuspendTemplate struct {
 	Duration string `json:"duration,omitempty" protobuf:"bytes,1,opt,name=duration"`
 }
 
+// HTTPTemplate is a template subtype to send an HTTP request. The request is sent by the controller, so no pod is
+// created. The response body is the `outputs.result` and the response status code is the `statusCode` output parameter.
+type HTTPTemplate struct {
+	// Method is the HTTP method of the request, defaults to GET
+	Method string `json:"method,omitempty" protobuf:"bytes,1,opt,name=method"`
+
+	// URL of the request
+	URL string `json:"url" protobuf:"bytes,2,opt,name=url"`
+
+	// Headers of the request
+	Headers []HTTPHeader `json:"headers,omitempty" protobuf:"bytes,3,rep,name=headers"`
+
+	// Body of the request
+	Body string `json:"body,omitempty" protobuf:"bytes,4,opt,name=body"`
+
+	// TimeoutSeconds is the timeout of the request, defaults to 30 seconds
+	TimeoutSeconds *int64 `json:"timeoutSeconds,omitempty" protobuf:"varint,5,opt,name=timeoutSeconds"`
+
+	// SuccessCondition is an expression (https://github.com/antonmedv/expr) that is evaluated against the response to
+	// decide whether the node succeeded, e.g. `response.statusCode == 201`. Defaults to any 2xx status code.
+	SuccessCondition string `json:"successCondition,omitempty" protobuf:"bytes,6,opt,name=successCondition"`
+}
+
+// HTTPHeader is a header of an HTTP request
+type HTTPHeader struct {
+	// Name of the header
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
+
+	// Value of the header
+	Value string `json:"value,omitempty" protobuf:"bytes,2,opt,name=value"`
+
+	// ValueFrom is the source of the header's value, if it is not in value
+	ValueFrom *HTTPHeaderSource `json:"valueFrom,omitempty" protobuf:"bytes,3,opt,name=valueFrom"`
+}
+
+// HTTPHeaderSource is the source of the value of an HTTP header
+type HTTPHeaderSource struct {
+	// SecretKeyRef is a key of a secret in the workflow's namespace
+	SecretKeyRef *apiv1.SecretKeySelector `json:"secretKeyRef,omitempty" protobuf:"bytes,1,opt,name=secretKeyRef"`
+}
+
+// ContainerSetTemplate is a template subtype to run several containers in a single pod. The containers start once
+// their dependencies have succeeded, and share the volumes mounted by `volumeMounts`.
+type ContainerSetTemplate struct {
+	// Containers to run. One of them must be named "main": the outputs of the template are collected from it.
+	Containers []ContainerNode `json:"containers" protobuf:"bytes,1,rep,name=containers"`
+
+	// VolumeMounts are mounted in all the containers of the set, typically an emptyDir volume used as a workspace
+	VolumeMounts []apiv1.VolumeMount `json:"volumeMounts,omitempty" protobuf:"bytes,2,rep,name=volumeMounts"`
+}
+
+// GetContainerByName returns the container of the set with the given name
+func (tmpl *ContainerSetTemplate) GetContainerByName(name string) *ContainerNode {
+	for i := range tmpl.Containers {
+		if tmpl.Containers[i].Name == name {
+			return &tmpl.Containers[i]
+		}
+	}
+	return nil
+}
+
+// ContainerNode is a container of a container set
+type ContainerNode struct {
+	apiv1.Container `json:",inline" protobuf:"bytes,1,opt,name=container"`
+
+	// Dependencies are the names of the containers that must succeed before this container starts
+	Dependencies []string `json:"dependencies,omitempty" protobuf:"bytes,2,rep,name=dependencies"`
+
+	// Outputs are the output parameters and artifacts of the container, collected from it once all the containers have
+	// completed. They are the outputs of the container's node.
+	Outputs Outputs `json:"outputs,omitempty" protobuf:"bytes,3,opt,name=outputs"`
+}
+
+// ChildWorkflowTemplate is a template subtype to run a child workflow from a WorkflowTemplate or a
+// ClusterWorkflowTemplate. The child workflow is created and tracked by the controller, and is owned by the parent
+// workflow, like its pods. Its global outputs are the outputs of the node.
+type ChildWorkflowTemplate struct {
+	// WorkflowTemplateRef is the reference to the WorkflowTemplate or ClusterWorkflowTemplate of the child workflow
+	WorkflowTemplateRef *WorkflowTemplateRef `json:"workflowTemplateRef" protobuf:"bytes,1,opt,name=workflowTemplateRef"`
+
+	// Arguments are the arguments of the child workflow
+	Arguments Arguments `json:"arguments,omitempty" protobuf:"bytes,2,opt,name=arguments"`
+}
+
+// DataTemplate is a template subtype to source data and transform it, step by step. It is run by the executor, in
+// place of a user container. The transformed data is the `outputs.result`, e.g. to fan out over it with `withParam`.
+type DataTemplate struct {
+	// Source of the data
+	Source DataSource `json:"source" protobuf:"bytes,1,opt,name=source"`
+
+	// Transformation is the list of steps transforming the data, each one is applied to the output of the previous one
+	Transformation []TransformationStep `json:"transformation,omitempty" protobuf:"bytes,2,rep,name=transformation"`
+}
+
+// DataSource is the source of the data of a data template
+type DataSource struct {
+	// ArtifactPaths is an artifact location, the data is the list of the keys of the objects under it. The location
+	// may only have a key, to list a key of the artifact repository.
+	ArtifactPaths *ArtifactLocation `json:"artifactPaths,omitempty" protobuf:"bytes,1,opt,name=artifactPaths"`
+}
+
+// TransformationStep is a step of the transformation of the data of a data template
+type TransformationStep struct {
+	// Expression (https://github.com/antonmedv/expr) evaluated against `data`, the output of the previous step, e.g.
+	// `filter(data, {# endsWith ".csv"})`, `map(data, {"prefix/" + #})` or `batch(data, 10)`
+	Expression string `json:"expression" protobuf:"bytes,1,opt,name=expression"`
+}
+
 // GetArtifactByName returns an input artifact by its name
 func (in *Inputs) GetArtifactByName(name string) *Artifact {
 	return in.Artifacts.GetArtifactByName(name)
@@ -2370,6 +2651,18 @@ func (wf *Workflow) GetResourceScope() ResourceScope {
 	return ResourceScopeLocal
 }
 
+// GetArtifactGCStrategy returns the strategy to use when deleting the output artifact, which is the artifact's own
+// strategy if it has one, or the workflow's otherwise.
+func (wf *Workflow) GetArtifactGCStrategy(a *Artifact) ArtifactGCStrategy {
+	if a.ArtifactGC != nil && a.ArtifactGC.Strategy != "" {
+		return a.ArtifactGC.Strategy
+	}
+	if wf.Status.StoredWorkflowSpec != nil {
+		return wf.Status.StoredWorkflowSpec.ArtifactGC.GetStrategy()
+	}
+	return wf.Spec.ArtifactGC.GetStrategy()
+}
+
 // GetWorkflowSpec returns the Spec of a workflow.
 func (wf *Workflow) GetWorkflowSpec() WorkflowSpec {
 	return wf.Spec
@@ -2633,12 +2926,75 @@ type MemoizationStatus struct {
 	Key string `json:"key" protobuf:"bytes,2,opt,name=key"`
 	// Cache is the name of the cache that was used
 	CacheName string `json:"cacheName" protobuf:"bytes,3,opt,name=cacheName"`
+	// CacheType is the type of the cache that was used, empty for a ConfigMap cache
+	CacheType MemoizationCacheType `json:"cacheType,omitempty" protobuf:"bytes,4,opt,name=cacheType,casttype=MemoizationCacheType"`
 }
 
-// Cache is the configuration for the type of cache to be used
+// MemoizationCacheType is the type of backend used to store memoization cache entries
+type MemoizationCacheType string
+
+const (
+	MemoizationCacheTypeConfigMap MemoizationCacheType = "ConfigMap"
+	MemoizationCacheTypeSQL       MemoizationCacheType = "SQL"
+	MemoizationCacheTypeArtifact  MemoizationCacheType = "Artifact"
+)
+
+// GetCacheType returns the type of the cache that was used
+func (m *MemoizationStatus) GetCacheType() MemoizationCacheType {
+	if m.CacheType == "" {
+		return MemoizationCacheTypeConfigMap
+	}
+	return m.CacheType
+}
+
+// Cache is the configuration for the type of cache to be used. Exactly one of the types must be set.
 type Cache struct {
 	// ConfigMap sets a ConfigMap-based cache
-	ConfigMap *apiv1.ConfigMapKeySelector `json:"configMap" protobuf:"bytes,1,opt,name=configMap"`
+	ConfigMap *apiv1.ConfigMapKeySelector `json:"configMap,omitempty" protobuf:"bytes,1,opt,name=configMap"`
+	// SQL sets a cache stored in the workflow controller's persistence database
+	SQL *SQLCache `json:"sql,omitempty" protobuf:"bytes,2,opt,name=sql"`
+	// Artifact sets a cache stored in the workflow controller's default artifact repository
+	Artifact *ArtifactCache `json:"artifact,omitempty" protobuf:"bytes,3,opt,name=artifact"`
+}
+
+// SQLCache is a memoization cache stored in the persistence database
+type SQLCache struct {
+	// Name of the cache
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
+}
+
+// ArtifactCache is a memoization cache stored in the default artifact repository, under the "memoization/{name}" key
+type ArtifactCache struct {
+	// Name of the cache
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
+}
+
+// GetType returns the type of the cache, or an empty string if none is set
+func (c *Cache) GetType() MemoizationCacheType {
+	switch {
+	case c == nil:
+		return ""
+	case c.ConfigMap != nil:
+		return MemoizationCacheTypeConfigMap
+	case c.SQL != nil:
+		return MemoizationCacheTypeSQL
+	case c.Artifact != nil:
+		return MemoizationCacheTypeArtifact
+	}
+	return ""
+}
+
+// GetName returns the name of the cache
+func (c *Cache) GetName() string {
+	switch c.GetType() {
+	case MemoizationCacheTypeConfigMap:
+		return c.ConfigMap.Name
+	case MemoizationCacheTypeSQL:
+		return c.SQL.Name
+	case MemoizationCacheTypeArtifact:
+		return c.Artifact.Name
+	}
+	return ""
 }
 
 type SynchronizationAction interface {
@@ -96,6 +96,15 @@ func TestArtifactoryArtifact(t *testing.T) {
 	assert.Equal(t, "/my-key", key, "has leading slash")
 }
 
+func TestAzureArtifact(t *testing.T) {
+	a := &AzureArtifact{Blob: "my-blob", AzureBlobContainer: AzureBlobContainer{Endpoint: "my-endpoint", Container: "my-container"}}
+	assert.True(t, a.HasLocation())
+	assert.NoError(t, a.SetKey("my-blob"))
+	key, err := a.GetKey()
+	assert.NoError(t, err)
+	assert.Equal(t, "my-blob", key)
+}
+
 func TestGitArtifact(t *testing.T) {
 	a := &GitArtifact{Repo: "my-repo"}
 	assert.True(t, a.HasLocation())
@@ -188,6 +197,7 @@ func TestArtifactLocation_Get(t *testing.T) {
 	var l *ArtifactLocation
 	assert.Nil(t, l.Get())
 	assert.Nil(t, (&ArtifactLocation{}).Get())
+	assert.IsType(t, &AzureArtifact{}, (&ArtifactLocation{Azure: &AzureArtifact{}}).Get())
 	assert.IsType(t, &GitArtifact{}, (&ArtifactLocation{Git: &GitArtifact{}}).Get())
 	assert.IsType(t, &GCSArtifact{}, (&ArtifactLocation{GCS: &GCSArtifact{}}).Get())
 	assert.IsType(t, &HDFSArtifact{}, (&ArtifactLocation{HDFS: &HDFSArtifact{}}).Get())
@@ -207,6 +217,11 @@ func TestArtifactLocation_SetType(t *testing.T) {
 		assert.NoError(t, l.SetType(&ArtifactoryArtifact{}))
 		assert.NotNil(t, l.Artifactory)
 	})
+	t.Run("Azure", func(t *testing.T) {
+		l := &ArtifactLocation{}
+		assert.NoError(t, l.SetType(&AzureArtifact{}))
+		assert.NotNil(t, l.Azure)
+	})
 	t.Run("GCS", func(t *testing.T) {
 		l := &ArtifactLocation{}
 		assert.NoError(t, l.SetType(&GCSArtifact{}))
@@ -271,6 +286,12 @@ func TestArtifactLocation_Key(t *testing.T) {
 		err = l.SetKey("my-file")
 		assert.Error(t, err, "cannot set Git key")
 	})
+	t.Run("Azure", func(t *testing.T) {
+		l := &ArtifactLocation{Azure: &AzureArtifact{Blob: "my-dir"}}
+		err := l.AppendToKey("my-file")
+		assert.NoError(t, err)
+		assert.Equal(t, "my-dir/my-file", l.Azure.Blob, "appends to Azure blob name")
+	})
 	t.Run("GCS", func(t *testing.T) {
 		l := &ArtifactLocation{GCS: &GCSArtifact{Key: "my-dir"}}
 		err := l.AppendToKey("my-file")
@@ -548,6 +569,62 @@ func TestWfGetTTLStrategy(t *testing.T) {
 	assert.Equal(t, int32(30), *result.SecondsAfterCompletion)
 }
 
+func TestArtifactGC_GetStrategy(t *testing.T) {
+	var agc *ArtifactGC
+	assert.Equal(t, ArtifactGCNever, agc.GetStrategy())
+	assert.Equal(t, ArtifactGCNever, (&ArtifactGC{}).GetStrategy())
+	assert.Equal(t, ArtifactGCOnWorkflowDeletion, (&ArtifactGC{Strategy: ArtifactGCOnWorkflowDeletion}).GetStrategy())
+}
+
+func TestWorkflow_GetArtifactGCStrategy(t *testing.T) {
+	wf := Workflow{}
+	assert.Equal(t, ArtifactGCNever, wf.GetArtifactGCStrategy(&Artifact{}))
+
+	wf.Spec.ArtifactGC = &ArtifactGC{Strategy: ArtifactGCOnWorkflowCompletion}
+	assert.Equal(t, ArtifactGCOnWorkflowCompletion, wf.GetArtifactGCStrategy(&Artifact{}))
+
+	wf.Status.StoredWorkflowSpec = &WorkflowSpec{ArtifactGC: &ArtifactGC{Strategy: ArtifactGCOnWorkflowDeletion}}
+	assert.Equal(t, ArtifactGCOnWorkflowDeletion, wf.GetArtifactGCStrategy(&Artifact{}))
+
+	assert.Equal(t, ArtifactGCNever, wf.GetArtifactGCStrategy(&Artifact{ArtifactGC: &ArtifactGC{Strategy: ArtifactGCNever}}))
+}
+
+func TestCache(t *testing.T) {
+	var c *Cache
+	assert.Equal(t, MemoizationCacheType(""), c.GetType())
+	assert.Equal(t, "", c.GetName())
+	c = &Cache{ConfigMap: &corev1.ConfigMapKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "my-cm"}}}
+	assert.Equal(t, MemoizationCacheTypeConfigMap, c.GetType())
+	assert.Equal(t, "my-cm", c.GetName())
+	c = &Cache{SQL: &SQLCache{Name: "my-sql"}}
+	assert.Equal(t, MemoizationCacheTypeSQL, c.GetType())
+	assert.Equal(t, "my-sql", c.GetName())
+	c = &Cache{Artifact: &ArtifactCache{Name: "my-artifact"}}
+	assert.Equal(t, MemoizationCacheTypeArtifact, c.GetType())
+	assert.Equal(t, "my-artifact", c.GetName())
+}
+
+func TestMemoizationStatus_GetCacheType(t *testing.T) {
+	assert.Equal(t, MemoizationCacheTypeConfigMap, (&MemoizationStatus{}).GetCacheType())
+	assert.Equal(t, MemoizationCacheTypeSQL, (&MemoizationStatus{CacheType: MemoizationCacheTypeSQL}).GetCacheType())
+}
+
+func TestSynchronization_GetLocks(t *testing.T) {
+	assert.Empty(t, (&Synchronization{}).GetLocks())
+	s := &Synchronization{
+		Semaphore:  &SemaphoreRef{Database: &SyncDatabaseRef{Key: "a"}},
+		Mutex:      &Mutex{Name: "b"},
+		Semaphores: []*SemaphoreRef{{Database: &SyncDatabaseRef{Key: "c"}}},
+		Mutexes:    []*Mutex{{Name: "d"}},
+	}
+	assert.Equal(t, []*Synchronization{
+		{Semaphore: &SemaphoreRef{Database: &SyncDatabaseRef{Key: "a"}}},
+		{Semaphore: &SemaphoreRef{Database: &SyncDatabaseRef{Key: "c"}}},
+		{Mutex: &Mutex{Name: "b"}},
+		{Mutex: &Mutex{Name: "d"}},
+	}, s.GetLocks())
+}
+
 func TestWorkflow_GetSemaphoreKeys(t *testing.T) {
 	assert := assert.New(t)
 	wf := Workflow{
@@ -599,12 +676,23 @@ func TestWorkflow_GetSemaphoreKeys(t *testing.T) {
 				}},
 			},
 		},
+		{
+			Name: "t3",
+			Synchronization: &Synchronization{
+				Semaphores: []*SemaphoreRef{{ConfigMapKeyRef: &corev1.ConfigMapKeySelector{
+					LocalObjectReference: corev1.LocalObjectReference{
+						Name: "template2",
+					},
+				}}},
+			},
+		},
 	}
 	keys = wf.GetSemaphoreKeys()
-	assert.Len(keys, 3)
+	assert.Len(keys, 4)
 	assert.Contains(keys, "test/test")
 	assert.Contains(keys, "test/template")
 	assert.Contains(keys, "test/template1")
+	assert.Contains(keys, "test/template2")
 
 	spec := wf.Spec.DeepCopy()
 	wf.Spec = WorkflowSpec{
@@ -614,7 +702,7 @@ func TestWorkflow_GetSemaphoreKeys(t *testing.T) {
 	}
 	wf.Status.StoredWorkflowSpec = spec
 	keys = wf.GetSemaphoreKeys()
-	assert.Len(keys, 3)
+	assert.Len(keys, 4)
 	assert.Contains(keys, "test/test")
 	assert.Contains(keys, "test/template")
 	assert.Contains(keys, "test/template1")
@@ -103,6 +103,11 @@ func (in *Artifact) DeepCopyInto(out *Artifact) {
 		*out = new(ArchiveStrategy)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.ArtifactGC != nil {
+		in, out := &in.ArtifactGC, &out.ArtifactGC
+		*out = new(ArtifactGC)
+		**out = **in
+	}
 	return
 }
 
@@ -116,6 +121,38 @@ func (in *Artifact) DeepCopy() *Artifact {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ArtifactCache) DeepCopyInto(out *ArtifactCache) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ArtifactCache.
+func (in *ArtifactCache) DeepCopy() *ArtifactCache {
+	if in == nil {
+		return nil
+	}
+	out := new(ArtifactCache)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ArtifactGC) DeepCopyInto(out *ArtifactGC) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ArtifactGC.
+func (in *ArtifactGC) DeepCopy() *ArtifactGC {
+	if in == nil {
+		return nil
+	}
+	out := new(ArtifactGC)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ArtifactLocation) DeepCopyInto(out *ArtifactLocation) {
 	*out = *in
@@ -164,6 +201,11 @@ func (in *ArtifactLocation) DeepCopyInto(out *ArtifactLocation) {
 		*out = new(GCSArtifact)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Azure != nil {
+		in, out := &in.Azure, &out.Azure
+		*out = new(AzureArtifact)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -275,6 +317,49 @@ func (in Artifacts) DeepCopy() Artifacts {
 	return *out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AzureArtifact) DeepCopyInto(out *AzureArtifact) {
+	*out = *in
+	in.AzureBlobContainer.DeepCopyInto(&out.AzureBlobContainer)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzureArtifact.
+func (in *AzureArtifact) DeepCopy() *AzureArtifact {
+	if in == nil {
+		return nil
+	}
+	out := new(AzureArtifact)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AzureBlobContainer) DeepCopyInto(out *AzureBlobContainer) {
+	*out = *in
+	if in.AccountKeySecret != nil {
+		in, out := &in.AccountKeySecret, &out.AccountKeySecret
+		*out = new(v1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SASTokenSecret != nil {
+		in, out := &in.SASTokenSecret, &out.SASTokenSecret
+		*out = new(v1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzureBlobContainer.
+func (in *AzureBlobContainer) DeepCopy() *AzureBlobContainer {
+	if in == nil {
+		return nil
+	}
+	out := new(AzureBlobContainer)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Backoff) DeepCopyInto(out *Backoff) {
 	*out = *in
@@ -304,6 +389,16 @@ func (in *Cache) DeepCopyInto(out *Cache) {
 		*out = new(v1.ConfigMapKeySelector)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.SQL != nil {
+		in, out := &in.SQL, &out.SQL
+		*out = new(SQLCache)
+		**out = **in
+	}
+	if in.Artifact != nil {
+		in, out := &in.Artifact, &out.Artifact
+		*out = new(ArtifactCache)
+		**out = **in
+	}
 	return
 }
 
@@ -317,6 +412,28 @@ func (in *Cache) DeepCopy() *Cache {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ChildWorkflowTemplate) DeepCopyInto(out *ChildWorkflowTemplate) {
+	*out = *in
+	if in.WorkflowTemplateRef != nil {
+		in, out := &in.WorkflowTemplateRef, &out.WorkflowTemplateRef
+		*out = new(WorkflowTemplateRef)
+		**out = **in
+	}
+	in.Arguments.DeepCopyInto(&out.Arguments)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChildWorkflowTemplate.
+func (in *ChildWorkflowTemplate) DeepCopy() *ChildWorkflowTemplate {
+	if in == nil {
+		return nil
+	}
+	out := new(ChildWorkflowTemplate)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ClusterWorkflowTemplate) DeepCopyInto(out *ClusterWorkflowTemplate) {
 	*out = *in
@@ -435,6 +552,59 @@ func (in Conditions) DeepCopy() Conditions {
 	return *out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ContainerNode) DeepCopyInto(out *ContainerNode) {
+	*out = *in
+	in.Container.DeepCopyInto(&out.Container)
+	if in.Dependencies != nil {
+		in, out := &in.Dependencies, &out.Dependencies
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	in.Outputs.DeepCopyInto(&out.Outputs)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ContainerNode.
+func (in *ContainerNode) DeepCopy() *ContainerNode {
+	if in == nil {
+		return nil
+	}
+	out := new(ContainerNode)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ContainerSetTemplate) DeepCopyInto(out *ContainerSetTemplate) {
+	*out = *in
+	if in.Containers != nil {
+		in, out := &in.Containers, &out.Containers
+		*out = make([]ContainerNode, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.VolumeMounts != nil {
+		in, out := &in.VolumeMounts, &out.VolumeMounts
+		*out = make([]v1.VolumeMount, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ContainerSetTemplate.
+func (in *ContainerSetTemplate) DeepCopy() *ContainerSetTemplate {
+	if in == nil {
+		return nil
+	}
+	out := new(ContainerSetTemplate)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ContinueOn) DeepCopyInto(out *ContinueOn) {
 	*out = *in
@@ -642,6 +812,13 @@ func (in *DAGTask) DeepCopyInto(out *DAGTask) {
 		*out = new(ContinueOn)
 		**out = **in
 	}
+	if in.Hooks != nil {
+		in, out := &in.Hooks, &out.Hooks
+		*out = make(map[string]LifecycleHook, len(*in))
+		for key, val := range *in {
+			(*out)[key] = *val.DeepCopy()
+		}
+	}
 	return
 }
 
@@ -683,6 +860,49 @@ func (in *DAGTemplate) DeepCopy() *DAGTemplate {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DataSource) DeepCopyInto(out *DataSource) {
+	*out = *in
+	if in.ArtifactPaths != nil {
+		in, out := &in.ArtifactPaths, &out.ArtifactPaths
+		*out = new(ArtifactLocation)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DataSource.
+func (in *DataSource) DeepCopy() *DataSource {
+	if in == nil {
+		return nil
+	}
+	out := new(DataSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DataTemplate) DeepCopyInto(out *DataTemplate) {
+	*out = *in
+	in.Source.DeepCopyInto(&out.Source)
+	if in.Transformation != nil {
+		in, out := &in.Transformation, &out.Transformation
+		*out = make([]TransformationStep, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DataTemplate.
+func (in *DataTemplate) DeepCopy() *DataTemplate {
+	if in == nil {
+		return nil
+	}
+	out := new(DataTemplate)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Event) DeepCopyInto(out *Event) {
 	*out = *in
@@ -699,6 +919,29 @@ func (in *Event) DeepCopy() *Event {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EventWorkflowSelector) DeepCopyInto(out *EventWorkflowSelector) {
+	*out = *in
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EventWorkflowSelector.
+func (in *EventWorkflowSelector) DeepCopy() *EventWorkflowSelector {
+	if in == nil {
+		return nil
+	}
+	out := new(EventWorkflowSelector)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ExecutorConfig) DeepCopyInto(out *ExecutorConfig) {
 	*out = *in
@@ -906,6 +1149,76 @@ func (in *HTTPArtifact) DeepCopy() *HTTPArtifact {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HTTPHeader) DeepCopyInto(out *HTTPHeader) {
+	*out = *in
+	if in.ValueFrom != nil {
+		in, out := &in.ValueFrom, &out.ValueFrom
+		*out = new(HTTPHeaderSource)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPHeader.
+func (in *HTTPHeader) DeepCopy() *HTTPHeader {
+	if in == nil {
+		return nil
+	}
+	out := new(HTTPHeader)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HTTPHeaderSource) DeepCopyInto(out *HTTPHeaderSource) {
+	*out = *in
+	if in.SecretKeyRef != nil {
+		in, out := &in.SecretKeyRef, &out.SecretKeyRef
+		*out = new(v1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPHeaderSource.
+func (in *HTTPHeaderSource) DeepCopy() *HTTPHeaderSource {
+	if in == nil {
+		return nil
+	}
+	out := new(HTTPHeaderSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HTTPTemplate) DeepCopyInto(out *HTTPTemplate) {
+	*out = *in
+	if in.Headers != nil {
+		in, out := &in.Headers, &out.Headers
+		*out = make([]HTTPHeader, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.TimeoutSeconds != nil {
+		in, out := &in.TimeoutSeconds, &out.TimeoutSeconds
+		*out = new(int64)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPTemplate.
+func (in *HTTPTemplate) DeepCopy() *HTTPTemplate {
+	if in == nil {
+		return nil
+	}
+	out := new(HTTPTemplate)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Header) DeepCopyInto(out *Header) {
 	*out = *in
@@ -983,6 +1296,23 @@ func (in *Item) DeepCopy() *Item {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LifecycleHook) DeepCopyInto(out *LifecycleHook) {
+	*out = *in
+	in.Arguments.DeepCopyInto(&out.Arguments)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LifecycleHook.
+func (in *LifecycleHook) DeepCopy() *LifecycleHook {
+	if in == nil {
+		return nil
+	}
+	out := new(LifecycleHook)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Link) DeepCopyInto(out *Link) {
 	*out = *in
@@ -1545,6 +1875,30 @@ func (in ResourcesDuration) DeepCopy() ResourcesDuration {
 	return *out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResumeAction) DeepCopyInto(out *ResumeAction) {
+	*out = *in
+	in.Workflows.DeepCopyInto(&out.Workflows)
+	if in.OutputParameters != nil {
+		in, out := &in.OutputParameters, &out.OutputParameters
+		*out = make([]Parameter, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResumeAction.
+func (in *ResumeAction) DeepCopy() *ResumeAction {
+	if in == nil {
+		return nil
+	}
+	out := new(ResumeAction)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RetryAffinity) DeepCopyInto(out *RetryAffinity) {
 	*out = *in
@@ -1666,6 +2020,22 @@ func (in *S3Bucket) DeepCopy() *S3Bucket {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SQLCache) DeepCopyInto(out *SQLCache) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SQLCache.
+func (in *SQLCache) DeepCopy() *SQLCache {
+	if in == nil {
+		return nil
+	}
+	out := new(SQLCache)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ScriptTemplate) DeepCopyInto(out *ScriptTemplate) {
 	*out = *in
@@ -1712,6 +2082,11 @@ func (in *SemaphoreRef) DeepCopyInto(out *SemaphoreRef) {
 		*out = new(v1.ConfigMapKeySelector)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Database != nil {
+		in, out := &in.Database, &out.Database
+		*out = new(SyncDatabaseRef)
+		**out = **in
+	}
 	return
 }
 
@@ -1786,6 +2161,23 @@ func (in *Sequence) DeepCopy() *Sequence {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StopAction) DeepCopyInto(out *StopAction) {
+	*out = *in
+	in.Workflows.DeepCopyInto(&out.Workflows)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StopAction.
+func (in *StopAction) DeepCopy() *StopAction {
+	if in == nil {
+		return nil
+	}
+	out := new(StopAction)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Submit) DeepCopyInto(out *Submit) {
 	*out = *in
@@ -1867,6 +2259,22 @@ func (in *SuspendTemplate) DeepCopy() *SuspendTemplate {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SyncDatabaseRef) DeepCopyInto(out *SyncDatabaseRef) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SyncDatabaseRef.
+func (in *SyncDatabaseRef) DeepCopy() *SyncDatabaseRef {
+	if in == nil {
+		return nil
+	}
+	out := new(SyncDatabaseRef)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Synchronization) DeepCopyInto(out *Synchronization) {
 	*out = *in
@@ -1880,6 +2288,28 @@ func (in *Synchronization) DeepCopyInto(out *Synchronization) {
 		*out = new(Mutex)
 		**out = **in
 	}
+	if in.Semaphores != nil {
+		in, out := &in.Semaphores, &out.Semaphores
+		*out = make([]*SemaphoreRef, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(SemaphoreRef)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.Mutexes != nil {
+		in, out := &in.Mutexes, &out.Mutexes
+		*out = make([]*Mutex, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(Mutex)
+				**out = **in
+			}
+		}
+	}
 	return
 }
 
@@ -2032,6 +2462,26 @@ func (in *Template) DeepCopyInto(out *Template) {
 		*out = new(SuspendTemplate)
 		**out = **in
 	}
+	if in.HTTP != nil {
+		in, out := &in.HTTP, &out.HTTP
+		*out = new(HTTPTemplate)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ContainerSet != nil {
+		in, out := &in.ContainerSet, &out.ContainerSet
+		*out = new(ContainerSetTemplate)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Data != nil {
+		in, out := &in.Data, &out.Data
+		*out = new(DataTemplate)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Workflow != nil {
+		in, out := &in.Workflow, &out.Workflow
+		*out = new(ChildWorkflowTemplate)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Volumes != nil {
 		in, out := &in.Volumes, &out.Volumes
 		*out = make([]v1.Volume, len(*in))
@@ -2151,6 +2601,39 @@ func (in *TemplateRef) DeepCopy() *TemplateRef {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TerminateAction) DeepCopyInto(out *TerminateAction) {
+	*out = *in
+	in.Workflows.DeepCopyInto(&out.Workflows)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TerminateAction.
+func (in *TerminateAction) DeepCopy() *TerminateAction {
+	if in == nil {
+		return nil
+	}
+	out := new(TerminateAction)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TransformationStep) DeepCopyInto(out *TransformationStep) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TransformationStep.
+func (in *TransformationStep) DeepCopy() *TransformationStep {
+	if in == nil {
+		return nil
+	}
+	out := new(TransformationStep)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *UserContainer) DeepCopyInto(out *UserContainer) {
 	*out = *in
@@ -2328,6 +2811,21 @@ func (in *WorkflowEventBindingSpec) DeepCopyInto(out *WorkflowEventBindingSpec)
 		*out = new(Submit)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Resume != nil {
+		in, out := &in.Resume, &out.Resume
+		*out = new(ResumeAction)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Stop != nil {
+		in, out := &in.Stop, &out.Stop
+		*out = new(StopAction)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Terminate != nil {
+		in, out := &in.Terminate, &out.Terminate
+		*out = new(TerminateAction)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -2535,6 +3033,18 @@ func (in *WorkflowSpec) DeepCopyInto(out *WorkflowSpec) {
 		*out = new(RetryStrategy)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.ArtifactGC != nil {
+		in, out := &in.ArtifactGC, &out.ArtifactGC
+		*out = new(ArtifactGC)
+		**out = **in
+	}
+	if in.Hooks != nil {
+		in, out := &in.Hooks, &out.Hooks
+		*out = make(map[string]LifecycleHook, len(*in))
+		for key, val := range *in {
+			(*out)[key] = *val.DeepCopy()
+		}
+	}
 	return
 }
 
@@ -2645,6 +3155,13 @@ func (in *WorkflowStep) DeepCopyInto(out *WorkflowStep) {
 		*out = new(ContinueOn)
 		**out = **in
 	}
+	if in.Hooks != nil {
+		in, out := &in.Hooks, &out.Hooks
+		*out = make(map[string]LifecycleHook, len(*in))
+		for key, val := range *in {
+			(*out)[key] = *val.DeepCopy()
+		}
+	}
 	return
 }
 
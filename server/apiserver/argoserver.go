@@ -29,6 +29,7 @@ import (
 	eventsourcepkg "github.com/argoproj/argo/v2/pkg/apiclient/eventsource"
 	infopkg "github.com/argoproj/argo/v2/pkg/apiclient/info"
 	sensorpkg "github.com/argoproj/argo/v2/pkg/apiclient/sensor"
+	syncpkg "github.com/argoproj/argo/v2/pkg/apiclient/sync"
 	workflowpkg "github.com/argoproj/argo/v2/pkg/apiclient/workflow"
 	workflowarchivepkg "github.com/argoproj/argo/v2/pkg/apiclient/workflowarchive"
 	workflowtemplatepkg "github.com/argoproj/argo/v2/pkg/apiclient/workflowtemplate"
@@ -44,6 +45,7 @@ import (
 	"github.com/argoproj/argo/v2/server/info"
 	"github.com/argoproj/argo/v2/server/sensor"
 	"github.com/argoproj/argo/v2/server/static"
+	"github.com/argoproj/argo/v2/server/sync"
 	"github.com/argoproj/argo/v2/server/types"
 	"github.com/argoproj/argo/v2/server/workflow"
 	"github.com/argoproj/argo/v2/server/workflowarchive"
@@ -69,6 +71,7 @@ type argoServer struct {
 	namespace        string
 	managedNamespace string
 	clients          *types.Clients
+	restConfig       *rest.Config
 	gatekeeper       auth.Gatekeeper
 	oAuth2Service    sso.Interface
 	configController config.Controller
@@ -121,6 +124,7 @@ func NewArgoServer(ctx context.Context, opts ArgoServerOpts) (*argoServer, error
 		namespace:        opts.Namespace,
 		managedNamespace: opts.ManagedNamespace,
 		clients:          opts.Clients,
+		restConfig:       opts.RestConfig,
 		gatekeeper:       gatekeeper,
 		oAuth2Service:    ssoIf,
 		configController: configController,
@@ -148,6 +152,7 @@ func (as *argoServer) Run(ctx context.Context, port int, browserOpenFunc func(st
 	instanceIDService := instanceid.NewService(config.InstanceID)
 	var offloadRepo = sqldb.ExplosiveOffloadNodeStatusRepo
 	var wfArchive = sqldb.NullWorkflowArchive
+	var eventHistory = sqldb.NullEventHistory
 	persistence := config.Persistence
 	if persistence != nil {
 		session, tableName, err := sqldb.CreateDBSession(as.clients.Kubernetes, as.namespace, persistence)
@@ -163,12 +168,16 @@ func (as *argoServer) Run(ctx context.Context, port int, browserOpenFunc func(st
 		// we always enable the archive for the Argo Server, as the Argo Server does not write records, so you can
 		// disable the archiving - and still read old records
 		wfArchive = sqldb.NewWorkflowArchive(session, persistence.GetClusterName(), as.managedNamespace, instanceIDService)
+		// unlike the archive, the Argo Server writes the event history, as it is the Argo Server that receives events
+		eventHistory = sqldb.NewEventHistory(session, persistence.GetClusterName(), instanceIDService)
 	}
 	eventRecorderManager := events.NewEventRecorderManager(as.clients.Kubernetes)
 	artifactRepositories := artifactrepositories.New(as.clients.Kubernetes, as.managedNamespace, &config.ArtifactRepository)
-	artifactServer := artifacts.NewArtifactServer(as.gatekeeper, hydrator.New(offloadRepo), wfArchive, instanceIDService, artifactRepositories)
-	eventServer := event.NewController(instanceIDService, eventRecorderManager, as.eventQueueSize, as.eventWorkerCount)
-	grpcServer := as.newGRPCServer(instanceIDService, offloadRepo, wfArchive, eventServer, config.Links)
+	wfHydrator := hydrator.New(offloadRepo)
+	artifactServer := artifacts.NewArtifactServer(as.gatekeeper, wfHydrator, wfArchive, instanceIDService, artifactRepositories)
+	eventServer := event.NewController(instanceIDService, wfHydrator, eventRecorderManager, eventHistory, as.eventQueueSize, as.eventWorkerCount)
+	syncServer := sync.NewSyncServer(as.namespace, as.restConfig, as.clients.Kubernetes.CoreV1().Secrets(as.namespace))
+	grpcServer := as.newGRPCServer(instanceIDService, offloadRepo, wfArchive, eventServer, syncServer, config.Links)
 	httpServer := as.newHTTPServer(ctx, port, artifactServer)
 
 	// Start listener
@@ -199,6 +208,9 @@ func (as *argoServer) Run(ctx context.Context, port int, browserOpenFunc func(st
 
 	go as.configController.Run(as.stopCh, as.restartOnConfigChange)
 	go eventServer.Run(as.stopCh)
+	if persistence != nil {
+		go eventServer.RunEventHistoryGC(as.stopCh, time.Duration(persistence.EventTTL))
+	}
 	go func() { as.checkServeErr("grpcServer", grpcServer.Serve(grpcL)) }()
 	go func() { as.checkServeErr("httpServer", httpServer.Serve(httpL)) }()
 	go func() { as.checkServeErr("tcpm", tcpm.Serve()) }()
@@ -212,7 +224,7 @@ func (as *argoServer) Run(ctx context.Context, port int, browserOpenFunc func(st
 	<-as.stopCh
 }
 
-func (as *argoServer) newGRPCServer(instanceIDService instanceid.Service, offloadNodeStatusRepo sqldb.OffloadNodeStatusRepo, wfArchive sqldb.WorkflowArchive, eventServer *event.Controller, links []*v1alpha1.Link) *grpc.Server {
+func (as *argoServer) newGRPCServer(instanceIDService instanceid.Service, offloadNodeStatusRepo sqldb.OffloadNodeStatusRepo, wfArchive sqldb.WorkflowArchive, eventServer *event.Controller, syncServer syncpkg.SyncServiceServer, links []*v1alpha1.Link) *grpc.Server {
 	serverLog := log.NewEntry(log.StandardLogger())
 
 	// "Prometheus histograms are a great way to measure latency distributions of your RPCs. However, since it is bad practice to have metrics of high cardinality the latency monitoring metrics are disabled by default. To enable them please call the following in your server initialization code:"
@@ -250,8 +262,9 @@ func (as *argoServer) newGRPCServer(instanceIDService instanceid.Service, offloa
 	workflowpkg.RegisterWorkflowServiceServer(grpcServer, workflow.NewWorkflowServer(instanceIDService, offloadNodeStatusRepo))
 	workflowtemplatepkg.RegisterWorkflowTemplateServiceServer(grpcServer, workflowtemplate.NewWorkflowTemplateServer(instanceIDService))
 	cronworkflowpkg.RegisterCronWorkflowServiceServer(grpcServer, cronworkflow.NewCronWorkflowServer(instanceIDService))
-	workflowarchivepkg.RegisterArchivedWorkflowServiceServer(grpcServer, workflowarchive.NewWorkflowArchiveServer(wfArchive))
+	workflowarchivepkg.RegisterArchivedWorkflowServiceServer(grpcServer, workflowarchive.NewWorkflowArchiveServer(wfArchive, offloadNodeStatusRepo))
 	clusterwftemplatepkg.RegisterClusterWorkflowTemplateServiceServer(grpcServer, clusterworkflowtemplate.NewClusterWorkflowTemplateServer(instanceIDService))
+	syncpkg.RegisterSyncServiceServer(grpcServer, syncServer)
 	grpc_prometheus.Register(grpcServer)
 	return grpcServer
 }
@@ -294,6 +307,7 @@ func (as *argoServer) newHTTPServer(ctx context.Context, port int, artifactServe
 	mustRegisterGWHandler(eventpkg.RegisterEventServiceHandlerFromEndpoint, ctx, gwmux, endpoint, dialOpts)
 	mustRegisterGWHandler(eventsourcepkg.RegisterEventSourceServiceHandlerFromEndpoint, ctx, gwmux, endpoint, dialOpts)
 	mustRegisterGWHandler(sensorpkg.RegisterSensorServiceHandlerFromEndpoint, ctx, gwmux, endpoint, dialOpts)
+	mustRegisterGWHandler(syncpkg.RegisterSyncServiceHandlerFromEndpoint, ctx, gwmux, endpoint, dialOpts)
 	mustRegisterGWHandler(workflowpkg.RegisterWorkflowServiceHandlerFromEndpoint, ctx, gwmux, endpoint, dialOpts)
 	mustRegisterGWHandler(workflowtemplatepkg.RegisterWorkflowTemplateServiceHandlerFromEndpoint, ctx, gwmux, endpoint, dialOpts)
 	mustRegisterGWHandler(cronworkflowpkg.RegisterCronWorkflowServiceHandlerFromEndpoint, ctx, gwmux, endpoint, dialOpts)
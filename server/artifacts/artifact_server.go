@@ -3,9 +3,8 @@ package artifacts
 import (
 	"context"
 	"fmt"
-	"io/ioutil"
+	"io"
 	"net/http"
-	"os"
 	"path"
 	"strconv"
 	"strings"
@@ -23,6 +22,7 @@ import (
 	"github.com/argoproj/argo/v2/util/instanceid"
 	"github.com/argoproj/argo/v2/workflow/artifactrepositories"
 	artifact "github.com/argoproj/argo/v2/workflow/artifacts"
+	"github.com/argoproj/argo/v2/workflow/artifacts/common"
 	"github.com/argoproj/argo/v2/workflow/hydrator"
 )
 
@@ -45,15 +45,16 @@ func newArtifactServer(authN auth.Gatekeeper, hydrator hydrator.Interface, wfArc
 
 func (a *ArtifactServer) GetArtifact(w http.ResponseWriter, r *http.Request) {
 
-	ctx, err := a.gateKeeping(r)
+	path := strings.SplitN(r.URL.Path, "/", 6)
+
+	namespace := path[2]
+
+	ctx, err := a.gateKeeping(r, namespace)
 	if err != nil {
 		w.WriteHeader(401)
 		_, _ = w.Write([]byte(err.Error()))
 		return
 	}
-	path := strings.SplitN(r.URL.Path, "/", 6)
-
-	namespace := path[2]
 	workflowName := path[3]
 	nodeId := path[4]
 	artifactName := path[5]
@@ -76,7 +77,8 @@ func (a *ArtifactServer) GetArtifact(w http.ResponseWriter, r *http.Request) {
 
 func (a *ArtifactServer) GetArtifactByUID(w http.ResponseWriter, r *http.Request) {
 
-	ctx, err := a.gateKeeping(r)
+	// the namespace of the workflow is not known until it has been found, so the server's namespace is used
+	ctx, err := a.gateKeeping(r, "")
 	if err != nil {
 		w.WriteHeader(401)
 		_, _ = w.Write([]byte(err.Error()))
@@ -105,7 +107,8 @@ func (a *ArtifactServer) GetArtifactByUID(w http.ResponseWriter, r *http.Request
 	}
 }
 
-func (a *ArtifactServer) gateKeeping(r *http.Request) (context.Context, error) {
+// gateKeeping authenticates the request, using the namespace (if not empty) like the namespace of an API request
+func (a *ArtifactServer) gateKeeping(r *http.Request, namespace string) (context.Context, error) {
 	token := r.Header.Get("Authorization")
 	if token == "" {
 		cookie, err := r.Cookie("authorization")
@@ -118,7 +121,7 @@ func (a *ArtifactServer) gateKeeping(r *http.Request) (context.Context, error) {
 		}
 	}
 	ctx := metadata.NewIncomingContext(r.Context(), metadata.MD{"authorization": []string{token}})
-	return a.gatekeeper.Context(ctx)
+	return a.gatekeeper.ContextWithRequest(ctx, &metav1.ObjectMeta{Namespace: namespace})
 }
 
 func (a *ArtifactServer) serverInternalError(err error, w http.ResponseWriter) {
@@ -152,40 +155,30 @@ func (a *ArtifactServer) returnArtifact(ctx context.Context, w http.ResponseWrit
 	if err != nil {
 		return err
 	}
-	tmp, err := ioutil.TempFile("/tmp", "artifact")
+	stream, err := driver.OpenStream(art)
 	if err != nil {
 		return err
 	}
-	tmpPath := tmp.Name()
-	defer func() { _ = os.Remove(tmpPath) }()
+	defer func() { _ = stream.Close() }()
 
-	err = driver.Load(art, tmpPath)
-	if err != nil {
-		return err
-	}
-
-	file, err := os.Open(tmpPath)
+	key, _ := art.GetKey()
+	w.Header().Add("Content-Disposition", fmt.Sprintf(`filename="%s"`, path.Base(key)))
 
-	if err != nil {
-		return err
+	// stream the artifact rather than downloading it to disk first, so large artifacts are served without a temp file
+	if seeker, ok := stream.(io.ReadSeeker); ok {
+		// this serves the requested range of the artifact, e.g. to resume a download, and sets the Content-Length
+		http.ServeContent(w, r, "", time.Time{}, seeker)
+		return nil
 	}
-
-	defer file.Close()
-
-	stats, err := file.Stat()
-
-	if err != nil {
-		return err
+	if sizer, ok := stream.(common.Sizer); ok {
+		w.Header().Set("Content-Length", strconv.FormatInt(sizer.Size(), 10))
 	}
-
-	contentLength := strconv.FormatInt(stats.Size(), 10)
-	log.WithFields(log.Fields{"size": contentLength}).Debug("Artifact file size")
-
-	key, _ := art.GetKey()
-	w.Header().Add("Content-Disposition", fmt.Sprintf(`filename="%s"`, path.Base(key)))
 	w.WriteHeader(200)
-
-	http.ServeContent(w, r, "", time.Time{}, file)
+	_, err = io.Copy(w, stream)
+	if err != nil {
+		// the headers are already written, so the best we can do is log this
+		log.WithError(err).Warn("Failed to stream artifact")
+	}
 
 	return nil
 }
@@ -1,14 +1,17 @@
 package artifacts
 
 import (
+	"bytes"
 	"context"
 	"fmt"
+	"io"
 	"io/ioutil"
 	"net/http"
 	"net/url"
 	"testing"
 
 	artifact "github.com/argoproj/argo/v2/workflow/artifacts"
+	artifactscommon "github.com/argoproj/argo/v2/workflow/artifacts/common"
 	"github.com/argoproj/argo/v2/workflow/artifacts/resource"
 
 	"github.com/stretchr/testify/assert"
@@ -39,13 +42,33 @@ func mustParse(text string) *url.URL {
 
 type fakeArtifactDriver struct {
 	artifact.ArtifactDriver
-	data []byte
+	data     []byte
+	seekable bool
 }
 
 func (a *fakeArtifactDriver) Load(_ *wfv1.Artifact, path string) error {
 	return ioutil.WriteFile(path, a.data, 0666)
 }
 
+type fakeReadSeekCloser struct {
+	*bytes.Reader
+}
+
+func (fakeReadSeekCloser) Close() error {
+	return nil
+}
+
+func (a *fakeArtifactDriver) OpenStream(art *wfv1.Artifact) (io.ReadCloser, error) {
+	if a.seekable {
+		return fakeReadSeekCloser{bytes.NewReader(a.data)}, nil
+	}
+	if art.GCS != nil {
+		// like GCS, we know the size
+		return artifactscommon.NewSizedReadCloser(ioutil.NopCloser(bytes.NewReader(a.data)), int64(len(a.data))), nil
+	}
+	return ioutil.NopCloser(bytes.NewReader(a.data)), nil
+}
+
 func (a *fakeArtifactDriver) Save(_ string, _ *wfv1.Artifact) error {
 	return fmt.Errorf("not implemented")
 }
@@ -104,7 +127,9 @@ func newServer() *ArtifactServer {
 	argo := fakewfv1.NewSimpleClientset(wf, &wfv1.Workflow{
 		ObjectMeta: metav1.ObjectMeta{Namespace: "my-ns", Name: "your-wf"}})
 	ctx := context.WithValue(context.WithValue(context.Background(), auth.KubeKey, kube), auth.WfKey, argo)
-	gatekeeper.On("Context", mock.Anything).Return(ctx, nil)
+	// artifacts are authorized using the namespace of the workflow, unless they are got by UID
+	gatekeeper.On("ContextWithRequest", mock.Anything, &metav1.ObjectMeta{Namespace: "my-ns"}).Return(ctx, nil)
+	gatekeeper.On("ContextWithRequest", mock.Anything, &metav1.ObjectMeta{}).Return(ctx, nil)
 	a := &sqldbmocks.WorkflowArchive{}
 	a.On("GetWorkflow", "my-uuid").Return(wf, nil)
 
@@ -128,16 +153,18 @@ func TestArtifactServer_GetArtifact(t *testing.T) {
 	s := newServer()
 
 	tests := []struct {
-		fileName     string
-		artifactName string
+		fileName      string
+		artifactName  string
+		contentLength string
 	}{
 		{
 			fileName:     "my-s3-artifact.tgz",
 			artifactName: "my-s3-artifact",
 		},
 		{
-			fileName:     "my-gcs-artifact",
-			artifactName: "my-gcs-artifact",
+			fileName:      "my-gcs-artifact",
+			artifactName:  "my-gcs-artifact",
+			contentLength: "7",
 		},
 		{
 			fileName:     "my-oss-artifact.zip",
@@ -153,12 +180,29 @@ func TestArtifactServer_GetArtifact(t *testing.T) {
 			s.GetArtifact(w, r)
 			if assert.Equal(t, 200, w.StatusCode) {
 				assert.Equal(t, fmt.Sprintf(`filename="%s"`, tt.fileName), w.Header().Get("Content-Disposition"))
+				assert.Equal(t, tt.contentLength, w.Header().Get("Content-Length"))
 				assert.Equal(t, "my-data", w.Output)
 			}
 		})
 	}
 }
 
+func TestArtifactServer_GetArtifactRange(t *testing.T) {
+	s := newServer()
+	s.artDriverFactory = func(_ context.Context, _ *wfv1.Artifact, _ resource.Interface) (artifact.ArtifactDriver, error) {
+		return &fakeArtifactDriver{data: []byte("my-data"), seekable: true}, nil
+	}
+	r := &http.Request{Header: http.Header{"Range": []string{"bytes=3-5"}}}
+	r.URL = mustParse("/artifacts/my-ns/my-wf/my-node/my-s3-artifact")
+	w := &testhttp.TestResponseWriter{}
+	s.GetArtifact(w, r)
+	if assert.Equal(t, http.StatusPartialContent, w.StatusCode) {
+		assert.Equal(t, "bytes 3-5/7", w.Header().Get("Content-Range"))
+		assert.Equal(t, "3", w.Header().Get("Content-Length"))
+		assert.Equal(t, "dat", w.Output)
+	}
+}
+
 func TestArtifactServer_GetArtifactWithoutInstanceID(t *testing.T) {
 	s := newServer()
 	r := &http.Request{}
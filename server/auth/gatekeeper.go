@@ -45,6 +45,8 @@ const (
 
 type Gatekeeper interface {
 	Context(ctx context.Context) (context.Context, error)
+	// ContextWithRequest is like Context, but also uses the namespace of the request if it has one
+	ContextWithRequest(ctx context.Context, req interface{}) (context.Context, error)
 	UnaryServerInterceptor() grpc.UnaryServerInterceptor
 	StreamServerInterceptor() grpc.StreamServerInterceptor
 }
@@ -71,7 +73,7 @@ func NewGatekeeper(modes Modes, clients *servertypes.Clients, restConfig *rest.C
 
 func (s *gatekeeper) UnaryServerInterceptor() grpc.UnaryServerInterceptor {
 	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp interface{}, err error) {
-		ctx, err = s.Context(ctx)
+		ctx, err = s.ContextWithRequest(ctx, req)
 		if err != nil {
 			return nil, err
 		}
@@ -81,6 +83,10 @@ func (s *gatekeeper) UnaryServerInterceptor() grpc.UnaryServerInterceptor {
 
 func (s *gatekeeper) StreamServerInterceptor() grpc.StreamServerInterceptor {
 	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if !info.IsClientStream {
+			// the handler receives the single request before doing anything else, so we can wait for it
+			return handler(srv, &requestServerStream{ServerStream: ss, ctx: ss.Context(), gatekeeper: s})
+		}
 		ctx, err := s.Context(ss.Context())
 		if err != nil {
 			return err
@@ -91,8 +97,40 @@ func (s *gatekeeper) StreamServerInterceptor() grpc.StreamServerInterceptor {
 	}
 }
 
+// requestServerStream authenticates a server-streaming RPC once its request has been received, so that, like unary
+// RPCs, the namespace of the request is taken into account
+type requestServerStream struct {
+	grpc.ServerStream
+	ctx           context.Context
+	gatekeeper    *gatekeeper
+	authenticated bool
+}
+
+func (s *requestServerStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *requestServerStream) RecvMsg(m interface{}) error {
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+	if !s.authenticated {
+		ctx, err := s.gatekeeper.ContextWithRequest(s.ServerStream.Context(), m)
+		if err != nil {
+			return err
+		}
+		s.ctx = ctx
+		s.authenticated = true
+	}
+	return nil
+}
+
 func (s *gatekeeper) Context(ctx context.Context) (context.Context, error) {
-	clients, claims, err := s.getClients(ctx)
+	return s.ContextWithRequest(ctx, nil)
+}
+
+func (s *gatekeeper) ContextWithRequest(ctx context.Context, req interface{}) (context.Context, error) {
+	clients, claims, err := s.getClients(ctx, req)
 	if err != nil {
 		return nil, err
 	}
@@ -143,7 +181,15 @@ func getAuthHeader(md metadata.MD) string {
 	return ""
 }
 
-func (s gatekeeper) getClients(ctx context.Context) (*servertypes.Clients, *types.Claims, error) {
+// getNamespace returns the namespace the request targets, or empty if it does not target one
+func getNamespace(req interface{}) string {
+	if r, ok := req.(interface{ GetNamespace() string }); ok {
+		return r.GetNamespace()
+	}
+	return ""
+}
+
+func (s gatekeeper) getClients(ctx context.Context, req interface{}) (*servertypes.Clients, *types.Claims, error) {
 	md, _ := metadata.FromIncomingContext(ctx)
 	authorization := getAuthHeader(md)
 	mode, valid := s.Modes.GetMode(authorization)
@@ -167,7 +213,7 @@ func (s gatekeeper) getClients(ctx context.Context) (*servertypes.Clients, *type
 			return nil, nil, status.Error(codes.Unauthenticated, err.Error())
 		}
 		if s.ssoIf.IsRBACEnabled() {
-			clients, err := s.rbacAuthorization(ctx, claims)
+			clients, err := s.rbacAuthorization(ctx, claims, getNamespace(req))
 			if err != nil {
 				log.WithError(err).Error("failed to perform RBAC authorization")
 				return nil, nil, status.Error(codes.PermissionDenied, "not allowed")
@@ -181,8 +227,41 @@ func (s gatekeeper) getClients(ctx context.Context) (*servertypes.Clients, *type
 	}
 }
 
-func (s *gatekeeper) rbacAuthorization(ctx context.Context, claims *types.Claims) (*servertypes.Clients, error) {
-	list, err := s.clients.Kubernetes.CoreV1().ServiceAccounts(s.namespace).List(ctx, metav1.ListOptions{})
+// rbacAuthorization returns the clients of the service account selected for the user. If namespace delegation is
+// enabled and the request targets another namespace than the Argo Server's, a service account in that namespace takes
+// precedence over the ones in the Argo Server's namespace.
+func (s *gatekeeper) rbacAuthorization(ctx context.Context, claims *types.Claims, namespace string) (*servertypes.Clients, error) {
+	rules := &ruleCache{claims: claims}
+	namespaces := []string{s.namespace}
+	if namespace != "" && namespace != s.namespace && s.ssoIf.IsNamespaceDelegationEnabled() {
+		namespaces = []string{namespace, s.namespace}
+	}
+	for _, namespace := range namespaces {
+		serviceAccount, err := s.getServiceAccount(ctx, namespace, rules)
+		if err != nil {
+			return nil, err
+		}
+		if serviceAccount == nil {
+			continue
+		}
+		authorization, err := s.authorizationForServiceAccount(ctx, serviceAccount)
+		if err != nil {
+			return nil, err
+		}
+		_, clients, err := s.clientForAuthorization(authorization)
+		if err != nil {
+			return nil, err
+		}
+		claims.ServiceAccountName = serviceAccount.Name
+		log.WithFields(log.Fields{"serviceAccount": serviceAccount.Name, "namespace": serviceAccount.Namespace, "subject": claims.Subject}).Info("selected SSO RBAC service account for user")
+		return clients, nil
+	}
+	return nil, fmt.Errorf("no service account rule matches")
+}
+
+// getServiceAccount returns the service account in the namespace with the highest precedence whose rule matches, or nil if none does
+func (s *gatekeeper) getServiceAccount(ctx context.Context, namespace string, rules *ruleCache) (*corev1.ServiceAccount, error) {
+	list, err := s.clients.Kubernetes.CoreV1().ServiceAccounts(namespace).List(ctx, metav1.ListOptions{})
 	if err != nil {
 		return nil, fmt.Errorf("failed to list SSO RBAC service accounts: %w", err)
 	}
@@ -200,51 +279,57 @@ func (s *gatekeeper) rbacAuthorization(ctx context.Context, claims *types.Claims
 	}
 	sort.Slice(serviceAccounts, func(i, j int) bool { return precedence(serviceAccounts[i]) > precedence(serviceAccounts[j]) })
 	for _, serviceAccount := range serviceAccounts {
-		rule := serviceAccount.Annotations[common.AnnotationKeyRBACRule]
-		data, err := json.Marshal(claims)
-		if err != nil {
-			return nil, fmt.Errorf("failed to marshall claims: %w", err)
-		}
-		v := make(map[string]interface{})
-		err = json.Unmarshal(data, &v)
+		allow, err := rules.eval(serviceAccount.Annotations[common.AnnotationKeyRBACRule])
 		if err != nil {
-			return nil, fmt.Errorf("failed to unmarshall claims: %w", err)
-		}
-		result, err := expr.Eval(rule, v)
-		if err != nil {
-			return nil, fmt.Errorf("failed to evaluate rule: %w", err)
-		}
-		allow, ok := result.(bool)
-		if !ok {
-			return nil, fmt.Errorf("failed to evaluate rule: not a boolean")
+			return nil, err
 		}
-		if !allow {
-			continue
+		if allow {
+			return serviceAccount.DeepCopy(), nil
 		}
-		authorization, err := s.authorizationForServiceAccount(ctx, serviceAccount.Name)
+	}
+	return nil, nil
+}
+
+// ruleCache evaluates RBAC rules against the claims of a single request, evaluating each distinct rule once, as
+// the same rule is often used by the service accounts of many namespaces
+type ruleCache struct {
+	claims  *types.Claims
+	env     map[string]interface{}
+	results map[string]bool
+}
+
+func (c *ruleCache) eval(rule string) (bool, error) {
+	if allow, ok := c.results[rule]; ok {
+		return allow, nil
+	}
+	if c.env == nil {
+		data, err := json.Marshal(c.claims)
 		if err != nil {
-			return nil, err
+			return false, fmt.Errorf("failed to marshall claims: %w", err)
 		}
-		_, clients, err := s.clientForAuthorization(authorization)
+		err = json.Unmarshal(data, &c.env)
 		if err != nil {
-			return nil, err
+			return false, fmt.Errorf("failed to unmarshall claims: %w", err)
 		}
-		claims.ServiceAccountName = serviceAccount.Name
-		log.WithFields(log.Fields{"serviceAccount": serviceAccount.Name, "subject": claims.Subject}).Info("selected SSO RBAC service account for user")
-		return clients, nil
+		c.results = map[string]bool{}
 	}
-	return nil, fmt.Errorf("no service account rule matches")
-}
-
-func (s *gatekeeper) authorizationForServiceAccount(ctx context.Context, serviceAccountName string) (string, error) {
-	serviceAccount, err := s.clients.Kubernetes.CoreV1().ServiceAccounts(s.namespace).Get(ctx, serviceAccountName, metav1.GetOptions{})
+	result, err := expr.Eval(rule, c.env)
 	if err != nil {
-		return "", fmt.Errorf("failed to get service account: %w", err)
+		return false, fmt.Errorf("failed to evaluate rule: %w", err)
 	}
+	allow, ok := result.(bool)
+	if !ok {
+		return false, fmt.Errorf("failed to evaluate rule: not a boolean")
+	}
+	c.results[rule] = allow
+	return allow, nil
+}
+
+func (s *gatekeeper) authorizationForServiceAccount(ctx context.Context, serviceAccount *corev1.ServiceAccount) (string, error) {
 	if len(serviceAccount.Secrets) == 0 {
-		return "", fmt.Errorf("expected at least one secret for SSO RBAC service account: %w", err)
+		return "", fmt.Errorf("expected at least one secret for SSO RBAC service account %s/%s", serviceAccount.Namespace, serviceAccount.Name)
 	}
-	secret, err := s.clients.Kubernetes.CoreV1().Secrets(s.namespace).Get(ctx, serviceAccount.Secrets[0].Name, metav1.GetOptions{})
+	secret, err := s.clients.Kubernetes.CoreV1().Secrets(serviceAccount.Namespace).Get(ctx, serviceAccount.Secrets[0].Name, metav1.GetOptions{})
 	if err != nil {
 		return "", fmt.Errorf("failed to get service account secret: %w", err)
 	}
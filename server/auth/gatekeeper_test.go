@@ -9,6 +9,7 @@ import (
 	"github.com/sirupsen/logrus/hooks/test"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc"
 	"google.golang.org/grpc/metadata"
 	"gopkg.in/square/go-jose.v2/jwt"
 	corev1 "k8s.io/api/core/v1"
@@ -16,6 +17,7 @@ import (
 	kubefake "k8s.io/client-go/kubernetes/fake"
 	"k8s.io/client-go/rest"
 
+	workflowpkg "github.com/argoproj/argo/v2/pkg/apiclient/workflow"
 	fakewfclientset "github.com/argoproj/argo/v2/pkg/client/clientset/versioned/fake"
 	ssomocks "github.com/argoproj/argo/v2/server/auth/sso/mocks"
 	"github.com/argoproj/argo/v2/server/auth/types"
@@ -55,6 +57,23 @@ func TestServer_GetWFClient(t *testing.T) {
 				"token": {},
 			},
 		},
+		&corev1.ServiceAccount{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "tenant-sa", Namespace: "tenant-ns",
+				Annotations: map[string]string{
+					common.AnnotationKeyRBACRule: "'tenant-group' in groups",
+					// lower than my-sa, but the namespace's service accounts always win
+					common.AnnotationKeyRBACRulePrecedence: "-1",
+				},
+			},
+			Secrets: []corev1.ObjectReference{{Name: "tenant-secret"}},
+		},
+		&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "tenant-secret", Namespace: "tenant-ns"},
+			Data: map[string][]byte{
+				"token": {},
+			},
+		},
 	)
 	var clientForAuthorization ClientForAuthorization = func(authorization string) (*rest.Config, *servertypes.Clients, error) {
 		return &rest.Config{}, &servertypes.Clients{Workflow: &fakewfclientset.Clientset{}, Kubernetes: &kubefake.Clientset{}}, nil
@@ -158,6 +177,89 @@ func TestServer_GetWFClient(t *testing.T) {
 			assert.EqualError(t, err, "rpc error: code = PermissionDenied desc = not allowed")
 		}
 	})
+	t.Run("SSO+RBAC,namespaceDelegation", func(t *testing.T) {
+		ssoIf := &ssomocks.Interface{}
+		ssoIf.On("Authorize", mock.Anything, mock.Anything).Return(&types.Claims{Groups: []string{"my-group", "tenant-group"}}, nil)
+		ssoIf.On("IsRBACEnabled").Return(true)
+		ssoIf.On("IsNamespaceDelegationEnabled").Return(true)
+		g, err := NewGatekeeper(Modes{SSO: true}, clients, nil, ssoIf, clientForAuthorization, "my-ns")
+		if assert.NoError(t, err) {
+			ctx, err := g.ContextWithRequest(x("Bearer v2:whatever"), &workflowpkg.WorkflowListRequest{Namespace: "tenant-ns"})
+			if assert.NoError(t, err) {
+				assert.Equal(t, "tenant-sa", GetClaims(ctx).ServiceAccountName)
+				assert.Equal(t, "tenant-ns", hook.LastEntry().Data["namespace"])
+			}
+			// the namespace has no matching service account, so we fall back to the server's namespace
+			ctx, err = g.ContextWithRequest(x("Bearer v2:whatever"), &workflowpkg.WorkflowListRequest{Namespace: "other-ns"})
+			if assert.NoError(t, err) {
+				assert.Equal(t, "my-sa", GetClaims(ctx).ServiceAccountName)
+			}
+			// requests without a namespace use the server's namespace
+			ctx, err = g.Context(x("Bearer v2:whatever"))
+			if assert.NoError(t, err) {
+				assert.Equal(t, "my-sa", GetClaims(ctx).ServiceAccountName)
+			}
+			// streaming RPCs use the namespace of their request too
+			stream := &fakeServerStream{ctx: x("Bearer v2:whatever"), namespace: "tenant-ns"}
+			err = g.StreamServerInterceptor()(nil, stream, &grpc.StreamServerInfo{IsServerStream: true}, func(_ interface{}, stream grpc.ServerStream) error {
+				if err := stream.RecvMsg(&workflowpkg.WatchWorkflowsRequest{}); err != nil {
+					return err
+				}
+				assert.Equal(t, "tenant-sa", GetClaims(stream.Context()).ServiceAccountName)
+				return nil
+			})
+			assert.NoError(t, err)
+		}
+	})
+	t.Run("SSO+RBAC,namespaceDelegationDisabled", func(t *testing.T) {
+		ssoIf := &ssomocks.Interface{}
+		ssoIf.On("Authorize", mock.Anything, mock.Anything).Return(&types.Claims{Groups: []string{"my-group", "tenant-group"}}, nil)
+		ssoIf.On("IsRBACEnabled").Return(true)
+		ssoIf.On("IsNamespaceDelegationEnabled").Return(false)
+		g, err := NewGatekeeper(Modes{SSO: true}, clients, nil, ssoIf, clientForAuthorization, "my-ns")
+		if assert.NoError(t, err) {
+			ctx, err := g.ContextWithRequest(x("Bearer v2:whatever"), &workflowpkg.WorkflowListRequest{Namespace: "tenant-ns"})
+			if assert.NoError(t, err) {
+				assert.Equal(t, "my-sa", GetClaims(ctx).ServiceAccountName)
+			}
+		}
+	})
+}
+
+func Test_ruleCache(t *testing.T) {
+	rules := &ruleCache{claims: &types.Claims{Groups: []string{"my-group"}}}
+	allow, err := rules.eval("'my-group' in groups")
+	if assert.NoError(t, err) {
+		assert.True(t, allow)
+		assert.Equal(t, map[string]bool{"'my-group' in groups": true}, rules.results)
+	}
+	allow, err = rules.eval("'other-group' in groups")
+	if assert.NoError(t, err) {
+		assert.False(t, allow)
+		assert.Len(t, rules.results, 2)
+	}
+	_, err = rules.eval("groups")
+	assert.EqualError(t, err, "failed to evaluate rule: not a boolean")
+}
+
+func Test_getNamespace(t *testing.T) {
+	assert.Empty(t, getNamespace(nil))
+	assert.Equal(t, "my-ns", getNamespace(&workflowpkg.WorkflowGetRequest{Namespace: "my-ns"}))
+}
+
+type fakeServerStream struct {
+	grpc.ServerStream
+	ctx       context.Context
+	namespace string
+}
+
+func (s *fakeServerStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *fakeServerStream) RecvMsg(m interface{}) error {
+	m.(*workflowpkg.WatchWorkflowsRequest).Namespace = s.namespace
+	return nil
 }
 
 func x(authorization string) context.Context {
@@ -2,8 +2,15 @@ package rbac
 
 type Config struct {
 	Enabled bool `json:"enabled,omitempty"`
+	// NamespaceDelegation also selects from the rule-annotated service accounts in the namespace of the request,
+	// which take precedence over the ones in the Argo Server's namespace
+	NamespaceDelegation bool `json:"namespaceDelegation,omitempty"`
 }
 
 func (c *Config) IsEnabled() bool {
 	return c != nil && c.Enabled
 }
+
+func (c *Config) IsNamespaceDelegationEnabled() bool {
+	return c.IsEnabled() && c.NamespaceDelegation
+}
@@ -48,6 +48,20 @@ func (_m *Interface) HandleRedirect(writer http.ResponseWriter, request *http.Re
 	_m.Called(writer, request)
 }
 
+// IsNamespaceDelegationEnabled provides a mock function with given fields:
+func (_m *Interface) IsNamespaceDelegationEnabled() bool {
+	ret := _m.Called()
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
 // IsRBACEnabled provides a mock function with given fields:
 func (_m *Interface) IsRBACEnabled() bool {
 	ret := _m.Called()
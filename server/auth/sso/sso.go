@@ -40,6 +40,7 @@ type Interface interface {
 	HandleRedirect(writer http.ResponseWriter, request *http.Request)
 	HandleCallback(writer http.ResponseWriter, request *http.Request)
 	IsRBACEnabled() bool
+	IsNamespaceDelegationEnabled() bool
 }
 
 var _ Interface = &sso{}
@@ -59,6 +60,10 @@ func (s *sso) IsRBACEnabled() bool {
 	return s.rbacConfig.IsEnabled()
 }
 
+func (s *sso) IsNamespaceDelegationEnabled() bool {
+	return s.rbacConfig.IsNamespaceDelegationEnabled()
+}
+
 type Config struct {
 	Issuer       string                  `json:"issuer"`
 	ClientID     apiv1.SecretKeySelector `json:"clientId"`
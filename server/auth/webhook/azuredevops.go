@@ -0,0 +1,18 @@
+package webhook
+
+import (
+	"crypto/subtle"
+	"net/http"
+)
+
+// azureDevOpsMatch matches Azure DevOps service hooks, which use basic authentication
+// https://docs.microsoft.com/en-us/azure/devops/service-hooks/services/webhooks
+func azureDevOpsMatch(client *webhookClient, r *http.Request) bool {
+	username, password, ok := r.BasicAuth()
+	if !ok || client.Username == "" || client.Secret == "" {
+		return false
+	}
+	validUsername := subtle.ConstantTimeCompare([]byte(username), []byte(client.Username)) == 1
+	validPassword := subtle.ConstantTimeCompare([]byte(password), []byte(client.Secret)) == 1
+	return validUsername && validPassword
+}
@@ -0,0 +1,28 @@
+package webhook
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_azureDevOpsMatch(t *testing.T) {
+	client := &webhookClient{Type: "azuredevops", Username: "my-username", Secret: "sh!"}
+	match := func(username, password string) bool {
+		r := httptest.NewRequest("POST", "/api/v1/events/my-ns/my-d", nil)
+		r.SetBasicAuth(username, password)
+		return azureDevOpsMatch(client, r)
+	}
+	assert.True(t, match("my-username", "sh!"))
+	assert.False(t, match("my-username", "wrong"), "wrong password")
+	assert.False(t, match("other-username", "sh!"), "wrong username")
+	assert.False(t, azureDevOpsMatch(client, httptest.NewRequest("POST", "/api/v1/events/my-ns/my-d", nil)), "no basic auth")
+	assert.False(t, azureDevOpsMatch(&webhookClient{Secret: "sh!"}, httptest.NewRequest("POST", "/api/v1/events/my-ns/my-d", nil)), "no username configured")
+	assert.False(t, azureDevOpsMatch(&webhookClient{Username: "my-username"}, func() *http.Request {
+		r := httptest.NewRequest("POST", "/api/v1/events/my-ns/my-d", nil)
+		r.SetBasicAuth("my-username", "")
+		return r
+	}()), "no secret configured")
+}
@@ -6,8 +6,8 @@ import (
 	"gopkg.in/go-playground/webhooks.v5/bitbucket"
 )
 
-func bitbucketMatch(secret string, r *http.Request) bool {
-	hook, err := bitbucket.New(bitbucket.Options.UUID(secret))
+func bitbucketMatch(client *webhookClient, r *http.Request) bool {
+	hook, err := bitbucket.New(bitbucket.Options.UUID(client.Secret))
 	if err != nil {
 		return false
 	}
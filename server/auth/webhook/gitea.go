@@ -0,0 +1,20 @@
+package webhook
+
+import (
+	"io/ioutil"
+	"net/http"
+)
+
+// giteaMatch matches Gitea webhooks, which sign the body with HMAC-SHA256
+// https://docs.gitea.io/en-us/webhooks/
+func giteaMatch(client *webhookClient, r *http.Request) bool {
+	signature := r.Header.Get("X-Gitea-Signature")
+	if client.Secret == "" || r.Header.Get("X-Gitea-Event") == "" || signature == "" {
+		return false
+	}
+	body, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		return false
+	}
+	return validSignature(signature, client.Secret, body)
+}
@@ -0,0 +1,26 @@
+package webhook
+
+import (
+	"bytes"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_giteaMatch(t *testing.T) {
+	client := &webhookClient{Type: "gitea", Secret: "sh!"}
+	body := []byte(`{"ref":"refs/heads/main"}`)
+	match := func(event, signature string) bool {
+		r := httptest.NewRequest("POST", "/api/v1/events/my-ns/my-d", bytes.NewReader(body))
+		r.Header.Set("X-Gitea-Event", event)
+		r.Header.Set("X-Gitea-Signature", signature)
+		return giteaMatch(client, r)
+	}
+	assert.True(t, match("push", hmacSHA256("sh!", body)))
+	assert.False(t, match("push", hmacSHA256("other", body)), "wrong secret")
+	assert.False(t, match("", hmacSHA256("sh!", body)), "not a Gitea event")
+	assert.False(t, match("push", ""), "not signed")
+	client.Secret = ""
+	assert.False(t, match("push", hmacSHA256("", body)), "no secret configured")
+}
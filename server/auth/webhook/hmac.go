@@ -0,0 +1,39 @@
+package webhook
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"io/ioutil"
+	"net/http"
+	"strings"
+)
+
+// hmacMatch matches requests whose body is signed with HMAC-SHA256 in a configurable header, as hex, with an optional
+// prefix such as "sha256="
+func hmacMatch(client *webhookClient, r *http.Request) bool {
+	if client.Header == "" || client.Secret == "" {
+		return false
+	}
+	signature := r.Header.Get(client.Header)
+	if signature == "" || !strings.HasPrefix(signature, client.Prefix) {
+		return false
+	}
+	body, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		return false
+	}
+	return validSignature(strings.TrimPrefix(signature, client.Prefix), client.Secret, body)
+}
+
+// hmacSHA256 returns the hex encoded HMAC-SHA256 of the message
+func hmacSHA256(secret string, message []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	_, _ = mac.Write(message)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// validSignature compares the signature in constant time, so it does not leak how much of it is valid
+func validSignature(signature, secret string, message []byte) bool {
+	return hmac.Equal([]byte(strings.ToLower(signature)), []byte(hmacSHA256(secret, message)))
+}
@@ -0,0 +1,32 @@
+package webhook
+
+import (
+	"bytes"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_hmacMatch(t *testing.T) {
+	client := &webhookClient{Type: "hmac", Secret: "sh!", Header: "X-Signature", Prefix: "sha256="}
+	body := []byte(`{"foo":"bar"}`)
+	signature := "sha256=" + hmacSHA256("sh!", body)
+	match := func(header, signature string) bool {
+		r := httptest.NewRequest("POST", "/api/v1/events/my-ns/my-d", bytes.NewReader(body))
+		r.Header.Set(header, signature)
+		return hmacMatch(client, r)
+	}
+	assert.True(t, match("X-Signature", signature))
+	assert.False(t, match("X-Other-Signature", signature), "wrong header")
+	assert.False(t, match("X-Signature", hmacSHA256("sh!", body)), "missing prefix")
+	assert.False(t, match("X-Signature", "sha256="+hmacSHA256("other", body)), "wrong secret")
+	assert.False(t, hmacMatch(&webhookClient{Secret: "sh!"}, httptest.NewRequest("POST", "/", bytes.NewReader(body))), "no header configured")
+	client.Secret = ""
+	assert.False(t, match("X-Signature", "sha256="+hmacSHA256("", body)), "no secret configured")
+}
+
+func Test_hmacSHA256(t *testing.T) {
+	// https://en.wikipedia.org/wiki/HMAC#Examples
+	assert.Equal(t, "f7bc83f430538424b13298e6aa6fb143ef4d59a14946175997479dbc2d1a3cd8", hmacSHA256("key", []byte("The quick brown fox jumps over the lazy dog")))
+}
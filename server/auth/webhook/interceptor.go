@@ -8,6 +8,7 @@ import (
 	"strings"
 
 	log "github.com/sirupsen/logrus"
+	apierr "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes"
 	"sigs.k8s.io/yaml"
@@ -16,18 +17,28 @@ import (
 type webhookClient struct {
 	// e.g "github"
 	Type string `json:"type"`
-	// e.g. "shh!"
+	// e.g. "shh!", the password for "azuredevops"
 	Secret string `json:"secret"`
+	// the header with the signature for "hmac", e.g. "X-Signature"
+	Header string `json:"header,omitempty"`
+	// the optional prefix of the signature for "hmac", e.g. "sha256="
+	Prefix string `json:"prefix,omitempty"`
+	// the basic authentication username for "azuredevops"
+	Username string `json:"username,omitempty"`
 }
 
-type matcher = func(secret string, r *http.Request) bool
+type matcher = func(client *webhookClient, r *http.Request) bool
 
 // parser for each types, these should be fast, i.e. no database or API interactions
 var webhookParsers = map[string]matcher{
+	"azuredevops":     azureDevOpsMatch,
 	"bitbucket":       bitbucketMatch,
 	"bitbucketserver": bitbucketserverMatch,
+	"gitea":           giteaMatch,
 	"github":          githubMatch,
 	"gitlab":          gitlabMatch,
+	"hmac":            hmacMatch,
+	"slack":           slackMatch,
 }
 
 const pathPrefix = "/api/v1/events/"
@@ -48,8 +59,9 @@ func Interceptor(client kubernetes.Interface) func(w http.ResponseWriter, r *htt
 }
 
 func addWebhookAuthorization(r *http.Request, kube kubernetes.Interface) error {
-	// try and exit quickly before we do anything API calls
-	if r.Method != "POST" || len(r.Header["Authorization"]) > 0 || !strings.HasPrefix(r.URL.Path, pathPrefix) {
+	// try and exit quickly before we do anything API calls, basic authentication is used by some webhooks, e.g. Azure DevOps
+	_, _, basicAuth := r.BasicAuth()
+	if r.Method != "POST" || (len(r.Header["Authorization"]) > 0 && !basicAuth) || !strings.HasPrefix(r.URL.Path, pathPrefix) {
 		return nil
 	}
 	parts := strings.SplitN(strings.TrimPrefix(r.URL.Path, pathPrefix), "/", 2)
@@ -61,6 +73,10 @@ func addWebhookAuthorization(r *http.Request, kube kubernetes.Interface) error {
 	ctx := r.Context()
 
 	webhookClients, err := secretsInterface.Get(ctx, "argo-workflows-webhook-clients", metav1.GetOptions{})
+	if basicAuth && apierr.IsNotFound(err) {
+		// the namespace has no webhook clients, so the basic authentication is left to the server, as for any request
+		return nil
+	}
 	if err != nil {
 		return fmt.Errorf("failed to get webhook clients: %w", err)
 	}
@@ -77,8 +93,11 @@ func addWebhookAuthorization(r *http.Request, kube kubernetes.Interface) error {
 			return fmt.Errorf("failed to unmarshal webhook client \"%s\": %w", serviceAccountName, err)
 		}
 		log.WithFields(log.Fields{"serviceAccountName": serviceAccountName, "webhookType": client.Type}).Debug("Attempting to match webhook request")
-		ok := webhookParsers[client.Type](client.Secret, r)
-		if ok {
+		match, ok := webhookParsers[client.Type]
+		if !ok {
+			return fmt.Errorf("unknown type \"%s\" of webhook client \"%s\"", client.Type, serviceAccountName)
+		}
+		if match(client, r) {
 			log.WithField("serviceAccountName", serviceAccountName).Debug("Matched webhook request")
 			serviceAccount, err := serviceAccountInterface.Get(ctx, serviceAccountName, metav1.GetOptions{})
 			if err != nil {
@@ -0,0 +1,39 @@
+package webhook
+
+import (
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// slackMaxAge is the age after which Slack requests are rejected to prevent replay attacks, as Slack recommends
+const slackMaxAge = 5 * time.Minute
+
+var now = time.Now
+
+// slackMatch matches Slack signed requests
+// https://api.slack.com/authentication/verifying-requests-from-slack
+func slackMatch(client *webhookClient, r *http.Request) bool {
+	if client.Secret == "" {
+		return false
+	}
+	timestamp := r.Header.Get("X-Slack-Request-Timestamp")
+	signature := r.Header.Get("X-Slack-Signature")
+	if timestamp == "" || len(signature) < 3 || signature[:3] != "v0=" {
+		return false
+	}
+	seconds, err := strconv.ParseInt(timestamp, 10, 64)
+	if err != nil {
+		return false
+	}
+	age := now().Sub(time.Unix(seconds, 0))
+	if age > slackMaxAge || age < -slackMaxAge {
+		return false
+	}
+	body, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		return false
+	}
+	return validSignature(signature[3:], client.Secret, append([]byte("v0:"+timestamp+":"), body...))
+}
@@ -0,0 +1,34 @@
+package webhook
+
+import (
+	"bytes"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_slackMatch(t *testing.T) {
+	defer func() { now = time.Now }()
+	now = func() time.Time { return time.Unix(1531420618, 0) }
+	client := &webhookClient{Type: "slack", Secret: "8f742231b10e8888abcd99yyyzzz85a5"}
+	// https://api.slack.com/authentication/verifying-requests-from-slack#a_recipe_for_security
+	body := []byte("token=xyzz0WbapA4vBCDEFasx0q6G&team_id=T1DC2JH3J&team_domain=testteamnow&channel_id=G8PSS9T3V&channel_name=foobar&user_id=U2CERLKJA&user_name=roadrunner&command=%2Fwebhook-collect&text=&response_url=https%3A%2F%2Fhooks.slack.com%2Fcommands%2FT1DC2JH3J%2F397700885554%2F96rGlfmibIGlgcZRskXaIFfN&trigger_id=398738663015.47445629121.803a0bc887a14d10d2c447fce8b6703c")
+	match := func(timestamp int64, signature string) bool {
+		r := httptest.NewRequest("POST", "/api/v1/events/my-ns/my-d", bytes.NewReader(body))
+		r.Header.Set("X-Slack-Request-Timestamp", strconv.FormatInt(timestamp, 10))
+		r.Header.Set("X-Slack-Signature", signature)
+		return slackMatch(client, r)
+	}
+	signature := "v0=a2114d57b48eac39b9ad189dd8316235a7b4a8d21a10bd27519666489c69b503"
+	assert.True(t, match(1531420618, signature))
+	assert.False(t, match(1531420618, "v0=a2114d57b48eac39b9ad189dd8316235a7b4a8d21a10bd27519666489c69b504"), "wrong signature")
+	assert.False(t, match(1531420618, "a2114d57b48eac39b9ad189dd8316235a7b4a8d21a10bd27519666489c69b503"), "missing version")
+	now = func() time.Time { return time.Unix(1531420618, 0).Add(time.Hour) }
+	assert.False(t, match(1531420618, signature), "replayed")
+	now = func() time.Time { return time.Unix(1531420618, 0) }
+	client.Secret = ""
+	assert.False(t, match(1531420618, "v0="+hmacSHA256("", append([]byte("v0:1531420618:"), body...))), "no secret configured")
+}
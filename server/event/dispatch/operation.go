@@ -13,9 +13,11 @@ import (
 	"google.golang.org/grpc/metadata"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/uuid"
 	"k8s.io/client-go/tools/record"
 	"k8s.io/client-go/util/retry"
 
+	"github.com/argoproj/argo/v2/persist/sqldb"
 	wfv1 "github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1"
 	"github.com/argoproj/argo/v2/server/auth"
 	errorsutil "github.com/argoproj/argo/v2/util/errors"
@@ -24,118 +26,235 @@ import (
 	waitutil "github.com/argoproj/argo/v2/util/wait"
 	"github.com/argoproj/argo/v2/workflow/common"
 	"github.com/argoproj/argo/v2/workflow/creator"
+	"github.com/argoproj/argo/v2/workflow/hydrator"
 )
 
 type Operation struct {
 	ctx               context.Context
 	eventRecorder     record.EventRecorder
+	eventHistory      sqldb.EventHistory
 	instanceIDService instanceid.Service
+	hydrator          hydrator.Interface
 	events            []wfv1.WorkflowEventBinding
 	env               map[string]interface{}
+	// the UID the event is recorded under in the event history
+	uid string
+	// whether the event was received, and recorded as pending, rather than retried
+	recorded bool
 }
 
-func NewOperation(ctx context.Context, instanceIDService instanceid.Service, eventRecorder record.EventRecorder, events []wfv1.WorkflowEventBinding, namespace, discriminator string, payload *wfv1.Item) (*Operation, error) {
+func NewOperation(ctx context.Context, instanceIDService instanceid.Service, hydrator hydrator.Interface, eventRecorder record.EventRecorder, eventHistory sqldb.EventHistory, events []wfv1.WorkflowEventBinding, namespace, discriminator string, payload *wfv1.Item) (*Operation, error) {
 	env, err := expressionEnvironment(ctx, namespace, discriminator, payload)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create workflow template expression environment: %w", err)
 	}
+	uid := string(uuid.NewUUID())
+	recorded := false
+	if eventHistory.IsEnabled() {
+		data, err := json.Marshal(env)
+		if err != nil {
+			return nil, err
+		}
+		// the history is for diagnosis, failing to record the event must not prevent it being dispatched
+		err = eventHistory.RecordEvent(&sqldb.EventRecord{UID: uid, Namespace: namespace, Discriminator: discriminator, Environment: string(data), ReceivedAt: time.Now()})
+		if err != nil {
+			log.WithError(err).WithField("uid", uid).Error("failed to record event")
+		}
+		recorded = err == nil
+	}
+	return &Operation{
+		ctx:               ctx,
+		eventRecorder:     eventRecorder,
+		eventHistory:      eventHistory,
+		instanceIDService: instanceIDService,
+		hydrator:          hydrator,
+		events:            events,
+		env:               env,
+		uid:               uid,
+		recorded:          recorded,
+	}, nil
+}
+
+// NewRetryOperation creates an operation that dispatches a recorded event again. Pending events are dispatched to all the
+// bindings, failed events only to the bindings that failed. The caller must have set the event's status to dispatching.
+func NewRetryOperation(ctx context.Context, instanceIDService instanceid.Service, hydrator hydrator.Interface, eventRecorder record.EventRecorder, eventHistory sqldb.EventHistory, events []wfv1.WorkflowEventBinding, event *sqldb.Event) (*Operation, error) {
+	if !event.Status.Undelivered() {
+		return nil, fmt.Errorf("event %s is %s, only %s or %s events can be retried", event.UID, event.Status, sqldb.EventPending, sqldb.EventFailed)
+	}
+	env := make(map[string]interface{})
+	err := json.Unmarshal([]byte(event.Environment), &env)
+	if err != nil {
+		return nil, fmt.Errorf("failed to unmarshal event expression environment: %w", err)
+	}
+	if event.Status == sqldb.EventFailed {
+		failed := make(map[string]bool)
+		for _, d := range event.Dispatches {
+			if d.Error != "" {
+				failed[d.BindingNamespace+"/"+d.Binding] = true
+			}
+		}
+		var retried []wfv1.WorkflowEventBinding
+		for _, wfeb := range events {
+			if failed[wfeb.Namespace+"/"+wfeb.Name] {
+				retried = append(retried, wfeb)
+			}
+		}
+		events = retried
+	}
 	return &Operation{
 		ctx:               ctx,
 		eventRecorder:     eventRecorder,
+		eventHistory:      eventHistory,
 		instanceIDService: instanceIDService,
+		hydrator:          hydrator,
 		events:            events,
 		env:               env,
+		uid:               event.UID,
 	}, nil
 }
 
 func (o *Operation) Dispatch(ctx context.Context) {
 	log.Debug("Executing event dispatch")
 
+	// the event may have been retried while it was queued, in which case the retry dispatches it
+	if o.recorded {
+		dispatching, err := o.eventHistory.UpdateEventStatus(o.uid, sqldb.EventPending, sqldb.EventDispatching)
+		if err != nil {
+			log.WithError(err).WithField("uid", o.uid).Error("failed to record event dispatching")
+		} else if !dispatching {
+			log.WithField("uid", o.uid).Info("Event already retried, not dispatching it again")
+			return
+		}
+	}
+
 	data, _ := json.MarshalIndent(o.env, "", "  ")
 	log.Debugln(string(data))
 
+	dispatches := make([]sqldb.EventDispatchRecord, 0, len(o.events))
 	for _, event := range o.events {
 		// we use a predicable suffix for the name so that lost connections cannot result in the same workflow being created twice
 		// being created twice
 		nameSuffix := fmt.Sprintf("%v", time.Now().Unix())
+		var names []string
+		var matched bool
 		err := waitutil.Backoff(retry.DefaultRetry, func() (bool, error) {
-			_, err := o.dispatch(ctx, event, nameSuffix)
+			var err error
+			names, matched, err = o.dispatch(ctx, event, nameSuffix)
 			return !errorsutil.IsTransientErr(err), err
 		})
+		record := sqldb.EventDispatchRecord{BindingNamespace: event.Namespace, Binding: event.Name, Matched: matched, WorkflowName: strings.Join(names, ","), DispatchedAt: time.Now()}
 		if err != nil {
 			log.WithError(err).WithFields(log.Fields{"namespace": event.Namespace, "event": event.Name}).Error("failed to dispatch from event")
 			o.eventRecorder.Event(&event, corev1.EventTypeWarning, "WorkflowEventBindingError", "failed to dispatch event: "+err.Error())
+			record.Error = err.Error()
+		}
+		dispatches = append(dispatches, record)
+	}
+	if o.eventHistory.IsEnabled() {
+		err := o.eventHistory.RecordDispatches(o.uid, dispatches)
+		if err != nil {
+			log.WithError(err).WithField("uid", o.uid).Error("failed to record event dispatches")
 		}
 	}
 }
 
-func (o *Operation) dispatch(ctx context.Context, wfeb wfv1.WorkflowEventBinding, nameSuffix string) (*wfv1.Workflow, error) {
+// dispatch returns the names of the workflows the binding acted on, and whether its selector matched the event
+func (o *Operation) dispatch(ctx context.Context, wfeb wfv1.WorkflowEventBinding, nameSuffix string) ([]string, bool, error) {
 	selector := wfeb.Spec.Event.Selector
 	result, err := expr.Eval(selector, o.env)
 	if err != nil {
-		return nil, fmt.Errorf("failed to evaluate workflow template expression: %w", err)
+		return nil, false, fmt.Errorf("failed to evaluate workflow template expression: %w", err)
 	}
 	matched, boolExpr := result.(bool)
 	log.WithFields(log.Fields{"namespace": wfeb.Namespace, "event": wfeb.Name, "selector": selector, "matched": matched, "boolExpr": boolExpr}).Debug("Selector evaluation")
-	submit := wfeb.Spec.Submit
 	if !boolExpr {
-		return nil, errors.New("malformed workflow template expression: did not evaluate to boolean")
-	} else if matched && submit != nil {
-		client := auth.GetWfClient(o.ctx)
-		ref := wfeb.Spec.Submit.WorkflowTemplateRef
-		var tmpl wfv1.WorkflowSpecHolder
-		var err error
-		if ref.ClusterScope {
-			tmpl, err = client.ArgoprojV1alpha1().ClusterWorkflowTemplates().Get(ctx, ref.Name, metav1.GetOptions{})
-		} else {
-			tmpl, err = client.ArgoprojV1alpha1().WorkflowTemplates(wfeb.Namespace).Get(ctx, ref.Name, metav1.GetOptions{})
-		}
-		if err != nil {
-			return nil, fmt.Errorf("failed to get workflow template: %w", err)
-		}
-		err = o.instanceIDService.Validate(tmpl)
-		if err != nil {
-			return nil, fmt.Errorf("failed to validate workflow template instanceid: %w", err)
+		return nil, false, errors.New("malformed workflow template expression: did not evaluate to boolean")
+	} else if !matched {
+		return nil, false, nil
+	}
+	spec := wfeb.Spec
+	actions := 0
+	for _, action := range []bool{spec.Submit != nil, spec.Resume != nil, spec.Stop != nil, spec.Terminate != nil} {
+		if action {
+			actions++
 		}
-		wf := common.NewWorkflowFromWorkflowTemplate(tmpl.GetName(), tmpl.GetWorkflowMetadata(), ref.ClusterScope)
-		o.instanceIDService.Label(wf)
-		err = o.populateWorkflowMetadata(wf, &submit.ObjectMeta)
+	}
+	if actions > 1 {
+		return nil, true, errors.New("malformed workflow event binding: only one of submit, resume, stop or terminate may be specified")
+	}
+	var names []string
+	switch {
+	case spec.Submit != nil:
+		wf, err := o.submitWorkflow(ctx, wfeb, nameSuffix)
 		if err != nil {
-			return nil, err
+			return nil, true, err
 		}
+		names = []string{wf.Name}
+	case spec.Resume != nil:
+		names, err = o.resumeWorkflows(ctx, wfeb.Namespace, spec.Resume)
+	case spec.Stop != nil:
+		names, err = o.stopWorkflows(ctx, wfeb.Namespace, spec.Stop)
+	case spec.Terminate != nil:
+		names, err = o.terminateWorkflows(ctx, wfeb.Namespace, spec.Terminate)
+	}
+	return names, true, err
+}
+
+func (o *Operation) submitWorkflow(ctx context.Context, wfeb wfv1.WorkflowEventBinding, nameSuffix string) (*wfv1.Workflow, error) {
+	client := auth.GetWfClient(o.ctx)
+	submit := wfeb.Spec.Submit
+	ref := submit.WorkflowTemplateRef
+	var tmpl wfv1.WorkflowSpecHolder
+	var err error
+	if ref.ClusterScope {
+		tmpl, err = client.ArgoprojV1alpha1().ClusterWorkflowTemplates().Get(ctx, ref.Name, metav1.GetOptions{})
+	} else {
+		tmpl, err = client.ArgoprojV1alpha1().WorkflowTemplates(wfeb.Namespace).Get(ctx, ref.Name, metav1.GetOptions{})
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get workflow template: %w", err)
+	}
+	err = o.instanceIDService.Validate(tmpl)
+	if err != nil {
+		return nil, fmt.Errorf("failed to validate workflow template instanceid: %w", err)
+	}
+	wf := common.NewWorkflowFromWorkflowTemplate(tmpl.GetName(), tmpl.GetWorkflowMetadata(), ref.ClusterScope)
+	o.instanceIDService.Label(wf)
+	err = o.populateWorkflowMetadata(wf, &submit.ObjectMeta)
+	if err != nil {
+		return nil, err
+	}
 
-		if wf.Name == "" {
-			// make sure we have a predicable name, so re-creation doesn't create two workflows
-			wf.SetName(wf.GetGenerateName() + nameSuffix)
-		}
-
-		// users will always want to know why a workflow was submitted,
-		// so we label with creator (which is a standard) and the name of the triggering event
-		creator.Label(o.ctx, wf)
-		labels.Label(wf, common.LabelKeyWorkflowEventBinding, wfeb.Name)
-		if submit.Arguments != nil {
-			for _, p := range submit.Arguments.Parameters {
-				if p.ValueFrom == nil {
-					return nil, fmt.Errorf("malformed workflow template parameter \"%s\": validFrom is nil", p.Name)
-				}
-				result, err := expr.Eval(p.ValueFrom.Event, o.env)
-				if err != nil {
-					return nil, fmt.Errorf("failed to evaluate workflow template parameter \"%s\" expression: %w", p.Name, err)
-				}
-				data, err := json.Marshal(result)
-				if err != nil {
-					return nil, fmt.Errorf("failed to convert result to JSON \"%s\" expression: %w", p.Name, err)
-				}
-				wf.Spec.Arguments.Parameters = append(wf.Spec.Arguments.Parameters, wfv1.Parameter{Name: p.Name, Value: wfv1.AnyStringPtr(wfv1.Item{Value: data})})
+	if wf.Name == "" {
+		// make sure we have a predicable name, so re-creation doesn't create two workflows
+		wf.SetName(wf.GetGenerateName() + nameSuffix)
+	}
+
+	// users will always want to know why a workflow was submitted,
+	// so we label with creator (which is a standard) and the name of the triggering event
+	creator.Label(o.ctx, wf)
+	labels.Label(wf, common.LabelKeyWorkflowEventBinding, wfeb.Name)
+	if submit.Arguments != nil {
+		for _, p := range submit.Arguments.Parameters {
+			if p.ValueFrom == nil {
+				return nil, fmt.Errorf("malformed workflow template parameter \"%s\": validFrom is nil", p.Name)
 			}
+			result, err := expr.Eval(p.ValueFrom.Event, o.env)
+			if err != nil {
+				return nil, fmt.Errorf("failed to evaluate workflow template parameter \"%s\" expression: %w", p.Name, err)
+			}
+			data, err := json.Marshal(result)
+			if err != nil {
+				return nil, fmt.Errorf("failed to convert result to JSON \"%s\" expression: %w", p.Name, err)
+			}
+			wf.Spec.Arguments.Parameters = append(wf.Spec.Arguments.Parameters, wfv1.Parameter{Name: p.Name, Value: wfv1.AnyStringPtr(wfv1.Item{Value: data})})
 		}
-		wf, err = client.ArgoprojV1alpha1().Workflows(wfeb.Namespace).Create(ctx, wf, metav1.CreateOptions{})
-		if err != nil {
-			return nil, fmt.Errorf("failed to create workflow: %w", err)
-		}
-		return wf, nil
 	}
-	return nil, nil
+	wf, err = client.ArgoprojV1alpha1().Workflows(wfeb.Namespace).Create(ctx, wf, metav1.CreateOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create workflow: %w", err)
+	}
+	return wf, nil
 }
 
 func (o *Operation) populateWorkflowMetadata(wf *wfv1.Workflow, metadata *metav1.ObjectMeta) error {
@@ -3,20 +3,25 @@ package dispatch
 import (
 	"context"
 	"encoding/json"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 	"google.golang.org/grpc/metadata"
 	"gopkg.in/square/go-jose.v2/jwt"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/tools/record"
 
+	"github.com/argoproj/argo/v2/persist/sqldb"
+	sqldbmocks "github.com/argoproj/argo/v2/persist/sqldb/mocks"
 	wfv1 "github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1"
 	"github.com/argoproj/argo/v2/pkg/client/clientset/versioned/fake"
 	"github.com/argoproj/argo/v2/server/auth"
 	"github.com/argoproj/argo/v2/server/auth/types"
 	"github.com/argoproj/argo/v2/util/instanceid"
 	"github.com/argoproj/argo/v2/workflow/common"
+	hydratorfake "github.com/argoproj/argo/v2/workflow/hydrator/fake"
 )
 
 func Test_metaData(t *testing.T) {
@@ -56,7 +61,7 @@ func TestNewOperation(t *testing.T) {
 	recorder := record.NewFakeRecorder(6)
 
 	// act
-	operation, err := NewOperation(ctx, instanceid.NewService("my-instanceid"), recorder, []wfv1.WorkflowEventBinding{
+	operation, err := NewOperation(ctx, instanceid.NewService("my-instanceid"), hydratorfake.Noop, recorder, sqldb.NullEventHistory, []wfv1.WorkflowEventBinding{
 		// test a malformed binding
 		{
 			ObjectMeta: metav1.ObjectMeta{Name: "malformed", Namespace: "my-ns"},
@@ -169,6 +174,121 @@ func TestNewOperation(t *testing.T) {
 	assert.Equal(t, "Warning WorkflowEventBindingError failed to dispatch event: failed to evaluate workflow template parameter \"my-param\" expression: unexpected token Operator(\"!\") (1:8)\n | rubbish!!!\n | .......^", <-recorder.Events)
 }
 
+func TestOperation_EventHistory(t *testing.T) {
+	client := fake.NewSimpleClientset(&wfv1.WorkflowTemplate{
+		ObjectMeta: metav1.ObjectMeta{Name: "my-wft", Namespace: "my-ns", Labels: map[string]string{common.LabelKeyControllerInstanceID: "my-instanceid"}},
+	})
+	ctx := context.WithValue(context.Background(), auth.WfKey, client)
+	eventHistory := &sqldbmocks.EventHistory{}
+	eventHistory.On("IsEnabled").Return(true)
+	eventHistory.On("RecordEvent", mock.MatchedBy(func(e *sqldb.EventRecord) bool {
+		return e.UID != "" && e.Namespace == "my-ns" && e.Discriminator == "my-d" && strings.Contains(e.Environment, `"payload":{"foo":"bar"}`)
+	})).Return(nil)
+	eventHistory.On("UpdateEventStatus", mock.Anything, sqldb.EventPending, sqldb.EventDispatching).Return(true, nil)
+	var dispatches []sqldb.EventDispatchRecord
+	eventHistory.On("RecordDispatches", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		dispatches = args.Get(1).([]sqldb.EventDispatchRecord)
+	}).Return(nil)
+
+	operation, err := NewOperation(ctx, instanceid.NewService("my-instanceid"), hydratorfake.Noop, record.NewFakeRecorder(1), eventHistory, []wfv1.WorkflowEventBinding{
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "my-wfeb-0", Namespace: "my-ns"},
+			Spec:       wfv1.WorkflowEventBindingSpec{Event: wfv1.Event{Selector: "false"}},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "my-wfeb-1", Namespace: "my-ns"},
+			Spec: wfv1.WorkflowEventBindingSpec{
+				Event:  wfv1.Event{Selector: "true"},
+				Submit: &wfv1.Submit{WorkflowTemplateRef: wfv1.WorkflowTemplateRef{Name: "my-wft"}},
+			},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "my-wfeb-2", Namespace: "my-ns"},
+			Spec: wfv1.WorkflowEventBindingSpec{
+				Event:  wfv1.Event{Selector: "true"},
+				Submit: &wfv1.Submit{WorkflowTemplateRef: wfv1.WorkflowTemplateRef{Name: "not-found"}},
+			},
+		},
+	}, "my-ns", "my-d", &wfv1.Item{Value: json.RawMessage(`{"foo":"bar"}`)})
+	if assert.NoError(t, err) {
+		operation.Dispatch(ctx)
+		eventHistory.AssertExpectations(t)
+		if assert.Len(t, dispatches, 3) {
+			assert.Equal(t, "my-wfeb-0", dispatches[0].Binding)
+			assert.False(t, dispatches[0].Matched)
+			assert.Empty(t, dispatches[0].Error)
+			assert.True(t, dispatches[1].Matched)
+			assert.Contains(t, dispatches[1].WorkflowName, "my-wft")
+			assert.Empty(t, dispatches[1].Error)
+			assert.True(t, dispatches[2].Matched)
+			assert.Empty(t, dispatches[2].WorkflowName)
+			assert.Equal(t, `failed to get workflow template: workflowtemplates.argoproj.io "not-found" not found`, dispatches[2].Error)
+		}
+	}
+}
+
+func TestOperation_EventHistoryRetried(t *testing.T) {
+	client := fake.NewSimpleClientset()
+	ctx := context.WithValue(context.Background(), auth.WfKey, client)
+	eventHistory := &sqldbmocks.EventHistory{}
+	eventHistory.On("IsEnabled").Return(true)
+	eventHistory.On("RecordEvent", mock.Anything).Return(nil)
+	// the event was retried while it was queued
+	eventHistory.On("UpdateEventStatus", mock.Anything, sqldb.EventPending, sqldb.EventDispatching).Return(false, nil)
+
+	operation, err := NewOperation(ctx, instanceid.NewService("my-instanceid"), hydratorfake.Noop, record.NewFakeRecorder(1), eventHistory, []wfv1.WorkflowEventBinding{
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "my-wfeb", Namespace: "my-ns"},
+			Spec: wfv1.WorkflowEventBindingSpec{
+				Event:  wfv1.Event{Selector: "true"},
+				Submit: &wfv1.Submit{WorkflowTemplateRef: wfv1.WorkflowTemplateRef{Name: "my-wft"}},
+			},
+		},
+	}, "my-ns", "my-d", &wfv1.Item{Value: json.RawMessage(`{"foo":"bar"}`)})
+	if assert.NoError(t, err) {
+		operation.Dispatch(ctx)
+		eventHistory.AssertExpectations(t)
+		eventHistory.AssertNotCalled(t, "RecordDispatches", mock.Anything, mock.Anything)
+		assert.Empty(t, client.Actions(), "the event is not dispatched again")
+	}
+}
+
+func TestNewRetryOperation(t *testing.T) {
+	bindings := []wfv1.WorkflowEventBinding{
+		{ObjectMeta: metav1.ObjectMeta{Name: "my-wfeb-0", Namespace: "my-ns"}},
+		{ObjectMeta: metav1.ObjectMeta{Name: "my-wfeb-1", Namespace: "my-ns"}},
+	}
+	event := func(status sqldb.EventStatus) *sqldb.Event {
+		return &sqldb.Event{
+			EventRecord: sqldb.EventRecord{UID: "my-uid", Status: status, Environment: `{"payload":{"foo":"bar"}}`},
+			Dispatches: []sqldb.EventDispatchRecord{
+				{BindingNamespace: "my-ns", Binding: "my-wfeb-0"},
+				{BindingNamespace: "my-ns", Binding: "my-wfeb-1", Error: "failed"},
+			},
+		}
+	}
+	t.Run("Dispatched", func(t *testing.T) {
+		_, err := NewRetryOperation(context.TODO(), instanceid.NewService(""), hydratorfake.Noop, record.NewFakeRecorder(1), sqldb.NullEventHistory, bindings, event(sqldb.EventDispatched))
+		assert.EqualError(t, err, "event my-uid is Dispatched, only Pending or Failed events can be retried")
+	})
+	t.Run("Failed", func(t *testing.T) {
+		operation, err := NewRetryOperation(context.TODO(), instanceid.NewService(""), hydratorfake.Noop, record.NewFakeRecorder(1), sqldb.NullEventHistory, bindings, event(sqldb.EventFailed))
+		if assert.NoError(t, err) {
+			assert.Equal(t, "my-uid", operation.uid)
+			assert.Equal(t, map[string]interface{}{"foo": "bar"}, operation.env["payload"])
+			if assert.Len(t, operation.events, 1, "only the failed binding is retried") {
+				assert.Equal(t, "my-wfeb-1", operation.events[0].Name)
+			}
+		}
+	})
+	t.Run("Pending", func(t *testing.T) {
+		operation, err := NewRetryOperation(context.TODO(), instanceid.NewService(""), hydratorfake.Noop, record.NewFakeRecorder(1), sqldb.NullEventHistory, bindings, event(sqldb.EventPending))
+		if assert.NoError(t, err) {
+			assert.Len(t, operation.events, 2, "all bindings are dispatched")
+		}
+	})
+}
+
 func Test_populateWorkflowMetadata(t *testing.T) {
 	// set-up
 	client := fake.NewSimpleClientset(
@@ -180,7 +300,7 @@ func Test_populateWorkflowMetadata(t *testing.T) {
 	recorder := record.NewFakeRecorder(10)
 
 	// act
-	operation, err := NewOperation(ctx, instanceid.NewService("my-instanceid"), recorder, []wfv1.WorkflowEventBinding{
+	operation, err := NewOperation(ctx, instanceid.NewService("my-instanceid"), hydratorfake.Noop, recorder, sqldb.NullEventHistory, []wfv1.WorkflowEventBinding{
 		{
 			// No name specified
 			ObjectMeta: metav1.ObjectMeta{Name: "my-wfeb-1", Namespace: "my-ns"},
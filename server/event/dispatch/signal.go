@@ -0,0 +1,139 @@
+package dispatch
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/antonmedv/expr"
+	log "github.com/sirupsen/logrus"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/selection"
+
+	wfv1 "github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo/v2/server/auth"
+	"github.com/argoproj/argo/v2/workflow/common"
+	"github.com/argoproj/argo/v2/workflow/util"
+)
+
+// selectWorkflows returns the names of the running workflows in the namespace that the selector selects
+func (o *Operation) selectWorkflows(ctx context.Context, namespace string, selector wfv1.EventWorkflowSelector) ([]string, error) {
+	if selector.Name == "" && len(selector.Labels) == 0 {
+		return nil, errors.New("malformed workflow selector: name or labels must be specified")
+	}
+	name := ""
+	if selector.Name != "" {
+		var err error
+		name, err = o.evaluateStringExpression(selector.Name, "selector name")
+		if err != nil {
+			return nil, err
+		}
+	}
+	requirement, err := labels.NewRequirement(common.LabelKeyCompleted, selection.NotEquals, []string{"true"})
+	if err != nil {
+		return nil, err
+	}
+	labelSelector := labels.NewSelector().Add(*requirement)
+	for key, expression := range selector.Labels {
+		value, err := o.evaluateStringExpression(expression, fmt.Sprintf("selector label \"%s\"", key))
+		if err != nil {
+			return nil, err
+		}
+		requirement, err := labels.NewRequirement(key, selection.Equals, []string{value})
+		if err != nil {
+			return nil, fmt.Errorf("malformed workflow selector label \"%s\": %w", key, err)
+		}
+		labelSelector = labelSelector.Add(*requirement)
+	}
+	options := metav1.ListOptions{LabelSelector: labelSelector.String()}
+	o.instanceIDService.With(&options)
+	list, err := auth.GetWfClient(o.ctx).ArgoprojV1alpha1().Workflows(namespace).List(ctx, options)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list workflows: %w", err)
+	}
+	var names []string
+	for _, wf := range list.Items {
+		if name == "" || wf.Name == name {
+			names = append(names, wf.Name)
+		}
+	}
+	log.WithFields(log.Fields{"namespace": namespace, "labelSelector": options.LabelSelector, "name": name, "workflows": names}).Debug("Selected workflows")
+	return names, nil
+}
+
+func (o *Operation) resumeWorkflows(ctx context.Context, namespace string, action *wfv1.ResumeAction) ([]string, error) {
+	outputParameters := make(map[string]string)
+	for _, p := range action.OutputParameters {
+		if p.ValueFrom == nil || p.ValueFrom.Event == "" {
+			return nil, fmt.Errorf("malformed output parameter \"%s\": valueFrom.event is empty", p.Name)
+		}
+		result, err := expr.Eval(p.ValueFrom.Event, o.env)
+		if err != nil {
+			return nil, fmt.Errorf("failed to evaluate output parameter \"%s\" expression: %w", p.Name, err)
+		}
+		data, err := json.Marshal(result)
+		if err != nil {
+			return nil, fmt.Errorf("failed to convert result to JSON \"%s\" expression: %w", p.Name, err)
+		}
+		outputParameters[p.Name] = wfv1.AnyStringPtr(wfv1.Item{Value: data}).String()
+	}
+	if len(outputParameters) > 0 && action.NodeFieldSelector == "" {
+		return nil, errors.New("malformed resume: output parameters require a node field selector")
+	}
+	names, err := o.selectWorkflows(ctx, namespace, action.Workflows)
+	if err != nil {
+		return nil, err
+	}
+	wfIf := auth.GetWfClient(o.ctx).ArgoprojV1alpha1().Workflows(namespace)
+	for _, name := range names {
+		if len(outputParameters) > 0 {
+			err = util.SetWorkflow(ctx, wfIf, o.hydrator, name, action.NodeFieldSelector, util.SetOperationValues{Phase: wfv1.NodeSucceeded, OutputParameters: outputParameters})
+		} else {
+			err = util.ResumeWorkflow(ctx, wfIf, o.hydrator, name, action.NodeFieldSelector)
+		}
+		if err != nil {
+			return names, fmt.Errorf("failed to resume workflow \"%s\": %w", name, err)
+		}
+	}
+	return names, nil
+}
+
+func (o *Operation) stopWorkflows(ctx context.Context, namespace string, action *wfv1.StopAction) ([]string, error) {
+	message := ""
+	if action.Message != "" {
+		var err error
+		message, err = o.evaluateStringExpression(action.Message, "stop message")
+		if err != nil {
+			return nil, err
+		}
+	}
+	names, err := o.selectWorkflows(ctx, namespace, action.Workflows)
+	if err != nil {
+		return nil, err
+	}
+	wfIf := auth.GetWfClient(o.ctx).ArgoprojV1alpha1().Workflows(namespace)
+	for _, name := range names {
+		err := util.StopWorkflow(ctx, wfIf, o.hydrator, name, action.NodeFieldSelector, message)
+		if err != nil {
+			return names, fmt.Errorf("failed to stop workflow \"%s\": %w", name, err)
+		}
+	}
+	return names, nil
+}
+
+func (o *Operation) terminateWorkflows(ctx context.Context, namespace string, action *wfv1.TerminateAction) ([]string, error) {
+	names, err := o.selectWorkflows(ctx, namespace, action.Workflows)
+	if err != nil {
+		return nil, err
+	}
+	wfIf := auth.GetWfClient(o.ctx).ArgoprojV1alpha1().Workflows(namespace)
+	for _, name := range names {
+		err := util.TerminateWorkflow(ctx, wfIf, name)
+		if err != nil {
+			return names, fmt.Errorf("failed to terminate workflow \"%s\": %w", name, err)
+		}
+	}
+	return names, nil
+}
@@ -0,0 +1,150 @@
+package dispatch
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+
+	"github.com/argoproj/argo/v2/persist/sqldb"
+	wfv1 "github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo/v2/pkg/client/clientset/versioned/fake"
+	"github.com/argoproj/argo/v2/server/auth"
+	"github.com/argoproj/argo/v2/util/instanceid"
+	"github.com/argoproj/argo/v2/workflow/common"
+	hydratorfake "github.com/argoproj/argo/v2/workflow/hydrator/fake"
+)
+
+func suspendedWorkflow(name string, labels map[string]string) *wfv1.Workflow {
+	return &wfv1.Workflow{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "my-ns", Labels: labels},
+		Status: wfv1.WorkflowStatus{
+			Phase: wfv1.WorkflowRunning,
+			Nodes: wfv1.Nodes{
+				"approve": {
+					ID:          "approve",
+					DisplayName: "approve",
+					Type:        wfv1.NodeTypeSuspend,
+					Phase:       wfv1.NodeRunning,
+					Outputs: &wfv1.Outputs{Parameters: []wfv1.Parameter{
+						{Name: "approver", ValueFrom: &wfv1.ValueFrom{Supplied: &wfv1.SuppliedValueFrom{}}},
+					}},
+				},
+			},
+		},
+	}
+}
+
+func newSignalOperation(t *testing.T, client *fake.Clientset, binding wfv1.WorkflowEventBindingSpec) *Operation {
+	ctx := context.WithValue(context.Background(), auth.WfKey, client)
+	operation, err := NewOperation(ctx, instanceid.NewService(""), hydratorfake.Noop, record.NewFakeRecorder(1), sqldb.NullEventHistory, []wfv1.WorkflowEventBinding{
+		{ObjectMeta: metav1.ObjectMeta{Name: "my-wfeb", Namespace: "my-ns"}, Spec: binding},
+	}, "my-ns", "", &wfv1.Item{Value: json.RawMessage(`{"workflow": "my-wf", "id": "1234", "user": "alice"}`)})
+	if !assert.NoError(t, err) {
+		t.FailNow()
+	}
+	return operation
+}
+
+func TestOperation_Resume(t *testing.T) {
+	t.Run("ByName", func(t *testing.T) {
+		client := fake.NewSimpleClientset(suspendedWorkflow("my-wf", nil), suspendedWorkflow("other-wf", nil))
+		operation := newSignalOperation(t, client, wfv1.WorkflowEventBindingSpec{
+			Event:  wfv1.Event{Selector: "true"},
+			Resume: &wfv1.ResumeAction{Workflows: wfv1.EventWorkflowSelector{Name: "payload.workflow"}, OutputParameters: []wfv1.Parameter{{Name: "approver", ValueFrom: &wfv1.ValueFrom{Supplied: &wfv1.SuppliedValueFrom{}}}}},
+		})
+		names, matched, err := operation.dispatch(context.TODO(), operation.events[0], "")
+		assert.True(t, matched)
+		assert.EqualError(t, err, `malformed output parameter "approver": valueFrom.event is empty`)
+		assert.Empty(t, names)
+
+		operation.events[0].Spec.Resume.NodeFieldSelector = "displayName=approve"
+		operation.events[0].Spec.Resume.OutputParameters[0].ValueFrom = &wfv1.ValueFrom{Event: "payload.user"}
+		names, _, err = operation.dispatch(context.TODO(), operation.events[0], "")
+		if assert.NoError(t, err) {
+			assert.Equal(t, []string{"my-wf"}, names)
+			wf, err := client.ArgoprojV1alpha1().Workflows("my-ns").Get(context.TODO(), "my-wf", metav1.GetOptions{})
+			if assert.NoError(t, err) {
+				node := wf.Status.Nodes["approve"]
+				assert.Equal(t, wfv1.NodeSucceeded, node.Phase)
+				assert.Equal(t, "alice", node.Outputs.Parameters[0].Value.String())
+			}
+			wf, err = client.ArgoprojV1alpha1().Workflows("my-ns").Get(context.TODO(), "other-wf", metav1.GetOptions{})
+			if assert.NoError(t, err) {
+				assert.Equal(t, wfv1.NodeRunning, wf.Status.Nodes["approve"].Phase, "other workflows are not resumed")
+			}
+		}
+	})
+	t.Run("OutputParametersWithoutNodeFieldSelector", func(t *testing.T) {
+		operation := newSignalOperation(t, fake.NewSimpleClientset(), wfv1.WorkflowEventBindingSpec{
+			Event:  wfv1.Event{Selector: "true"},
+			Resume: &wfv1.ResumeAction{Workflows: wfv1.EventWorkflowSelector{Name: "payload.workflow"}, OutputParameters: []wfv1.Parameter{{Name: "approver", ValueFrom: &wfv1.ValueFrom{Event: "payload.user"}}}},
+		})
+		_, _, err := operation.dispatch(context.TODO(), operation.events[0], "")
+		assert.EqualError(t, err, "malformed resume: output parameters require a node field selector")
+	})
+	t.Run("NoSelector", func(t *testing.T) {
+		operation := newSignalOperation(t, fake.NewSimpleClientset(), wfv1.WorkflowEventBindingSpec{
+			Event:  wfv1.Event{Selector: "true"},
+			Resume: &wfv1.ResumeAction{},
+		})
+		_, _, err := operation.dispatch(context.TODO(), operation.events[0], "")
+		assert.EqualError(t, err, "malformed workflow selector: name or labels must be specified")
+	})
+}
+
+func TestOperation_Stop(t *testing.T) {
+	client := fake.NewSimpleClientset(
+		suspendedWorkflow("my-wf", map[string]string{"approval-id": "1234"}),
+		suspendedWorkflow("completed-wf", map[string]string{"approval-id": "1234", common.LabelKeyCompleted: "true"}),
+		suspendedWorkflow("other-wf", map[string]string{"approval-id": "5678"}),
+	)
+	operation := newSignalOperation(t, client, wfv1.WorkflowEventBindingSpec{
+		Event: wfv1.Event{Selector: "true"},
+		Stop: &wfv1.StopAction{
+			Workflows:         wfv1.EventWorkflowSelector{Labels: map[string]string{"approval-id": "payload.id"}},
+			NodeFieldSelector: "displayName=approve",
+			Message:           `"rejected by " + payload.user`,
+		},
+	})
+	names, matched, err := operation.dispatch(context.TODO(), operation.events[0], "")
+	assert.True(t, matched)
+	if assert.NoError(t, err) {
+		assert.Equal(t, []string{"my-wf"}, names, "only running workflows with matching labels are selected")
+		wf, err := client.ArgoprojV1alpha1().Workflows("my-ns").Get(context.TODO(), "my-wf", metav1.GetOptions{})
+		if assert.NoError(t, err) {
+			node := wf.Status.Nodes["approve"]
+			assert.Equal(t, wfv1.NodeFailed, node.Phase)
+			assert.Equal(t, "rejected by alice", node.Message)
+		}
+	}
+}
+
+func TestOperation_Terminate(t *testing.T) {
+	client := fake.NewSimpleClientset(suspendedWorkflow("my-wf", nil))
+	operation := newSignalOperation(t, client, wfv1.WorkflowEventBindingSpec{
+		Event:     wfv1.Event{Selector: "true"},
+		Terminate: &wfv1.TerminateAction{Workflows: wfv1.EventWorkflowSelector{Name: "payload.workflow"}},
+	})
+	names, _, err := operation.dispatch(context.TODO(), operation.events[0], "")
+	if assert.NoError(t, err) {
+		assert.Equal(t, []string{"my-wf"}, names)
+		wf, err := client.ArgoprojV1alpha1().Workflows("my-ns").Get(context.TODO(), "my-wf", metav1.GetOptions{})
+		if assert.NoError(t, err) {
+			assert.Equal(t, wfv1.ShutdownStrategyTerminate, wf.Spec.Shutdown)
+		}
+	}
+}
+
+func TestOperation_MultipleActions(t *testing.T) {
+	operation := newSignalOperation(t, fake.NewSimpleClientset(), wfv1.WorkflowEventBindingSpec{
+		Event:     wfv1.Event{Selector: "true"},
+		Stop:      &wfv1.StopAction{Workflows: wfv1.EventWorkflowSelector{Name: "payload.workflow"}},
+		Terminate: &wfv1.TerminateAction{Workflows: wfv1.EventWorkflowSelector{Name: "payload.workflow"}},
+	})
+	_, _, err := operation.dispatch(context.TODO(), operation.events[0], "")
+	assert.EqualError(t, err, "malformed workflow event binding: only one of submit, resume, stop or terminate may be specified")
+}
@@ -2,23 +2,34 @@ package event
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
+	"os"
 	"sync"
+	"time"
 
 	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
+	"github.com/argoproj/argo/v2/persist/sqldb"
 	eventpkg "github.com/argoproj/argo/v2/pkg/apiclient/event"
+	"github.com/argoproj/argo/v2/pkg/apis/workflow"
 	wfv1 "github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1"
 	"github.com/argoproj/argo/v2/server/auth"
 	"github.com/argoproj/argo/v2/server/event/dispatch"
 	"github.com/argoproj/argo/v2/util/instanceid"
 	"github.com/argoproj/argo/v2/workflow/events"
+	"github.com/argoproj/argo/v2/workflow/hydrator"
 )
 
 type Controller struct {
 	instanceIDService    instanceid.Service
+	hydrator             hydrator.Interface
 	eventRecorderManager events.EventRecorderManager
+	eventHistory         sqldb.EventHistory
 	// a channel for operations to be executed async on
 	operationQueue chan dispatch.Operation
 	workerCount    int
@@ -26,12 +37,14 @@ type Controller struct {
 
 var _ eventpkg.EventServiceServer = &Controller{}
 
-func NewController(instanceIDService instanceid.Service, eventRecorderManager events.EventRecorderManager, operationQueueSize, workerCount int) *Controller {
-	log.WithFields(log.Fields{"workerCount": workerCount, "operationQueueSize": operationQueueSize}).Info("Creating event controller")
+func NewController(instanceIDService instanceid.Service, hydrator hydrator.Interface, eventRecorderManager events.EventRecorderManager, eventHistory sqldb.EventHistory, operationQueueSize, workerCount int) *Controller {
+	log.WithFields(log.Fields{"workerCount": workerCount, "operationQueueSize": operationQueueSize, "eventHistory": eventHistory.IsEnabled()}).Info("Creating event controller")
 
 	return &Controller{
 		instanceIDService:    instanceIDService,
+		hydrator:             hydrator,
 		eventRecorderManager: eventRecorderManager,
+		eventHistory:         eventHistory,
 		//  so we can have `operationQueueSize` operations outstanding before we start putting back pressure on the senders
 		operationQueue: make(chan dispatch.Operation, operationQueueSize),
 		workerCount:    workerCount,
@@ -65,6 +78,43 @@ func (s *Controller) Run(stopCh <-chan struct{}) {
 	wg.Wait()
 }
 
+// RunEventHistoryGC periodically deletes the dispatched events that were received more than ttl ago from the event
+// history, until stopCh is closed
+func (s *Controller) RunEventHistoryGC(stopCh <-chan struct{}, ttl time.Duration) {
+	if !s.eventHistory.IsEnabled() {
+		log.Info("Event history disabled - so event history GC disabled")
+		return
+	}
+	if ttl == 0 {
+		log.Info("Event TTL zero - so event history GC disabled")
+		return
+	}
+	value, ok := os.LookupEnv("EVENT_HISTORY_GC_PERIOD")
+	periodicity := time.Hour
+	if ok {
+		var err error
+		periodicity, err = time.ParseDuration(value)
+		if err != nil {
+			log.WithFields(log.Fields{"err": err, "value": value}).Fatal("Failed to parse EVENT_HISTORY_GC_PERIOD")
+		}
+	}
+	log.WithFields(log.Fields{"ttl": ttl, "periodicity": periodicity}).Info("Performing event history GC")
+	ticker := time.NewTicker(periodicity)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-stopCh:
+			return
+		case <-ticker.C:
+			log.Info("Performing event history GC")
+			err := s.eventHistory.DeleteExpiredEvents(ttl)
+			if err != nil {
+				log.WithField("err", err).Error("Failed to delete expired events")
+			}
+		}
+	}
+}
+
 func (s *Controller) ReceiveEvent(ctx context.Context, req *eventpkg.EventRequest) (*eventpkg.EventResponse, error) {
 
 	options := metav1.ListOptions{}
@@ -75,11 +125,15 @@ func (s *Controller) ReceiveEvent(ctx context.Context, req *eventpkg.EventReques
 		return nil, err
 	}
 
-	operation, err := dispatch.NewOperation(ctx, s.instanceIDService, s.eventRecorderManager.Get(req.Namespace), list.Items, req.Namespace, req.Discriminator, req.Payload)
+	operation, err := dispatch.NewOperation(ctx, s.instanceIDService, s.hydrator, s.eventRecorderManager.Get(req.Namespace), s.eventHistory, list.Items, req.Namespace, req.Discriminator, req.Payload)
 	if err != nil {
 		return nil, err
 	}
 
+	return s.enqueue(operation)
+}
+
+func (s *Controller) enqueue(operation *dispatch.Operation) (*eventpkg.EventResponse, error) {
 	select {
 	case s.operationQueue <- *operation:
 		return &eventpkg.EventResponse{}, nil
@@ -95,3 +149,129 @@ func (s *Controller) ListWorkflowEventBindings(ctx context.Context, in *eventpkg
 	}
 	return auth.GetWfClient(ctx).ArgoprojV1alpha1().WorkflowEventBindings(in.Namespace).List(ctx, listOptions)
 }
+
+func (s *Controller) ListEventDeliveries(ctx context.Context, req *eventpkg.ListEventDeliveriesRequest) (*eventpkg.EventDeliveryList, error) {
+	err := s.checkEventHistory(ctx, req.Namespace)
+	if err != nil {
+		return nil, err
+	}
+	records, err := s.eventHistory.ListEvents(req.Namespace, req.Undelivered, int(req.Limit))
+	if err != nil {
+		return nil, err
+	}
+	items := make([]*eventpkg.EventDelivery, len(records))
+	for i := range records {
+		items[i], err = eventDelivery(&records[i])
+		if err != nil {
+			return nil, err
+		}
+	}
+	return &eventpkg.EventDeliveryList{Items: items}, nil
+}
+
+func (s *Controller) GetEventDelivery(ctx context.Context, req *eventpkg.GetEventDeliveryRequest) (*eventpkg.EventDelivery, error) {
+	event, err := s.getEvent(ctx, req.Namespace, req.Uid)
+	if err != nil {
+		return nil, err
+	}
+	return eventDelivery(event)
+}
+
+func (s *Controller) RetryEventDelivery(ctx context.Context, req *eventpkg.RetryEventDeliveryRequest) (*eventpkg.EventResponse, error) {
+	event, err := s.getEvent(ctx, req.Namespace, req.Uid)
+	if err != nil {
+		return nil, err
+	}
+
+	options := metav1.ListOptions{}
+	s.instanceIDService.With(&options)
+
+	// the bindings may have changed since the event was received, we dispatch to the current ones
+	list, err := auth.GetWfClient(ctx).ArgoprojV1alpha1().WorkflowEventBindings(event.Namespace).List(ctx, options)
+	if err != nil {
+		return nil, err
+	}
+
+	operation, err := dispatch.NewRetryOperation(ctx, s.instanceIDService, s.hydrator, s.eventRecorderManager.Get(event.Namespace), s.eventHistory, list.Items, event)
+	if err != nil {
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	}
+
+	// only one retry, or the dispatch of the received event if it is still queued, may dispatch the event
+	dispatching, err := s.eventHistory.UpdateEventStatus(event.UID, event.Status, sqldb.EventDispatching)
+	if err != nil {
+		return nil, err
+	}
+	if !dispatching {
+		return nil, status.Errorf(codes.FailedPrecondition, "event %s is already being dispatched", event.UID)
+	}
+	resp, err := s.enqueue(operation)
+	if err != nil {
+		// so that it can be retried later
+		if _, err := s.eventHistory.UpdateEventStatus(event.UID, sqldb.EventDispatching, event.Status); err != nil {
+			log.WithError(err).WithField("uid", event.UID).Error("failed to record event status")
+		}
+		return nil, err
+	}
+	return resp, nil
+}
+
+// checkEventHistory checks the event history is enabled, and the user may see the namespace's events. Events are
+// only dispatched to bindings the user can list, so we use the same permission.
+func (s *Controller) checkEventHistory(ctx context.Context, namespace string) error {
+	if !s.eventHistory.IsEnabled() {
+		return status.Error(codes.Unimplemented, "event delivery history requires persistence to be enabled")
+	}
+	allowed, err := auth.CanI(ctx, "list", workflow.WorkflowEventBindingPlural, namespace, "")
+	if err != nil {
+		return err
+	}
+	if !allowed {
+		return status.Error(codes.PermissionDenied, "permission denied")
+	}
+	return nil
+}
+
+func (s *Controller) getEvent(ctx context.Context, namespace, uid string) (*sqldb.Event, error) {
+	err := s.checkEventHistory(ctx, namespace)
+	if err != nil {
+		return nil, err
+	}
+	event, err := s.eventHistory.GetEvent(uid)
+	if err != nil {
+		return nil, err
+	}
+	if event == nil || event.Namespace != namespace {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+	return event, nil
+}
+
+func eventDelivery(event *sqldb.Event) (*eventpkg.EventDelivery, error) {
+	env := struct {
+		Payload *wfv1.Item `json:"payload"`
+	}{}
+	err := json.Unmarshal([]byte(event.Environment), &env)
+	if err != nil {
+		return nil, fmt.Errorf("failed to unmarshal event %s: %w", event.UID, err)
+	}
+	delivery := &eventpkg.EventDelivery{
+		Uid:           event.UID,
+		Namespace:     event.Namespace,
+		Discriminator: event.Discriminator,
+		ReceivedAt:    &metav1.Time{Time: event.ReceivedAt},
+		Status:        string(event.Status),
+		Payload:       env.Payload,
+	}
+	for _, d := range event.Dispatches {
+		delivery.Dispatches = append(delivery.Dispatches, &eventpkg.EventDispatch{
+			BindingNamespace: d.BindingNamespace,
+			Binding:          d.Binding,
+			Matched:          d.Matched,
+			WorkflowName:     d.WorkflowName,
+			Error:            d.Error,
+			DispatchedAt:     &metav1.Time{Time: d.DispatchedAt},
+		})
+	}
+	return delivery, nil
+}
@@ -1,23 +1,35 @@
 package event
 
 import (
+	"os"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	fakekube "k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
 
+	"github.com/argoproj/argo/v2/persist/sqldb"
+	"github.com/argoproj/argo/v2/persist/sqldb/mocks"
 	eventpkg "github.com/argoproj/argo/v2/pkg/apiclient/event"
 	wfv1 "github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1"
 	"github.com/argoproj/argo/v2/pkg/client/clientset/versioned/fake"
 	"github.com/argoproj/argo/v2/server/auth"
 	"github.com/argoproj/argo/v2/util/instanceid"
 	"github.com/argoproj/argo/v2/workflow/events"
+	hydratorfake "github.com/argoproj/argo/v2/workflow/hydrator/fake"
 )
 
 func TestController(t *testing.T) {
 	clientset := fake.NewSimpleClientset()
-	s := NewController(instanceid.NewService("my-instanceid"), events.NewEventRecorderManager(fakekube.NewSimpleClientset()), 1, 1)
+	s := NewController(instanceid.NewService("my-instanceid"), hydratorfake.Noop, events.NewEventRecorderManager(fakekube.NewSimpleClientset()), sqldb.NullEventHistory, 1, 1)
 
 	ctx := context.WithValue(context.TODO(), auth.WfKey, clientset)
 	_, err := s.ReceiveEvent(ctx, &eventpkg.EventRequest{Namespace: "my-ns", Payload: &wfv1.Item{}})
@@ -34,3 +46,106 @@ func TestController(t *testing.T) {
 
 	assert.Len(t, s.operationQueue, 0, "all events were processed")
 }
+
+func TestController_EventDeliveries(t *testing.T) {
+	eventHistory := &mocks.EventHistory{}
+	eventHistory.On("IsEnabled").Return(true)
+	receivedAt := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	failed := &sqldb.Event{
+		EventRecord: sqldb.EventRecord{UID: "my-uid", Namespace: "my-ns", Status: sqldb.EventFailed, Environment: `{"payload":{"foo":"bar"}}`, ReceivedAt: receivedAt},
+		Dispatches:  []sqldb.EventDispatchRecord{{BindingNamespace: "my-ns", Binding: "my-wfeb", Matched: true, Error: "failed", DispatchedAt: receivedAt}},
+	}
+	eventHistory.On("ListEvents", "my-ns", true, 10).Return([]sqldb.Event{*failed}, nil)
+	eventHistory.On("GetEvent", "my-uid").Return(failed, nil)
+	eventHistory.On("GetEvent", "not-found").Return(nil, nil)
+	s := NewController(instanceid.NewService("my-instanceid"), hydratorfake.Noop, events.NewEventRecorderManager(fakekube.NewSimpleClientset()), eventHistory, 1, 1)
+	kubeClient := &fakekube.Clientset{}
+	allowed := true
+	kubeClient.AddReactor("create", "selfsubjectaccessreviews", func(action k8stesting.Action) (handled bool, ret runtime.Object, err error) {
+		return true, &authorizationv1.SelfSubjectAccessReview{Status: authorizationv1.SubjectAccessReviewStatus{Allowed: allowed}}, nil
+	})
+	ctx := context.WithValue(context.WithValue(context.TODO(), auth.WfKey, fake.NewSimpleClientset()), auth.KubeKey, kubeClient)
+
+	t.Run("List", func(t *testing.T) {
+		list, err := s.ListEventDeliveries(ctx, &eventpkg.ListEventDeliveriesRequest{Namespace: "my-ns", Undelivered: true, Limit: 10})
+		if assert.NoError(t, err) && assert.Len(t, list.Items, 1) {
+			item := list.Items[0]
+			assert.Equal(t, "my-uid", item.Uid)
+			assert.Equal(t, "Failed", item.Status)
+			assert.Equal(t, receivedAt, item.ReceivedAt.Time)
+			assert.JSONEq(t, `{"foo":"bar"}`, string(item.Payload.Value))
+			if assert.Len(t, item.Dispatches, 1) {
+				assert.Equal(t, &eventpkg.EventDispatch{BindingNamespace: "my-ns", Binding: "my-wfeb", Matched: true, Error: "failed", DispatchedAt: &metav1.Time{Time: receivedAt}}, item.Dispatches[0])
+			}
+		}
+	})
+	t.Run("Get", func(t *testing.T) {
+		item, err := s.GetEventDelivery(ctx, &eventpkg.GetEventDeliveryRequest{Namespace: "my-ns", Uid: "my-uid"})
+		if assert.NoError(t, err) {
+			assert.Equal(t, "my-uid", item.Uid)
+		}
+	})
+	t.Run("GetOtherNamespace", func(t *testing.T) {
+		_, err := s.GetEventDelivery(ctx, &eventpkg.GetEventDeliveryRequest{Namespace: "other-ns", Uid: "my-uid"})
+		assert.Equal(t, codes.NotFound, status.Code(err))
+	})
+	t.Run("GetNotFound", func(t *testing.T) {
+		_, err := s.GetEventDelivery(ctx, &eventpkg.GetEventDeliveryRequest{Namespace: "my-ns", Uid: "not-found"})
+		assert.Equal(t, codes.NotFound, status.Code(err))
+	})
+	t.Run("Retry", func(t *testing.T) {
+		eventHistory.On("UpdateEventStatus", "my-uid", sqldb.EventFailed, sqldb.EventDispatching).Return(true, nil).Once()
+		_, err := s.RetryEventDelivery(ctx, &eventpkg.RetryEventDeliveryRequest{Namespace: "my-ns", Uid: "my-uid"})
+		assert.NoError(t, err)
+		assert.Len(t, s.operationQueue, 1, "one event to be retried")
+	})
+	t.Run("RetryAlreadyDispatching", func(t *testing.T) {
+		eventHistory.On("UpdateEventStatus", "my-uid", sqldb.EventFailed, sqldb.EventDispatching).Return(false, nil).Once()
+		_, err := s.RetryEventDelivery(ctx, &eventpkg.RetryEventDeliveryRequest{Namespace: "my-ns", Uid: "my-uid"})
+		assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+		assert.Len(t, s.operationQueue, 1, "the event is not retried twice")
+	})
+	t.Run("RetryQueueFull", func(t *testing.T) {
+		eventHistory.On("UpdateEventStatus", "my-uid", sqldb.EventFailed, sqldb.EventDispatching).Return(true, nil).Once()
+		eventHistory.On("UpdateEventStatus", "my-uid", sqldb.EventDispatching, sqldb.EventFailed).Return(true, nil).Once()
+		_, err := s.RetryEventDelivery(ctx, &eventpkg.RetryEventDeliveryRequest{Namespace: "my-ns", Uid: "my-uid"})
+		assert.EqualError(t, err, "operation queue full")
+		eventHistory.AssertCalled(t, "UpdateEventStatus", "my-uid", sqldb.EventDispatching, sqldb.EventFailed)
+	})
+	t.Run("PermissionDenied", func(t *testing.T) {
+		allowed = false
+		defer func() { allowed = true }()
+		_, err := s.ListEventDeliveries(ctx, &eventpkg.ListEventDeliveriesRequest{Namespace: "my-ns"})
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	})
+	t.Run("Disabled", func(t *testing.T) {
+		s := NewController(instanceid.NewService("my-instanceid"), hydratorfake.Noop, events.NewEventRecorderManager(fakekube.NewSimpleClientset()), sqldb.NullEventHistory, 1, 1)
+		_, err := s.ListEventDeliveries(ctx, &eventpkg.ListEventDeliveriesRequest{Namespace: "my-ns"})
+		assert.Equal(t, codes.Unimplemented, status.Code(err))
+	})
+}
+
+func TestController_RunEventHistoryGC(t *testing.T) {
+	_ = os.Setenv("EVENT_HISTORY_GC_PERIOD", "10ms")
+	defer func() { _ = os.Unsetenv("EVENT_HISTORY_GC_PERIOD") }()
+	eventHistory := &mocks.EventHistory{}
+	eventHistory.On("IsEnabled").Return(true)
+	deleted := make(chan time.Duration, 1)
+	eventHistory.On("DeleteExpiredEvents", mock.Anything).Run(func(args mock.Arguments) {
+		select {
+		case deleted <- args.Get(0).(time.Duration):
+		default:
+		}
+	}).Return(nil)
+	s := NewController(instanceid.NewService("my-instanceid"), hydratorfake.Noop, events.NewEventRecorderManager(fakekube.NewSimpleClientset()), eventHistory, 1, 1)
+
+	stopCh := make(chan struct{})
+	go s.RunEventHistoryGC(stopCh, time.Hour)
+	select {
+	case ttl := <-deleted:
+		assert.Equal(t, time.Hour, ttl)
+	case <-time.After(time.Second):
+		t.Error("expired events were not deleted")
+	}
+	close(stopCh)
+}
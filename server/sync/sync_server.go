@@ -0,0 +1,163 @@
+package sync
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	gosync "sync"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	corev1 "k8s.io/client-go/kubernetes/typed/core/v1"
+	"k8s.io/client-go/rest"
+
+	syncpkg "github.com/argoproj/argo/v2/pkg/apiclient/sync"
+	"github.com/argoproj/argo/v2/pkg/apis/workflow"
+	"github.com/argoproj/argo/v2/server/auth"
+	"github.com/argoproj/argo/v2/workflow/sync"
+)
+
+type syncServer struct {
+	// the URL of the workflow controller's locks
+	url string
+	// the name the certificate of the workflow controller's locks server is for
+	serverName string
+	restConfig *rest.Config
+	// the secrets of the namespace of the workflow controller
+	secrets corev1.SecretInterface
+	// the client of the workflow controller, which authenticates as the Argo Server, created on first use as the
+	// workflow controller may not have created its certificate yet when the Argo Server starts
+	clientLock gosync.Mutex
+	client     *http.Client
+}
+
+// NewSyncServer returns a server that reads the locks of the workflow controller in the namespace over TLS,
+// authenticating as the Argo Server using the rest config
+func NewSyncServer(namespace string, restConfig *rest.Config, secrets corev1.SecretInterface) syncpkg.SyncServiceServer {
+	return &syncServer{
+		url:        fmt.Sprintf("https://%s%s", net.JoinHostPort(sync.LocksServerName(namespace), strconv.Itoa(sync.LocksServerPort)), sync.LocksPath),
+		serverName: sync.LocksServerName(namespace),
+		restConfig: restConfig,
+		secrets:    secrets,
+	}
+}
+
+// getClient returns a client that only trusts the certificate of the workflow controller's locks server, so that the
+// Argo Server's credentials are never sent to anything else
+func (s *syncServer) getClient(ctx context.Context) (*http.Client, error) {
+	s.clientLock.Lock()
+	defer s.clientLock.Unlock()
+	if s.client == nil {
+		pool, err := sync.GetLocksCertPool(ctx, s.secrets)
+		if err != nil {
+			return nil, err
+		}
+		transport, err := rest.HTTPWrappersForConfig(s.restConfig, &http.Transport{
+			TLSClientConfig: &tls.Config{RootCAs: pool, ServerName: s.serverName, MinVersion: tls.VersionTLS12},
+		})
+		if err != nil {
+			return nil, err
+		}
+		s.client = &http.Client{Transport: transport}
+	}
+	return s.client, nil
+}
+
+func (s *syncServer) getSyncLocks(ctx context.Context) (*syncpkg.SyncLockList, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, s.url, nil)
+	if err != nil {
+		return nil, err
+	}
+	client, err := s.getClient(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get the locks from the workflow controller: %w", err)
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get the locks from the workflow controller: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+	data, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get the locks from the workflow controller: %w", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to get the locks from the workflow controller: %s: %s", resp.Status, strings.TrimSpace(string(data)))
+	}
+	list := &syncpkg.SyncLockList{}
+	return list, json.Unmarshal(data, list)
+}
+
+func (s *syncServer) ListSyncLocks(ctx context.Context, req *syncpkg.ListSyncLocksRequest) (*syncpkg.SyncLockList, error) {
+	list, err := s.getSyncLocks(ctx)
+	if err != nil {
+		return nil, err
+	}
+	// a lock and its holders are in the namespace of its name, and the locks are only listed in the namespaces where
+	// the caller can list workflows
+	allowed := make(map[string]bool)
+	items := []*syncpkg.SyncLock{}
+	for _, item := range list.Items {
+		namespace := strings.SplitN(item.Name, "/", 2)[0]
+		if req.Namespace != "" && namespace != req.Namespace {
+			continue
+		}
+		if _, ok := allowed[namespace]; !ok {
+			allowed[namespace], err = auth.CanI(ctx, "list", workflow.WorkflowPlural, namespace, "")
+			if err != nil {
+				return nil, err
+			}
+		}
+		if allowed[namespace] {
+			items = append(items, item)
+		}
+	}
+	return &syncpkg.SyncLockList{Items: items}, nil
+}
+
+func (s *syncServer) GetSyncLock(ctx context.Context, req *syncpkg.GetSyncLockRequest) (*syncpkg.SyncLock, error) {
+	lockName, err := getLockName(req)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	allowed, err := auth.CanI(ctx, "list", workflow.WorkflowPlural, req.Namespace, "")
+	if err != nil {
+		return nil, err
+	}
+	if !allowed {
+		return nil, status.Error(codes.PermissionDenied, "permission denied")
+	}
+	list, err := s.getSyncLocks(ctx)
+	if err != nil {
+		return nil, err
+	}
+	name := lockName.EncodeName()
+	for _, item := range list.Items {
+		if item.Name == name {
+			return item, nil
+		}
+	}
+	return nil, status.Error(codes.NotFound, "not found")
+}
+
+func getLockName(req *syncpkg.GetSyncLockRequest) (*sync.LockName, error) {
+	key := req.Key
+	switch sync.LockKind(req.Kind) {
+	case sync.LockKindConfigMap:
+	case sync.LockKindDatabase, sync.LockKindMutex:
+		key = ""
+	default:
+		return nil, fmt.Errorf("unknown lock kind \"%s\", must be one of %s, %s or %s", req.Kind, sync.LockKindConfigMap, sync.LockKindDatabase, sync.LockKindMutex)
+	}
+	if strings.Contains(req.Name, "/") || strings.Contains(key, "/") {
+		return nil, fmt.Errorf("lock name and key must not contain \"/\"")
+	}
+	lockName := sync.NewLockName(req.Namespace, req.Name, key, sync.LockKind(req.Kind))
+	return lockName, lockName.Validate()
+}
@@ -0,0 +1,114 @@
+package sync
+
+import (
+	"context"
+	"crypto/tls"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	fakekube "k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/rest"
+	k8stesting "k8s.io/client-go/testing"
+
+	syncpkg "github.com/argoproj/argo/v2/pkg/apiclient/sync"
+	"github.com/argoproj/argo/v2/server/auth"
+	"github.com/argoproj/argo/v2/workflow/sync"
+)
+
+func TestSyncServer(t *testing.T) {
+	kubeClient := fakekube.NewSimpleClientset()
+	secrets := kubeClient.CoreV1().Secrets("argo")
+	server := NewSyncServer("argo", &rest.Config{BearerToken: "my-token"}, secrets)
+	s := server.(*syncServer)
+	assert.Equal(t, "https://workflow-controller-sync.argo.svc:9093/sync/locks", s.url)
+	_, err := s.ListSyncLocks(context.TODO(), &syncpkg.ListSyncLocksRequest{})
+	assert.Error(t, err, "the controller has not created its certificate yet")
+
+	cert, err := sync.GetOrCreateLocksCertificate(context.TODO(), secrets, "argo")
+	if !assert.NoError(t, err) {
+		return
+	}
+	controller := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/sync/locks", r.URL.Path)
+		if r.Header.Get("Authorization") != "Bearer my-token" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		_, _ = w.Write([]byte(`{"items":[
+{"name":"my-ns/ConfigMap/my-config/my-key","type":"Semaphore","limit":2,"holders":["my-ns/my-wf"],"waiters":[{"holderKey":"my-ns/my-other-wf","priority":1,"creationTimestamp":"2020-01-01T00:00:00Z"}]},
+{"name":"my-ns/Mutex/my-mutex","type":"Mutex","limit":1},
+{"name":"other-ns/Mutex/my-mutex","type":"Mutex","limit":1},
+{"name":"forbidden-ns/Mutex/my-mutex","type":"Mutex","limit":1}
+]}`))
+	}))
+	controller.TLS = &tls.Config{Certificates: []tls.Certificate{cert}}
+	controller.StartTLS()
+	defer controller.Close()
+	s.url = controller.URL + "/sync/locks"
+
+	kubeClient.PrependReactor("create", "selfsubjectaccessreviews", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		review := action.(k8stesting.CreateAction).GetObject().(*authorizationv1.SelfSubjectAccessReview)
+		attributes := review.Spec.ResourceAttributes
+		review.Status.Allowed = attributes.Verb == "list" && attributes.Resource == "workflows" && attributes.Namespace != "forbidden-ns"
+		return true, review, nil
+	})
+	ctx := context.WithValue(context.TODO(), auth.KubeKey, kubeClient)
+
+	t.Run("UntrustedCertificate", func(t *testing.T) {
+		untrusted := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Fail(t, "the request must not be sent to a server whose certificate is not trusted")
+		}))
+		defer untrusted.Close()
+		s := NewSyncServer("argo", &rest.Config{BearerToken: "my-token"}, secrets).(*syncServer)
+		s.url = untrusted.URL + "/sync/locks"
+		_, err := s.ListSyncLocks(ctx, &syncpkg.ListSyncLocksRequest{})
+		assert.Error(t, err)
+	})
+	t.Run("ListSyncLocks", func(t *testing.T) {
+		list, err := s.ListSyncLocks(ctx, &syncpkg.ListSyncLocksRequest{Namespace: "my-ns"})
+		if assert.NoError(t, err) && assert.Len(t, list.Items, 2) {
+			lock := list.Items[0]
+			assert.Equal(t, "my-ns/ConfigMap/my-config/my-key", lock.Name)
+			assert.Equal(t, "Semaphore", lock.Type)
+			assert.Equal(t, int32(2), lock.Limit)
+			assert.Equal(t, []string{"my-ns/my-wf"}, lock.Holders)
+			if assert.Len(t, lock.Waiters, 1) {
+				assert.Equal(t, "my-ns/my-other-wf", lock.Waiters[0].HolderKey)
+				assert.Equal(t, int32(1), lock.Waiters[0].Priority)
+				assert.Equal(t, 2020, lock.Waiters[0].CreationTimestamp.Year())
+			}
+		}
+		list, err = s.ListSyncLocks(ctx, &syncpkg.ListSyncLocksRequest{})
+		if assert.NoError(t, err) {
+			assert.Len(t, list.Items, 3, "the locks of the namespaces where workflows cannot be listed are not listed")
+		}
+		list, err = s.ListSyncLocks(ctx, &syncpkg.ListSyncLocksRequest{Namespace: "forbidden-ns"})
+		if assert.NoError(t, err) {
+			assert.Empty(t, list.Items)
+		}
+	})
+	t.Run("GetSyncLock", func(t *testing.T) {
+		lock, err := s.GetSyncLock(ctx, &syncpkg.GetSyncLockRequest{Namespace: "other-ns", Kind: "Mutex", Name: "my-mutex"})
+		if assert.NoError(t, err) {
+			assert.Equal(t, "other-ns/Mutex/my-mutex", lock.Name)
+		}
+		lock, err = s.GetSyncLock(ctx, &syncpkg.GetSyncLockRequest{Namespace: "my-ns", Kind: "ConfigMap", Name: "my-config", Key: "my-key"})
+		if assert.NoError(t, err) {
+			assert.Equal(t, "my-ns/ConfigMap/my-config/my-key", lock.Name)
+		}
+		_, err = s.GetSyncLock(ctx, &syncpkg.GetSyncLockRequest{Namespace: "my-ns", Kind: "Mutex", Name: "not-found"})
+		assert.Equal(t, codes.NotFound, status.Code(err))
+		_, err = s.GetSyncLock(ctx, &syncpkg.GetSyncLockRequest{Namespace: "my-ns", Kind: "ConfigMap", Name: "my-config"})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err), "config map semaphores need a key")
+		_, err = s.GetSyncLock(ctx, &syncpkg.GetSyncLockRequest{Namespace: "my-ns", Kind: "Foo", Name: "my-mutex"})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		_, err = s.GetSyncLock(ctx, &syncpkg.GetSyncLockRequest{Namespace: "forbidden-ns", Kind: "Mutex", Name: "my-mutex"})
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	})
+}
@@ -8,6 +8,7 @@ import (
 	"strings"
 	"time"
 
+	argotime "github.com/argoproj/pkg/time"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -18,15 +19,18 @@ import (
 	"github.com/argoproj/argo/v2/pkg/apis/workflow"
 	wfv1 "github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1"
 	"github.com/argoproj/argo/v2/server/auth"
+	"github.com/argoproj/argo/v2/workflow/hydrator"
+	"github.com/argoproj/argo/v2/workflow/util"
 )
 
 type archivedWorkflowServer struct {
 	wfArchive sqldb.WorkflowArchive
+	hydrator  hydrator.Interface
 }
 
 // NewWorkflowArchiveServer returns a new archivedWorkflowServer
-func NewWorkflowArchiveServer(wfArchive sqldb.WorkflowArchive) workflowarchivepkg.ArchivedWorkflowServiceServer {
-	return &archivedWorkflowServer{wfArchive: wfArchive}
+func NewWorkflowArchiveServer(wfArchive sqldb.WorkflowArchive, offloadNodeStatusRepo sqldb.OffloadNodeStatusRepo) workflowarchivepkg.ArchivedWorkflowServiceServer {
+	return &archivedWorkflowServer{wfArchive: wfArchive, hydrator: hydrator.New(offloadNodeStatusRepo)}
 }
 
 func (w *archivedWorkflowServer) ListArchivedWorkflows(ctx context.Context, req *workflowarchivepkg.ListArchivedWorkflowsRequest) (*wfv1.WorkflowList, error) {
@@ -47,35 +51,21 @@ func (w *archivedWorkflowServer) ListArchivedWorkflows(ctx context.Context, req
 		return nil, status.Error(codes.InvalidArgument, "listOptions.continue must >= 0")
 	}
 
-	namespace := ""
-	minStartedAt := time.Time{}
-	maxStartedAt := time.Time{}
-	for _, selector := range strings.Split(options.FieldSelector, ",") {
-		if len(selector) == 0 {
-			continue
-		}
-		if strings.HasPrefix(selector, "metadata.namespace=") {
-			namespace = strings.TrimPrefix(selector, "metadata.namespace=")
-		} else if strings.HasPrefix(selector, "spec.startedAt>") {
-			minStartedAt, err = time.Parse(time.RFC3339, strings.TrimPrefix(selector, "spec.startedAt>"))
-			if err != nil {
-				return nil, err
-			}
-		} else if strings.HasPrefix(selector, "spec.startedAt<") {
-			maxStartedAt, err = time.Parse(time.RFC3339, strings.TrimPrefix(selector, "spec.startedAt<"))
-			if err != nil {
-				return nil, err
-			}
-		} else {
-			return nil, fmt.Errorf("unsupported requirement %s", selector)
-		}
+	listOptions := sqldb.ListOptions{
+		NamePrefix:           req.NamePrefix,
+		NamePattern:          req.NamePattern,
+		WorkflowTemplateName: req.WorkflowTemplateRef,
+		SortBy:               req.SortBy,
 	}
-	requirements, err := labels.ParseToRequirements(options.LabelSelector)
+	for _, phase := range req.Phases {
+		listOptions.Phases = append(listOptions.Phases, wfv1.WorkflowPhase(phase))
+	}
+	err = parseSelectors(options, &listOptions)
 	if err != nil {
 		return nil, err
 	}
 
-	allowed, err := auth.CanI(ctx, "list", workflow.WorkflowPlural, namespace, "")
+	allowed, err := auth.CanI(ctx, "list", workflow.WorkflowPlural, listOptions.Namespace, "")
 	if err != nil {
 		return nil, err
 	}
@@ -94,7 +84,9 @@ func (w *archivedWorkflowServer) ListArchivedWorkflows(ctx context.Context, req
 		limitWithMore = limit + 1
 	}
 
-	items, err := w.wfArchive.ListWorkflows(namespace, minStartedAt, maxStartedAt, requirements, limitWithMore, offset)
+	listOptions.Limit = limitWithMore
+	listOptions.Offset = offset
+	items, err := w.wfArchive.ListWorkflows(listOptions)
 
 	if err != nil {
 		return nil, err
@@ -107,7 +99,20 @@ func (w *archivedWorkflowServer) ListArchivedWorkflows(ctx context.Context, req
 		meta.Continue = fmt.Sprintf("%v", offset+limit)
 	}
 
-	sort.Sort(items)
+	// the total count is offset + len(items) + remainingItemCount, whether or not the list is paginated
+	total, err := w.wfArchive.CountWorkflows(listOptions)
+	if err != nil {
+		return nil, err
+	}
+	remaining := total - int64(offset+len(items))
+	if remaining < 0 {
+		remaining = 0
+	}
+	meta.RemainingItemCount = &remaining
+
+	if req.SortBy == "" {
+		sort.Sort(items)
+	}
 	return &wfv1.WorkflowList{ListMeta: meta, Items: items}, nil
 }
 
@@ -129,6 +134,63 @@ func (w *archivedWorkflowServer) GetArchivedWorkflow(ctx context.Context, req *w
 	return wf, err
 }
 
+func (w *archivedWorkflowServer) GetArchivedWorkflowStats(ctx context.Context, req *workflowarchivepkg.GetArchivedWorkflowStatsRequest) (*workflowarchivepkg.ArchivedWorkflowStats, error) {
+	options := req.ListOptions
+	if options == nil {
+		options = &metav1.ListOptions{}
+	}
+	statsOptions := sqldb.StatsOptions{GroupBy: req.GroupBy}
+	err := parseSelectors(options, &statsOptions.ListOptions)
+	if err != nil {
+		return nil, err
+	}
+	if req.Bucket != "" {
+		bucket, err := argotime.ParseDuration(req.Bucket)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid bucket %q: %v", req.Bucket, err)
+		}
+		statsOptions.Bucket = *bucket
+	}
+
+	allowed, err := auth.CanI(ctx, "list", workflow.WorkflowPlural, statsOptions.Namespace, "")
+	if err != nil {
+		return nil, err
+	}
+	if !allowed {
+		return nil, status.Error(codes.PermissionDenied, "permission denied")
+	}
+
+	stats, err := w.wfArchive.GetWorkflowStats(statsOptions)
+	if err != nil {
+		return nil, err
+	}
+	resp := &workflowarchivepkg.ArchivedWorkflowStats{}
+	for _, g := range stats.Groups {
+		group := &workflowarchivepkg.ArchivedWorkflowStatsGroup{
+			Key:         g.Key,
+			Total:       g.Total(),
+			PhaseCounts: map[string]int64{},
+			SuccessRate: g.SuccessRate(),
+			DurationP50: g.DurationP50.Seconds(),
+			DurationP90: g.DurationP90.Seconds(),
+			DurationP99: g.DurationP99.Seconds(),
+		}
+		for phase, n := range g.PhaseCounts {
+			group.PhaseCounts[string(phase)] = n
+		}
+		for _, b := range g.Buckets {
+			group.Buckets = append(group.Buckets, &workflowarchivepkg.ArchivedWorkflowStatsBucket{
+				StartedAt:   &metav1.Time{Time: b.StartedAt},
+				Total:       b.Total,
+				Succeeded:   b.Succeeded,
+				SuccessRate: b.SuccessRate(),
+			})
+		}
+		resp.Groups = append(resp.Groups, group)
+	}
+	return resp, nil
+}
+
 func (w *archivedWorkflowServer) DeleteArchivedWorkflow(ctx context.Context, req *workflowarchivepkg.DeleteArchivedWorkflowRequest) (*workflowarchivepkg.ArchivedWorkflowDeletedResponse, error) {
 	wf, err := w.GetArchivedWorkflow(ctx, &workflowarchivepkg.GetArchivedWorkflowRequest{Uid: req.Uid})
 	if err != nil {
@@ -147,3 +209,76 @@ func (w *archivedWorkflowServer) DeleteArchivedWorkflow(ctx context.Context, req
 	}
 	return &workflowarchivepkg.ArchivedWorkflowDeletedResponse{}, nil
 }
+
+func (w *archivedWorkflowServer) ResubmitArchivedWorkflow(ctx context.Context, req *workflowarchivepkg.ResubmitArchivedWorkflowRequest) (*wfv1.Workflow, error) {
+	wfClient := auth.GetWfClient(ctx)
+	wf, err := w.GetArchivedWorkflow(ctx, &workflowarchivepkg.GetArchivedWorkflowRequest{Uid: req.Uid})
+	if err != nil {
+		return nil, err
+	}
+	newWF, err := util.FormulateResubmitWorkflow(wf, req.Memoized)
+	if err != nil {
+		return nil, err
+	}
+	created, err := util.SubmitWorkflow(ctx, wfClient.ArgoprojV1alpha1().Workflows(wf.Namespace), wfClient, wf.Namespace, newWF, &wfv1.SubmitOpts{})
+	if err != nil {
+		return nil, err
+	}
+	return created, nil
+}
+
+func (w *archivedWorkflowServer) RetryArchivedWorkflow(ctx context.Context, req *workflowarchivepkg.RetryArchivedWorkflowRequest) (*wfv1.Workflow, error) {
+	wfClient := auth.GetWfClient(ctx)
+	kubeClient := auth.GetKubeClient(ctx)
+	wf, err := w.GetArchivedWorkflow(ctx, &workflowarchivepkg.GetArchivedWorkflowRequest{Uid: req.Uid})
+	if err != nil {
+		return nil, err
+	}
+	wf, err = util.RetryArchivedWorkflow(ctx, kubeClient, w.hydrator, wfClient.ArgoprojV1alpha1().Workflows(wf.Namespace), wf, req.RestartSuccessful, req.NodeFieldSelector)
+	if err != nil {
+		return nil, err
+	}
+	return wf, nil
+}
+
+// parseSelectors sets the namespace, time range and label requirements of the list options from the field and label selectors
+func parseSelectors(options *metav1.ListOptions, listOptions *sqldb.ListOptions) error {
+	var err error
+	for _, selector := range strings.Split(options.FieldSelector, ",") {
+		if len(selector) == 0 {
+			continue
+		}
+		if strings.HasPrefix(selector, "metadata.namespace=") {
+			listOptions.Namespace = strings.TrimPrefix(selector, "metadata.namespace=")
+		} else if strings.HasPrefix(selector, "spec.startedAt>") {
+			listOptions.MinStartedAt, err = time.Parse(time.RFC3339, strings.TrimPrefix(selector, "spec.startedAt>"))
+			if err != nil {
+				return err
+			}
+		} else if strings.HasPrefix(selector, "spec.startedAt<") {
+			listOptions.MaxStartedAt, err = time.Parse(time.RFC3339, strings.TrimPrefix(selector, "spec.startedAt<"))
+			if err != nil {
+				return err
+			}
+		} else if strings.HasPrefix(selector, "spec.finishedAt>") {
+			listOptions.MinFinishedAt, err = time.Parse(time.RFC3339, strings.TrimPrefix(selector, "spec.finishedAt>"))
+			if err != nil {
+				return err
+			}
+		} else if strings.HasPrefix(selector, "spec.finishedAt<") {
+			listOptions.MaxFinishedAt, err = time.Parse(time.RFC3339, strings.TrimPrefix(selector, "spec.finishedAt<"))
+			if err != nil {
+				return err
+			}
+		} else {
+			return fmt.Errorf("unsupported requirement %s", selector)
+		}
+	}
+	requirements, err := labels.ParseToRequirements(options.LabelSelector)
+	if err != nil {
+		return err
+	}
+
+	listOptions.LabelRequirements = requirements
+	return nil
+}
@@ -10,12 +10,14 @@ import (
 	"google.golang.org/grpc/status"
 	authorizationv1 "k8s.io/api/authorization/v1"
 	apiv1 "k8s.io/api/core/v1"
+	apierr "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
 	kubefake "k8s.io/client-go/kubernetes/fake"
 	k8stesting "k8s.io/client-go/testing"
 
+	"github.com/argoproj/argo/v2/persist/sqldb"
 	"github.com/argoproj/argo/v2/persist/sqldb/mocks"
 	workflowarchivepkg "github.com/argoproj/argo/v2/pkg/apiclient/workflowarchive"
 	wfv1 "github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1"
@@ -27,7 +29,7 @@ func Test_archivedWorkflowServer(t *testing.T) {
 	repo := &mocks.WorkflowArchive{}
 	kubeClient := &kubefake.Clientset{}
 	wfClient := &argofake.Clientset{}
-	w := NewWorkflowArchiveServer(repo)
+	w := NewWorkflowArchiveServer(repo, sqldb.ExplosiveOffloadNodeStatusRepo)
 	allowed := true
 	kubeClient.AddReactor("create", "selfsubjectaccessreviews", func(action k8stesting.Action) (handled bool, ret runtime.Object, err error) {
 		return true, &authorizationv1.SelfSubjectAccessReview{
@@ -46,21 +48,77 @@ func Test_archivedWorkflowServer(t *testing.T) {
 		}, nil
 	})
 	// two pages of results for limit 1
-	repo.On("ListWorkflows", "", time.Time{}, time.Time{}, labels.Requirements(nil), 2, 0).Return(wfv1.Workflows{{}, {}}, nil)
-	repo.On("ListWorkflows", "", time.Time{}, time.Time{}, labels.Requirements(nil), 2, 1).Return(wfv1.Workflows{{}}, nil)
+	repo.On("ListWorkflows", sqldb.ListOptions{LabelRequirements: labels.Requirements(nil), Limit: 2, Offset: 0}).Return(wfv1.Workflows{{}, {}}, nil)
+	repo.On("ListWorkflows", sqldb.ListOptions{LabelRequirements: labels.Requirements(nil), Limit: 2, Offset: 1}).Return(wfv1.Workflows{{}}, nil)
+	repo.On("CountWorkflows", sqldb.ListOptions{LabelRequirements: labels.Requirements(nil), Limit: 2, Offset: 0}).Return(int64(2), nil)
+	repo.On("CountWorkflows", sqldb.ListOptions{LabelRequirements: labels.Requirements(nil), Limit: 2, Offset: 1}).Return(int64(2), nil)
 	minStartAt, _ := time.Parse(time.RFC3339, "2020-01-01T00:00:00Z")
 	maxStartAt, _ := time.Parse(time.RFC3339, "2020-01-02T00:00:00Z")
-	repo.On("ListWorkflows", "", minStartAt, maxStartAt, labels.Requirements(nil), 2, 0).Return(wfv1.Workflows{{}}, nil)
+	repo.On("ListWorkflows", sqldb.ListOptions{MinStartedAt: minStartAt, MaxStartedAt: maxStartAt, LabelRequirements: labels.Requirements(nil), Limit: 2, Offset: 0}).Return(wfv1.Workflows{{}}, nil)
+	repo.On("CountWorkflows", sqldb.ListOptions{MinStartedAt: minStartAt, MaxStartedAt: maxStartAt, LabelRequirements: labels.Requirements(nil), Limit: 2, Offset: 0}).Return(int64(1), nil)
+	filtered := sqldb.ListOptions{
+		Namespace:            "my-ns",
+		NamePrefix:           "nightly-",
+		NamePattern:          "*-build",
+		Phases:               []wfv1.WorkflowPhase{wfv1.WorkflowFailed, wfv1.WorkflowError},
+		MinFinishedAt:        minStartAt,
+		MaxFinishedAt:        maxStartAt,
+		WorkflowTemplateName: "my-wftmpl",
+		LabelRequirements:    labels.Requirements(nil),
+		SortBy:               "finishedAt",
+	}
+	repo.On("ListWorkflows", filtered).Return(wfv1.Workflows{{ObjectMeta: metav1.ObjectMeta{Name: "nightly-a-build"}}, {ObjectMeta: metav1.ObjectMeta{Name: "nightly-b-build"}}}, nil)
+	repo.On("CountWorkflows", filtered).Return(int64(2), nil)
+	repo.On("GetWorkflowStats", sqldb.StatsOptions{
+		ListOptions: sqldb.ListOptions{Namespace: "my-ns", MinStartedAt: minStartAt, LabelRequirements: labels.Requirements(nil)},
+		GroupBy:     "workflowTemplate",
+		Bucket:      24 * time.Hour,
+	}).Return(&sqldb.WorkflowStats{Groups: []sqldb.WorkflowStatsGroup{{
+		Key:         "my-wftmpl",
+		PhaseCounts: map[wfv1.WorkflowPhase]int64{wfv1.WorkflowSucceeded: 3, wfv1.WorkflowFailed: 1},
+		DurationP50: 10 * time.Second,
+		DurationP90: 30 * time.Second,
+		DurationP99: time.Minute,
+		Buckets:     []sqldb.WorkflowStatsBucket{{StartedAt: minStartAt, Total: 4, Succeeded: 3}},
+	}}}, nil)
 	repo.On("GetWorkflow", "").Return(nil, nil)
 	repo.On("GetWorkflow", "my-uid").Return(&wfv1.Workflow{
 		ObjectMeta: metav1.ObjectMeta{Name: "my-name"},
 		Spec: wfv1.WorkflowSpec{
 			Entrypoint: "my-entrypoint",
 			Templates: []wfv1.Template{
-				{Name: "my-entrypoint", Container: &apiv1.Container{}},
+				{Name: "my-entrypoint", Container: &apiv1.Container{Image: "docker/whalesay:latest"}},
 			},
 		},
 	}, nil)
+	repo.On("GetWorkflow", "failed-uid").Return(&wfv1.Workflow{
+		ObjectMeta: metav1.ObjectMeta{Name: "failed", UID: "failed-uid", ResourceVersion: "1", Labels: map[string]string{"workflows.argoproj.io/workflow-archiving-status": "Archived"}},
+		Spec: wfv1.WorkflowSpec{
+			Entrypoint: "my-entrypoint",
+			Templates: []wfv1.Template{
+				{Name: "my-entrypoint", Container: &apiv1.Container{Image: "docker/whalesay:latest"}},
+			},
+		},
+		Status: wfv1.WorkflowStatus{
+			Phase: wfv1.WorkflowFailed,
+			Nodes: wfv1.Nodes{
+				"failed": {ID: "failed", Name: "failed", Type: wfv1.NodeTypePod, Phase: wfv1.NodeFailed},
+			},
+		},
+	}, nil)
+	wfClient.AddReactor("get", "workflows", func(action k8stesting.Action) (handled bool, ret runtime.Object, err error) {
+		// the archived workflows no longer exist
+		return true, nil, apierr.NewNotFound(wfv1.Resource("workflows"), action.(k8stesting.GetAction).GetName())
+	})
+	var created *wfv1.Workflow
+	wfClient.AddReactor("create", "workflows", func(action k8stesting.Action) (handled bool, ret runtime.Object, err error) {
+		wf := action.(k8stesting.CreateAction).GetObject().(*wfv1.Workflow)
+		if wf.Name != "failed" {
+			return false, nil, nil
+		}
+		created = wf
+		return true, wf, nil
+	})
 	wfClient.AddReactor("create", "workflows", func(action k8stesting.Action) (handled bool, ret runtime.Object, err error) {
 		return true, &wfv1.Workflow{
 			ObjectMeta: metav1.ObjectMeta{Name: "my-name-resubmitted"},
@@ -79,10 +137,16 @@ func Test_archivedWorkflowServer(t *testing.T) {
 			assert.Len(t, resp.Items, 1)
 			assert.Equal(t, "1", resp.Continue)
 		}
+		if assert.NotNil(t, resp.RemainingItemCount) {
+			assert.Equal(t, int64(1), *resp.RemainingItemCount)
+		}
 		resp, err = w.ListArchivedWorkflows(ctx, &workflowarchivepkg.ListArchivedWorkflowsRequest{ListOptions: &metav1.ListOptions{Continue: "1", Limit: 1}})
 		if assert.NoError(t, err) {
 			assert.Len(t, resp.Items, 1)
 			assert.Empty(t, resp.Continue)
+			if assert.NotNil(t, resp.RemainingItemCount) {
+				assert.Zero(t, *resp.RemainingItemCount)
+			}
 		}
 		resp, err = w.ListArchivedWorkflows(ctx, &workflowarchivepkg.ListArchivedWorkflowsRequest{ListOptions: &metav1.ListOptions{FieldSelector: "spec.startedAt>2020-01-01T00:00:00Z,spec.startedAt<2020-01-02T00:00:00Z", Limit: 1}})
 		if assert.NoError(t, err) {
@@ -90,6 +154,54 @@ func Test_archivedWorkflowServer(t *testing.T) {
 			assert.Empty(t, resp.Continue)
 		}
 	})
+	t.Run("ListArchivedWorkflowsWithFilters", func(t *testing.T) {
+		resp, err := w.ListArchivedWorkflows(ctx, &workflowarchivepkg.ListArchivedWorkflowsRequest{
+			ListOptions:         &metav1.ListOptions{FieldSelector: "metadata.namespace=my-ns,spec.finishedAt>2020-01-01T00:00:00Z,spec.finishedAt<2020-01-02T00:00:00Z"},
+			NamePrefix:          "nightly-",
+			NamePattern:         "*-build",
+			Phases:              []string{"Failed", "Error"},
+			WorkflowTemplateRef: "my-wftmpl",
+			SortBy:              "finishedAt",
+		})
+		if assert.NoError(t, err) {
+			// the order of the archive is kept
+			assert.Equal(t, "nightly-a-build", resp.Items[0].Name)
+			assert.Equal(t, "nightly-b-build", resp.Items[1].Name)
+			if assert.NotNil(t, resp.RemainingItemCount) {
+				assert.Zero(t, *resp.RemainingItemCount, "the total count is returned when the list is not paginated")
+			}
+		}
+		_, err = w.ListArchivedWorkflows(ctx, &workflowarchivepkg.ListArchivedWorkflowsRequest{ListOptions: &metav1.ListOptions{FieldSelector: "spec.finishedAt>yesterday"}})
+		assert.Error(t, err)
+	})
+	t.Run("GetArchivedWorkflowStats", func(t *testing.T) {
+		req := &workflowarchivepkg.GetArchivedWorkflowStatsRequest{
+			ListOptions: &metav1.ListOptions{FieldSelector: "metadata.namespace=my-ns,spec.startedAt>2020-01-01T00:00:00Z"},
+			GroupBy:     "workflowTemplate",
+			Bucket:      "1d",
+		}
+		allowed = false
+		_, err := w.GetArchivedWorkflowStats(ctx, req)
+		assert.Equal(t, err, status.Error(codes.PermissionDenied, "permission denied"))
+		allowed = true
+		stats, err := w.GetArchivedWorkflowStats(ctx, req)
+		if assert.NoError(t, err) && assert.Len(t, stats.Groups, 1) {
+			group := stats.Groups[0]
+			assert.Equal(t, "my-wftmpl", group.Key)
+			assert.Equal(t, int64(4), group.Total)
+			assert.Equal(t, map[string]int64{"Succeeded": 3, "Failed": 1}, group.PhaseCounts)
+			assert.Equal(t, 0.75, group.SuccessRate)
+			assert.Equal(t, 10.0, group.DurationP50)
+			assert.Equal(t, 30.0, group.DurationP90)
+			assert.Equal(t, 60.0, group.DurationP99)
+			if assert.Len(t, group.Buckets, 1) {
+				assert.Equal(t, minStartAt, group.Buckets[0].StartedAt.Time)
+				assert.Equal(t, 0.75, group.Buckets[0].SuccessRate)
+			}
+		}
+		_, err = w.GetArchivedWorkflowStats(ctx, &workflowarchivepkg.GetArchivedWorkflowStatsRequest{Bucket: "often"})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
 	t.Run("GetArchivedWorkflow", func(t *testing.T) {
 		allowed = false
 		_, err := w.GetArchivedWorkflow(ctx, &workflowarchivepkg.GetArchivedWorkflowRequest{Uid: "my-uid"})
@@ -109,4 +221,31 @@ func Test_archivedWorkflowServer(t *testing.T) {
 		_, err = w.DeleteArchivedWorkflow(ctx, &workflowarchivepkg.DeleteArchivedWorkflowRequest{Uid: "my-uid"})
 		assert.NoError(t, err)
 	})
+	t.Run("ResubmitArchivedWorkflow", func(t *testing.T) {
+		allowed = false
+		_, err := w.ResubmitArchivedWorkflow(ctx, &workflowarchivepkg.ResubmitArchivedWorkflowRequest{Uid: "my-uid"})
+		assert.Equal(t, err, status.Error(codes.PermissionDenied, "permission denied"))
+		allowed = true
+		wf, err := w.ResubmitArchivedWorkflow(ctx, &workflowarchivepkg.ResubmitArchivedWorkflowRequest{Uid: "my-uid"})
+		if assert.NoError(t, err) {
+			assert.Equal(t, "my-name-resubmitted", wf.Name)
+		}
+	})
+	t.Run("RetryArchivedWorkflow", func(t *testing.T) {
+		allowed = false
+		_, err := w.RetryArchivedWorkflow(ctx, &workflowarchivepkg.RetryArchivedWorkflowRequest{Uid: "failed-uid"})
+		assert.Equal(t, err, status.Error(codes.PermissionDenied, "permission denied"))
+		allowed = true
+		_, err = w.RetryArchivedWorkflow(ctx, &workflowarchivepkg.RetryArchivedWorkflowRequest{Uid: "my-uid"})
+		assert.EqualError(t, err, "workflow must be Failed/Error to retry")
+		wf, err := w.RetryArchivedWorkflow(ctx, &workflowarchivepkg.RetryArchivedWorkflowRequest{Uid: "failed-uid"})
+		if assert.NoError(t, err) && assert.NotNil(t, created) {
+			assert.Equal(t, "failed", wf.Name)
+			assert.Empty(t, created.UID)
+			assert.Empty(t, created.ResourceVersion)
+			assert.NotContains(t, created.Labels, "workflows.argoproj.io/workflow-archiving-status")
+			assert.Equal(t, wfv1.WorkflowRunning, created.Status.Phase)
+			assert.Empty(t, created.Status.Nodes)
+		}
+	})
 }
@@ -3,8 +3,11 @@
 package e2e
 
 import (
+	"context"
+	"net/url"
 	"testing"
 
+	"github.com/Azure/azure-storage-blob-go/azblob"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/suite"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -150,6 +153,33 @@ func (s *ArtifactsSuite) TestOutputArtifactS3BucketCreationEnabled() {
 		})
 }
 
+func (s *ArtifactsSuite) TestAzureArtifactPassing() {
+	s.Need(fixtures.BaseLayerArtifacts)
+	s.createAzuriteContainer("my-container")
+	s.Given().
+		Workflow("@testdata/azure-artifact-passing.yaml").
+		When().
+		SubmitWorkflow().
+		WaitForWorkflow().
+		Then().
+		ExpectWorkflow(func(t *testing.T, _ *metav1.ObjectMeta, status *wfv1.WorkflowStatus) {
+			assert.Equal(t, wfv1.WorkflowSucceeded, status.Phase)
+		})
+}
+
+// createAzuriteContainer creates the container in the Azurite emulator, which is port-forwarded to localhost:10000
+func (s *ArtifactsSuite) createAzuriteContainer(name string) {
+	credential, err := azblob.NewSharedKeyCredential("devstoreaccount1", "Eby8vdM02xNOcqFlqUwJPLlmEtlCDXJ1OUzFT50uSRZ6IFsuFq2UVErCz4I6tq/K1SZFPTOtr/KBHBeksoGMGw==")
+	s.Require().NoError(err)
+	u, err := url.Parse("http://localhost:10000/devstoreaccount1/" + name)
+	s.Require().NoError(err)
+	_, err = azblob.NewContainerURL(*u, azblob.NewPipeline(credential, azblob.PipelineOptions{})).Create(context.Background(), nil, azblob.PublicAccessNone)
+	if storageErr, ok := err.(azblob.StorageError); ok && storageErr.ServiceCode() == azblob.ServiceCodeContainerAlreadyExists {
+		return
+	}
+	s.Require().NoError(err)
+}
+
 func TestArtifactsSuite(t *testing.T) {
 	suite.Run(t, new(ArtifactsSuite))
 }
@@ -19,6 +19,7 @@ import (
 	"k8s.io/client-go/rest"
 
 	"github.com/argoproj/argo/v2/config"
+	"github.com/argoproj/argo/v2/persist/sqldb"
 	"github.com/argoproj/argo/v2/pkg/apis/workflow"
 	"github.com/argoproj/argo/v2/pkg/client/clientset/versioned"
 	"github.com/argoproj/argo/v2/pkg/client/clientset/versioned/typed/workflow/v1alpha1"
@@ -102,7 +103,7 @@ func (s *E2ESuite) DeleteResources() {
 		archive := s.Persistence.workflowArchive
 		parse, err := labels.ParseToRequirements(Label)
 		s.CheckError(err)
-		workflows, err := archive.ListWorkflows(Namespace, time.Time{}, time.Time{}, parse, 0, 0)
+		workflows, err := archive.ListWorkflows(sqldb.ListOptions{Namespace: Namespace, LabelRequirements: parse})
 		s.CheckError(err)
 		for _, w := range workflows {
 			err := archive.DeleteWorkflow(string(w.UID))
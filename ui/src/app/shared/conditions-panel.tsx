@@ -7,7 +7,7 @@ interface Props {
 }
 
 const WarningConditions: ConditionType[] = ['SpecWarning'];
-const ErrorConditions: ConditionType[] = ['MetricsError', 'SubmissionError', 'SpecError'];
+const ErrorConditions: ConditionType[] = ['MetricsError', 'SubmissionError', 'SpecError', 'ArtifactGCError'];
 
 export function hasWarningConditionBadge(conditions: Condition[]): boolean {
     if (conditions.length === 0) {
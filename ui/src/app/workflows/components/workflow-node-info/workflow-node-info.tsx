@@ -94,6 +94,10 @@ const WorkflowNodeSummary = (props: Props) => {
                                       left: <div> CACHE NAME </div>,
                                       right: <div> {props.node.memoizationStatus.cacheName} </div>
                                   },
+                                  {
+                                      left: <div> CACHE TYPE </div>,
+                                      right: <div> {props.node.memoizationStatus.cacheType || 'ConfigMap'} </div>
+                                  },
                                   {
                                       left: <div> HIT? </div>,
                                       right: <div> {props.node.memoizationStatus.hit ? 'YES' : 'NO'} </div>
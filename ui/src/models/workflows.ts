@@ -42,6 +42,16 @@ export interface Artifact {
      * Path is the container path to the artifact
      */
     path?: string;
+    /**
+     * ArtifactGC describes the strategy to use when deleting this output artifact
+     */
+    artifactGC?: {
+        strategy?: string;
+    };
+    /**
+     * Deleted is set once the artifact has been garbage collected
+     */
+    deleted?: boolean;
 }
 
 /**
@@ -629,7 +639,7 @@ export interface Condition {
     message: string;
 }
 
-export type ConditionType = 'Completed' | 'SpecWarning' | 'MetricsError' | 'SubmissionError' | 'SpecError';
+export type ConditionType = 'Completed' | 'SpecWarning' | 'MetricsError' | 'SubmissionError' | 'SpecError' | 'ArtifactGCError';
 export type ConditionStatus = 'True' | 'False' | 'Unknown';
 
 /**
@@ -677,6 +687,12 @@ export interface WorkflowSpec {
     podGC?: {
         strategy?: string;
     };
+    /**
+     * ArtifactGC describes the strategy to use when deleting the output artifacts of the workflow
+     */
+    artifactGC?: {
+        strategy?: string;
+    };
     /**
      * SecurityContext holds pod-level security attributes and common container settings.
      */
@@ -846,6 +862,10 @@ export interface MemoizationStatus {
      * Cache name stores the identifier of the cache used for this node
      */
     cacheName: string;
+    /**
+     * Cache type is the type of the cache used for this node, empty for a ConfigMap cache
+     */
+    cacheType?: 'ConfigMap' | 'SQL' | 'Artifact';
 }
 
 export type WorkflowPhase = 'Pending' | 'Running' | 'Succeeded' | 'Failed' | 'Error';
@@ -17,7 +17,7 @@ func IsTransientErr(err error) bool {
 		return false
 	}
 	err = argoerrs.Cause(err)
-	return isExceededQuotaErr(err) || apierr.IsTooManyRequests(err) || isResourceQuotaConflictErr(err) || isTransientNetworkErr(err) || apierr.IsServerTimeout(err) || apierr.IsServiceUnavailable(err) || matchTransientErrPattern(err)
+	return IsExceededQuotaErr(err) || apierr.IsTooManyRequests(err) || isResourceQuotaConflictErr(err) || isTransientNetworkErr(err) || apierr.IsServerTimeout(err) || apierr.IsServiceUnavailable(err) || matchTransientErrPattern(err)
 }
 
 func matchTransientErrPattern(err error) bool {
@@ -31,7 +31,12 @@ func matchTransientErrPattern(err error) bool {
 	return match
 }
 
-func isExceededQuotaErr(err error) bool {
+// IsExceededQuotaErr is true if the error is because a namespace's resource quota was exceeded
+func IsExceededQuotaErr(err error) bool {
+	if err == nil {
+		return false
+	}
+	err = argoerrs.Cause(err)
 	return apierr.IsForbidden(err) && strings.Contains(err.Error(), "exceeded quota")
 }
 
@@ -74,4 +74,10 @@ func TestIsTransientErr(t *testing.T) {
 
 		_ = os.Unsetenv(transientEnvVarKey)
 	})
-}
\ No newline at end of file
+}
+func TestIsExceededQuotaErr(t *testing.T) {
+	assert.False(t, IsExceededQuotaErr(nil))
+	assert.False(t, IsExceededQuotaErr(apierr.NewForbidden(schema.GroupResource{}, "", nil)))
+	assert.False(t, IsExceededQuotaErr(apierr.NewTooManyRequests("", 0)))
+	assert.True(t, IsExceededQuotaErr(apierr.NewForbidden(schema.GroupResource{Group: "v1", Resource: "pods"}, "", errors.New("exceeded quota"))))
+}
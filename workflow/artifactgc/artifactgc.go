@@ -0,0 +1,229 @@
+package artifactgc
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	apierr "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	runtimeutil "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/util/workqueue"
+
+	wfv1 "github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1"
+	wfclientset "github.com/argoproj/argo/v2/pkg/client/clientset/versioned"
+	"github.com/argoproj/argo/v2/util/slice"
+	artifact "github.com/argoproj/argo/v2/workflow/artifacts"
+	"github.com/argoproj/argo/v2/workflow/artifacts/resource"
+	"github.com/argoproj/argo/v2/workflow/common"
+	"github.com/argoproj/argo/v2/workflow/hydrator"
+	"github.com/argoproj/argo/v2/workflow/metrics"
+	"github.com/argoproj/argo/v2/workflow/util"
+)
+
+// Controller deletes the output artifacts of workflows which have the artifact GC finalizer, once they are
+// completed or deleted depending on the artifact's strategy, and then removes the finalizer.
+type Controller struct {
+	kubeclientset kubernetes.Interface
+	wfclientset   wfclientset.Interface
+	wfInformer    cache.SharedIndexInformer
+	hydrator      hydrator.Interface
+	workqueue     workqueue.RateLimitingInterface
+	newDriver     artifact.NewDriverFunc
+}
+
+// NewController returns a new workflow artifact GC controller
+func NewController(kubeclientset kubernetes.Interface, wfClientset wfclientset.Interface, wfInformer cache.SharedIndexInformer, hydrator hydrator.Interface, metrics *metrics.Metrics) *Controller {
+	controller := &Controller{
+		kubeclientset: kubeclientset,
+		wfclientset:   wfClientset,
+		wfInformer:    wfInformer,
+		hydrator:      hydrator,
+		workqueue:     metrics.RateLimiterWithBusyWorkers(workqueue.DefaultControllerRateLimiter(), "workflow_artifact_gc_queue"),
+		newDriver:     artifact.NewDriver,
+	}
+
+	wfInformer.AddEventHandler(cache.FilteringResourceEventHandler{
+		FilterFunc: func(obj interface{}) bool {
+			un, ok := obj.(*unstructured.Unstructured)
+			return ok && needsProcessing(un)
+		},
+		Handler: cache.ResourceEventHandlerFuncs{
+			AddFunc: controller.enqueueWF,
+			UpdateFunc: func(old, new interface{}) {
+				controller.enqueueWF(new)
+			},
+		},
+	})
+	return controller
+}
+
+// we only look at workflows that have the finalizer and are either completed or being deleted
+func needsProcessing(un *unstructured.Unstructured) bool {
+	return slice.ContainsString(un.GetFinalizers(), common.FinalizerArtifactGC) &&
+		(un.GetDeletionTimestamp() != nil || un.GetLabels()[common.LabelKeyCompleted] == "true")
+}
+
+func (c *Controller) Run(stopCh <-chan struct{}, artifactGCWorkers int) error {
+	defer runtimeutil.HandleCrash()
+	defer c.workqueue.ShutDown()
+	log.Infof("Starting workflow artifact GC controller (artifactGCWorkers %d)", artifactGCWorkers)
+	go c.wfInformer.Run(stopCh)
+	if ok := cache.WaitForCacheSync(stopCh, c.wfInformer.HasSynced); !ok {
+		return fmt.Errorf("failed to wait for caches to sync")
+	}
+	for i := 0; i < artifactGCWorkers; i++ {
+		go wait.Until(c.runWorker, time.Second, stopCh)
+	}
+	log.Info("Started workflow artifact GC worker")
+	<-stopCh
+	log.Info("Shutting workflow artifact GC worker")
+	return nil
+}
+
+func (c *Controller) runWorker() {
+	ctx := context.Background()
+	for c.processNextWorkItem(ctx) {
+	}
+}
+
+func (c *Controller) processNextWorkItem(ctx context.Context) bool {
+	key, quit := c.workqueue.Get()
+	if quit {
+		return false
+	}
+	defer c.workqueue.Done(key)
+
+	err := c.garbageCollect(ctx, key.(string))
+	if err != nil {
+		runtimeutil.HandleError(err)
+		c.workqueue.AddRateLimited(key)
+	} else {
+		c.workqueue.Forget(key)
+	}
+	return true
+}
+
+func (c *Controller) enqueueWF(obj interface{}) {
+	key, err := cache.MetaNamespaceKeyFunc(obj)
+	if err != nil {
+		log.Warnf("Failed to get key for %v: %v", obj, err)
+		return
+	}
+	c.workqueue.Add(key)
+}
+
+func (c *Controller) garbageCollect(ctx context.Context, key string) error {
+	obj, exists, err := c.wfInformer.GetIndexer().GetByKey(key)
+	if err != nil || !exists {
+		return err
+	}
+	un, ok := obj.(*unstructured.Unstructured)
+	if !ok || !needsProcessing(un) {
+		return nil
+	}
+	wf, err := util.FromUnstructured(un)
+	if err != nil {
+		return err
+	}
+	err = c.hydrator.Hydrate(wf)
+	if err != nil {
+		return err
+	}
+	logCtx := log.WithFields(log.Fields{"namespace": wf.Namespace, "workflow": wf.Name})
+	deleting := wf.DeletionTimestamp != nil
+	ri := resources{kubeClient: c.kubeclientset, namespace: wf.Namespace}
+	pending := false
+	updated := false
+	var failed []string
+	var lastErr error
+	for _, node := range wf.Status.Nodes {
+		if node.Outputs == nil {
+			continue
+		}
+		if node.MemoizationStatus != nil && node.MemoizationStatus.Hit {
+			// the outputs were restored from the memoization cache, so they are the artifacts of another workflow,
+			// which the cache still references, this includes the restored sub-tree of a steps or DAG node
+			continue
+		}
+		for i, art := range node.Outputs.Artifacts {
+			if art.Deleted || !art.HasLocation() {
+				continue
+			}
+			switch wf.GetArtifactGCStrategy(&art) {
+			case wfv1.ArtifactGCOnWorkflowCompletion:
+			case wfv1.ArtifactGCOnWorkflowDeletion:
+				if !deleting {
+					pending = true
+					continue
+				}
+			default:
+				continue
+			}
+			logCtx.WithFields(log.Fields{"node": node.Name, "artifact": art.Name}).Info("Deleting artifact")
+			err := c.deleteArtifact(ctx, &art, ri)
+			if err != nil {
+				logCtx.WithField("artifact", art.Name).WithError(err).Warn("Failed to delete artifact")
+				failed = append(failed, fmt.Sprintf("%s/%s", node.Name, art.Name))
+				lastErr = err
+				continue
+			}
+			node.Outputs.Artifacts[i].Deleted = true
+			updated = true
+		}
+	}
+
+	if len(failed) > 0 {
+		wf.Status.Conditions.UpsertCondition(wfv1.Condition{
+			Type:    wfv1.ConditionTypeArtifactGCError,
+			Status:  metav1.ConditionTrue,
+			Message: fmt.Sprintf("failed to delete artifacts %v: %v", failed, lastErr),
+		})
+		updated = true
+	} else {
+		for _, condition := range wf.Status.Conditions {
+			if condition.Type == wfv1.ConditionTypeArtifactGCError {
+				wf.Status.Conditions.RemoveCondition(wfv1.ConditionTypeArtifactGCError)
+				updated = true
+				break
+			}
+		}
+		if !pending {
+			logCtx.Info("Removing artifact GC finalizer")
+			wf.Finalizers = slice.RemoveString(wf.Finalizers, common.FinalizerArtifactGC)
+			updated = true
+		}
+	}
+	if !updated {
+		return nil
+	}
+
+	err = c.hydrator.Dehydrate(wf)
+	if err != nil {
+		return err
+	}
+	_, err = c.wfclientset.ArgoprojV1alpha1().Workflows(wf.Namespace).Update(ctx, wf, metav1.UpdateOptions{})
+	if err != nil {
+		if apierr.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+	if len(failed) > 0 {
+		return fmt.Errorf("failed to delete %d artifacts of workflow %s: %w", len(failed), key, lastErr)
+	}
+	return nil
+}
+
+func (c *Controller) deleteArtifact(ctx context.Context, art *wfv1.Artifact, ri resource.Interface) error {
+	driver, err := c.newDriver(ctx, art, ri)
+	if err != nil {
+		return err
+	}
+	return driver.Delete(art)
+}
@@ -0,0 +1,256 @@
+package artifactgc
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kubefake "k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/util/workqueue"
+
+	wfv1 "github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1"
+	fakewfclientset "github.com/argoproj/argo/v2/pkg/client/clientset/versioned/fake"
+	"github.com/argoproj/argo/v2/test"
+	artifact "github.com/argoproj/argo/v2/workflow/artifacts"
+	"github.com/argoproj/argo/v2/workflow/artifacts/resource"
+	"github.com/argoproj/argo/v2/workflow/common"
+	hydratorfake "github.com/argoproj/argo/v2/workflow/hydrator/fake"
+	"github.com/argoproj/argo/v2/workflow/util"
+)
+
+var completedWf = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  name: artifact-gc
+  namespace: default
+  finalizers:
+  - workflows.argoproj.io/artifact-gc
+  labels:
+    workflows.argoproj.io/completed: "true"
+    workflows.argoproj.io/phase: Succeeded
+spec:
+  entrypoint: main
+  artifactGC:
+    strategy: OnWorkflowCompletion
+  templates:
+  - name: main
+    container:
+      image: argoproj/argosay:v2
+    outputs:
+      artifacts:
+      - name: on-completion
+        path: /tmp/on-completion
+      - name: on-deletion
+        path: /tmp/on-deletion
+        artifactGC:
+          strategy: OnWorkflowDeletion
+      - name: never
+        path: /tmp/never
+        artifactGC:
+          strategy: Never
+status:
+  phase: Succeeded
+  nodes:
+    artifact-gc:
+      id: artifact-gc
+      name: artifact-gc
+      type: Pod
+      phase: Succeeded
+      templateName: main
+      outputs:
+        artifacts:
+        - name: on-completion
+          path: /tmp/on-completion
+          s3:
+            bucket: my-bucket
+            endpoint: minio:9000
+            key: artifact-gc/on-completion.tgz
+        - name: on-deletion
+          path: /tmp/on-deletion
+          artifactGC:
+            strategy: OnWorkflowDeletion
+          s3:
+            bucket: my-bucket
+            endpoint: minio:9000
+            key: artifact-gc/on-deletion.tgz
+        - name: never
+          path: /tmp/never
+          artifactGC:
+            strategy: Never
+          s3:
+            bucket: my-bucket
+            endpoint: minio:9000
+            key: artifact-gc/never.tgz
+`
+
+type fakeDriver struct {
+	deleted []string
+	err     error
+}
+
+func (d *fakeDriver) Load(*wfv1.Artifact, string) error { return nil }
+
+func (d *fakeDriver) OpenStream(*wfv1.Artifact) (io.ReadCloser, error) { return nil, nil }
+
+func (d *fakeDriver) Save(string, *wfv1.Artifact) error { return nil }
+
+func (d *fakeDriver) Delete(a *wfv1.Artifact) error {
+	if d.err != nil {
+		return d.err
+	}
+	d.deleted = append(d.deleted, a.Name)
+	return nil
+}
+
+func (d *fakeDriver) ListObjects(*wfv1.Artifact) ([]string, error) { return nil, nil }
+
+func newController(t *testing.T, wf *wfv1.Workflow, driver *fakeDriver) *Controller {
+	wfclientset := fakewfclientset.NewSimpleClientset(wf)
+	wfInformer := cache.NewSharedIndexInformer(nil, nil, 0, nil)
+	un, err := util.ToUnstructured(wf)
+	if !assert.NoError(t, err) {
+		t.FailNow()
+	}
+	assert.NoError(t, wfInformer.GetIndexer().Add(un))
+	return &Controller{
+		kubeclientset: kubefake.NewSimpleClientset(),
+		wfclientset:   wfclientset,
+		wfInformer:    wfInformer,
+		hydrator:      hydratorfake.Noop,
+		workqueue:     workqueue.NewRateLimitingQueue(workqueue.DefaultControllerRateLimiter()),
+		newDriver: func(context.Context, *wfv1.Artifact, resource.Interface) (artifact.ArtifactDriver, error) {
+			return driver, nil
+		},
+	}
+}
+
+func getWorkflow(t *testing.T, controller *Controller) *wfv1.Workflow {
+	wf, err := controller.wfclientset.ArgoprojV1alpha1().Workflows("default").Get(context.Background(), "artifact-gc", metav1.GetOptions{})
+	if !assert.NoError(t, err) {
+		t.FailNow()
+	}
+	return wf
+}
+
+func deletedArtifacts(wf *wfv1.Workflow) []string {
+	var deleted []string
+	for _, a := range wf.Status.Nodes["artifact-gc"].Outputs.Artifacts {
+		if a.Deleted {
+			deleted = append(deleted, a.Name)
+		}
+	}
+	return deleted
+}
+
+func TestNeedsProcessing(t *testing.T) {
+	wf := test.LoadWorkflowFromBytes([]byte(completedWf))
+	un, err := util.ToUnstructured(wf)
+	assert.NoError(t, err)
+	assert.True(t, needsProcessing(un))
+
+	un.SetLabels(map[string]string{common.LabelKeyCompleted: "false"})
+	assert.False(t, needsProcessing(un))
+
+	un.SetDeletionTimestamp(&metav1.Time{Time: time.Now()})
+	assert.True(t, needsProcessing(un))
+
+	un.SetFinalizers(nil)
+	assert.False(t, needsProcessing(un))
+}
+
+func TestGarbageCollectOnCompletion(t *testing.T) {
+	ctx := context.Background()
+	driver := &fakeDriver{}
+	controller := newController(t, test.LoadWorkflowFromBytes([]byte(completedWf)), driver)
+
+	err := controller.garbageCollect(ctx, "default/artifact-gc")
+	if assert.NoError(t, err) {
+		assert.Equal(t, []string{"on-completion"}, driver.deleted)
+		wf := getWorkflow(t, controller)
+		assert.Equal(t, []string{"on-completion"}, deletedArtifacts(wf))
+		// the on-deletion artifact is still pending
+		assert.Contains(t, wf.Finalizers, common.FinalizerArtifactGC)
+	}
+}
+
+func TestGarbageCollectOnDeletion(t *testing.T) {
+	ctx := context.Background()
+	driver := &fakeDriver{}
+	wf := test.LoadWorkflowFromBytes([]byte(completedWf))
+	wf.DeletionTimestamp = &metav1.Time{Time: time.Now()}
+	controller := newController(t, wf, driver)
+
+	err := controller.garbageCollect(ctx, "default/artifact-gc")
+	if assert.NoError(t, err) {
+		assert.ElementsMatch(t, []string{"on-completion", "on-deletion"}, driver.deleted)
+		wf := getWorkflow(t, controller)
+		assert.ElementsMatch(t, []string{"on-completion", "on-deletion"}, deletedArtifacts(wf))
+		assert.NotContains(t, wf.Finalizers, common.FinalizerArtifactGC)
+	}
+}
+
+func TestGarbageCollectSkipsDeleted(t *testing.T) {
+	ctx := context.Background()
+	driver := &fakeDriver{}
+	wf := test.LoadWorkflowFromBytes([]byte(completedWf))
+	wf.Status.Nodes["artifact-gc"].Outputs.Artifacts[0].Deleted = true
+	controller := newController(t, wf, driver)
+
+	err := controller.garbageCollect(ctx, "default/artifact-gc")
+	if assert.NoError(t, err) {
+		assert.Empty(t, driver.deleted)
+	}
+}
+
+func TestGarbageCollectSkipsMemoizationHits(t *testing.T) {
+	ctx := context.Background()
+	driver := &fakeDriver{}
+	wf := test.LoadWorkflowFromBytes([]byte(completedWf))
+	wf.DeletionTimestamp = &metav1.Time{Time: time.Now()}
+	node := wf.Status.Nodes["artifact-gc"]
+	node.MemoizationStatus = &wfv1.MemoizationStatus{Hit: true, Key: "my-key", CacheName: "my-cache"}
+	wf.Status.Nodes["artifact-gc"] = node
+	controller := newController(t, wf, driver)
+
+	err := controller.garbageCollect(ctx, "default/artifact-gc")
+	if assert.NoError(t, err) {
+		assert.Empty(t, driver.deleted)
+		wf := getWorkflow(t, controller)
+		assert.Empty(t, deletedArtifacts(wf))
+		assert.NotContains(t, wf.Finalizers, common.FinalizerArtifactGC)
+	}
+}
+
+func TestGarbageCollectError(t *testing.T) {
+	ctx := context.Background()
+	driver := &fakeDriver{err: fmt.Errorf("access denied")}
+	controller := newController(t, test.LoadWorkflowFromBytes([]byte(completedWf)), driver)
+
+	err := controller.garbageCollect(ctx, "default/artifact-gc")
+	if assert.Error(t, err) {
+		wf := getWorkflow(t, controller)
+		assert.Empty(t, deletedArtifacts(wf))
+		assert.Contains(t, wf.Finalizers, common.FinalizerArtifactGC)
+		if assert.Len(t, wf.Status.Conditions, 1) {
+			assert.Equal(t, wfv1.ConditionTypeArtifactGCError, wf.Status.Conditions[0].Type)
+			assert.Contains(t, wf.Status.Conditions[0].Message, "access denied")
+		}
+	}
+}
+
+func TestProcessNextWorkItem(t *testing.T) {
+	ctx := context.Background()
+	driver := &fakeDriver{err: fmt.Errorf("access denied")}
+	controller := newController(t, test.LoadWorkflowFromBytes([]byte(completedWf)), driver)
+	controller.workqueue.Add("default/artifact-gc")
+
+	assert.True(t, controller.processNextWorkItem(ctx))
+	// failed items are re-queued with back-off
+	assert.Equal(t, 1, controller.workqueue.NumRequeues("default/artifact-gc"))
+}
@@ -7,6 +7,7 @@ import (
 
 	"github.com/argoproj/argo/v2/errors"
 	wfv1 "github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo/v2/workflow/artifacts/common"
 )
 
 type ArtifactoryArtifactDriver struct {
@@ -25,28 +26,39 @@ func (a *ArtifactoryArtifactDriver) Load(artifact *wfv1.Artifact, path string) e
 		_ = lf.Close()
 	}()
 
-	req, err := http.NewRequest(http.MethodGet, artifact.Artifactory.URL, nil)
+	rc, err := a.OpenStream(artifact)
 	if err != nil {
 		return err
 	}
+	defer func() {
+		_ = rc.Close()
+	}()
+
+	_, err = io.Copy(lf, rc)
+
+	return err
+}
+
+// OpenStream opens the artifactory URL for reading
+func (a *ArtifactoryArtifactDriver) OpenStream(artifact *wfv1.Artifact) (io.ReadCloser, error) {
+	req, err := http.NewRequest(http.MethodGet, artifact.Artifactory.URL, nil)
+	if err != nil {
+		return nil, err
+	}
 	req.SetBasicAuth(a.Username, a.Password)
 	res, err := (&http.Client{}).Do(req)
 	if err != nil {
-		return err
+		return nil, err
 	}
-	defer func() {
-		_ = res.Body.Close()
-	}()
 	if res.StatusCode == 404 {
-		return errors.New(errors.CodeNotFound, res.Status)
+		_ = res.Body.Close()
+		return nil, errors.New(errors.CodeNotFound, res.Status)
 	}
 	if res.StatusCode < 200 || res.StatusCode >= 300 {
-		return errors.InternalErrorf("loading file from artifactory failed with reason:%s", res.Status)
+		_ = res.Body.Close()
+		return nil, errors.InternalErrorf("loading file from artifactory failed with reason:%s", res.Status)
 	}
-
-	_, err = io.Copy(lf, res.Body)
-
-	return err
+	return common.NewSizedReadCloser(res.Body, res.ContentLength), nil
 }
 
 // UpLoad artifact to an artifactory URL
@@ -73,3 +85,32 @@ func (a *ArtifactoryArtifactDriver) Save(path string, artifact *wfv1.Artifact) e
 	}
 	return nil
 }
+
+// Delete artifact from an artifactory URL
+func (a *ArtifactoryArtifactDriver) Delete(artifact *wfv1.Artifact) error {
+	req, err := http.NewRequest(http.MethodDelete, artifact.Artifactory.URL, nil)
+	if err != nil {
+		return err
+	}
+	req.SetBasicAuth(a.Username, a.Password)
+	res, err := (&http.Client{}).Do(req)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = res.Body.Close()
+	}()
+	// already deleted is not an error
+	if res.StatusCode == 404 {
+		return nil
+	}
+	if res.StatusCode < 200 || res.StatusCode >= 300 {
+		return errors.InternalErrorf("deleting file from artifactory failed with reason:%s", res.Status)
+	}
+	return nil
+}
+
+// ListObjects returns the URL itself, an artifactory artifact is always a single file
+func (a *ArtifactoryArtifactDriver) ListObjects(artifact *wfv1.Artifact) ([]string, error) {
+	return []string{artifact.Artifactory.URL}, nil
+}
@@ -1,6 +1,9 @@
 package artifactory
 
 import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
 	"os"
 	"testing"
 
@@ -37,3 +40,73 @@ func TestArtifactoryArtifactDriver_Load(t *testing.T) {
 		}
 	})
 }
+
+func TestArtifactoryArtifactDriver_OpenStream(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		username, password, _ := r.BasicAuth()
+		if username != "my-username" || password != "my-password" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		if r.URL.Path != "/found" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_, _ = w.Write([]byte("my-data"))
+	}))
+	defer server.Close()
+	driver := &ArtifactoryArtifactDriver{Username: "my-username", Password: "my-password"}
+	t.Run("Found", func(t *testing.T) {
+		rc, err := driver.OpenStream(&wfv1.Artifact{
+			ArtifactLocation: wfv1.ArtifactLocation{
+				Artifactory: &wfv1.ArtifactoryArtifact{URL: server.URL + "/found"},
+			},
+		})
+		if assert.NoError(t, err) {
+			defer func() { _ = rc.Close() }()
+			data, err := ioutil.ReadAll(rc)
+			assert.NoError(t, err)
+			assert.Equal(t, "my-data", string(data))
+		}
+	})
+	t.Run("NotFound", func(t *testing.T) {
+		_, err := driver.OpenStream(&wfv1.Artifact{
+			ArtifactLocation: wfv1.ArtifactLocation{
+				Artifactory: &wfv1.ArtifactoryArtifact{URL: server.URL + "/not-found"},
+			},
+		})
+		if assert.Error(t, err) {
+			argoError, ok := err.(errors.ArgoError)
+			if assert.True(t, ok) {
+				assert.Equal(t, errors.CodeNotFound, argoError.Code())
+			}
+		}
+	})
+}
+
+func TestArtifactoryArtifactDriver_Delete(t *testing.T) {
+	var deleted []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodDelete {
+			w.WriteHeader(http.StatusMethodNotAllowed)
+			return
+		}
+		if r.URL.Path != "/found" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		deleted = append(deleted, r.URL.Path)
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+	driver := &ArtifactoryArtifactDriver{}
+	for _, path := range []string{"/found", "/not-found"} {
+		err := driver.Delete(&wfv1.Artifact{
+			ArtifactLocation: wfv1.ArtifactLocation{
+				Artifactory: &wfv1.ArtifactoryArtifact{URL: server.URL + path},
+			},
+		})
+		assert.NoError(t, err)
+	}
+	assert.Equal(t, []string{"/found"}, deleted)
+}
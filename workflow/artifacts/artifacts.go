@@ -3,12 +3,14 @@ package executor
 import (
 	"context"
 	"fmt"
+	"io"
 
 	"github.com/argoproj/argo/v2/workflow/artifacts/gcs"
 	"github.com/argoproj/argo/v2/workflow/artifacts/oss"
 
 	wfv1 "github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1"
 	"github.com/argoproj/argo/v2/workflow/artifacts/artifactory"
+	"github.com/argoproj/argo/v2/workflow/artifacts/azure"
 	"github.com/argoproj/argo/v2/workflow/artifacts/git"
 	"github.com/argoproj/argo/v2/workflow/artifacts/hdfs"
 	"github.com/argoproj/argo/v2/workflow/artifacts/http"
@@ -22,8 +24,18 @@ type ArtifactDriver interface {
 	// Load accepts an artifact source URL and places it at specified path
 	Load(inputArtifact *wfv1.Artifact, path string) error
 
+	// OpenStream opens the artifact for reading, the caller must close the returned reader. The reader implements
+	// io.Seeker if the driver can seek within the artifact, and common.Sizer if the driver knows its size.
+	OpenStream(inputArtifact *wfv1.Artifact) (io.ReadCloser, error)
+
 	// Save uploads the path to artifact destination
 	Save(path string, outputArtifact *wfv1.Artifact) error
+
+	// Delete removes the artifact, and everything under it if it is a directory
+	Delete(artifact *wfv1.Artifact) error
+
+	// ListObjects returns the keys of every object stored under the artifact
+	ListObjects(artifact *wfv1.Artifact) ([]string, error)
 }
 
 var ErrUnsupportedDriver = fmt.Errorf("unsupported artifact driver")
@@ -36,7 +48,7 @@ func NewDriver(ctx context.Context, art *wfv1.Artifact, ri resource.Interface) (
 		var accessKey string
 		var secretKey string
 
-		if art.S3.AccessKeySecret.Name != "" {
+		if art.S3.AccessKeySecret != nil && art.S3.AccessKeySecret.Name != "" {
 			accessKeyBytes, err := ri.GetSecret(ctx, art.S3.AccessKeySecret.Name, art.S3.AccessKeySecret.Key)
 			if err != nil {
 				return nil, err
@@ -153,5 +165,27 @@ func NewDriver(ctx context.Context, art *wfv1.Artifact, ri resource.Interface) (
 		return &driver, nil
 	}
 
+	if art.Azure != nil {
+		driver := azure.ArtifactDriver{
+			Endpoint:    art.Azure.Endpoint,
+			UseSDKCreds: art.Azure.UseSDKCreds,
+		}
+		if art.Azure.AccountKeySecret != nil && art.Azure.AccountKeySecret.Name != "" {
+			accountKeyBytes, err := ri.GetSecret(ctx, art.Azure.AccountKeySecret.Name, art.Azure.AccountKeySecret.Key)
+			if err != nil {
+				return nil, err
+			}
+			driver.AccountKey = string(accountKeyBytes)
+		}
+		if art.Azure.SASTokenSecret != nil && art.Azure.SASTokenSecret.Name != "" {
+			sasTokenBytes, err := ri.GetSecret(ctx, art.Azure.SASTokenSecret.Name, art.Azure.SASTokenSecret.Key)
+			if err != nil {
+				return nil, err
+			}
+			driver.SASToken = string(sasTokenBytes)
+		}
+		return &driver, nil
+	}
+
 	return nil, ErrUnsupportedDriver
 }
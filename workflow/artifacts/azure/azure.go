@@ -0,0 +1,290 @@
+package azure
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Azure/azure-storage-blob-go/azblob"
+	log "github.com/sirupsen/logrus"
+	"k8s.io/apimachinery/pkg/util/wait"
+
+	"github.com/argoproj/pkg/file"
+
+	"github.com/argoproj/argo/v2/errors"
+	wfv1 "github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo/v2/workflow/artifacts/common"
+)
+
+// ArtifactDriver is a driver for Azure Blob Storage
+type ArtifactDriver struct {
+	Endpoint    string
+	AccountKey  string
+	SASToken    string
+	UseSDKCreds bool
+
+	// the token credential is created on first use and shared by every request, as it fetches a token and
+	// starts a timer that refreshes it
+	tokenCredentialLock sync.Mutex
+	tokenCredential     azblob.TokenCredential
+}
+
+// newContainerURL returns the URL of the container, authenticated with an account key, a SAS token or, if
+// UseSDKCreds is set, workload or managed identity. Without any of these, requests are anonymous.
+func (a *ArtifactDriver) newContainerURL(container string) (azblob.ContainerURL, error) {
+	u, err := url.Parse(a.Endpoint)
+	if err != nil {
+		return azblob.ContainerURL{}, fmt.Errorf("failed to parse endpoint %q: %w", a.Endpoint, err)
+	}
+	var credential azblob.Credential
+	switch {
+	case a.AccountKey != "":
+		credential, err = azblob.NewSharedKeyCredential(accountName(u), strings.TrimSpace(a.AccountKey))
+	case a.SASToken != "":
+		credential = azblob.NewAnonymousCredential()
+		u.RawQuery = strings.TrimPrefix(strings.TrimSpace(a.SASToken), "?")
+	case a.UseSDKCreds:
+		credential, err = a.getTokenCredential()
+	default:
+		credential = azblob.NewAnonymousCredential()
+	}
+	if err != nil {
+		return azblob.ContainerURL{}, err
+	}
+	u.Path = path.Join("/", u.Path, container)
+	return azblob.NewContainerURL(*u, azblob.NewPipeline(credential, azblob.PipelineOptions{})), nil
+}
+
+// getTokenCredential returns the driver's token credential, creating it if need be. A credential that
+// could not be created is not remembered, so that callers retrying on error try again.
+func (a *ArtifactDriver) getTokenCredential() (azblob.TokenCredential, error) {
+	a.tokenCredentialLock.Lock()
+	defer a.tokenCredentialLock.Unlock()
+	if a.tokenCredential == nil {
+		credential, err := newTokenCredential()
+		if err != nil {
+			return nil, err
+		}
+		a.tokenCredential = credential
+	}
+	return a.tokenCredential, nil
+}
+
+// accountName returns the storage account of the endpoint, which is either the first path segment of a
+// path-style URL (e.g. "http://azurite:10000/devstoreaccount1") or the first label of the host name
+// (e.g. "https://myaccount.blob.core.windows.net")
+func accountName(u *url.URL) string {
+	if p := strings.Trim(u.Path, "/"); p != "" {
+		return strings.Split(p, "/")[0]
+	}
+	return strings.Split(u.Hostname(), ".")[0]
+}
+
+func isBlobNotFound(err error) bool {
+	storageErr, ok := err.(azblob.StorageError)
+	return ok && storageErr.ServiceCode() == azblob.ServiceCodeBlobNotFound
+}
+
+// Load downloads a blob, or every blob under the blob name if it is a "directory"
+func (a *ArtifactDriver) Load(inputArtifact *wfv1.Artifact, path string) error {
+	return wait.ExponentialBackoff(wait.Backoff{Duration: time.Second * 2, Factor: 2.0, Steps: 5, Jitter: 0.1},
+		func() (bool, error) {
+			log.Infof("Azure Load path: %s, blob: %s", path, inputArtifact.Azure.Blob)
+			containerURL, err := a.newContainerURL(inputArtifact.Azure.Container)
+			if err != nil {
+				log.Warnf("Failed to create new Azure container URL: %v", err)
+				return false, nil
+			}
+			ctx := context.Background()
+			origErr := downloadBlob(ctx, containerURL, inputArtifact.Azure.Blob, path)
+			if origErr == nil {
+				return true, nil
+			}
+			if !isBlobNotFound(origErr) {
+				log.Warnf("Failed to download blob: %v", origErr)
+				return false, nil
+			}
+			// If we get here, the blob does not exist. The blob name might be a "directory"
+			blobNames, err := listBlobs(ctx, containerURL, dirPrefix(inputArtifact.Azure.Blob))
+			if err != nil {
+				log.Warnf("Failed to list blobs: %v", err)
+				return false, nil
+			}
+			if len(blobNames) == 0 {
+				return false, errors.New(errors.CodeNotFound, origErr.Error())
+			}
+			for _, blobName := range blobNames {
+				localPath := filepath.Join(path, strings.TrimPrefix(blobName, dirPrefix(inputArtifact.Azure.Blob)))
+				if err := downloadBlob(ctx, containerURL, blobName, localPath); err != nil {
+					log.Warnf("Failed to download blob %s: %v", blobName, err)
+					return false, nil
+				}
+			}
+			return true, nil
+		})
+}
+
+func downloadBlob(ctx context.Context, containerURL azblob.ContainerURL, blobName, localPath string) error {
+	blobURL := containerURL.NewBlobURL(blobName)
+	// stat first, so that we do not create an empty file for a blob that does not exist
+	if _, err := blobURL.GetProperties(ctx, azblob.BlobAccessConditions{}, azblob.ClientProvidedKeyOptions{}); err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(localPath), 0700); err != nil {
+		return err
+	}
+	f, err := os.Create(localPath)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = f.Close() }()
+	return azblob.DownloadBlobToFile(ctx, blobURL, 0, azblob.CountToEnd, f, azblob.DownloadFromBlobOptions{})
+}
+
+// Save uploads a file as a blob, or every file in a directory as a blob under the blob name
+func (a *ArtifactDriver) Save(path string, outputArtifact *wfv1.Artifact) error {
+	return wait.ExponentialBackoff(wait.Backoff{Duration: time.Second * 2, Factor: 2.0, Steps: 5, Jitter: 0.1},
+		func() (bool, error) {
+			log.Infof("Azure Save path: %s, blob: %s", path, outputArtifact.Azure.Blob)
+			containerURL, err := a.newContainerURL(outputArtifact.Azure.Container)
+			if err != nil {
+				log.Warnf("Failed to create new Azure container URL: %v", err)
+				return false, nil
+			}
+			isDir, err := file.IsDirectory(path)
+			if err != nil {
+				log.Warnf("Failed to test if %s is a directory: %v", path, err)
+				return false, nil
+			}
+			ctx := context.Background()
+			if !isDir {
+				if err := uploadBlob(ctx, containerURL, outputArtifact.Azure.Blob, path); err != nil {
+					log.Warnf("Failed to upload blob: %v", err)
+					return false, nil
+				}
+				return true, nil
+			}
+			err = filepath.Walk(path, func(localPath string, info os.FileInfo, err error) error {
+				if err != nil || info.IsDir() || info.Mode()&os.ModeSymlink != 0 {
+					return err
+				}
+				relPath, err := filepath.Rel(path, localPath)
+				if err != nil {
+					return err
+				}
+				return uploadBlob(ctx, containerURL, dirPrefix(outputArtifact.Azure.Blob)+filepath.ToSlash(relPath), localPath)
+			})
+			if err != nil {
+				log.Warnf("Failed to upload directory: %v", err)
+				return false, nil
+			}
+			return true, nil
+		})
+}
+
+func uploadBlob(ctx context.Context, containerURL azblob.ContainerURL, blobName, localPath string) error {
+	f, err := os.Open(localPath)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = f.Close() }()
+	_, err = azblob.UploadFileToBlockBlob(ctx, f, containerURL.NewBlockBlobURL(blobName), azblob.UploadToBlockBlobOptions{})
+	return err
+}
+
+// OpenStream opens a single blob for reading
+func (a *ArtifactDriver) OpenStream(inputArtifact *wfv1.Artifact) (io.ReadCloser, error) {
+	log.Infof("Azure OpenStream blob: %s", inputArtifact.Azure.Blob)
+	containerURL, err := a.newContainerURL(inputArtifact.Azure.Container)
+	if err != nil {
+		return nil, err
+	}
+	ctx := context.Background()
+	resp, err := containerURL.NewBlobURL(inputArtifact.Azure.Blob).Download(ctx, 0, azblob.CountToEnd, azblob.BlobAccessConditions{}, false, azblob.ClientProvidedKeyOptions{})
+	if err != nil {
+		if isBlobNotFound(err) {
+			return nil, errors.New(errors.CodeNotFound, err.Error())
+		}
+		return nil, err
+	}
+	return common.NewSizedReadCloser(resp.Body(azblob.RetryReaderOptions{MaxRetryRequests: 5}), resp.ContentLength()), nil
+}
+
+// Delete removes a blob, or every blob under the blob name if it is a "directory"
+func (a *ArtifactDriver) Delete(artifact *wfv1.Artifact) error {
+	blobNames, err := a.ListObjects(artifact)
+	if err != nil {
+		return err
+	}
+	return wait.ExponentialBackoff(wait.Backoff{Duration: time.Second * 2, Factor: 2.0, Steps: 5, Jitter: 0.1},
+		func() (bool, error) {
+			log.Infof("Azure Delete blob: %s", artifact.Azure.Blob)
+			containerURL, err := a.newContainerURL(artifact.Azure.Container)
+			if err != nil {
+				log.Warnf("Failed to create new Azure container URL: %v", err)
+				return false, nil
+			}
+			for _, blobName := range blobNames {
+				_, err := containerURL.NewBlobURL(blobName).Delete(context.Background(), azblob.DeleteSnapshotsOptionInclude, azblob.BlobAccessConditions{})
+				if err != nil && !isBlobNotFound(err) {
+					log.Warnf("Failed to delete %s: %v", blobName, err)
+					return false, nil
+				}
+			}
+			return true, nil
+		})
+}
+
+// ListObjects returns the name of the blob itself, or the names of every blob under it if it is a "directory"
+func (a *ArtifactDriver) ListObjects(artifact *wfv1.Artifact) ([]string, error) {
+	var blobNames []string
+	err := wait.ExponentialBackoff(wait.Backoff{Duration: time.Second * 2, Factor: 2.0, Steps: 5, Jitter: 0.1},
+		func() (bool, error) {
+			log.Infof("Azure ListObjects blob: %s", artifact.Azure.Blob)
+			containerURL, err := a.newContainerURL(artifact.Azure.Container)
+			if err != nil {
+				log.Warnf("Failed to create new Azure container URL: %v", err)
+				return false, nil
+			}
+			names, err := listBlobs(context.Background(), containerURL, artifact.Azure.Blob)
+			if err != nil {
+				log.Warnf("Failed to list blobs: %v", err)
+				return false, nil
+			}
+			blobNames = nil
+			// a blob name is either a blob or a "directory", so we list "name" and "name/..." but not "namefoo"
+			for _, name := range names {
+				if name == artifact.Azure.Blob || strings.HasPrefix(name, dirPrefix(artifact.Azure.Blob)) {
+					blobNames = append(blobNames, name)
+				}
+			}
+			return true, nil
+		})
+	return blobNames, err
+}
+
+func listBlobs(ctx context.Context, containerURL azblob.ContainerURL, prefix string) ([]string, error) {
+	var blobNames []string
+	for marker := (azblob.Marker{}); marker.NotDone(); {
+		resp, err := containerURL.ListBlobsFlatSegment(ctx, marker, azblob.ListBlobsSegmentOptions{Prefix: prefix})
+		if err != nil {
+			return nil, err
+		}
+		for _, item := range resp.Segment.BlobItems {
+			blobNames = append(blobNames, item.Name)
+		}
+		marker = resp.NextMarker
+	}
+	return blobNames, nil
+}
+
+func dirPrefix(blobName string) string {
+	return strings.TrimSuffix(blobName, "/") + "/"
+}
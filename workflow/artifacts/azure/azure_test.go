@@ -0,0 +1,96 @@
+package azure
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_accountName(t *testing.T) {
+	for endpoint, want := range map[string]string{
+		"https://myaccount.blob.core.windows.net":  "myaccount",
+		"https://myaccount.blob.core.windows.net/": "myaccount",
+		"http://azurite:10000/devstoreaccount1":    "devstoreaccount1",
+		"http://127.0.0.1:10000/devstoreaccount1/": "devstoreaccount1",
+	} {
+		t.Run(endpoint, func(t *testing.T) {
+			u, err := url.Parse(endpoint)
+			if assert.NoError(t, err) {
+				assert.Equal(t, want, accountName(u))
+			}
+		})
+	}
+}
+
+func TestArtifactDriver_newContainerURL(t *testing.T) {
+	t.Run("AccountKey", func(t *testing.T) {
+		driver := &ArtifactDriver{Endpoint: "http://azurite:10000/devstoreaccount1", AccountKey: "bXkta2V5"}
+		containerURL, err := driver.newContainerURL("my-container")
+		if assert.NoError(t, err) {
+			u := containerURL.URL()
+			assert.Equal(t, "http://azurite:10000/devstoreaccount1/my-container", u.String())
+		}
+	})
+	t.Run("InvalidAccountKey", func(t *testing.T) {
+		driver := &ArtifactDriver{Endpoint: "https://myaccount.blob.core.windows.net", AccountKey: "not base64"}
+		_, err := driver.newContainerURL("my-container")
+		assert.Error(t, err)
+	})
+	t.Run("SASToken", func(t *testing.T) {
+		driver := &ArtifactDriver{Endpoint: "https://myaccount.blob.core.windows.net", SASToken: "?sv=2019-12-12&sig=my-sig\n"}
+		containerURL, err := driver.newContainerURL("my-container")
+		if assert.NoError(t, err) {
+			u := containerURL.URL()
+			assert.Equal(t, "https://myaccount.blob.core.windows.net/my-container?sv=2019-12-12&sig=my-sig", u.String())
+			blobURL := containerURL.NewBlobURL("my/blob")
+			u = blobURL.URL()
+			assert.Equal(t, "/my-container/my/blob", u.Path)
+			assert.Equal(t, "my-sig", u.Query().Get("sig"))
+		}
+	})
+	t.Run("SDKCreds", func(t *testing.T) {
+		requests := 0
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			requests++
+			if requests == 1 {
+				w.WriteHeader(http.StatusServiceUnavailable)
+				return
+			}
+			_, _ = w.Write([]byte(`{"access_token":"my-token","expires_in":3600}`))
+		}))
+		defer server.Close()
+		dir, err := ioutil.TempDir("", "azure")
+		if !assert.NoError(t, err) {
+			return
+		}
+		defer func() { _ = os.RemoveAll(dir) }()
+		tokenFile := filepath.Join(dir, "token")
+		if !assert.NoError(t, ioutil.WriteFile(tokenFile, []byte("my-assertion"), 0600)) {
+			return
+		}
+		setEnv(t, envVarAuthorityHost, server.URL)
+		setEnv(t, envVarFederatedTokenFile, tokenFile)
+		driver := &ArtifactDriver{Endpoint: "https://myaccount.blob.core.windows.net", UseSDKCreds: true}
+		_, err = driver.newContainerURL("my-container")
+		assert.Error(t, err, "a failure is not remembered")
+		for i := 0; i < 2; i++ {
+			_, err = driver.newContainerURL("my-container")
+			assert.NoError(t, err)
+		}
+		assert.Equal(t, 2, requests, "the token is only fetched once it has been fetched successfully")
+		if assert.NotNil(t, driver.tokenCredential) {
+			assert.Equal(t, "my-token", driver.tokenCredential.Token())
+		}
+	})
+}
+
+func Test_dirPrefix(t *testing.T) {
+	assert.Equal(t, "my/blob/", dirPrefix("my/blob"))
+	assert.Equal(t, "my/blob/", dirPrefix("my/blob/"))
+}
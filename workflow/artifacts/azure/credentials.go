@@ -0,0 +1,117 @@
+package azure
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-storage-blob-go/azblob"
+	"github.com/Azure/go-autorest/autorest/adal"
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	// the resource (and scope) tokens are requested for
+	storageResource = "https://storage.azure.com/"
+	// how long before a token expires that we refresh it
+	tokenRefreshMargin = 5 * time.Minute
+
+	defaultAuthorityHost = "https://login.microsoftonline.com/"
+
+	// environment variables injected by the Azure Workload Identity webhook
+	envVarClientID           = "AZURE_CLIENT_ID"
+	envVarTenantID           = "AZURE_TENANT_ID"
+	envVarFederatedTokenFile = "AZURE_FEDERATED_TOKEN_FILE"
+	envVarAuthorityHost      = "AZURE_AUTHORITY_HOST"
+)
+
+type tokenSource func() (token string, expiresOn time.Time, err error)
+
+// newTokenCredential returns a credential that uses workload identity if the pod has been set up for it,
+// and falls back to a managed identity otherwise. The token is refreshed shortly before it expires.
+func newTokenCredential() (azblob.TokenCredential, error) {
+	source := managedIdentityTokenSource
+	if os.Getenv(envVarFederatedTokenFile) != "" {
+		source = workloadIdentityTokenSource
+	}
+	token, expiresOn, err := source()
+	if err != nil {
+		return nil, err
+	}
+	return azblob.NewTokenCredential(token, func(credential azblob.TokenCredential) time.Duration {
+		if remaining := time.Until(expiresOn) - tokenRefreshMargin; remaining > 0 {
+			return remaining
+		}
+		token, newExpiresOn, err := source()
+		if err != nil {
+			log.Warnf("Failed to refresh Azure token: %v", err)
+			return time.Minute
+		}
+		expiresOn = newExpiresOn
+		credential.SetToken(token)
+		return time.Until(expiresOn) - tokenRefreshMargin
+	}), nil
+}
+
+// workloadIdentityTokenSource exchanges the projected service account token for an Azure AD token
+func workloadIdentityTokenSource() (string, time.Time, error) {
+	assertion, err := ioutil.ReadFile(os.Getenv(envVarFederatedTokenFile))
+	if err != nil {
+		return "", time.Time{}, fmt.Errorf("failed to read federated token file: %w", err)
+	}
+	authorityHost := os.Getenv(envVarAuthorityHost)
+	if authorityHost == "" {
+		authorityHost = defaultAuthorityHost
+	}
+	tokenURL := strings.TrimSuffix(authorityHost, "/") + "/" + os.Getenv(envVarTenantID) + "/oauth2/v2.0/token"
+	resp, err := http.PostForm(tokenURL, url.Values{
+		"client_id":             {os.Getenv(envVarClientID)},
+		"scope":                 {storageResource + ".default"},
+		"grant_type":            {"client_credentials"},
+		"client_assertion_type": {"urn:ietf:params:oauth:client-assertion-type:jwt-bearer"},
+		"client_assertion":      {strings.TrimSpace(string(assertion))},
+	})
+	if err != nil {
+		return "", time.Time{}, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+	if resp.StatusCode != http.StatusOK {
+		body, _ := ioutil.ReadAll(resp.Body)
+		return "", time.Time{}, fmt.Errorf("failed to exchange federated token: %s: %s", resp.Status, string(body))
+	}
+	result := struct {
+		AccessToken string `json:"access_token"`
+		ExpiresIn   int64  `json:"expires_in"`
+	}{}
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return "", time.Time{}, err
+	}
+	return result.AccessToken, time.Now().Add(time.Duration(result.ExpiresIn) * time.Second), nil
+}
+
+// managedIdentityTokenSource gets a token for the (optionally user-assigned) managed identity of the node
+func managedIdentityTokenSource() (string, time.Time, error) {
+	msiEndpoint, err := adal.GetMSIEndpoint()
+	if err != nil {
+		return "", time.Time{}, err
+	}
+	var spt *adal.ServicePrincipalToken
+	if clientID := os.Getenv(envVarClientID); clientID != "" {
+		spt, err = adal.NewServicePrincipalTokenFromMSIWithUserAssignedID(msiEndpoint, storageResource, clientID)
+	} else {
+		spt, err = adal.NewServicePrincipalTokenFromMSI(msiEndpoint, storageResource)
+	}
+	if err != nil {
+		return "", time.Time{}, err
+	}
+	if err := spt.Refresh(); err != nil {
+		return "", time.Time{}, err
+	}
+	token := spt.Token()
+	return token.AccessToken, token.Expires(), nil
+}
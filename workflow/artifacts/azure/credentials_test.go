@@ -0,0 +1,66 @@
+package azure
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_workloadIdentityTokenSource(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/my-tenant/oauth2/v2.0/token" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_ = r.ParseForm()
+		if r.Form.Get("client_id") != "my-client" || r.Form.Get("client_assertion") != "my-assertion" || r.Form.Get("scope") != "https://storage.azure.com/.default" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		_, _ = w.Write([]byte(`{"access_token":"my-token","expires_in":3600}`))
+	}))
+	defer server.Close()
+	dir, err := ioutil.TempDir("", "azure")
+	if !assert.NoError(t, err) {
+		return
+	}
+	defer func() { _ = os.RemoveAll(dir) }()
+	tokenFile := filepath.Join(dir, "token")
+	if !assert.NoError(t, ioutil.WriteFile(tokenFile, []byte("my-assertion\n"), 0600)) {
+		return
+	}
+	setEnv(t, envVarAuthorityHost, server.URL+"/")
+	setEnv(t, envVarTenantID, "my-tenant")
+	setEnv(t, envVarFederatedTokenFile, tokenFile)
+	t.Run("Valid", func(t *testing.T) {
+		setEnv(t, envVarClientID, "my-client")
+		token, expiresOn, err := workloadIdentityTokenSource()
+		if assert.NoError(t, err) {
+			assert.Equal(t, "my-token", token)
+			assert.WithinDuration(t, time.Now().Add(time.Hour), expiresOn, time.Minute)
+		}
+	})
+	t.Run("Unauthorized", func(t *testing.T) {
+		setEnv(t, envVarClientID, "other-client")
+		_, _, err := workloadIdentityTokenSource()
+		assert.Error(t, err)
+	})
+}
+
+func setEnv(t *testing.T, key, value string) {
+	old, ok := os.LookupEnv(key)
+	_ = os.Setenv(key, value)
+	t.Cleanup(func() {
+		if ok {
+			_ = os.Setenv(key, old)
+		} else {
+			_ = os.Unsetenv(key)
+		}
+	})
+}
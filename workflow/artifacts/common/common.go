@@ -0,0 +1,26 @@
+package common
+
+import "io"
+
+// Sizer is implemented by the readers of artifact drivers which know the size of the artifact, so that it can be
+// served with a Content-Length
+type Sizer interface {
+	Size() int64
+}
+
+type sizedReadCloser struct {
+	io.ReadCloser
+	size int64
+}
+
+func (r *sizedReadCloser) Size() int64 {
+	return r.size
+}
+
+// NewSizedReadCloser returns a reader which implements Sizer, unless the size is unknown, i.e. negative
+func NewSizedReadCloser(rc io.ReadCloser, size int64) io.ReadCloser {
+	if size < 0 {
+		return rc
+	}
+	return &sizedReadCloser{ReadCloser: rc, size: size}
+}
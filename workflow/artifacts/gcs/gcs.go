@@ -249,3 +249,93 @@ func uploadObject(client *storage.Client, bucket, key, localPath string) error {
 	}
 	return nil
 }
+
+// gcsReadCloser closes the GCS client together with the object reader
+type gcsReadCloser struct {
+	*storage.Reader
+	client *storage.Client
+}
+
+func (r *gcsReadCloser) Close() error {
+	defer r.client.Close()
+	return r.Reader.Close()
+}
+
+// OpenStream opens a single GCS object for reading
+func (g *ArtifactDriver) OpenStream(inputArtifact *wfv1.Artifact) (io.ReadCloser, error) {
+	log.Infof("GCS OpenStream key: %s", inputArtifact.GCS.Key)
+	client, err := g.newGCSClient()
+	if err != nil {
+		return nil, err
+	}
+	rc, err := client.Bucket(inputArtifact.GCS.Bucket).Object(inputArtifact.GCS.Key).NewReader(context.Background())
+	if err != nil {
+		_ = client.Close()
+		if err == storage.ErrObjectNotExist {
+			return nil, errors.New(errors.CodeNotFound, err.Error())
+		}
+		return nil, fmt.Errorf("new bucket reader: %v", err)
+	}
+	return &gcsReadCloser{Reader: rc, client: client}, nil
+}
+
+// Delete removes a GCS object, or every object under the key if it is a directory
+func (g *ArtifactDriver) Delete(artifact *wfv1.Artifact) error {
+	err := wait.ExponentialBackoff(wait.Backoff{Duration: time.Second * 2, Factor: 2.0, Steps: 5, Jitter: 0.1},
+		func() (bool, error) {
+			log.Infof("GCS Delete key: %s", artifact.GCS.Key)
+			client, err := g.newGCSClient()
+			if err != nil {
+				return false, err
+			}
+			defer client.Close()
+			objNames, err := listObjects(client, artifact.GCS.Bucket, artifact.GCS.Key)
+			if err != nil {
+				return false, err
+			}
+			for _, objName := range objNames {
+				err = client.Bucket(artifact.GCS.Bucket).Object(objName).Delete(context.Background())
+				if err != nil && err != storage.ErrObjectNotExist {
+					return false, fmt.Errorf("delete %s: %v", objName, err)
+				}
+			}
+			return true, nil
+		})
+	return err
+}
+
+// ListObjects returns the name of the object itself, or the names of every object under it if it is a directory
+func (g *ArtifactDriver) ListObjects(artifact *wfv1.Artifact) ([]string, error) {
+	var objNames []string
+	err := wait.ExponentialBackoff(wait.Backoff{Duration: time.Second * 2, Factor: 2.0, Steps: 5, Jitter: 0.1},
+		func() (bool, error) {
+			log.Infof("GCS ListObjects key: %s", artifact.GCS.Key)
+			client, err := g.newGCSClient()
+			if err != nil {
+				return false, err
+			}
+			defer client.Close()
+			objNames, err = listObjects(client, artifact.GCS.Bucket, artifact.GCS.Key)
+			if err != nil {
+				return false, err
+			}
+			return true, nil
+		})
+	return objNames, err
+}
+
+// list the object named by key, or the objects under key if it is a directory, but not siblings sharing the prefix
+func listObjects(client *storage.Client, bucket, key string) ([]string, error) {
+	objNames, err := listByPrefix(client, bucket, key, "")
+	if err != nil {
+		return nil, err
+	}
+	dirPrefix := strings.TrimSuffix(key, "/") + "/"
+	results := []string{}
+	for _, objName := range objNames {
+		if objName == key || strings.HasPrefix(objName, dirPrefix) {
+			results = append(results, objName)
+		}
+	}
+	return results, nil
+}
@@ -2,6 +2,7 @@ package git
 
 import (
 	"errors"
+	"io"
 	"io/ioutil"
 	"os"
 	"os/exec"
@@ -95,6 +96,21 @@ func (g *GitArtifactDriver) Save(string, *wfv1.Artifact) error {
 	return errors.New("git output artifacts unsupported")
 }
 
+// OpenStream is unsupported for git artifacts, a repository is a directory rather than a single file
+func (g *GitArtifactDriver) OpenStream(*wfv1.Artifact) (io.ReadCloser, error) {
+	return nil, errors.New("git artifacts cannot be streamed")
+}
+
+// Delete is unsupported for git artifacts
+func (g *GitArtifactDriver) Delete(*wfv1.Artifact) error {
+	return errors.New("git artifacts cannot be deleted")
+}
+
+// ListObjects is unsupported for git artifacts
+func (g *GitArtifactDriver) ListObjects(*wfv1.Artifact) ([]string, error) {
+	return nil, errors.New("git artifacts cannot be listed")
+}
+
 func (g *GitArtifactDriver) Load(inputArtifact *wfv1.Artifact, path string) error {
 	closer, auth, env, err := g.auth()
 	if err != nil {
@@ -3,10 +3,12 @@ package hdfs
 import (
 	"context"
 	"fmt"
+	"io"
 	"os"
 	"path/filepath"
 
 	"github.com/argoproj/pkg/file"
+	"github.com/colinmarc/hdfs"
 	"gopkg.in/jcmturner/gokrb5.v5/credentials"
 	"gopkg.in/jcmturner/gokrb5.v5/keytab"
 
@@ -221,3 +223,73 @@ func (driver *ArtifactDriver) Save(path string, outputArtifact *wfv1.Artifact) e
 
 	return hdfscli.CopyToRemote(path, driver.Path)
 }
+
+// hdfsReadCloser closes the HDFS client together with the file reader
+type hdfsReadCloser struct {
+	*hdfs.FileReader
+	client *hdfs.Client
+}
+
+func (r *hdfsReadCloser) Close() error {
+	defer util.Close(r.client)
+	return r.FileReader.Close()
+}
+
+// OpenStream opens the HDFS file for reading
+func (driver *ArtifactDriver) OpenStream(_ *wfv1.Artifact) (io.ReadCloser, error) {
+	hdfscli, err := createHDFSClient(driver.Addresses, driver.HDFSUser, driver.KrbOptions)
+	if err != nil {
+		return nil, err
+	}
+	reader, err := hdfscli.Open(driver.Path)
+	if err != nil {
+		util.Close(hdfscli)
+		if os.IsNotExist(err) {
+			return nil, errors.New(errors.CodeNotFound, err.Error())
+		}
+		return nil, err
+	}
+	return &hdfsReadCloser{FileReader: reader, client: hdfscli}, nil
+}
+
+// Delete removes the HDFS file or directory
+func (driver *ArtifactDriver) Delete(_ *wfv1.Artifact) error {
+	hdfscli, err := createHDFSClient(driver.Addresses, driver.HDFSUser, driver.KrbOptions)
+	if err != nil {
+		return err
+	}
+	defer util.Close(hdfscli)
+
+	err = hdfscli.Remove(driver.Path)
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	return nil
+}
+
+// ListObjects returns the path of the file itself, or the paths of every file under it if it is a directory
+func (driver *ArtifactDriver) ListObjects(_ *wfv1.Artifact) ([]string, error) {
+	hdfscli, err := createHDFSClient(driver.Addresses, driver.HDFSUser, driver.KrbOptions)
+	if err != nil {
+		return nil, err
+	}
+	defer util.Close(hdfscli)
+
+	var paths []string
+	err = hdfscli.Walk(driver.Path, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if !info.IsDir() {
+			paths = append(paths, path)
+		}
+		return nil
+	})
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, errors.New(errors.CodeNotFound, err.Error())
+		}
+		return nil, err
+	}
+	return paths, nil
+}
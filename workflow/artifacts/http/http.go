@@ -2,6 +2,8 @@ package http
 
 import (
 	"fmt"
+	"io"
+	nethttp "net/http"
 	"os/exec"
 	"strings"
 
@@ -9,6 +11,7 @@ import (
 
 	"github.com/argoproj/argo/v2/errors"
 	wfv1 "github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo/v2/workflow/artifacts/common"
 )
 
 // HTTPArtifactDriver is the artifact driver for a HTTP URL
@@ -41,6 +44,38 @@ func (h *HTTPArtifactDriver) Load(inputArtifact *wfv1.Artifact, path string) err
 	return nil
 }
 
+// OpenStream opens the HTTP URL for reading
+func (h *HTTPArtifactDriver) OpenStream(inputArtifact *wfv1.Artifact) (io.ReadCloser, error) {
+	req, err := nethttp.NewRequest(nethttp.MethodGet, inputArtifact.HTTP.URL, nil)
+	if err != nil {
+		return nil, err
+	}
+	for _, v := range inputArtifact.HTTP.Headers {
+		req.Header.Add(v.Name, v.Value)
+	}
+	res, err := nethttp.DefaultClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if res.StatusCode == nethttp.StatusNotFound {
+		_ = res.Body.Close()
+		return nil, errors.New(errors.CodeNotFound, res.Status)
+	}
+	if res.StatusCode < 200 || res.StatusCode >= 300 {
+		_ = res.Body.Close()
+		return nil, errors.InternalErrorf("loading %s failed with reason: %s", inputArtifact.HTTP.URL, res.Status)
+	}
+	return common.NewSizedReadCloser(res.Body, res.ContentLength), nil
+}
+
 func (h *HTTPArtifactDriver) Save(string, *wfv1.Artifact) error {
 	return errors.Errorf(errors.CodeBadRequest, "HTTP output artifacts unsupported")
 }
+
+func (h *HTTPArtifactDriver) Delete(*wfv1.Artifact) error {
+	return errors.Errorf(errors.CodeBadRequest, "HTTP artifacts cannot be deleted")
+}
+
+func (h *HTTPArtifactDriver) ListObjects(*wfv1.Artifact) ([]string, error) {
+	return nil, errors.Errorf(errors.CodeBadRequest, "HTTP artifacts cannot be listed")
+}
@@ -2,6 +2,9 @@ package http
 
 import (
 	"bytes"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
 	"os"
 	"regexp"
 	"testing"
@@ -66,7 +69,53 @@ func TestHTTPArtifactDriver_Load(t *testing.T) {
 	})
 }
 
+func TestHTTPArtifactDriver_OpenStream(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/found" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_, _ = w.Write([]byte(r.Header.Get("Authorization")))
+	}))
+	defer server.Close()
+	driver := &HTTPArtifactDriver{}
+	t.Run("Found", func(t *testing.T) {
+		rc, err := driver.OpenStream(&wfv1.Artifact{
+			ArtifactLocation: wfv1.ArtifactLocation{
+				HTTP: &wfv1.HTTPArtifact{
+					URL:     server.URL + "/found",
+					Headers: []wfv1.Header{{Name: "Authorization", Value: "Bearer foo-bar"}},
+				},
+			},
+		})
+		if assert.NoError(t, err) {
+			defer func() { _ = rc.Close() }()
+			data, err := ioutil.ReadAll(rc)
+			assert.NoError(t, err)
+			assert.Equal(t, "Bearer foo-bar", string(data))
+		}
+	})
+	t.Run("NotFound", func(t *testing.T) {
+		_, err := driver.OpenStream(&wfv1.Artifact{
+			ArtifactLocation: wfv1.ArtifactLocation{
+				HTTP: &wfv1.HTTPArtifact{URL: server.URL + "/not-found"},
+			},
+		})
+		if assert.Error(t, err) {
+			argoError, ok := err.(errors.ArgoError)
+			if assert.True(t, ok) {
+				assert.Equal(t, errors.CodeNotFound, argoError.Code())
+			}
+		}
+	})
+}
+
 func TestHTTPArtifactDriver_Save(t *testing.T) {
 	driver := &HTTPArtifactDriver{}
 	assert.Error(t, driver.Save("", nil))
 }
+
+func TestHTTPArtifactDriver_Delete(t *testing.T) {
+	driver := &HTTPArtifactDriver{}
+	assert.Error(t, driver.Delete(nil))
+}
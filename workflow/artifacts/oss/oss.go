@@ -1,6 +1,8 @@
 package oss
 
 import (
+	"io"
+	"strings"
 	"time"
 
 	log "github.com/sirupsen/logrus"
@@ -8,6 +10,7 @@ import (
 
 	"github.com/aliyun/aliyun-oss-go-sdk/oss"
 
+	"github.com/argoproj/argo/v2/errors"
 	wfv1 "github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1"
 )
 
@@ -75,3 +78,88 @@ func (ossDriver *OSSArtifactDriver) Save(path string, outputArtifact *wfv1.Artif
 		})
 	return err
 }
+
+// OpenStream opens a single OSS object for reading
+func (ossDriver *OSSArtifactDriver) OpenStream(inputArtifact *wfv1.Artifact) (io.ReadCloser, error) {
+	log.Infof("OSS OpenStream key: %s", inputArtifact.OSS.Key)
+	osscli, err := ossDriver.newOSSClient()
+	if err != nil {
+		return nil, err
+	}
+	bucket, err := osscli.Bucket(inputArtifact.OSS.Bucket)
+	if err != nil {
+		return nil, err
+	}
+	rc, err := bucket.GetObject(inputArtifact.OSS.Key)
+	if err != nil {
+		if ossErr, ok := err.(oss.ServiceError); ok && ossErr.Code == "NoSuchKey" {
+			return nil, errors.New(errors.CodeNotFound, err.Error())
+		}
+		return nil, err
+	}
+	return rc, nil
+}
+
+// Delete removes an OSS object, or every object under the key if it is a directory
+func (ossDriver *OSSArtifactDriver) Delete(artifact *wfv1.Artifact) error {
+	objectNames, err := ossDriver.ListObjects(artifact)
+	if err != nil {
+		return err
+	}
+	return wait.ExponentialBackoff(wait.Backoff{Duration: time.Second * 2, Factor: 2.0, Steps: 5, Jitter: 0.1},
+		func() (bool, error) {
+			log.Infof("OSS Delete key: %s", artifact.OSS.Key)
+			osscli, err := ossDriver.newOSSClient()
+			if err != nil {
+				return false, err
+			}
+			bucket, err := osscli.Bucket(artifact.OSS.Bucket)
+			if err != nil {
+				return false, err
+			}
+			for _, objectName := range objectNames {
+				err = bucket.DeleteObject(objectName)
+				if err != nil {
+					return false, err
+				}
+			}
+			return true, nil
+		})
+}
+
+// ListObjects returns the name of the object itself, or the names of every object under it if it is a directory
+func (ossDriver *OSSArtifactDriver) ListObjects(artifact *wfv1.Artifact) ([]string, error) {
+	var objectNames []string
+	err := wait.ExponentialBackoff(wait.Backoff{Duration: time.Second * 2, Factor: 2.0, Steps: 5, Jitter: 0.1},
+		func() (bool, error) {
+			log.Infof("OSS ListObjects key: %s", artifact.OSS.Key)
+			osscli, err := ossDriver.newOSSClient()
+			if err != nil {
+				return false, err
+			}
+			bucket, err := osscli.Bucket(artifact.OSS.Bucket)
+			if err != nil {
+				return false, err
+			}
+			objectNames = nil
+			dirPrefix := strings.TrimSuffix(artifact.OSS.Key, "/") + "/"
+			marker := oss.Marker("")
+			for {
+				result, err := bucket.ListObjects(oss.Prefix(artifact.OSS.Key), marker)
+				if err != nil {
+					return false, err
+				}
+				for _, object := range result.Objects {
+					if object.Key == artifact.OSS.Key || strings.HasPrefix(object.Key, dirPrefix) {
+						objectNames = append(objectNames, object.Key)
+					}
+				}
+				if !result.IsTruncated {
+					break
+				}
+				marker = oss.Marker(result.NextMarker)
+			}
+			return true, nil
+		})
+	return objectNames, err
+}
@@ -1,7 +1,9 @@
 package raw
 
 import (
+	"io"
 	"os"
+	"strings"
 
 	"github.com/argoproj/argo/v2/errors"
 	wfv1 "github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1"
@@ -24,7 +26,31 @@ func (a *RawArtifactDriver) Load(artifact *wfv1.Artifact, path string) error {
 	return err
 }
 
+// rawReadCloser is a no-op closer over the raw content, which can seek, unlike ioutil.NopCloser
+type rawReadCloser struct {
+	*strings.Reader
+}
+
+func (rawReadCloser) Close() error {
+	return nil
+}
+
+// OpenStream returns a reader over the raw content
+func (a *RawArtifactDriver) OpenStream(artifact *wfv1.Artifact) (io.ReadCloser, error) {
+	return rawReadCloser{strings.NewReader(artifact.Raw.Data)}, nil
+}
+
 // Save is unsupported for raw output artifacts
 func (g *RawArtifactDriver) Save(string, *wfv1.Artifact) error {
 	return errors.Errorf(errors.CodeBadRequest, "Raw output artifacts unsupported")
 }
+
+// Delete is unsupported for raw artifacts, the content lives in the workflow itself
+func (g *RawArtifactDriver) Delete(*wfv1.Artifact) error {
+	return errors.Errorf(errors.CodeBadRequest, "Raw artifacts cannot be deleted")
+}
+
+// ListObjects is unsupported for raw artifacts
+func (g *RawArtifactDriver) ListObjects(*wfv1.Artifact) ([]string, error) {
+	return nil, errors.Errorf(errors.CodeBadRequest, "Raw artifacts cannot be listed")
+}
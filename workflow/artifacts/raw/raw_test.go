@@ -37,3 +37,18 @@ func TestLoad(t *testing.T) {
 	assert.Equal(t, content, string(dat))
 
 }
+
+func TestOpenStream(t *testing.T) {
+	art := &wfv1.Artifact{}
+	art.Raw = &wfv1.RawArtifact{
+		Data: "my-data",
+	}
+	driver := &raw.RawArtifactDriver{}
+	rc, err := driver.OpenStream(art)
+	assert.NoError(t, err)
+	defer rc.Close()
+
+	dat, err := ioutil.ReadAll(rc)
+	assert.NoError(t, err)
+	assert.Equal(t, "my-data", string(dat))
+}
@@ -2,7 +2,9 @@ package s3
 
 import (
 	"context"
+	"io"
 	"os"
+	"strings"
 	"time"
 
 	"github.com/minio/minio-go/v7"
@@ -45,6 +47,31 @@ func (s3Driver *S3ArtifactDriver) newS3Client(ctx context.Context) (argos3.S3Cli
 	return argos3.NewS3Client(ctx, opts)
 }
 
+// newMinioClient instantiates a raw minio client, for the operations that argos3.S3Client does not offer.
+func (s3Driver *S3ArtifactDriver) newMinioClient() (*minio.Client, error) {
+	opts := argos3.S3ClientOpts{
+		Endpoint:    s3Driver.Endpoint,
+		Region:      s3Driver.Region,
+		Secure:      s3Driver.Secure,
+		AccessKey:   strings.TrimSpace(s3Driver.AccessKey),
+		SecretKey:   strings.TrimSpace(s3Driver.SecretKey),
+		RoleARN:     s3Driver.RoleARN,
+		UseSDKCreds: s3Driver.UseSDKCreds,
+	}
+	credentials, err := argos3.GetCredentials(opts)
+	if err != nil {
+		return nil, err
+	}
+	minioClient, err := minio.New(opts.Endpoint, &minio.Options{Creds: credentials, Secure: opts.Secure, Region: opts.Region})
+	if err != nil {
+		return nil, err
+	}
+	if os.Getenv(common.EnvVarArgoTrace) == "1" {
+		minioClient.TraceOn(log.StandardLogger().Out)
+	}
+	return minioClient, nil
+}
+
 // Load downloads artifacts from S3 compliant storage
 func (s3Driver *S3ArtifactDriver) Load(inputArtifact *wfv1.Artifact, path string) error {
 	ctx, cancel := context.WithCancel(context.Background())
@@ -133,3 +160,77 @@ func (s3Driver *S3ArtifactDriver) Save(path string, outputArtifact *wfv1.Artifac
 		})
 	return err
 }
+
+// OpenStream opens a single S3 object for reading
+func (s3Driver *S3ArtifactDriver) OpenStream(inputArtifact *wfv1.Artifact) (io.ReadCloser, error) {
+	log.Infof("S3 OpenStream key: %s", inputArtifact.S3.Key)
+	minioClient, err := s3Driver.newMinioClient()
+	if err != nil {
+		return nil, err
+	}
+	ctx := context.Background()
+	// GetObject only fails lazily, so we stat first to surface a missing key up-front
+	_, err = minioClient.StatObject(ctx, inputArtifact.S3.Bucket, inputArtifact.S3.Key, minio.StatObjectOptions{})
+	if err != nil {
+		if argos3.IsS3ErrCode(err, "NoSuchKey") {
+			return nil, errors.New(errors.CodeNotFound, err.Error())
+		}
+		return nil, err
+	}
+	return minioClient.GetObject(ctx, inputArtifact.S3.Bucket, inputArtifact.S3.Key, minio.GetObjectOptions{})
+}
+
+// Delete removes an S3 object, or every object under the key if it is a "directory"
+func (s3Driver *S3ArtifactDriver) Delete(artifact *wfv1.Artifact) error {
+	keys, err := s3Driver.ListObjects(artifact)
+	if err != nil {
+		return err
+	}
+	return wait.ExponentialBackoff(wait.Backoff{Duration: time.Second * 2, Factor: 2.0, Steps: 5, Jitter: 0.1},
+		func() (bool, error) {
+			log.Infof("S3 Delete key: %s", artifact.S3.Key)
+			minioClient, err := s3Driver.newMinioClient()
+			if err != nil {
+				log.Warnf("Failed to create new S3 client: %v", err)
+				return false, nil
+			}
+			for _, key := range keys {
+				err := minioClient.RemoveObject(context.Background(), artifact.S3.Bucket, key, minio.RemoveObjectOptions{})
+				if err != nil && !argos3.IsS3ErrCode(err, "NoSuchKey") {
+					log.Warnf("Failed to delete %s: %v", key, err)
+					return false, nil
+				}
+			}
+			return true, nil
+		})
+}
+
+// ListObjects returns the key of the object itself, or the keys of every object under it if it is a "directory"
+func (s3Driver *S3ArtifactDriver) ListObjects(artifact *wfv1.Artifact) ([]string, error) {
+	var keys []string
+	err := wait.ExponentialBackoff(wait.Backoff{Duration: time.Second * 2, Factor: 2.0, Steps: 5, Jitter: 0.1},
+		func() (bool, error) {
+			log.Infof("S3 ListObjects key: %s", artifact.S3.Key)
+			minioClient, err := s3Driver.newMinioClient()
+			if err != nil {
+				log.Warnf("Failed to create new S3 client: %v", err)
+				return false, nil
+			}
+			keys = nil
+			// stops the listing if we return before it has finished
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			// a key is either an object or a "directory", so we list "key" and "key/..." but not "keyfoo"
+			for obj := range minioClient.ListObjects(ctx, artifact.S3.Bucket, minio.ListObjectsOptions{Prefix: artifact.S3.Key, Recursive: true}) {
+				if obj.Err != nil {
+					log.Warnf("Failed to list objects: %v", obj.Err)
+					return false, nil
+				}
+				if obj.Key == artifact.S3.Key || strings.HasPrefix(obj.Key, strings.TrimSuffix(artifact.S3.Key, "/")+"/") {
+					keys = append(keys, obj.Key)
+				}
+			}
+			return true, nil
+		})
+	return keys, err
+}
@@ -46,11 +46,18 @@ const (
 	AnnotationKeyTemplate = workflow.WorkflowFullName + "/template"
 	// AnnotationKeyOutputs is the pod metadata annotation key containing the container outputs
 	AnnotationKeyOutputs = workflow.WorkflowFullName + "/outputs"
+	// AnnotationKeyContainerOutputs is the pod metadata annotation key containing the outputs of each container of a
+	// container set, by container name
+	AnnotationKeyContainerOutputs = workflow.WorkflowFullName + "/container-outputs"
 	// AnnotationKeyExecutionControl is the pod metadata annotation key containing execution control parameters
 	// set by the controller and obeyed by the executor. For example, the controller will use this annotation to
 	// signal the executors of daemoned containers that it should terminate.
 	AnnotationKeyExecutionControl = workflow.WorkflowFullName + "/execution"
 
+	// FinalizerArtifactGC is the finalizer the controller adds to workflows with output artifacts to garbage collect.
+	// It is removed once the artifacts have been deleted.
+	FinalizerArtifactGC = workflow.WorkflowFullName + "/artifact-gc"
+
 	// LabelKeyControllerInstanceID is the label the controller will carry forward to workflows/pod labels
 	// for the purposes of workflow segregation
 	LabelKeyControllerInstanceID = workflow.WorkflowFullName + "/controller-instanceid"
@@ -83,6 +90,10 @@ const (
 	LabelKeyClusterWorkflowTemplate = workflow.WorkflowFullName + "/cluster-workflow-template"
 	// LabelKeyOnExit is a label applied to Pods that are run from onExit nodes, so that they are not shut down when stopping a Workflow
 	LabelKeyOnExit = workflow.WorkflowFullName + "/on-exit"
+	// LabelKeyConfigMapType is a label applied to ConfigMaps to indicate their type, e.g. that they are memoization caches
+	LabelKeyConfigMapType = workflow.WorkflowFullName + "/configmap-type"
+	// LabelValueTypeConfigMapCache is the value of LabelKeyConfigMapType for the ConfigMaps of memoization caches
+	LabelValueTypeConfigMapCache = "Cache"
 
 	// ExecutorArtifactBaseDir is the base directory in the init container in which artifacts will be copied to.
 	// Each artifact will be named according to its input name (e.g: /argo/inputs/artifacts/CODE)
@@ -101,6 +112,15 @@ const (
 	// ExecutorResourceManifestPath is the path which init will write the a manifest file to for resource templates
 	ExecutorResourceManifestPath = "/tmp/manifest.yaml"
 
+	// ExecutorVarRunArgoDir is the path of the emptydir shared by the init container and the containers of a container set
+	ExecutorVarRunArgoDir = "/var/run/argo"
+	// ExecutorContainerSetPath is the path which init copies argoexec to for container set templates. The containers of
+	// the set run their command through it, so that they start once their dependencies have succeeded.
+	ExecutorContainerSetPath = "/var/run/argo/argoexec"
+	// ContainerSetOmittedMessagePrefix prefixes the termination message of a container of a container set that did not
+	// run because one of its dependencies did not succeed
+	ContainerSetOmittedMessagePrefix = "omitted: "
+
 	// Various environment variables containing pod information exposed to the executor container(s)
 
 	// EnvVarPodName contains the name of the pod (currently unused)
@@ -154,6 +174,14 @@ const (
 	LocalVarPodName = "pod.name"
 	// LocalVarRetries is a step level variable that references the retries number if retryStrategy is specified
 	LocalVarRetries = "retries"
+	// LocalVarRetriesLastExitCode is a retryStrategy.expression variable that references the exit code of the last retry
+	LocalVarRetriesLastExitCode = "lastRetry.exitCode"
+	// LocalVarRetriesLastStatus is a retryStrategy.expression variable that references the phase of the last retry
+	LocalVarRetriesLastStatus = "lastRetry.status"
+	// LocalVarRetriesLastDuration is a retryStrategy.expression variable that references the duration of the last retry in seconds
+	LocalVarRetriesLastDuration = "lastRetry.duration"
+	// LocalVarRetriesLastMessage is a retryStrategy.expression variable that references the message of the last retry
+	LocalVarRetriesLastMessage = "lastRetry.message"
 	// LocalVarDuration is a step level variable (currently only available in metric emission) that tracks the duration of the step
 	LocalVarDuration = "duration"
 	// LocalVarStatus is a step level variable (currently only available in metric emission) that tracks the duration of the step
@@ -171,7 +199,7 @@ const (
 )
 
 // GlobalVarWorkflowRootTags is a list of root tags in workflow which could be used for variable reference
-var GlobalVarValidWorkflowVariablePrefix = []string{"item.", "steps.", "inputs.", "outputs.", "pod.", "workflow.", "tasks."}
+var GlobalVarValidWorkflowVariablePrefix = []string{"item.", "steps.", "inputs.", "outputs.", "pod.", "workflow.", "tasks.", "lastRetry."}
 
 // ExecutionControl contains execution control parameters for executor to decide how to execute the container
 type ExecutionControl struct {
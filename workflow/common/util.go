@@ -44,6 +44,8 @@ func FindOverlappingVolume(tmpl *wfv1.Template, path string) *apiv1.VolumeMount
 		volMounts = tmpl.Container.VolumeMounts
 	} else if tmpl.Script != nil {
 		volMounts = tmpl.Script.VolumeMounts
+	} else if tmpl.ContainerSet != nil {
+		volMounts = tmpl.ContainerSet.VolumeMounts
 	} else {
 		return nil
 	}
@@ -738,3 +740,8 @@ func SplitClusterWorkflowTemplateYAMLFile(body []byte, strict bool) ([]wfv1.Clus
 func GenerateOnExitNodeName(parentDisplayName string) string {
 	return fmt.Sprintf("%s.onExit", parentDisplayName)
 }
+
+// GenerateLifecycleHookNodeName returns the name of the node of a hook of the node
+func GenerateLifecycleHookNodeName(parentNodeName, hookName string) string {
+	return fmt.Sprintf("%s.hooks.%s", parentNodeName, hookName)
+}
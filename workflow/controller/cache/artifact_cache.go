@@ -0,0 +1,276 @@
+package cache
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/argoproj/argo/v2/errors"
+	wfv1 "github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo/v2/workflow/artifactrepositories"
+	artifact "github.com/argoproj/argo/v2/workflow/artifacts"
+)
+
+// artifactCache stores each entry as a JSON file, "memoization/{name}/{key}/{created}-{size}.json", in the default
+// artifact repository. The creation time (in Unix nanoseconds) and size of an entry are in its object key, so that
+// entries can be evicted by listing the objects of the cache, without reading them.
+type artifactCache struct {
+	namespace            string
+	name                 string
+	kubeClient           kubernetes.Interface
+	artifactRepositories artifactrepositories.Interface
+	newDriver            artifact.NewDriverFunc
+	lock                 sync.Mutex
+}
+
+func NewArtifactCache(ns string, ki kubernetes.Interface, artifactRepositories artifactrepositories.Interface, n string) MemoizationCache {
+	return &artifactCache{
+		namespace:            ns,
+		name:                 n,
+		kubeClient:           ki,
+		artifactRepositories: artifactRepositories,
+		newDriver:            artifact.NewDriver,
+	}
+}
+
+func (c *artifactCache) prefix() string {
+	return path.Join("memoization", c.name)
+}
+
+// driver returns the artifact at the key in the default artifact repository, and its driver
+func (c *artifactCache) driver(ctx context.Context, key string) (*wfv1.Artifact, artifact.ArtifactDriver, error) {
+	repo, err := c.artifactRepositories.Get(ctx, wfv1.DefaultArtifactRepositoryRefStatus)
+	if err != nil {
+		return nil, nil, err
+	}
+	if repo == nil {
+		return nil, nil, fmt.Errorf("artifact cache %s needs a default artifact repository to be configured", c.name)
+	}
+	// entries are found and evicted by listing the objects under a key, which Artifactory artifacts cannot do
+	if repo.Artifactory != nil {
+		return nil, nil, fmt.Errorf("artifact cache %s cannot use an Artifactory artifact repository, as its objects cannot be listed", c.name)
+	}
+	l := repo.ToArtifactLocation()
+	if err := l.SetKey(key); err != nil {
+		return nil, nil, err
+	}
+	art := &wfv1.Artifact{Name: path.Base(key), ArtifactLocation: *l}
+	driver, err := c.newDriver(ctx, art, resources{kubeClient: c.kubeClient, namespace: c.namespace})
+	if err != nil {
+		return nil, nil, err
+	}
+	return art, driver, nil
+}
+
+// keyPrefix is the prefix of the objects of the entry at the key, there is more than one if the entry was saved more
+// than once at the same time
+func (c *artifactCache) keyPrefix(key string) string {
+	return path.Join(c.prefix(), key)
+}
+
+// entryObject is an object of an entry
+type entryObject struct {
+	key string
+	entryInfo
+}
+
+// parseEntryObject parses the key of an entry's object, it returns false if the object is not an entry
+func parseEntryObject(key string) (entryObject, bool) {
+	parts := strings.SplitN(strings.TrimSuffix(path.Base(key), ".json"), "-", 2)
+	if !strings.HasSuffix(key, ".json") || len(parts) != 2 {
+		return entryObject{}, false
+	}
+	created, err := strconv.ParseInt(parts[0], 10, 64)
+	if err != nil {
+		return entryObject{}, false
+	}
+	size, err := strconv.ParseInt(parts[1], 10, 64)
+	if err != nil {
+		return entryObject{}, false
+	}
+	return entryObject{key: key, entryInfo: entryInfo{created: time.Unix(0, created), size: size}}, true
+}
+
+// listEntryObjects lists the objects of the entries under the prefix
+func (c *artifactCache) listEntryObjects(ctx context.Context, prefix string) ([]entryObject, error) {
+	art, driver, err := c.driver(ctx, prefix)
+	if err != nil {
+		return nil, err
+	}
+	keys, err := driver.ListObjects(art)
+	if err != nil {
+		return nil, fmt.Errorf("could not list artifact cache entries: %w", err)
+	}
+	var objects []entryObject
+	for _, key := range keys {
+		if object, ok := parseEntryObject(key); ok {
+			objects = append(objects, object)
+		}
+	}
+	return objects, nil
+}
+
+func (c *artifactCache) delete(ctx context.Context, key string) error {
+	art, driver, err := c.driver(ctx, key)
+	if err != nil {
+		return err
+	}
+	return driver.Delete(art)
+}
+
+func (c *artifactCache) Load(ctx context.Context, key string) (*Entry, error) {
+	if !cacheKeyRegex.MatchString(key) {
+		return nil, fmt.Errorf("invalid cache key: %s", key)
+	}
+	objects, err := c.listEntryObjects(ctx, c.keyPrefix(key))
+	if err != nil {
+		return nil, err
+	}
+	var newest *entryObject
+	for i, object := range objects {
+		if newest == nil || object.created.After(newest.created) {
+			newest = &objects[i]
+		}
+	}
+	if newest == nil {
+		log.WithFields(log.Fields{"name": c.name, "key": key}).Debug("artifact cache miss: entry does not exist")
+		return nil, nil
+	}
+	return c.load(ctx, newest.key)
+}
+
+func (c *artifactCache) load(ctx context.Context, key string) (*Entry, error) {
+	art, driver, err := c.driver(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+	stream, err := driver.OpenStream(art)
+	if err != nil {
+		if errors.IsCode(errors.CodeNotFound, err) {
+			log.WithFields(log.Fields{"name": c.name, "key": key}).Debug("artifact cache miss: entry does not exist")
+			return nil, nil
+		}
+		return nil, fmt.Errorf("could not load artifact cache entry: %w", err)
+	}
+	defer func() { _ = stream.Close() }()
+	var entry Entry
+	err = json.NewDecoder(stream).Decode(&entry)
+	if err != nil {
+		return nil, fmt.Errorf("malformed cache entry: could not unmarshal JSON; unable to parse: %w", err)
+	}
+	return &entry, nil
+}
+
+func (c *artifactCache) Save(ctx context.Context, key string, nodeId string, value *wfv1.Outputs, nodes []EntryNode) error {
+	if !cacheKeyRegex.MatchString(key) {
+		return fmt.Errorf("invalid cache key: %s", key)
+	}
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	log.WithFields(log.Fields{"name": c.name, "key": key, "nodeId": nodeId}).Info("Saving artifact cache entry")
+	created := time.Now()
+	entryJSON, err := json.Marshal(Entry{NodeID: nodeId, Outputs: value, CreationTimestamp: metav1.Time{Time: created}, Nodes: nodes})
+	if err != nil {
+		return fmt.Errorf("unable to marshal cache entry: %w", err)
+	}
+	file, err := ioutil.TempFile("", "memoization")
+	if err != nil {
+		return err
+	}
+	defer func() { _ = os.Remove(file.Name()) }()
+	_, err = file.Write(entryJSON)
+	if err != nil {
+		_ = file.Close()
+		return err
+	}
+	err = file.Close()
+	if err != nil {
+		return err
+	}
+	objectKey := path.Join(c.keyPrefix(key), fmt.Sprintf("%d-%d.json", created.UnixNano(), len(entryJSON)))
+	art, driver, err := c.driver(ctx, objectKey)
+	if err != nil {
+		return err
+	}
+	err = driver.Save(file.Name(), art)
+	if err != nil {
+		return fmt.Errorf("error creating cache entry: %w", err)
+	}
+	// the entry replaces the previous ones at the key
+	objects, err := c.listEntryObjects(ctx, c.keyPrefix(key))
+	if err != nil {
+		return err
+	}
+	for _, object := range objects {
+		if object.key != objectKey {
+			if err := c.delete(ctx, object.key); err != nil {
+				log.WithFields(log.Fields{"name": c.name, "key": object.key}).WithError(err).Warn("Failed to delete replaced cache entry")
+			}
+		}
+	}
+	return nil
+}
+
+func (c *artifactCache) Evict(ctx context.Context, maxAge time.Duration, maxSize int64) (int, error) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	objects, err := c.listEntryObjects(ctx, c.prefix())
+	if err != nil {
+		return 0, err
+	}
+	entries := make(map[string]entryInfo, len(objects))
+	for _, object := range objects {
+		entries[object.key] = object.entryInfo
+	}
+	evicted := 0
+	for _, key := range keysToEvict(entries, maxAge, maxSize) {
+		err := c.delete(ctx, key)
+		if err != nil {
+			return evicted, fmt.Errorf("error evicting cache entry %s: %w", key, err)
+		}
+		evicted++
+	}
+	return evicted, nil
+}
+
+// listArtifactCacheNames returns the names of the artifact caches of the default artifact repository, if any
+func listArtifactCacheNames(ctx context.Context, namespace string, kubeClient kubernetes.Interface, artifactRepositories artifactrepositories.Interface) ([]string, error) {
+	repo, err := artifactRepositories.Get(ctx, wfv1.DefaultArtifactRepositoryRefStatus)
+	if err != nil || repo == nil {
+		return nil, err
+	}
+	c := NewArtifactCache(namespace, kubeClient, artifactRepositories, "").(*artifactCache)
+	return c.listCacheNames(ctx)
+}
+
+func (c *artifactCache) listCacheNames(ctx context.Context) ([]string, error) {
+	objects, err := c.listEntryObjects(ctx, c.prefix())
+	if err != nil {
+		return nil, err
+	}
+	found := make(map[string]bool)
+	var names []string
+	for _, object := range objects {
+		// memoization/{name}/{key}/{created}-{size}.json
+		parts := strings.Split(strings.TrimPrefix(object.key, c.prefix()+"/"), "/")
+		if len(parts) == 3 && !found[parts[0]] {
+			found[parts[0]] = true
+			names = append(names, parts[0])
+		}
+	}
+	return names, nil
+}
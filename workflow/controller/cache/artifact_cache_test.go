@@ -0,0 +1,169 @@
+package cache
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"io/ioutil"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/argoproj/argo/v2/config"
+	"github.com/argoproj/argo/v2/errors"
+	wfv1 "github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1"
+	armocks "github.com/argoproj/argo/v2/workflow/artifactrepositories/mocks"
+	artifact "github.com/argoproj/argo/v2/workflow/artifacts"
+	"github.com/argoproj/argo/v2/workflow/artifacts/resource"
+)
+
+// memoryDriver stores S3 artifacts in memory
+type memoryDriver map[string][]byte
+
+func (d memoryDriver) Load(*wfv1.Artifact, string) error { return nil }
+
+func (d memoryDriver) OpenStream(a *wfv1.Artifact) (io.ReadCloser, error) {
+	data, ok := d[a.S3.Key]
+	if !ok {
+		return nil, errors.New(errors.CodeNotFound, a.S3.Key)
+	}
+	return ioutil.NopCloser(bytes.NewReader(data)), nil
+}
+
+func (d memoryDriver) Save(path string, a *wfv1.Artifact) error {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	d[a.S3.Key] = data
+	return nil
+}
+
+func (d memoryDriver) Delete(a *wfv1.Artifact) error {
+	delete(d, a.S3.Key)
+	return nil
+}
+
+func (d memoryDriver) ListObjects(a *wfv1.Artifact) ([]string, error) {
+	var keys []string
+	for key := range d {
+		if strings.HasPrefix(key, a.S3.Key+"/") {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+	return keys, nil
+}
+
+func newArtifactCache(driver memoryDriver) *artifactCache {
+	c := NewArtifactCache("default", fake.NewSimpleClientset(), armocks.DummyArtifactRepositories(&config.ArtifactRepository{
+		S3: &config.S3ArtifactRepository{S3Bucket: wfv1.S3Bucket{Endpoint: "my-endpoint", Bucket: "my-bucket"}},
+	}), "my-cache").(*artifactCache)
+	c.newDriver = func(context.Context, *wfv1.Artifact, resource.Interface) (artifact.ArtifactDriver, error) {
+		return driver, nil
+	}
+	return c
+}
+
+func TestArtifactCache(t *testing.T) {
+	ctx := context.Background()
+	driver := memoryDriver{}
+	c := newArtifactCache(driver)
+
+	entry, err := c.Load(ctx, "my-key")
+	if assert.NoError(t, err) {
+		assert.False(t, entry.Hit())
+	}
+
+	err = c.Save(ctx, "my-key", "my-node", &wfv1.Outputs{Parameters: []wfv1.Parameter{{Name: "foo", Value: wfv1.AnyStringPtr("bar")}}}, nil)
+	if assert.NoError(t, err) && assert.Len(t, driver, 1) {
+		for key, data := range driver {
+			object, ok := parseEntryObject(key)
+			if assert.True(t, ok, key) {
+				assert.True(t, strings.HasPrefix(key, "memoization/my-cache/my-key/"))
+				assert.Equal(t, int64(len(data)), object.size)
+				assert.WithinDuration(t, time.Now(), object.created, time.Minute)
+			}
+		}
+	}
+
+	entry, err = c.Load(ctx, "my-key")
+	if assert.NoError(t, err) && assert.True(t, entry.Hit()) {
+		assert.Equal(t, "my-node", entry.NodeID)
+		assert.Equal(t, "bar", entry.GetOutputs().Parameters[0].Value.String())
+	}
+
+	err = c.Save(ctx, "my-key", "my-other-node", nil, nil)
+	if assert.NoError(t, err) {
+		assert.Len(t, driver, 1, "the entry replaces the previous one")
+	}
+	entry, err = c.Load(ctx, "my-key")
+	if assert.NoError(t, err) && assert.True(t, entry.Hit()) {
+		assert.Equal(t, "my-other-node", entry.NodeID)
+	}
+
+	_, err = c.Load(ctx, "not/valid")
+	assert.Error(t, err)
+}
+
+func TestArtifactCache_Artifactory(t *testing.T) {
+	ctx := context.Background()
+	c := NewArtifactCache("default", fake.NewSimpleClientset(), armocks.DummyArtifactRepositories(&config.ArtifactRepository{
+		Artifactory: &config.ArtifactoryArtifactRepository{RepoURL: "https://my-artifactory/my-repo"},
+	}), "my-cache")
+	_, err := c.Load(ctx, "my-key")
+	assert.EqualError(t, err, "artifact cache my-cache cannot use an Artifactory artifact repository, as its objects cannot be listed")
+	err = c.Save(ctx, "my-key", "my-node", nil, nil)
+	assert.Error(t, err)
+}
+
+// the entries are not read to be evicted
+func TestArtifactCache_Evict(t *testing.T) {
+	ctx := context.Background()
+	driver := memoryDriver{
+		"memoization/my-cache/old/1600711976000000000-100.json":    nil,
+		"memoization/other-cache/old/1600711976000000000-100.json": nil,
+		"memoization/my-cache/not-an-entry":                        []byte(`foo`),
+	}
+	c := newArtifactCache(driver)
+	assert.NoError(t, c.Save(ctx, "new", "new", nil, nil))
+	assert.NoError(t, c.Save(ctx, "newer", "newer", nil, nil))
+
+	n, err := c.Evict(ctx, 24*time.Hour, 0)
+	if assert.NoError(t, err) {
+		assert.Equal(t, 1, n)
+		assert.NotContains(t, driver, "memoization/my-cache/old/1600711976000000000-100.json")
+		assert.Contains(t, driver, "memoization/other-cache/old/1600711976000000000-100.json")
+		assert.Contains(t, driver, "memoization/my-cache/not-an-entry")
+		assert.Len(t, driver, 4)
+	}
+
+	n, err = c.Evict(ctx, 0, 1)
+	if assert.NoError(t, err) {
+		assert.Equal(t, 2, n)
+	}
+
+	c.name = ""
+	names, err := c.listCacheNames(ctx)
+	if assert.NoError(t, err) {
+		assert.Equal(t, []string{"other-cache"}, names)
+	}
+}
+
+func Test_keysToEvict(t *testing.T) {
+	now := time.Now()
+	entries := map[string]entryInfo{
+		"a": {created: now.Add(-3 * time.Hour), size: 1},
+		"b": {created: now.Add(-2 * time.Hour), size: 2},
+		"c": {created: now.Add(-1 * time.Hour), size: 3},
+	}
+	assert.Empty(t, keysToEvict(entries, 0, 0))
+	assert.ElementsMatch(t, []string{"a"}, keysToEvict(entries, 150*time.Minute, 0))
+	assert.ElementsMatch(t, []string{"a", "b"}, keysToEvict(entries, 0, 4))
+	assert.ElementsMatch(t, []string{"a"}, keysToEvict(entries, 0, 5))
+	assert.ElementsMatch(t, []string{"a", "b"}, keysToEvict(entries, 90*time.Minute, 6))
+}
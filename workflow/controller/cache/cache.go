@@ -3,25 +3,54 @@ package cache
 import (
 	"context"
 	"regexp"
+	"sort"
+	"sync"
 	"time"
 
+	log "github.com/sirupsen/logrus"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes"
+	"upper.io/db.v3/lib/sqlbuilder"
 
 	wfv1 "github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo/v2/workflow/artifactrepositories"
+	"github.com/argoproj/argo/v2/workflow/metrics"
 )
 
 var cacheKeyRegex = regexp.MustCompile("^[a-zA-Z0-9][-a-zA-Z0-9]*$")
 
 type MemoizationCache interface {
 	Load(ctx context.Context, key string) (*Entry, error)
-	Save(ctx context.Context, key string, nodeId string, value *wfv1.Outputs) error
+	// Save saves the outputs of a node, and the nodes of its sub-tree if it is a steps or DAG node
+	Save(ctx context.Context, key string, nodeId string, value *wfv1.Outputs, nodes []EntryNode) error
+	// Evict removes the entries older than maxAge, and then the oldest entries so that the remaining entries are at
+	// most maxSize bytes. A zero maxAge or maxSize disables that limit. It returns the number of entries removed.
+	Evict(ctx context.Context, maxAge time.Duration, maxSize int64) (int, error)
 }
 
 type Entry struct {
 	NodeID            string        `json:"nodeID"`
 	Outputs           *wfv1.Outputs `json:"outputs"`
 	CreationTimestamp metav1.Time   `json:"creationTimestamp"`
+	// Nodes is the sub-tree of a steps or DAG node, including the node itself
+	Nodes []EntryNode `json:"nodes,omitempty"`
+}
+
+// EntryNode is a node of the sub-tree of a memoized steps or DAG node, which is restored on a cache hit. The names of the
+// node, its boundary, children and outbound nodes are relative to the name of the memoized node, as the IDs of the nodes
+// are derived from their names.
+type EntryNode struct {
+	Name          string            `json:"name"`
+	DisplayName   string            `json:"displayName,omitempty"`
+	Type          wfv1.NodeType     `json:"type"`
+	TemplateName  string            `json:"templateName,omitempty"`
+	TemplateRef   *wfv1.TemplateRef `json:"templateRef,omitempty"`
+	TemplateScope string            `json:"templateScope,omitempty"`
+	Phase         wfv1.NodePhase    `json:"phase"`
+	BoundaryName  string            `json:"boundaryName,omitempty"`
+	Children      []string          `json:"children,omitempty"`
+	OutboundNodes []string          `json:"outboundNodes,omitempty"`
+	Outputs       *wfv1.Outputs     `json:"outputs,omitempty"`
 }
 
 func (e *Entry) Hit() bool {
@@ -35,6 +64,13 @@ func (e *Entry) GetOutputs() *wfv1.Outputs {
 	return e.Outputs
 }
 
+func (e *Entry) GetNodes() []EntryNode {
+	if e == nil {
+		return nil
+	}
+	return e.Nodes
+}
+
 func (e *Entry) GetOutputsWithMaxAge(maxAge time.Duration) (*wfv1.Outputs, bool) {
 	if e == nil {
 		return nil, false
@@ -47,42 +83,165 @@ func (e *Entry) GetOutputsWithMaxAge(maxAge time.Duration) (*wfv1.Outputs, bool)
 }
 
 type cacheFactory struct {
-	caches     map[string]MemoizationCache
-	kubeclient kubernetes.Interface
-	namespace  string
+	caches               map[string]MemoizationCache
+	lock                 sync.Mutex
+	kubeclient           kubernetes.Interface
+	namespace            string
+	session              sqlbuilder.Database
+	clusterName          string
+	artifactRepositories artifactrepositories.Interface
+	metrics              *metrics.Metrics
 }
 
 type Factory interface {
-	GetCache(ct CacheType, name string) MemoizationCache
+	GetCache(ct wfv1.MemoizationCacheType, name string) MemoizationCache
+	// Evict evicts entries from every cache of the backing stores: the ConfigMap caches of the namespace, the SQL caches
+	// of the cluster and the artifact caches of the default artifact repository
+	Evict(ctx context.Context, maxAge time.Duration, maxSize int64)
 }
 
-func NewCacheFactory(ki kubernetes.Interface, ns string) Factory {
+// NewCacheFactory returns a cache factory. SQL caches are only available if session is not nil.
+func NewCacheFactory(ki kubernetes.Interface, ns string, session sqlbuilder.Database, clusterName string, artifactRepositories artifactrepositories.Interface, metrics *metrics.Metrics) Factory {
 	return &cacheFactory{
-		make(map[string]MemoizationCache),
-		ki,
-		ns,
+		caches:               make(map[string]MemoizationCache),
+		kubeclient:           ki,
+		namespace:            ns,
+		session:              session,
+		clusterName:          clusterName,
+		artifactRepositories: artifactRepositories,
+		metrics:              metrics,
 	}
 }
 
-type CacheType string
-
-const (
-	// Only config maps are currently supported for caching
-	ConfigMapCache CacheType = "ConfigMapCache"
-)
-
 // Returns a cache if it exists and creates it otherwise
-func (cf *cacheFactory) GetCache(ct CacheType, name string) MemoizationCache {
+func (cf *cacheFactory) GetCache(ct wfv1.MemoizationCacheType, name string) MemoizationCache {
+	cf.lock.Lock()
+	defer cf.lock.Unlock()
 	idx := string(ct) + "." + name
 	if c := cf.caches[idx]; c != nil {
 		return c
 	}
+	var c MemoizationCache
 	switch ct {
-	case ConfigMapCache:
-		c := NewConfigMapCache(cf.namespace, cf.kubeclient, name)
-		cf.caches[idx] = c
-		return c
+	case wfv1.MemoizationCacheTypeConfigMap:
+		c = NewConfigMapCache(cf.namespace, cf.kubeclient, name)
+	case wfv1.MemoizationCacheTypeSQL:
+		if cf.session == nil {
+			log.WithField("name", name).Warn("SQL memoization caches need persistence to be configured")
+			return nil
+		}
+		c = NewSQLCache(cf.session, cf.clusterName, name)
+	case wfv1.MemoizationCacheTypeArtifact:
+		c = NewArtifactCache(cf.namespace, cf.kubeclient, cf.artifactRepositories, name)
 	default:
 		return nil
 	}
+	c = &instrumentedCache{MemoizationCache: c, cacheType: string(ct), name: name, metrics: cf.metrics}
+	cf.caches[idx] = c
+	return c
+}
+
+func (cf *cacheFactory) Evict(ctx context.Context, maxAge time.Duration, maxSize int64) {
+	cacheTypes := []wfv1.MemoizationCacheType{wfv1.MemoizationCacheTypeConfigMap}
+	// ConfigMap caches are always kept under the size limit of ConfigMaps, the other caches only have the configured limits
+	if maxAge > 0 || maxSize > 0 {
+		cacheTypes = append(cacheTypes, wfv1.MemoizationCacheTypeSQL, wfv1.MemoizationCacheTypeArtifact)
+	}
+	for _, ct := range cacheTypes {
+		names, err := cf.listCacheNames(ctx, ct)
+		if err != nil {
+			log.WithField("type", ct).WithError(err).Error("Failed to list memoization caches")
+			continue
+		}
+		for _, name := range names {
+			c := cf.GetCache(ct, name)
+			if c == nil {
+				continue
+			}
+			n, err := c.Evict(ctx, maxAge, maxSize)
+			logCtx := log.WithFields(log.Fields{"cache": string(ct) + "." + name, "evicted": n})
+			if err != nil {
+				logCtx.WithError(err).Error("Failed to evict memoization cache entries")
+			} else if n > 0 {
+				logCtx.Info("Evicted memoization cache entries")
+			}
+		}
+	}
+}
+
+// listCacheNames returns the names of the caches of the type in its backing store, whether or not they have been used
+// since the factory was created
+func (cf *cacheFactory) listCacheNames(ctx context.Context, ct wfv1.MemoizationCacheType) ([]string, error) {
+	switch ct {
+	case wfv1.MemoizationCacheTypeConfigMap:
+		return listConfigMapCacheNames(ctx, cf.kubeclient, cf.namespace)
+	case wfv1.MemoizationCacheTypeSQL:
+		if cf.session == nil {
+			return nil, nil
+		}
+		return listSQLCacheNames(cf.session, cf.clusterName)
+	case wfv1.MemoizationCacheTypeArtifact:
+		return listArtifactCacheNames(ctx, cf.namespace, cf.kubeclient, cf.artifactRepositories)
+	}
+	return nil, nil
+}
+
+// instrumentedCache records the hits, misses and evictions of a cache
+type instrumentedCache struct {
+	MemoizationCache
+	cacheType string
+	name      string
+	metrics   *metrics.Metrics
+}
+
+func (c *instrumentedCache) Load(ctx context.Context, key string) (*Entry, error) {
+	entry, err := c.MemoizationCache.Load(ctx, key)
+	if err == nil && c.metrics != nil {
+		if entry.Hit() {
+			c.metrics.MemoizationCacheHit(c.cacheType, c.name)
+		} else {
+			c.metrics.MemoizationCacheMiss(c.cacheType, c.name)
+		}
+	}
+	return entry, err
+}
+
+func (c *instrumentedCache) Evict(ctx context.Context, maxAge time.Duration, maxSize int64) (int, error) {
+	n, err := c.MemoizationCache.Evict(ctx, maxAge, maxSize)
+	if n > 0 && c.metrics != nil {
+		c.metrics.MemoizationCacheEvictions(c.cacheType, c.name, n)
+	}
+	return n, err
+}
+
+// entryInfo is what is needed to evict an entry
+type entryInfo struct {
+	created time.Time
+	// size is the size of the entry in bytes
+	size int64
+}
+
+// keysToEvict returns the keys of the entries that are older than maxAge, and then the keys of the oldest entries so
+// that the remaining entries are at most maxSize bytes, a zero maxAge or maxSize disables that limit
+func keysToEvict(entries map[string]entryInfo, maxAge time.Duration, maxSize int64) []string {
+	keys := make([]string, 0, len(entries))
+	for key := range entries {
+		keys = append(keys, key)
+	}
+	// newest first
+	sort.Slice(keys, func(i, j int) bool {
+		if entries[keys[i]].created.Equal(entries[keys[j]].created) {
+			return keys[i] < keys[j]
+		}
+		return entries[keys[i]].created.After(entries[keys[j]].created)
+	})
+	var evict []string
+	var size int64
+	for _, key := range keys {
+		size += entries[key].size
+		if (maxSize > 0 && size > maxSize) || (maxAge > 0 && time.Since(entries[key].created) > maxAge) {
+			evict = append(evict, key)
+		}
+	}
+	return evict
 }
@@ -15,8 +15,13 @@ import (
 	"k8s.io/client-go/kubernetes"
 
 	wfv1 "github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo/v2/workflow/common"
 )
 
+// configMapMaxSize is the size the entries of a ConfigMap cache are kept under, as ConfigMaps, including their
+// metadata, are limited to 1MiB
+const configMapMaxSize = 1000 * 1024
+
 type configMapCache struct {
 	namespace  string
 	name       string
@@ -76,7 +81,7 @@ func (c *configMapCache) Load(ctx context.Context, key string) (*Entry, error) {
 	return &entry, nil
 }
 
-func (c *configMapCache) Save(ctx context.Context, key string, nodeId string, value *wfv1.Outputs) error {
+func (c *configMapCache) Save(ctx context.Context, key string, nodeId string, value *wfv1.Outputs, nodes []EntryNode) error {
 	if !cacheKeyRegex.MatchString(key) {
 		errString := fmt.Sprintf("invalid cache key: %s", key)
 		err := errors.New(errString)
@@ -93,7 +98,8 @@ func (c *configMapCache) Save(ctx context.Context, key string, nodeId string, va
 	if apierr.IsNotFound(err) || cache == nil {
 		cache, err = c.kubeClient.CoreV1().ConfigMaps(c.namespace).Create(ctx, &apiv1.ConfigMap{
 			ObjectMeta: metav1.ObjectMeta{
-				Name: c.name,
+				Name:   c.name,
+				Labels: map[string]string{common.LabelKeyConfigMapType: common.LabelValueTypeConfigMapCache},
 			},
 		}, metav1.CreateOptions{})
 		if err != nil {
@@ -106,6 +112,7 @@ func (c *configMapCache) Save(ctx context.Context, key string, nodeId string, va
 		NodeID:            nodeId,
 		Outputs:           value,
 		CreationTimestamp: metav1.Time{Time: time.Now()},
+		Nodes:             nodes,
 	}
 
 	entryJSON, err := json.Marshal(newEntry)
@@ -118,6 +125,13 @@ func (c *configMapCache) Save(ctx context.Context, key string, nodeId string, va
 		cache.Data = make(map[string]string)
 	}
 	cache.Data[key] = string(entryJSON)
+	// the caches created before they were labelled are labelled, so that they are evicted
+	if cache.Labels[common.LabelKeyConfigMapType] != common.LabelValueTypeConfigMapCache {
+		if cache.Labels == nil {
+			cache.Labels = make(map[string]string)
+		}
+		cache.Labels[common.LabelKeyConfigMapType] = common.LabelValueTypeConfigMapCache
+	}
 
 	_, err = c.kubeClient.CoreV1().ConfigMaps(c.namespace).Update(ctx, cache, metav1.UpdateOptions{})
 	if err != nil {
@@ -126,3 +140,57 @@ func (c *configMapCache) Save(ctx context.Context, key string, nodeId string, va
 	}
 	return nil
 }
+
+func (c *configMapCache) Evict(ctx context.Context, maxAge time.Duration, maxSize int64) (int, error) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	cache, err := c.kubeClient.CoreV1().ConfigMaps(c.namespace).Get(ctx, c.name, metav1.GetOptions{})
+	if err != nil {
+		if apierr.IsNotFound(err) {
+			return 0, nil
+		}
+		return 0, fmt.Errorf("could not load config map cache: %w", err)
+	}
+
+	if maxSize == 0 || maxSize > configMapMaxSize {
+		maxSize = configMapMaxSize
+	}
+	entries := make(map[string]entryInfo, len(cache.Data))
+	for key, rawEntry := range cache.Data {
+		var entry Entry
+		if err := json.Unmarshal([]byte(rawEntry), &entry); err != nil {
+			c.logError(err, log.Fields{"key": key}, "Ignoring malformed cache entry")
+			continue
+		}
+		entries[key] = entryInfo{created: entry.CreationTimestamp.Time, size: int64(len(key) + len(rawEntry))}
+	}
+	keys := keysToEvict(entries, maxAge, maxSize)
+	if len(keys) == 0 {
+		return 0, nil
+	}
+	for _, key := range keys {
+		delete(cache.Data, key)
+	}
+	_, err = c.kubeClient.CoreV1().ConfigMaps(c.namespace).Update(ctx, cache, metav1.UpdateOptions{})
+	if err != nil {
+		return 0, fmt.Errorf("error evicting cache entries: %w", err)
+	}
+	c.logInfo(log.Fields{"keys": keys}, "Evicted ConfigMap cache entries")
+	return len(keys), nil
+}
+
+// listConfigMapCacheNames returns the names of the ConfigMap caches of the namespace
+func listConfigMapCacheNames(ctx context.Context, kubeClient kubernetes.Interface, namespace string) ([]string, error) {
+	list, err := kubeClient.CoreV1().ConfigMaps(namespace).List(ctx, metav1.ListOptions{
+		LabelSelector: common.LabelKeyConfigMapType + "=" + common.LabelValueTypeConfigMapCache,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("could not list config map caches: %w", err)
+	}
+	var names []string
+	for _, cm := range list.Items {
+		names = append(names, cm.Name)
+	}
+	return names, nil
+}
@@ -0,0 +1,182 @@
+package cache
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"upper.io/db.v3"
+	"upper.io/db.v3/lib/sqlbuilder"
+
+	wfv1 "github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1"
+)
+
+// the table is created by the persistence migration, see persist/sqldb/migrate.go
+const memoizationCacheTableName = "argo_memoization_cache"
+
+type sqlCacheRecord struct {
+	ClusterName string `db:"clustername"`
+	CacheName   string `db:"cachename"`
+	CacheKey    string `db:"cachekey"`
+	NodeID      string `db:"nodeid"`
+	Outputs     string `db:"outputs"`
+	// Nodes is null for the entries saved before the column was added
+	Nodes     *string   `db:"nodes"`
+	CreatedAt time.Time `db:"createdat"`
+	// Size is the size of the outputs and nodes in bytes
+	Size int64 `db:"size"`
+}
+
+type sqlCache struct {
+	session     sqlbuilder.Database
+	clusterName string
+	name        string
+	lock        sync.Mutex
+}
+
+func NewSQLCache(session sqlbuilder.Database, clusterName string, n string) MemoizationCache {
+	return &sqlCache{
+		session:     session,
+		clusterName: clusterName,
+		name:        n,
+	}
+}
+
+func (c *sqlCache) cond() db.Cond {
+	return db.Cond{"clustername": c.clusterName, "cachename": c.name}
+}
+
+func (c *sqlCache) Load(_ context.Context, key string) (*Entry, error) {
+	if !cacheKeyRegex.MatchString(key) {
+		return nil, fmt.Errorf("invalid cache key: %s", key)
+	}
+	var record sqlCacheRecord
+	err := c.session.
+		SelectFrom(memoizationCacheTableName).
+		Where(c.cond()).
+		And(db.Cond{"cachekey": key}).
+		One(&record)
+	if err == db.ErrNoMoreRows {
+		log.WithFields(log.Fields{"name": c.name, "key": key}).Debug("SQL cache miss: entry does not exist")
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("could not load SQL cache entry: %w", err)
+	}
+	var outputs *wfv1.Outputs
+	err = json.Unmarshal([]byte(record.Outputs), &outputs)
+	if err != nil {
+		return nil, fmt.Errorf("malformed cache entry: could not unmarshal JSON; unable to parse: %w", err)
+	}
+	var nodes []EntryNode
+	if record.Nodes != nil {
+		err = json.Unmarshal([]byte(*record.Nodes), &nodes)
+		if err != nil {
+			return nil, fmt.Errorf("malformed cache entry: could not unmarshal JSON; unable to parse: %w", err)
+		}
+	}
+	return &Entry{NodeID: record.NodeID, Outputs: outputs, CreationTimestamp: metav1.Time{Time: record.CreatedAt}, Nodes: nodes}, nil
+}
+
+func (c *sqlCache) Save(ctx context.Context, key string, nodeId string, value *wfv1.Outputs, nodes []EntryNode) error {
+	if !cacheKeyRegex.MatchString(key) {
+		return fmt.Errorf("invalid cache key: %s", key)
+	}
+	outputs, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Errorf("unable to marshal cache entry: %w", err)
+	}
+	nodesJSON, err := json.Marshal(nodes)
+	if err != nil {
+		return fmt.Errorf("unable to marshal cache entry: %w", err)
+	}
+	nodesValue := string(nodesJSON)
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	log.WithFields(log.Fields{"name": c.name, "key": key, "nodeId": nodeId}).Info("Saving SQL cache entry")
+	return c.session.Tx(ctx, func(sess sqlbuilder.Tx) error {
+		_, err := sess.
+			DeleteFrom(memoizationCacheTableName).
+			Where(c.cond()).
+			And(db.Cond{"cachekey": key}).
+			Exec()
+		if err != nil {
+			return err
+		}
+		_, err = sess.Collection(memoizationCacheTableName).
+			Insert(&sqlCacheRecord{
+				ClusterName: c.clusterName,
+				CacheName:   c.name,
+				CacheKey:    key,
+				NodeID:      nodeId,
+				Outputs:     string(outputs),
+				Nodes:       &nodesValue,
+				CreatedAt:   time.Now().UTC(),
+				Size:        int64(len(outputs) + len(nodesJSON)),
+			})
+		return err
+	})
+}
+
+func (c *sqlCache) Evict(ctx context.Context, maxAge time.Duration, maxSize int64) (int, error) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	var records []struct {
+		CacheKey  string    `db:"cachekey"`
+		CreatedAt time.Time `db:"createdat"`
+		Size      int64     `db:"size"`
+	}
+	err := c.session.
+		Select("cachekey", "createdat", "size").
+		From(memoizationCacheTableName).
+		Where(c.cond()).
+		All(&records)
+	if err != nil {
+		return 0, fmt.Errorf("could not list SQL cache entries: %w", err)
+	}
+	entries := make(map[string]entryInfo, len(records))
+	for _, r := range records {
+		entries[r.CacheKey] = entryInfo{created: r.CreatedAt, size: r.Size}
+	}
+	keys := keysToEvict(entries, maxAge, maxSize)
+	if len(keys) == 0 {
+		return 0, nil
+	}
+	_, err = c.session.
+		DeleteFrom(memoizationCacheTableName).
+		Where(c.cond()).
+		And(db.Cond{"cachekey IN": keys}).
+		Exec()
+	if err != nil {
+		return 0, fmt.Errorf("error evicting cache entries: %w", err)
+	}
+	return len(keys), nil
+}
+
+// listSQLCacheNames returns the names of the SQL caches of the cluster
+func listSQLCacheNames(session sqlbuilder.Database, clusterName string) ([]string, error) {
+	var records []struct {
+		CacheName string `db:"cachename"`
+	}
+	err := session.
+		Select().
+		Distinct("cachename").
+		From(memoizationCacheTableName).
+		Where(db.Cond{"clustername": clusterName}).
+		All(&records)
+	if err != nil {
+		return nil, fmt.Errorf("could not list SQL caches: %w", err)
+	}
+	var names []string
+	for _, r := range records {
+		names = append(names, r.CacheName)
+	}
+	return names, nil
+}
@@ -2,13 +2,19 @@ package controller
 
 import (
 	"context"
+	"fmt"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	apiv1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/pointer"
 
 	wfv1 "github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1"
+	armocks "github.com/argoproj/argo/v2/workflow/artifactrepositories/mocks"
+	"github.com/argoproj/argo/v2/workflow/common"
 	"github.com/argoproj/argo/v2/workflow/controller/cache"
 )
 
@@ -81,10 +87,102 @@ func TestConfigMapCacheSave(t *testing.T) {
 	ctx := context.Background()
 	outputs := wfv1.Outputs{}
 	outputs.Parameters = append(outputs.Parameters, MockParam)
-	err := c.Save(ctx, "hi-there-world", "", &outputs)
+	err := c.Save(ctx, "hi-there-world", "", &outputs, nil)
 	assert.NoError(t, err)
 
 	cm, err := controller.kubeclientset.CoreV1().ConfigMaps("default").Get(ctx, "whalesay-cache", metav1.GetOptions{})
 	assert.NoError(t, err)
 	assert.NotNil(t, cm)
 }
+
+func TestConfigMapCacheEvict(t *testing.T) {
+	cancel, controller := newController()
+	defer cancel()
+	ctx := context.Background()
+	cm := sampleConfigMapCacheEntry.DeepCopy()
+	cm.Data["recent"] = fmt.Sprintf(`{"nodeID":"recent","creationTimestamp":"%s"}`, time.Now().UTC().Format(time.RFC3339))
+	_, err := controller.kubeclientset.CoreV1().ConfigMaps("default").Create(ctx, cm, metav1.CreateOptions{})
+	assert.NoError(t, err)
+	c := cache.NewConfigMapCache("default", controller.kubeclientset, "whalesay-cache")
+
+	t.Run("NoLimits", func(t *testing.T) {
+		n, err := c.Evict(ctx, 0, 0)
+		if assert.NoError(t, err) {
+			assert.Equal(t, 0, n)
+		}
+	})
+	t.Run("MaxAge", func(t *testing.T) {
+		n, err := c.Evict(ctx, 24*time.Hour, 0)
+		if assert.NoError(t, err) {
+			assert.Equal(t, 1, n)
+		}
+		cm, err := controller.kubeclientset.CoreV1().ConfigMaps("default").Get(ctx, "whalesay-cache", metav1.GetOptions{})
+		if assert.NoError(t, err) {
+			assert.NotContains(t, cm.Data, "hi-there-world")
+			assert.Contains(t, cm.Data, "recent")
+		}
+	})
+	t.Run("MaxSize", func(t *testing.T) {
+		err := c.Save(ctx, "newest", "newest", &wfv1.Outputs{}, nil)
+		assert.NoError(t, err)
+		cm, err := controller.kubeclientset.CoreV1().ConfigMaps("default").Get(ctx, "whalesay-cache", metav1.GetOptions{})
+		if assert.NoError(t, err) {
+			assert.Equal(t, common.LabelValueTypeConfigMapCache, cm.Labels[common.LabelKeyConfigMapType], "the cache is labelled when it is saved")
+		}
+		n, err := c.Evict(ctx, 0, int64(len("newest")+len(cm.Data["newest"])))
+		if assert.NoError(t, err) {
+			assert.Equal(t, 1, n)
+		}
+		entry, err := c.Load(ctx, "newest")
+		if assert.NoError(t, err) {
+			assert.True(t, entry.Hit())
+		}
+		entry, err = c.Load(ctx, "recent")
+		if assert.NoError(t, err) {
+			assert.False(t, entry.Hit())
+		}
+	})
+	t.Run("ConfigMapMaxSize", func(t *testing.T) {
+		for i := 0; i < 20; i++ {
+			err := c.Save(ctx, fmt.Sprintf("large-%d", i), "", &wfv1.Outputs{Result: pointer.StringPtr(strings.Repeat("x", 100*1024))}, nil)
+			assert.NoError(t, err)
+		}
+		n, err := c.Evict(ctx, 0, 0)
+		if assert.NoError(t, err) {
+			assert.Greater(t, n, 10, "the cache is kept under the size limit of ConfigMaps")
+		}
+	})
+}
+
+func TestCacheFactory_Evict(t *testing.T) {
+	cancel, controller := newController()
+	defer cancel()
+	ctx := context.Background()
+	// a cache that has not been used since the controller started
+	cm := sampleConfigMapCacheEntry.DeepCopy()
+	cm.Labels = map[string]string{common.LabelKeyConfigMapType: common.LabelValueTypeConfigMapCache}
+	_, err := controller.kubeclientset.CoreV1().ConfigMaps("default").Create(ctx, cm, metav1.CreateOptions{})
+	assert.NoError(t, err)
+
+	// without a default artifact repository, so that only the ConfigMap caches are listed
+	factory := cache.NewCacheFactory(controller.kubeclientset, "default", nil, "", armocks.DummyArtifactRepositories(nil), nil)
+	factory.Evict(ctx, 24*time.Hour, 0)
+	cm, err = controller.kubeclientset.CoreV1().ConfigMaps("default").Get(ctx, "whalesay-cache", metav1.GetOptions{})
+	if assert.NoError(t, err) {
+		assert.Empty(t, cm.Data)
+	}
+}
+
+func TestCacheFactory(t *testing.T) {
+	cancel, controller := newController()
+	defer cancel()
+	factory := controller.cacheFactory
+
+	c := factory.GetCache(wfv1.MemoizationCacheTypeConfigMap, "whalesay-cache")
+	assert.NotNil(t, c)
+	assert.Equal(t, c, factory.GetCache(wfv1.MemoizationCacheTypeConfigMap, "whalesay-cache"))
+	assert.NotNil(t, factory.GetCache(wfv1.MemoizationCacheTypeArtifact, "whalesay-cache"))
+	// SQL caches need persistence
+	assert.Nil(t, factory.GetCache(wfv1.MemoizationCacheTypeSQL, "whalesay-cache"))
+	assert.Nil(t, factory.GetCache("Unknown", "whalesay-cache"))
+}
@@ -0,0 +1,159 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+
+	apierr "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/argoproj/argo/v2/pkg/apis/workflow"
+	wfv1 "github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo/v2/workflow/common"
+	"github.com/argoproj/argo/v2/workflow/util"
+)
+
+// executeChildWorkflow creates the child workflow of the node, and then reflects its phase in the node. The child
+// workflow is named after the node, like a pod, and is owned by the workflow. Once it succeeded, its global outputs
+// are the outputs of the node. Stopping or terminating the workflow stops or terminates the child workflow.
+func (woc *wfOperationCtx) executeChildWorkflow(ctx context.Context, nodeName string, templateScope string, tmpl *wfv1.Template, orgTmpl wfv1.TemplateReferenceHolder, opts *executeTemplateOpts) (*wfv1.NodeStatus, error) {
+	node := woc.wf.GetNodeByName(nodeName)
+	if node == nil {
+		node = woc.initializeExecutableNode(nodeName, wfv1.NodeTypeWorkflow, templateScope, tmpl, orgTmpl, opts.boundaryID, wfv1.NodePending)
+	}
+
+	name := util.ChildWorkflowName(woc.wf, node.ID)
+	wfIf := woc.controller.wfclientset.ArgoprojV1alpha1().Workflows(woc.wf.Namespace)
+	child, err := woc.getOrCreateChildWorkflow(ctx, name, tmpl.Workflow)
+	if err != nil {
+		return woc.requeueIfTransientErr(err, node.Name)
+	}
+
+	if woc.execWf.Spec.Shutdown != "" && child.Spec.Shutdown != woc.execWf.Spec.Shutdown {
+		woc.log.WithField("childWorkflow", child.Name).WithField("shutdown", woc.execWf.Spec.Shutdown).Info("Shutting down child workflow")
+		switch woc.execWf.Spec.Shutdown {
+		case wfv1.ShutdownStrategyTerminate:
+			err = util.TerminateWorkflow(ctx, wfIf, child.Name)
+		case wfv1.ShutdownStrategyStop:
+			err = util.StopWorkflow(ctx, wfIf, woc.controller.hydrator, child.Name, "", "")
+		}
+		if err != nil {
+			return node, err
+		}
+	}
+
+	switch child.Status.Phase {
+	case wfv1.WorkflowRunning:
+		if node.Phase != wfv1.NodeRunning {
+			node = woc.markNodePhase(node.Name, wfv1.NodeRunning)
+		}
+	case wfv1.WorkflowSucceeded:
+		outputs, err := getChildWorkflowOutputs(tmpl, child)
+		if err != nil {
+			return woc.markNodePhase(node.Name, wfv1.NodeFailed, err.Error()), nil
+		}
+		node = woc.wf.GetNodeByName(node.Name)
+		node.Outputs = outputs
+		woc.wf.Status.Nodes[node.ID] = *node
+		woc.updated = true
+		woc.addOutputsToGlobalScope(outputs)
+		node = woc.markNodePhase(node.Name, wfv1.NodeSucceeded)
+	case wfv1.WorkflowFailed:
+		node = woc.markNodePhase(node.Name, wfv1.NodeFailed, childWorkflowMessage(child))
+	case wfv1.WorkflowError:
+		node = woc.markNodePhase(node.Name, wfv1.NodeError, childWorkflowMessage(child))
+	}
+	return node, nil
+}
+
+// getOrCreateChildWorkflow returns the child workflow from the informer, so that reconciling the workflow does not hit
+// the API server, and only creates it if the informer does not have it. The informer may not have seen a child workflow
+// that was just created yet, in which case it is got from the API server.
+func (woc *wfOperationCtx) getOrCreateChildWorkflow(ctx context.Context, name string, tmpl *wfv1.ChildWorkflowTemplate) (*wfv1.Workflow, error) {
+	obj, exists, err := woc.controller.wfInformer.GetIndexer().GetByKey(woc.wf.Namespace + "/" + name)
+	if err != nil {
+		return nil, err
+	}
+	if exists {
+		un, ok := obj.(*unstructured.Unstructured)
+		if !ok {
+			return nil, fmt.Errorf("child workflow '%s' is not an unstructured", name)
+		}
+		return util.FromUnstructured(un)
+	}
+	wfIf := woc.controller.wfclientset.ArgoprojV1alpha1().Workflows(woc.wf.Namespace)
+	woc.log.WithField("childWorkflow", name).Info("Creating child workflow")
+	child, err := wfIf.Create(ctx, woc.newChildWorkflow(name, tmpl), metav1.CreateOptions{})
+	if apierr.IsAlreadyExists(err) {
+		return wfIf.Get(ctx, name, metav1.GetOptions{})
+	}
+	return child, err
+}
+
+// newChildWorkflow returns the child workflow of a node, run by the same controller as the workflow
+func (woc *wfOperationCtx) newChildWorkflow(name string, tmpl *wfv1.ChildWorkflowTemplate) *wfv1.Workflow {
+	child := &wfv1.Workflow{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: woc.wf.Namespace,
+			Labels:    map[string]string{},
+			OwnerReferences: []metav1.OwnerReference{
+				*metav1.NewControllerRef(woc.wf, wfv1.SchemeGroupVersion.WithKind(workflow.WorkflowKind)),
+			},
+		},
+		Spec: wfv1.WorkflowSpec{
+			WorkflowTemplateRef: tmpl.WorkflowTemplateRef.DeepCopy(),
+			Arguments:           *tmpl.Arguments.DeepCopy(),
+		},
+	}
+	if instanceID, ok := woc.wf.Labels[common.LabelKeyControllerInstanceID]; ok {
+		child.Labels[common.LabelKeyControllerInstanceID] = instanceID
+	}
+	return child
+}
+
+// getChildWorkflowOutputs returns the global outputs of the child workflow. The outputs declared by the template must
+// be amongst them, and they get the global names of their declaration.
+func getChildWorkflowOutputs(tmpl *wfv1.Template, child *wfv1.Workflow) (*wfv1.Outputs, error) {
+	outputs := &wfv1.Outputs{}
+	if child.Status.Outputs != nil {
+		outputs = child.Status.Outputs.DeepCopy()
+	}
+	for _, param := range tmpl.Outputs.Parameters {
+		found := false
+		for i := range outputs.Parameters {
+			if outputs.Parameters[i].Name == param.Name {
+				outputs.Parameters[i].GlobalName = param.GlobalName
+				found = true
+			}
+		}
+		if !found {
+			return nil, fmt.Errorf("output parameter '%s' is not a global output of child workflow '%s'", param.Name, child.Name)
+		}
+	}
+	for _, art := range tmpl.Outputs.Artifacts {
+		found := false
+		for i := range outputs.Artifacts {
+			if outputs.Artifacts[i].Name == art.Name {
+				outputs.Artifacts[i].GlobalName = art.GlobalName
+				found = true
+			}
+		}
+		if !found && !art.Optional {
+			return nil, fmt.Errorf("output artifact '%s' is not a global output of child workflow '%s'", art.Name, child.Name)
+		}
+	}
+	if len(outputs.Parameters) == 0 && len(outputs.Artifacts) == 0 {
+		return nil, nil
+	}
+	return outputs, nil
+}
+
+func childWorkflowMessage(child *wfv1.Workflow) string {
+	message := fmt.Sprintf("child workflow '%s' did not succeed", child.Name)
+	if child.Status.Message != "" {
+		message += ": " + child.Status.Message
+	}
+	return message
+}
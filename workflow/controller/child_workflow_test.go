@@ -0,0 +1,180 @@
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8stesting "k8s.io/client-go/testing"
+
+	wfv1 "github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1"
+	fakewfclientset "github.com/argoproj/argo/v2/pkg/client/clientset/versioned/fake"
+	"github.com/argoproj/argo/v2/workflow/util"
+)
+
+var childWorkflowTemplate = `
+apiVersion: argoproj.io/v1alpha1
+kind: WorkflowTemplate
+metadata:
+  name: child
+  namespace: default
+spec:
+  entrypoint: main
+  arguments:
+    parameters:
+    - name: message
+  templates:
+  - name: main
+    container:
+      image: alpine:3.7
+      args: ["{{workflow.parameters.message}}"]
+`
+
+var childWorkflowWf = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  name: parent
+  namespace: default
+  uid: parent-uid
+spec:
+  entrypoint: main
+  templates:
+  - name: main
+    workflow:
+      workflowTemplateRef:
+        name: child
+      arguments:
+        parameters:
+        - name: message
+          value: hello
+    outputs:
+      parameters:
+      - name: message
+        globalName: message
+`
+
+// setChildWorkflowStatus updates the child workflow, and the informer, which does not watch the fake clientset
+func setChildWorkflowStatus(ctx context.Context, t *testing.T, woc *wfOperationCtx, status wfv1.WorkflowStatus) {
+	wfIf := woc.controller.wfclientset.ArgoprojV1alpha1().Workflows(woc.wf.Namespace)
+	child, err := wfIf.Get(ctx, "parent-child", metav1.GetOptions{})
+	if assert.NoError(t, err) {
+		child.Status = status
+		child, err = wfIf.Update(ctx, child, metav1.UpdateOptions{})
+		if assert.NoError(t, err) {
+			un, err := util.ToUnstructured(child)
+			if assert.NoError(t, err) {
+				assert.NoError(t, woc.controller.wfInformer.GetStore().Add(un))
+			}
+		}
+	}
+}
+
+// childWorkflowGets returns the number of times the child workflow was got from the API server
+func childWorkflowGets(woc *wfOperationCtx) int {
+	gets := 0
+	for _, action := range woc.controller.wfclientset.(*fakewfclientset.Clientset).Actions() {
+		if get, ok := action.(k8stesting.GetAction); ok && get.GetResource().Resource == "workflows" && get.GetName() == "parent-child" {
+			gets++
+		}
+	}
+	return gets
+}
+
+func TestChildWorkflow(t *testing.T) {
+	ctx := context.Background()
+	newWoc := func() (context.CancelFunc, *wfOperationCtx) {
+		wf := unmarshalWF(childWorkflowWf)
+		cancel, controller := newController(wf, unmarshalWFTmpl(childWorkflowTemplate))
+		woc := newWorkflowOperationCtx(wf, controller)
+		woc.operate(ctx)
+		return cancel, woc
+	}
+
+	t.Run("Create", func(t *testing.T) {
+		cancel, woc := newWoc()
+		defer cancel()
+		node := woc.wf.GetNodeByName("parent")
+		if assert.NotNil(t, node) {
+			assert.Equal(t, wfv1.NodeTypeWorkflow, node.Type)
+			assert.Equal(t, wfv1.NodePending, node.Phase)
+		}
+		child, err := woc.controller.wfclientset.ArgoprojV1alpha1().Workflows("default").Get(ctx, "parent-child", metav1.GetOptions{})
+		if assert.NoError(t, err) {
+			ownerRef := metav1.GetControllerOf(child)
+			if assert.NotNil(t, ownerRef) {
+				assert.Equal(t, "parent", ownerRef.Name)
+			}
+			assert.Equal(t, "child", child.Spec.WorkflowTemplateRef.Name)
+			assert.Equal(t, "hello", child.Spec.Arguments.GetParameterByName("message").Value.String())
+		}
+
+		setChildWorkflowStatus(ctx, t, woc, wfv1.WorkflowStatus{Phase: wfv1.WorkflowRunning})
+		woc.controller.wfclientset.(*fakewfclientset.Clientset).ClearActions()
+		woc = newWorkflowOperationCtx(woc.wf, woc.controller)
+		woc.operate(ctx)
+		assert.Equal(t, wfv1.NodeRunning, woc.wf.GetNodeByName("parent").Phase)
+		assert.Zero(t, childWorkflowGets(woc), "the child workflow is got from the informer")
+	})
+	t.Run("NotInInformer", func(t *testing.T) {
+		cancel, woc := newWoc()
+		defer cancel()
+		woc.controller.wfclientset.(*fakewfclientset.Clientset).ClearActions()
+		woc = newWorkflowOperationCtx(woc.wf, woc.controller)
+		woc.operate(ctx)
+		assert.Equal(t, wfv1.NodePending, woc.wf.GetNodeByName("parent").Phase)
+		assert.Equal(t, 1, childWorkflowGets(woc), "the child workflow already exists, so it is got from the API server")
+	})
+	t.Run("Succeeded", func(t *testing.T) {
+		cancel, woc := newWoc()
+		defer cancel()
+		setChildWorkflowStatus(ctx, t, woc, wfv1.WorkflowStatus{
+			Phase:   wfv1.WorkflowSucceeded,
+			Outputs: &wfv1.Outputs{Parameters: []wfv1.Parameter{{Name: "message", Value: wfv1.AnyStringPtr("hello")}}},
+		})
+		woc = newWorkflowOperationCtx(woc.wf, woc.controller)
+		woc.operate(ctx)
+		node := woc.wf.GetNodeByName("parent")
+		assert.Equal(t, wfv1.NodeSucceeded, node.Phase)
+		if assert.NotNil(t, node.Outputs) && assert.Len(t, node.Outputs.Parameters, 1) {
+			assert.Equal(t, "hello", node.Outputs.Parameters[0].Value.String())
+		}
+		assert.Equal(t, wfv1.WorkflowSucceeded, woc.wf.Status.Phase)
+		if assert.NotNil(t, woc.wf.Status.Outputs) && assert.Len(t, woc.wf.Status.Outputs.Parameters, 1) {
+			assert.Equal(t, "hello", woc.wf.Status.Outputs.Parameters[0].Value.String())
+		}
+	})
+	t.Run("MissingOutput", func(t *testing.T) {
+		cancel, woc := newWoc()
+		defer cancel()
+		setChildWorkflowStatus(ctx, t, woc, wfv1.WorkflowStatus{Phase: wfv1.WorkflowSucceeded})
+		woc = newWorkflowOperationCtx(woc.wf, woc.controller)
+		woc.operate(ctx)
+		node := woc.wf.GetNodeByName("parent")
+		assert.Equal(t, wfv1.NodeFailed, node.Phase)
+		assert.Contains(t, node.Message, "output parameter 'message' is not a global output of child workflow")
+	})
+	t.Run("Failed", func(t *testing.T) {
+		cancel, woc := newWoc()
+		defer cancel()
+		setChildWorkflowStatus(ctx, t, woc, wfv1.WorkflowStatus{Phase: wfv1.WorkflowFailed, Message: "oops"})
+		woc = newWorkflowOperationCtx(woc.wf, woc.controller)
+		woc.operate(ctx)
+		node := woc.wf.GetNodeByName("parent")
+		assert.Equal(t, wfv1.NodeFailed, node.Phase)
+		assert.Equal(t, "child workflow 'parent-child' did not succeed: oops", node.Message)
+	})
+	t.Run("Terminate", func(t *testing.T) {
+		cancel, woc := newWoc()
+		defer cancel()
+		setChildWorkflowStatus(ctx, t, woc, wfv1.WorkflowStatus{Phase: wfv1.WorkflowRunning})
+		woc.wf.Spec.Shutdown = wfv1.ShutdownStrategyTerminate
+		woc = newWorkflowOperationCtx(woc.wf, woc.controller)
+		woc.operate(ctx)
+		child, err := woc.controller.wfclientset.ArgoprojV1alpha1().Workflows("default").Get(ctx, "parent-child", metav1.GetOptions{})
+		if assert.NoError(t, err) {
+			assert.Equal(t, wfv1.ShutdownStrategyTerminate, child.Spec.Shutdown)
+		}
+	})
+}
@@ -2,6 +2,7 @@ package controller
 
 import (
 	"context"
+	"time"
 
 	log "github.com/sirupsen/logrus"
 	apiv1 "k8s.io/api/core/v1"
@@ -11,6 +12,7 @@ import (
 	"github.com/argoproj/argo/v2/config"
 	"github.com/argoproj/argo/v2/errors"
 	"github.com/argoproj/argo/v2/persist/sqldb"
+	"github.com/argoproj/argo/v2/util/env"
 	"github.com/argoproj/argo/v2/util/instanceid"
 	"github.com/argoproj/argo/v2/workflow/artifactrepositories"
 	"github.com/argoproj/argo/v2/workflow/hydrator"
@@ -37,6 +39,7 @@ func (wfc *WorkflowController) updateConfig(v interface{}) error {
 	wfc.artifactRepositories = artifactrepositories.New(wfc.kubeclientset, wfc.namespace, &wfc.Config.ArtifactRepository)
 	wfc.offloadNodeStatusRepo = sqldb.ExplosiveOffloadNodeStatusRepo
 	wfc.wfArchive = sqldb.NullWorkflowArchive
+	wfc.semaphoreRepository = sqldb.NullSemaphoreRepository
 	wfc.archiveLabelSelector = labels.Everything()
 	persistence := wfc.Config.Persistence
 	if persistence != nil {
@@ -56,6 +59,8 @@ func (wfc *WorkflowController) updateConfig(v interface{}) error {
 		}
 
 		wfc.session = session
+		instanceIDService := instanceid.NewService(wfc.Config.InstanceID)
+		wfc.semaphoreRepository = sqldb.NewSemaphoreRepository(session, persistence.GetClusterName(), instanceIDService, env.LookupEnvDurationOr("SEMAPHORE_HOLDER_EXPIRY", time.Minute))
 		if persistence.NodeStatusOffload {
 			wfc.offloadNodeStatusRepo, err = sqldb.NewOffloadNodeStatusRepo(session, persistence.GetClusterName(), tableName)
 			if err != nil {
@@ -66,8 +71,6 @@ func (wfc *WorkflowController) updateConfig(v interface{}) error {
 			log.Info("Node status offloading is disabled")
 		}
 		if persistence.Archive {
-			instanceIDService := instanceid.NewService(wfc.Config.InstanceID)
-
 			wfc.archiveLabelSelector, err = persistence.GetArchiveLabelSelector()
 			if err != nil {
 				return err
@@ -82,6 +85,7 @@ func (wfc *WorkflowController) updateConfig(v interface{}) error {
 	}
 	wfc.hydrator = hydrator.New(wfc.offloadNodeStatusRepo)
 	wfc.updateEstimatorFactory()
+	wfc.updateCacheFactory()
 	return nil
 }
 
@@ -0,0 +1,157 @@
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	apiv1 "k8s.io/api/core/v1"
+	"k8s.io/utils/pointer"
+
+	wfv1 "github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo/v2/workflow/common"
+)
+
+// executeContainerSet creates the pod running all the containers of the set. Each container is reported as a child
+// node of the pod node, updated from the container statuses of the pod.
+func (woc *wfOperationCtx) executeContainerSet(ctx context.Context, nodeName string, templateScope string, tmpl *wfv1.Template, orgTmpl wfv1.TemplateReferenceHolder, opts *executeTemplateOpts) (*wfv1.NodeStatus, error) {
+	node := woc.wf.GetNodeByName(nodeName)
+	if node == nil {
+		node = woc.initializeExecutableNode(nodeName, wfv1.NodeTypePod, templateScope, tmpl, orgTmpl, opts.boundaryID, wfv1.NodePending)
+	}
+	for _, ctr := range tmpl.ContainerSet.Containers {
+		ctrNodeName := fmt.Sprintf("%s.%s", nodeName, ctr.Name)
+		if woc.wf.GetNodeByName(ctrNodeName) == nil {
+			woc.initializeNode(ctrNodeName, wfv1.NodeTypeContainer, templateScope, orgTmpl, opts.boundaryID, wfv1.NodePending)
+			woc.addChildNode(nodeName, ctrNodeName)
+		}
+	}
+
+	// Check if the output of the main container is referenced elsewhere in the Workflow. If so, make sure to include
+	// it during execution.
+	includeScriptOutput, err := woc.includeScriptOutput(nodeName, opts.boundaryID)
+	if err != nil {
+		return node, err
+	}
+
+	mainCtr := newContainerSetContainer(tmpl.ContainerSet, *tmpl.ContainerSet.GetContainerByName(common.MainContainerName))
+	_, err = woc.createWorkflowPod(ctx, nodeName, mainCtr, tmpl, &createWorkflowPodOpts{
+		includeScriptOutput: includeScriptOutput,
+		onExitPod:           opts.onExitTemplate,
+		executionDeadline:   opts.executionDeadline,
+	})
+	if err != nil {
+		return woc.requeueIfTransientErr(err, node.Name)
+	}
+	return node, err
+}
+
+// newContainerSetContainer returns the pod container running a container of a container set. Its command is run by
+// argoexec, which starts it once the dependencies of the container have succeeded.
+func newContainerSetContainer(tmpl *wfv1.ContainerSetTemplate, ctr wfv1.ContainerNode) apiv1.Container {
+	c := *ctr.Container.DeepCopy()
+	command := []string{common.ExecutorContainerSetPath, "container-set", c.Name}
+	if len(ctr.Dependencies) > 0 {
+		command = append(command, "--dependencies="+strings.Join(ctr.Dependencies, ","))
+	}
+	command = append(command, "--")
+	command = append(command, c.Command...)
+	c.Command = append(command, c.Args...)
+	c.Args = nil
+	c.VolumeMounts = append(c.VolumeMounts, tmpl.VolumeMounts...)
+	return c
+}
+
+// updateContainerNodes updates the nodes of the containers of a container set from the container statuses of its pod,
+// and their outputs once the pod is annotated with them. The pod is nil if it was deleted. Once the pod node is
+// fulfilled, the containers which did not complete inherit its phase.
+func (woc *wfOperationCtx) updateContainerNodes(pod *apiv1.Pod, podNodeID string) {
+	podNode := woc.wf.Status.Nodes[podNodeID]
+	ctrStatuses := make(map[string]apiv1.ContainerStatus)
+	ctrOutputs := make(map[string]wfv1.Outputs)
+	if pod != nil {
+		for _, ctrStatus := range pod.Status.ContainerStatuses {
+			ctrStatuses[ctrStatus.Name] = ctrStatus
+		}
+		if outputStr, ok := pod.Annotations[common.AnnotationKeyContainerOutputs]; ok {
+			if err := json.Unmarshal([]byte(outputStr), &ctrOutputs); err != nil {
+				woc.log.WithField("pod", pod.Name).WithError(err).Error("Failed to unmarshal container outputs from pod annotation")
+			}
+		}
+	}
+	for _, childID := range podNode.Children {
+		node, ok := woc.wf.Status.Nodes[childID]
+		if !ok || node.Type != wfv1.NodeTypeContainer {
+			continue
+		}
+		ctrName := strings.TrimPrefix(node.Name, podNode.Name+".")
+		if outputs, ok := ctrOutputs[ctrName]; ok && (node.Outputs == nil || (len(node.Outputs.Parameters) == 0 && len(node.Outputs.Artifacts) == 0)) {
+			if node.Outputs != nil {
+				outputs.ExitCode = node.Outputs.ExitCode
+			}
+			node.Outputs = &outputs
+			woc.wf.Status.Nodes[node.ID] = node
+			woc.addOutputsToGlobalScope(node.Outputs)
+			woc.updated = true
+		}
+		if node.Fulfilled() {
+			continue
+		}
+		phase, message := node.Phase, node.Message
+		var exitCode *string
+		if ctrStatus, ok := ctrStatuses[ctrName]; ok {
+			switch {
+			case ctrStatus.State.Running != nil:
+				phase = wfv1.NodeRunning
+			case ctrStatus.State.Terminated != nil:
+				terminated := ctrStatus.State.Terminated
+				switch {
+				case strings.HasPrefix(terminated.Message, common.ContainerSetOmittedMessagePrefix):
+					phase, message = wfv1.NodeOmitted, strings.TrimPrefix(terminated.Message, common.ContainerSetOmittedMessagePrefix)
+				case terminated.ExitCode == 0:
+					phase = wfv1.NodeSucceeded
+				default:
+					phase, message = wfv1.NodeFailed, terminated.Message
+					if message == "" {
+						message = fmt.Sprintf("failed with exit code %d", terminated.ExitCode)
+					}
+				}
+				if phase != wfv1.NodeOmitted {
+					exitCode = pointer.StringPtr(fmt.Sprint(terminated.ExitCode))
+				}
+			}
+		}
+		if !phase.Fulfilled() && podNode.Fulfilled() {
+			phase, message = podNode.Phase, podNode.Message
+		}
+		if exitCode != nil {
+			if node.Outputs == nil {
+				node.Outputs = &wfv1.Outputs{}
+			}
+			node.Outputs.ExitCode = exitCode
+			woc.wf.Status.Nodes[node.ID] = node
+			woc.updated = true
+		}
+		if phase != node.Phase || message != node.Message {
+			woc.markNodePhase(node.Name, phase, message)
+		}
+	}
+}
+
+// getContainerSet returns the container set run by the pod, nil if it does not run one
+func getContainerSet(pod *apiv1.Pod) *wfv1.ContainerSetTemplate {
+	var tmpl wfv1.Template
+	if err := json.Unmarshal([]byte(pod.Annotations[common.AnnotationKeyTemplate]), &tmpl); err != nil {
+		return nil
+	}
+	return tmpl.ContainerSet
+}
+
+// containerKind returns how a container of the pod other than main is called in messages
+func containerKind(containerSet *wfv1.ContainerSetTemplate, name string) string {
+	if containerSet != nil && containerSet.GetContainerByName(name) != nil {
+		return "container"
+	}
+	return "sidecar"
+}
@@ -0,0 +1,222 @@
+package controller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	apiv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	wfv1 "github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo/v2/workflow/common"
+)
+
+var containerSetWf = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  name: container-set
+  namespace: default
+spec:
+  entrypoint: main
+  volumes:
+  - name: workspace
+    emptyDir: {}
+  templates:
+  - name: main
+    containerSet:
+      volumeMounts:
+      - name: workspace
+        mountPath: /workspace
+      containers:
+      - name: build
+        image: golang:1.15
+        command: [go, build, -o, /workspace/app]
+      - name: test
+        image: golang:1.15
+        command: [go, test]
+        dependencies: [build]
+      - name: main
+        image: alpine:3.7
+        command: [tar]
+        args: [-czf, /workspace/app.tgz, /workspace/app]
+        dependencies: [test]
+`
+
+func TestContainerSetPod(t *testing.T) {
+	wf := unmarshalWF(containerSetWf)
+	cancel, controller := newController(wf)
+	defer cancel()
+	ctx := context.Background()
+	woc := newWorkflowOperationCtx(wf, controller)
+	woc.operate(ctx)
+
+	pods, err := listPods(woc)
+	if !assert.NoError(t, err) || !assert.Len(t, pods.Items, 1) {
+		return
+	}
+	pod := pods.Items[0]
+	var names []string
+	for _, ctr := range pod.Spec.Containers {
+		names = append(names, ctr.Name)
+	}
+	assert.Equal(t, []string{common.WaitContainerName, "main", "build", "test"}, names)
+	if assert.Len(t, pod.Spec.InitContainers, 1) {
+		assert.Contains(t, pod.Spec.InitContainers[0].VolumeMounts, apiv1.VolumeMount{Name: "var-run-argo", MountPath: common.ExecutorVarRunArgoDir})
+	}
+	for _, ctr := range pod.Spec.Containers[1:] {
+		assert.Contains(t, ctr.VolumeMounts, apiv1.VolumeMount{Name: "workspace", MountPath: "/workspace"}, ctr.Name)
+		assert.Contains(t, ctr.VolumeMounts, apiv1.VolumeMount{Name: "var-run-argo", MountPath: common.ExecutorVarRunArgoDir}, ctr.Name)
+		assert.Empty(t, ctr.Args, ctr.Name)
+	}
+	assert.Equal(t, []string{common.ExecutorContainerSetPath, "container-set", "main", "--dependencies=test", "--", "tar", "-czf", "/workspace/app.tgz", "/workspace/app"}, pod.Spec.Containers[1].Command)
+	assert.Equal(t, []string{common.ExecutorContainerSetPath, "container-set", "build", "--", "go", "build", "-o", "/workspace/app"}, pod.Spec.Containers[2].Command)
+	var volumes []string
+	for _, vol := range pod.Spec.Volumes {
+		volumes = append(volumes, vol.Name)
+	}
+	assert.Contains(t, volumes, "workspace")
+	assert.Contains(t, volumes, "var-run-argo")
+
+	podNode := woc.wf.GetNodeByName("container-set")
+	if assert.NotNil(t, podNode) {
+		assert.Equal(t, wfv1.NodeTypePod, podNode.Type)
+		assert.Len(t, podNode.Children, 3)
+	}
+	for _, name := range []string{"build", "test", "main"} {
+		node := woc.wf.GetNodeByName("container-set." + name)
+		if assert.NotNil(t, node, name) {
+			assert.Equal(t, wfv1.NodeTypeContainer, node.Type)
+			assert.Equal(t, wfv1.NodePending, node.Phase)
+		}
+	}
+}
+
+func TestContainerSetNodes(t *testing.T) {
+	terminated := func(name string, exitCode int32, message string) apiv1.ContainerStatus {
+		return apiv1.ContainerStatus{Name: name, State: apiv1.ContainerState{Terminated: &apiv1.ContainerStateTerminated{ExitCode: exitCode, Message: message}}}
+	}
+	ctx := context.Background()
+
+	t.Run("Running", func(t *testing.T) {
+		wf := unmarshalWF(containerSetWf)
+		cancel, controller := newController(wf)
+		defer cancel()
+		woc := newWorkflowOperationCtx(wf, controller)
+		woc.operate(ctx)
+		makePodsPhase(ctx, woc, apiv1.PodRunning, func(pod *apiv1.Pod) {
+			pod.Status.ContainerStatuses = []apiv1.ContainerStatus{
+				{Name: "build", State: apiv1.ContainerState{Running: &apiv1.ContainerStateRunning{}}},
+				{Name: "test", State: apiv1.ContainerState{Waiting: &apiv1.ContainerStateWaiting{}}},
+			}
+		})
+		woc = newWorkflowOperationCtx(woc.wf, controller)
+		woc.operate(ctx)
+		assert.Equal(t, wfv1.NodeRunning, woc.wf.GetNodeByName("container-set.build").Phase)
+		assert.Equal(t, wfv1.NodePending, woc.wf.GetNodeByName("container-set.test").Phase)
+		assert.Equal(t, wfv1.NodePending, woc.wf.GetNodeByName("container-set.main").Phase)
+	})
+	t.Run("Failed", func(t *testing.T) {
+		wf := unmarshalWF(containerSetWf)
+		cancel, controller := newController(wf)
+		defer cancel()
+		woc := newWorkflowOperationCtx(wf, controller)
+		woc.operate(ctx)
+		makePodsPhase(ctx, woc, apiv1.PodFailed, func(pod *apiv1.Pod) {
+			pod.Status.Message = ""
+			pod.Status.ContainerStatuses = []apiv1.ContainerStatus{
+				terminated(common.WaitContainerName, 0, ""),
+				terminated("main", 0, common.ContainerSetOmittedMessagePrefix+"dependency 'test' did not succeed"),
+				terminated("build", 0, ""),
+				terminated("test", 1, ""),
+			}
+		})
+		woc = newWorkflowOperationCtx(woc.wf, controller)
+		woc.operate(ctx)
+		assert.Equal(t, wfv1.WorkflowFailed, woc.wf.Status.Phase)
+		podNode := woc.wf.GetNodeByName("container-set")
+		assert.Equal(t, wfv1.NodeFailed, podNode.Phase)
+		assert.Equal(t, "container 'test' failed with exit code 1", podNode.Message)
+
+		build := woc.wf.GetNodeByName("container-set.build")
+		assert.Equal(t, wfv1.NodeSucceeded, build.Phase)
+		if assert.NotNil(t, build.Outputs) {
+			assert.Equal(t, "0", *build.Outputs.ExitCode)
+		}
+		test := woc.wf.GetNodeByName("container-set.test")
+		assert.Equal(t, wfv1.NodeFailed, test.Phase)
+		assert.Equal(t, "failed with exit code 1", test.Message)
+		if assert.NotNil(t, test.Outputs) {
+			assert.Equal(t, "1", *test.Outputs.ExitCode)
+		}
+		main := woc.wf.GetNodeByName("container-set.main")
+		assert.Equal(t, wfv1.NodeOmitted, main.Phase)
+		assert.Equal(t, "dependency 'test' did not succeed", main.Message)
+		assert.Nil(t, main.Outputs)
+	})
+	t.Run("Outputs", func(t *testing.T) {
+		wf := unmarshalWF(containerSetWf)
+		cancel, controller := newController(wf)
+		defer cancel()
+		woc := newWorkflowOperationCtx(wf, controller)
+		woc.operate(ctx)
+		makePodsPhase(ctx, woc, apiv1.PodRunning, func(pod *apiv1.Pod) {
+			pod.Status.ContainerStatuses = []apiv1.ContainerStatus{terminated("build", 0, "")}
+		})
+		woc = newWorkflowOperationCtx(woc.wf, controller)
+		woc.operate(ctx)
+		assert.Equal(t, wfv1.NodeSucceeded, woc.wf.GetNodeByName("container-set.build").Phase)
+
+		// the outputs of the containers are annotated once all of them have completed
+		makePodsPhase(ctx, woc, apiv1.PodSucceeded, func(pod *apiv1.Pod) {
+			pod.Status.ContainerStatuses = []apiv1.ContainerStatus{
+				terminated(common.WaitContainerName, 0, ""),
+				terminated("main", 0, ""),
+				terminated("build", 0, ""),
+				terminated("test", 0, ""),
+			}
+			pod.Annotations[common.AnnotationKeyContainerOutputs] = `{"build": {"parameters": [{"name": "version", "value": "1.0"}]}, "test": {"artifacts": [{"name": "report", "path": "/workspace/report.xml", "s3": {"key": "my-key/test/report.tgz"}}]}}`
+		})
+		woc = newWorkflowOperationCtx(woc.wf, controller)
+		woc.operate(ctx)
+		assert.Equal(t, wfv1.WorkflowSucceeded, woc.wf.Status.Phase)
+		build := woc.wf.GetNodeByName("container-set.build")
+		if assert.NotNil(t, build.Outputs) && assert.Len(t, build.Outputs.Parameters, 1) {
+			assert.Equal(t, "1.0", build.Outputs.Parameters[0].Value.String())
+			assert.Equal(t, "0", *build.Outputs.ExitCode)
+		}
+		test := woc.wf.GetNodeByName("container-set.test")
+		if assert.NotNil(t, test.Outputs) && assert.Len(t, test.Outputs.Artifacts, 1) {
+			assert.Equal(t, "report", test.Outputs.Artifacts[0].Name)
+			assert.Equal(t, "0", *test.Outputs.ExitCode)
+		}
+		main := woc.wf.GetNodeByName("container-set.main")
+		if assert.NotNil(t, main.Outputs) {
+			assert.Empty(t, main.Outputs.Parameters)
+		}
+	})
+	t.Run("PodDeleted", func(t *testing.T) {
+		wf := unmarshalWF(containerSetWf)
+		cancel, controller := newController(wf)
+		defer cancel()
+		woc := newWorkflowOperationCtx(wf, controller)
+		woc.operate(ctx)
+		makePodsPhase(ctx, woc, apiv1.PodRunning)
+		woc = newWorkflowOperationCtx(woc.wf, controller)
+		woc.operate(ctx)
+		deletePods(ctx, woc)
+		// the pod is not reported missing right after it started
+		podNode := woc.wf.GetNodeByName("container-set")
+		podNode.StartedAt = metav1.NewTime(time.Now().Add(-time.Minute))
+		woc.wf.Status.Nodes[podNode.ID] = *podNode
+		woc = newWorkflowOperationCtx(woc.wf, controller)
+		woc.operate(ctx)
+		for _, name := range []string{"build", "test", "main"} {
+			node := woc.wf.GetNodeByName("container-set." + name)
+			assert.Equal(t, wfv1.NodeError, node.Phase, name)
+			assert.Equal(t, "pod deleted", node.Message, name)
+		}
+	})
+}
@@ -2,10 +2,14 @@ package controller
 
 import (
 	"context"
+	"crypto/sha256"
+	"crypto/tls"
 	"encoding/json"
 	"fmt"
+	"net/http"
 	"os"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/argoproj/pkg/errors"
@@ -20,6 +24,7 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/selection"
 	"k8s.io/apimachinery/pkg/types"
+	utilcache "k8s.io/apimachinery/pkg/util/cache"
 	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/apimachinery/pkg/watch"
 	"k8s.io/client-go/dynamic"
@@ -36,12 +41,14 @@ import (
 	"github.com/argoproj/argo/v2/config"
 	argoErr "github.com/argoproj/argo/v2/errors"
 	"github.com/argoproj/argo/v2/persist/sqldb"
+	"github.com/argoproj/argo/v2/pkg/apis/workflow"
 	wfv1 "github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1"
 	wfclientset "github.com/argoproj/argo/v2/pkg/client/clientset/versioned"
 	wfextvv1alpha1 "github.com/argoproj/argo/v2/pkg/client/informers/externalversions/workflow/v1alpha1"
 	authutil "github.com/argoproj/argo/v2/util/auth"
 	"github.com/argoproj/argo/v2/util/diff"
 	errorsutil "github.com/argoproj/argo/v2/util/errors"
+	"github.com/argoproj/argo/v2/workflow/artifactgc"
 	"github.com/argoproj/argo/v2/workflow/artifactrepositories"
 	"github.com/argoproj/argo/v2/workflow/common"
 	controllercache "github.com/argoproj/argo/v2/workflow/controller/cache"
@@ -88,6 +95,7 @@ type WorkflowController struct {
 	wftmplInformer        wfextvv1alpha1.WorkflowTemplateInformer
 	cwftmplInformer       wfextvv1alpha1.ClusterWorkflowTemplateInformer
 	podInformer           cache.SharedIndexInformer
+	quotaInformer         cache.SharedIndexInformer // nil if the controller cannot watch resource quotas
 	wfQueue               workqueue.RateLimitingInterface
 	podQueue              workqueue.RateLimitingInterface
 	podCleanupQueue       workqueue.RateLimitingInterface // pods to be deleted or labelled depend on GC strategy
@@ -97,12 +105,16 @@ type WorkflowController struct {
 	offloadNodeStatusRepo sqldb.OffloadNodeStatusRepo
 	hydrator              hydrator.Interface
 	wfArchive             sqldb.WorkflowArchive
+	semaphoreRepository   sqldb.SemaphoreRepository
 	estimatorFactory      estimation.EstimatorFactory
 	syncManager           *sync.Manager
 	metrics               *metrics.Metrics
 	eventRecorderManager  events.EventRecorderManager
 	archiveLabelSelector  labels.Selector
 	cacheFactory          controllercache.Factory
+	httpRequests          *httpRequests
+	// whether the owners of the tokens the sync locks are requested with can list workflows, by hash of the token
+	syncLocksAccess *utilcache.Expiring
 }
 
 const (
@@ -110,7 +122,10 @@ const (
 	workflowTemplateResyncPeriod        = 20 * time.Minute
 	podResyncPeriod                     = 30 * time.Minute
 	clusterWorkflowTemplateResyncPeriod = 20 * time.Minute
+	resourceQuotaResyncPeriod           = 20 * time.Minute
 	workflowExistenceCheckPeriod        = 1 * time.Minute
+	semaphoreHeartbeatPeriod            = 10 * time.Second
+	syncLocksAccessTTL                  = 1 * time.Minute
 )
 
 // NewWorkflowController instantiates a new WorkflowController
@@ -132,25 +147,32 @@ func NewWorkflowController(ctx context.Context, restConfig *rest.Config, kubecli
 		containerRuntimeExecutor:   containerRuntimeExecutor,
 		configController:           config.NewController(namespace, configMap, kubeclientset, config.EmptyConfigFunc),
 		workflowKeyLock:            syncpkg.NewKeyLock(),
-		cacheFactory:               controllercache.NewCacheFactory(kubeclientset, namespace),
 		eventRecorderManager:       events.NewEventRecorderManager(kubeclientset),
+		syncLocksAccess:            utilcache.NewExpiring(),
 	}
 
 	wfc.UpdateConfig(ctx)
 
 	wfc.metrics = metrics.New(wfc.getMetricsServerConfig())
+	wfc.updateCacheFactory()
 
 	workqueue.SetProvider(wfc.metrics) // must execute SetProvider before we created the queues
 	wfc.wfQueue = wfc.metrics.RateLimiterWithBusyWorkers(&fixedItemIntervalRateLimiter{}, "workflow_queue")
 	wfc.throttler = wfc.newThrottler()
 	wfc.podQueue = wfc.metrics.RateLimiterWithBusyWorkers(workqueue.DefaultControllerRateLimiter(), "pod_queue")
 	wfc.podCleanupQueue = wfc.metrics.RateLimiterWithBusyWorkers(workqueue.DefaultControllerRateLimiter(), "pod_cleanup_queue")
+	wfc.httpRequests = newHTTPRequests(wfc.Config.HTTPTemplate.GetParallelism(), func(key string) { wfc.wfQueue.AddRateLimited(key) })
 
 	return &wfc, nil
 }
 
 func (wfc *WorkflowController) newThrottler() sync.Throttler {
-	return sync.NewThrottler(wfc.Config.Parallelism, func(key string) { wfc.wfQueue.AddRateLimited(key) })
+	quotas := sync.Quotas{
+		Parallelism: wfc.Config.Parallelism,
+		Namespace:   wfc.Config.NamespaceParallelism,
+		Labels:      wfc.Config.LabelParallelism,
+	}
+	return sync.NewThrottler(quotas, func(key string) { wfc.wfQueue.AddRateLimited(key) }, wfc.metrics.SetParallelismQuota)
 }
 
 // RunTTLController runs the workflow TTL controller
@@ -162,6 +184,15 @@ func (wfc *WorkflowController) runTTLController(ctx context.Context, workflowTTL
 	}
 }
 
+// runArtifactGCController runs the workflow artifact GC controller
+func (wfc *WorkflowController) runArtifactGCController(ctx context.Context, artifactGCWorkers int) {
+	artifactGCCtrl := artifactgc.NewController(wfc.kubeclientset, wfc.wfclientset, wfc.wfInformer, wfc.hydrator, wfc.metrics)
+	err := artifactGCCtrl.Run(ctx.Done(), artifactGCWorkers)
+	if err != nil {
+		panic(err)
+	}
+}
+
 func (wfc *WorkflowController) runCronController(ctx context.Context) {
 	cronController := cron.NewCronController(wfc.wfclientset, wfc.dynamicInterface, wfc.namespace, wfc.GetManagedNamespace(), wfc.Config.InstanceID, wfc.metrics, wfc.eventRecorderManager)
 	cronController.Run(ctx)
@@ -177,13 +208,13 @@ var indexers = cache.Indexers{
 }
 
 // Run starts an Workflow resource controller
-func (wfc *WorkflowController) Run(ctx context.Context, wfWorkers, workflowTTLWorkers, podWorkers, podCleanupWorkers int) {
+func (wfc *WorkflowController) Run(ctx context.Context, wfWorkers, workflowTTLWorkers, podWorkers, podCleanupWorkers, artifactGCWorkers int) {
 	defer wfc.wfQueue.ShutDown()
 	defer wfc.podQueue.ShutDown()
 	defer wfc.podCleanupQueue.ShutDown()
 
 	log.WithField("version", argo.GetVersion().Version).Info("Starting Workflow Controller")
-	log.Infof("Workers: workflow: %d, pod: %d, pod cleanup: %d", wfWorkers, podWorkers, podCleanupWorkers)
+	log.Infof("Workers: workflow: %d, pod: %d, pod cleanup: %d, artifact GC: %d", wfWorkers, podWorkers, podCleanupWorkers, artifactGCWorkers)
 
 	wfc.wfInformer = util.NewWorkflowInformer(wfc.dynamicInterface, wfc.GetManagedNamespace(), workflowResyncPeriod, wfc.tweakListOptions, indexers)
 	wfc.wftmplInformer = informer.NewTolerantWorkflowTemplateInformer(wfc.dynamicInterface, workflowTemplateResyncPeriod, wfc.managedNamespace)
@@ -204,6 +235,7 @@ func (wfc *WorkflowController) Run(ctx context.Context, wfWorkers, workflowTTLWo
 	}
 
 	wfc.createClusterWorkflowTemplateInformer(ctx)
+	wfc.createResourceQuotaInformer(ctx)
 
 	// Create Synchronization Manager
 	err := wfc.createSynchronizationManager(ctx)
@@ -237,14 +269,19 @@ func (wfc *WorkflowController) Run(ctx context.Context, wfWorkers, workflowTTLWo
 				}
 				go wfc.workflowGarbageCollector(ctx.Done())
 				go wfc.archivedWorkflowGarbageCollector(ctx.Done())
+				go wfc.memoizationCacheGarbageCollector(ctx.Done())
 
 				go wfc.runTTLController(ctx, workflowTTLWorkers)
+				go wfc.runArtifactGCController(ctx, artifactGCWorkers)
 				go wfc.runCronController(ctx)
 				go wfc.metrics.RunServer(ctx)
+				go wfc.runSyncLocksServer(ctx)
 				go wait.Until(wfc.syncWorkflowPhaseMetrics, 15*time.Second, ctx.Done())
 				go wait.Until(wfc.syncPodPhaseMetrics, 15*time.Second, ctx.Done())
 
 				go wait.Until(wfc.syncManager.CheckWorkflowExistence, workflowExistenceCheckPeriod, ctx.Done())
+				go wait.Until(wfc.syncManager.Heartbeat, semaphoreHeartbeatPeriod, ctx.Done())
+				go wait.Until(wfc.httpRequests.gc, time.Minute, ctx.Done())
 
 				for i := 0; i < wfWorkers; i++ {
 					go wait.Until(wfc.runWorker, time.Second, ctx.Done())
@@ -309,7 +346,11 @@ func (wfc *WorkflowController) createSynchronizationManager(ctx context.Context)
 		return exists
 	}
 
-	wfc.syncManager = sync.NewLockManager(getSyncLimit, nextWorkflow, isWFDeleted)
+	getSemaphoreRepository := func() sqldb.SemaphoreRepository {
+		return wfc.semaphoreRepository
+	}
+
+	wfc.syncManager = sync.NewLockManager(getSyncLimit, nextWorkflow, isWFDeleted, getSemaphoreRepository)
 
 	labelSelector := v1Label.NewSelector()
 	req, _ := v1Label.NewRequirement(common.LabelKeyPhase, selection.Equals, []string{string(wfv1.NodeRunning)})
@@ -327,6 +368,89 @@ func (wfc *WorkflowController) createSynchronizationManager(ctx context.Context)
 	return nil
 }
 
+// runSyncLocksServer serves the status of the semaphores and mutexes of the controller, whether or not its metrics
+// server is enabled. It is served over TLS, as callers authenticate with their bearer token.
+func (wfc *WorkflowController) runSyncLocksServer(ctx context.Context) {
+	cert, err := sync.GetOrCreateLocksCertificate(ctx, wfc.kubeclientset.CoreV1().Secrets(wfc.namespace), wfc.namespace)
+	if err != nil {
+		log.WithError(err).Error("Sync locks server failed")
+		return
+	}
+	mux := http.NewServeMux()
+	mux.HandleFunc(sync.LocksPath, wfc.serveSyncLocks)
+	srv := &http.Server{
+		Addr:      fmt.Sprintf(":%v", sync.LocksServerPort),
+		Handler:   mux,
+		TLSConfig: &tls.Config{Certificates: []tls.Certificate{cert}, MinVersion: tls.VersionTLS12},
+	}
+
+	go func() {
+		log.Infof("Starting sync locks server at localhost:%v%s", sync.LocksServerPort, sync.LocksPath)
+		if err := srv.ListenAndServeTLS("", ""); err != nil && err != http.ErrServerClosed {
+			log.WithError(err).Error("Sync locks server failed")
+		}
+	}()
+
+	<-ctx.Done()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 1*time.Second)
+	defer cancel()
+	if err := srv.Shutdown(ctx); err != nil {
+		log.WithError(err).Info("Unable to shutdown sync locks server")
+	}
+}
+
+// serveSyncLocks serves the status of the semaphores and mutexes of the controller to the callers whose bearer token
+// allows them to list the workflows the controller manages, e.g. the Argo Server
+func (wfc *WorkflowController) serveSyncLocks(w http.ResponseWriter, r *http.Request) {
+	token := strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer ")
+	if token == "" || token == r.Header.Get("Authorization") {
+		http.Error(w, "a bearer token is required", http.StatusUnauthorized)
+		return
+	}
+	allowed, err := wfc.canListWorkflows(r.Context(), token)
+	if err != nil {
+		code := http.StatusInternalServerError
+		if apierr.IsUnauthorized(err) {
+			code = http.StatusUnauthorized
+		}
+		http.Error(w, err.Error(), code)
+		return
+	}
+	if !allowed {
+		http.Error(w, "not allowed to list workflows", http.StatusForbidden)
+		return
+	}
+	data, err := json.Marshal(sync.LockStatusList{Items: wfc.syncManager.ListLocks()})
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	_, _ = w.Write(data)
+}
+
+// canListWorkflows returns whether the owner of the token can list the workflows the controller manages. The answer
+// is remembered for a short while, as the Argo Server uses the same token for every request.
+func (wfc *WorkflowController) canListWorkflows(ctx context.Context, token string) (bool, error) {
+	key := sha256.Sum256([]byte(token))
+	if allowed, ok := wfc.syncLocksAccess.Get(key); ok {
+		return allowed.(bool), nil
+	}
+	config := rest.AnonymousClientConfig(wfc.restConfig)
+	config.BearerToken = token
+	kubeClient, err := kubernetes.NewForConfig(config)
+	if err != nil {
+		return false, err
+	}
+	allowed, err := authutil.CanI(ctx, kubeClient, "list", workflow.WorkflowPlural, wfc.managedNamespace, "")
+	if err != nil {
+		return false, err
+	}
+	wfc.syncLocksAccess.Set(key, allowed, syncLocksAccessTTL)
+	return allowed, nil
+}
+
 func (wfc *WorkflowController) runConfigMapWatcher(stopCh <-chan struct{}) {
 	ctx := context.Background()
 	retryWatcher, err := apiwatch.NewRetryWatcher("1", &cache.ListWatch{
@@ -373,6 +497,69 @@ func (wfc *WorkflowController) notifySemaphoreConfigUpdate(cm *apiv1.ConfigMap)
 	}
 }
 
+// createResourceQuotaInformer watches resource quotas, so that workflows waiting for a resource quota are requeued when
+// the quota is updated (e.g. because other pods were deleted), rather than being retried with back-off
+func (wfc *WorkflowController) createResourceQuotaInformer(ctx context.Context) {
+	listAllowed, err := authutil.CanI(ctx, wfc.kubeclientset, "list", "resourcequotas", wfc.managedNamespace, "")
+	errors.CheckError(err)
+	watchAllowed, err := authutil.CanI(ctx, wfc.kubeclientset, "watch", "resourcequotas", wfc.managedNamespace, "")
+	errors.CheckError(err)
+	if !listAllowed || !watchAllowed {
+		log.Warnf("Controller doesn't have RBAC access for ResourceQuotas, workflows waiting for a resource quota will be retried with back-off")
+		return
+	}
+	informer := cache.NewSharedIndexInformer(&cache.ListWatch{
+		ListFunc: func(options metav1.ListOptions) (runtime.Object, error) {
+			return wfc.kubeclientset.CoreV1().ResourceQuotas(wfc.managedNamespace).List(ctx, options)
+		},
+		WatchFunc: func(options metav1.ListOptions) (watch.Interface, error) {
+			return wfc.kubeclientset.CoreV1().ResourceQuotas(wfc.managedNamespace).Watch(ctx, options)
+		},
+	}, &apiv1.ResourceQuota{}, resourceQuotaResyncPeriod, cache.Indexers{})
+	informer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		UpdateFunc: func(old, new interface{}) {
+			oldQuota, newQuota := old.(*apiv1.ResourceQuota), new.(*apiv1.ResourceQuota)
+			if oldQuota.ResourceVersion == newQuota.ResourceVersion {
+				return
+			}
+			wfc.notifyResourceQuotaUpdate(newQuota.Namespace)
+		},
+		DeleteFunc: func(obj interface{}) {
+			key, err := cache.DeletionHandlingMetaNamespaceKeyFunc(obj)
+			if err != nil {
+				return
+			}
+			namespace, _, _ := cache.SplitMetaNamespaceKey(key)
+			wfc.notifyResourceQuotaUpdate(namespace)
+		},
+	})
+	go informer.Run(ctx.Done())
+	if !cache.WaitForCacheSync(ctx.Done(), informer.HasSynced) {
+		log.Fatal("Timed out waiting for caches to sync")
+	}
+	wfc.quotaInformer = informer
+}
+
+func (wfc *WorkflowController) isWatchingResourceQuotas() bool {
+	return wfc.quotaInformer != nil
+}
+
+// notifyResourceQuotaUpdate requeues the workflows in the namespace that are waiting for a resource quota
+func (wfc *WorkflowController) notifyResourceQuotaUpdate(namespace string) {
+	condition := wfv1.Condition{Type: wfv1.ConditionTypeResourceQuotaExceeded, Status: metav1.ConditionTrue}
+	keys, err := wfc.wfInformer.GetIndexer().IndexKeys(indexes.ConditionsIndex, indexes.ConditionValue(condition))
+	if err != nil {
+		log.WithError(err).Error("failed to get the workflows waiting for a resource quota")
+		return
+	}
+	for _, key := range keys {
+		wfNamespace, _, _ := cache.SplitMetaNamespaceKey(key)
+		if wfNamespace == namespace {
+			wfc.wfQueue.Add(key)
+		}
+	}
+}
+
 // Check if the controller has RBAC access to ClusterWorkflowTemplates
 func (wfc *WorkflowController) createClusterWorkflowTemplateInformer(ctx context.Context) {
 	cwftGetAllowed, err := authutil.CanI(ctx, wfc.kubeclientset, "get", "clusterworkflowtemplates", wfc.namespace, "")
@@ -453,6 +640,37 @@ func (wfc *WorkflowController) processNextPodCleanupItem(ctx context.Context) bo
 	return true
 }
 
+func (wfc *WorkflowController) memoizationCacheGarbageCollector(stopCh <-chan struct{}) {
+	value, ok := os.LookupEnv("MEMOIZATION_CACHE_GC_PERIOD")
+	periodicity := 5 * time.Minute
+	if ok {
+		var err error
+		periodicity, err = time.ParseDuration(value)
+		if err != nil {
+			log.WithFields(log.Fields{"err": err, "value": value}).Fatal("Failed to parse MEMOIZATION_CACHE_GC_PERIOD")
+		}
+	}
+	log.WithField("periodicity", periodicity).Info("Performing periodic memoization cache GC")
+	ticker := time.NewTicker(periodicity)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-stopCh:
+			return
+		case <-ticker.C:
+			// ConfigMap caches are evicted even if no limit is configured, so that they do not exceed the size limit of
+			// ConfigMaps
+			var maxAge time.Duration
+			var maxSize int64
+			if cacheConfig := wfc.Config.MemoizationCache; cacheConfig != nil {
+				maxAge, maxSize = time.Duration(cacheConfig.MaxAge), cacheConfig.GetMaxSize()
+			}
+			log.Info("Performing memoization cache GC")
+			wfc.cacheFactory.Evict(context.Background(), maxAge, maxSize)
+		}
+	}
+}
+
 func (wfc *WorkflowController) workflowGarbageCollector(stopCh <-chan struct{}) {
 	value, ok := os.LookupEnv("WORKFLOW_GC_PERIOD")
 	periodicity := 5 * time.Minute
@@ -708,6 +926,19 @@ func (wfc *WorkflowController) enqueueWfFromPodLabel(obj interface{}) error {
 	return nil
 }
 
+// enqueueParentWorkflow enqueues the workflow owning the workflow, if it is the child workflow of a node
+func (wfc *WorkflowController) enqueueParentWorkflow(obj interface{}) {
+	un, ok := obj.(*unstructured.Unstructured)
+	if !ok {
+		return
+	}
+	owner := metav1.GetControllerOf(un)
+	if owner == nil || owner.Kind != workflow.WorkflowKind || owner.APIVersion != wfv1.SchemeGroupVersion.String() {
+		return
+	}
+	wfc.wfQueue.AddRateLimited(un.GetNamespace() + "/" + owner.Name)
+}
+
 func (wfc *WorkflowController) tweakListOptions(options *metav1.ListOptions) {
 	labelSelector := labels.NewSelector().
 		Add(util.InstanceIDRequirement(wfc.Config.InstanceID))
@@ -730,6 +961,14 @@ func getWfPriority(obj interface{}) (int32, time.Time) {
 	return int32(priority), un.GetCreationTimestamp().Time
 }
 
+func getWfLabels(obj interface{}) map[string]string {
+	un, ok := obj.(*unstructured.Unstructured)
+	if !ok {
+		return nil
+	}
+	return un.GetLabels()
+}
+
 func (wfc *WorkflowController) addWorkflowInformerHandlers(ctx context.Context) {
 	wfc.wfInformer.AddEventHandler(
 		cache.FilteringResourceEventHandler{
@@ -743,7 +982,7 @@ func (wfc *WorkflowController) addWorkflowInformerHandlers(ctx context.Context)
 						// for a new workflow, we do not want to rate limit its execution using AddRateLimited
 						wfc.wfQueue.AddAfter(key, wfc.Config.InitialDelay.Duration)
 						priority, creation := getWfPriority(obj)
-						wfc.throttler.Add(key, priority, creation)
+						wfc.throttler.Add(key, getWfLabels(obj), priority, creation)
 					}
 				},
 				UpdateFunc: func(old, new interface{}) {
@@ -756,7 +995,7 @@ func (wfc *WorkflowController) addWorkflowInformerHandlers(ctx context.Context)
 					if err == nil {
 						wfc.wfQueue.AddRateLimited(key)
 						priority, creation := getWfPriority(new)
-						wfc.throttler.Add(key, priority, creation)
+						wfc.throttler.Add(key, newWf.GetLabels(), priority, creation)
 					}
 				},
 				DeleteFunc: func(obj interface{}) {
@@ -772,6 +1011,14 @@ func (wfc *WorkflowController) addWorkflowInformerHandlers(ctx context.Context)
 			},
 		},
 	)
+	// a child workflow, unlike its pods, is not reconciled by its parent, which needs to be requeued to track it
+	wfc.wfInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc: wfc.enqueueParentWorkflow,
+		UpdateFunc: func(_, obj interface{}) {
+			wfc.enqueueParentWorkflow(obj)
+		},
+		DeleteFunc: wfc.enqueueParentWorkflow,
+	})
 	wfc.wfInformer.AddEventHandler(cache.FilteringResourceEventHandler{
 		FilterFunc: func(obj interface{}) bool {
 			un, ok := obj.(*unstructured.Unstructured)
@@ -924,7 +1171,15 @@ func (wfc *WorkflowController) newPodInformer(ctx context.Context) cache.SharedI
 
 // call this func whenever the configuration changes, or when the workflow informer changes
 func (wfc *WorkflowController) updateEstimatorFactory() {
-	wfc.estimatorFactory = estimation.NewEstimatorFactory(wfc.wfInformer, wfc.hydrator, wfc.wfArchive)
+	wfc.estimatorFactory = estimation.NewEstimatorFactory(wfc.wfInformer, wfc.hydrator, wfc.wfArchive, wfc.Config.Estimation)
+}
+
+func (wfc *WorkflowController) updateCacheFactory() {
+	clusterName := ""
+	if wfc.Config.Persistence != nil {
+		clusterName = wfc.Config.Persistence.GetClusterName()
+	}
+	wfc.cacheFactory = controllercache.NewCacheFactory(wfc.kubeclientset, wfc.namespace, wfc.session, clusterName, wfc.artifactRepositories, wfc.metrics)
 }
 
 // setWorkflowDefaults sets values in the workflow.Spec with defaults from the
@@ -13,6 +13,7 @@ import (
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
+	utilcache "k8s.io/apimachinery/pkg/util/cache"
 	dynamicfake "k8s.io/client-go/dynamic/fake"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/kubernetes/fake"
@@ -162,7 +163,7 @@ func newController(options ...interface{}) (context.CancelFunc, *WorkflowControl
 		estimatorFactory:     estimation.DummyEstimatorFactory,
 		eventRecorderManager: &testEventRecorderManager{eventRecorder: record.NewFakeRecorder(16)},
 		archiveLabelSelector: labels.Everything(),
-		cacheFactory:         controllercache.NewCacheFactory(kube, "default"),
+		syncLocksAccess:      utilcache.NewExpiring(),
 	}
 
 	for _, opt := range options {
@@ -176,10 +177,12 @@ func newController(options ...interface{}) (context.CancelFunc, *WorkflowControl
 	// always compare to NewWorkflowController to see what this block of code should be doing
 	{
 		wfc.metrics = metrics.New(metrics.ServerConfig{}, metrics.ServerConfig{})
+		wfc.cacheFactory = controllercache.NewCacheFactory(kube, "default", nil, "", wfc.artifactRepositories, wfc.metrics)
 		wfc.wfQueue = workqueue.NewRateLimitingQueue(workqueue.DefaultControllerRateLimiter())
 		wfc.throttler = wfc.newThrottler()
 		wfc.podQueue = workqueue.NewRateLimitingQueue(workqueue.DefaultControllerRateLimiter())
 		wfc.podCleanupQueue = workqueue.NewRateLimitingQueue(workqueue.DefaultControllerRateLimiter())
+		wfc.httpRequests = newHTTPRequests(wfc.Config.HTTPTemplate.GetParallelism(), func(key string) { wfc.wfQueue.AddRateLimited(key) })
 	}
 
 	// always compare to WorkflowController.Run to see what this block of code should be doing
@@ -607,3 +610,28 @@ func TestNotifySemaphoreConfigUpdate(t *testing.T) {
 	time.Sleep(2 * time.Second)
 	assert.Equal(2, controller.wfQueue.Len())
 }
+
+func TestNotifyResourceQuotaUpdate(t *testing.T) {
+	wf := unmarshalWF(helloWorldWf)
+	wf.Namespace = "default"
+	wf.Status.Conditions = wfv1.Conditions{{Type: wfv1.ConditionTypeResourceQuotaExceeded, Status: metav1.ConditionTrue}}
+	otherNamespace := wf.DeepCopy()
+	otherNamespace.Namespace = "other"
+	notWaiting := wf.DeepCopy()
+	notWaiting.Name = "not-waiting"
+	notWaiting.Status.Conditions = nil
+
+	cancel, controller := newController(wf, otherNamespace, notWaiting)
+	defer cancel()
+
+	for controller.wfQueue.Len() > 0 {
+		key, _ := controller.wfQueue.Get()
+		controller.wfQueue.Done(key)
+	}
+
+	controller.notifyResourceQuotaUpdate("default")
+	if assert.Equal(t, 1, controller.wfQueue.Len()) {
+		key, _ := controller.wfQueue.Get()
+		assert.Equal(t, "default/hello-world", key)
+	}
+}
@@ -145,6 +145,11 @@ func (d *dagContext) assessDAGPhase(targetTasks []string, nodes wfv1.Nodes) wfv1
 		// We need to store the current branchPhase to remember the last completed phase in this branch so that we can apply it to omitted nodes
 		node, branchPhase := nodes[curr.nodeId], curr.phase
 
+		if node.Type == wfv1.NodeTypeContainer {
+			// the containers of a container set are reflected by the phase of their pod node
+			continue
+		}
+
 		if !node.Fulfilled() {
 			return wfv1.NodeRunning
 		}
@@ -262,6 +267,15 @@ func (woc *wfOperationCtx) executeDAG(ctx context.Context, nodeName string, tmpl
 		}
 	}
 
+	// hook nodes are not children of the task nodes, so the DAG needs to wait for them explicitly
+	hooksFulfilled, err := woc.executeTasksLifecycleHooks(ctx, dagCtx)
+	if err != nil {
+		return node, err
+	}
+	if !hooksFulfilled {
+		return node, nil
+	}
+
 	// check if we are still running any tasks in this dag and return early if we do
 	dagPhase := dagCtx.assessDAGPhase(targetTasks, woc.wf.Status.Nodes)
 	switch dagPhase {
@@ -494,6 +508,32 @@ func (woc *wfOperationCtx) executeDAGTask(ctx context.Context, dagCtx *dagContex
 	}
 }
 
+// executeTasksLifecycleHooks runs the hooks of the tasks of the DAG which were started. Their expressions can refer to
+// any task of the DAG, e.g. `tasks.train.status`. It returns whether all the hooks which ran are fulfilled.
+func (woc *wfOperationCtx) executeTasksLifecycleHooks(ctx context.Context, dagCtx *dagContext) (bool, error) {
+	scope := &wfScope{tmpl: dagCtx.tmpl, scope: make(map[string]interface{})}
+	for _, task := range dagCtx.tasks {
+		if taskNode := dagCtx.getTaskNode(task.Name); taskNode != nil {
+			woc.buildLocalScope(scope, fmt.Sprintf("tasks.%s", task.Name), taskNode)
+		}
+	}
+	params := woc.globalParams.Merge(scope.getParameters())
+
+	fulfilled := true
+	for _, task := range dagCtx.tasks {
+		taskNode := dagCtx.getTaskNode(task.Name)
+		if len(task.Hooks) == 0 || taskNode == nil {
+			continue
+		}
+		hooksFulfilled, err := woc.executeLifecycleHooks(ctx, task.Hooks, taskNode.Name, dagCtx.boundaryID, dagCtx.tmplCtx, params)
+		if err != nil {
+			return false, err
+		}
+		fulfilled = fulfilled && hooksFulfilled
+	}
+	return fulfilled, nil
+}
+
 func (woc *wfOperationCtx) buildLocalScopeFromTask(dagCtx *dagContext, task *wfv1.DAGTask) (*wfScope, error) {
 	// build up the scope
 	scope := wfScope{
@@ -6,8 +6,10 @@ import (
 
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/labels"
+	utilcache "k8s.io/apimachinery/pkg/util/cache"
 	"k8s.io/client-go/tools/cache"
 
+	"github.com/argoproj/argo/v2/config"
 	"github.com/argoproj/argo/v2/persist/sqldb"
 	wfv1 "github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1"
 	"github.com/argoproj/argo/v2/workflow/common"
@@ -25,24 +27,109 @@ type estimatorFactory struct {
 	wfInformer cache.SharedIndexInformer
 	hydrator   hydrator.Interface
 	wfArchive  sqldb.WorkflowArchive
+	config     config.Estimation
+	// the percentiles of the archived runs of each template, keyed by percentilesKey, nil if there are too few runs
+	percentiles *utilcache.Expiring
 }
 
 var _ EstimatorFactory = &estimatorFactory{}
 
-func NewEstimatorFactory(wfInformer cache.SharedIndexInformer, hydrator hydrator.Interface, wfArchive sqldb.WorkflowArchive) EstimatorFactory {
-	return &estimatorFactory{wfInformer, hydrator, wfArchive}
+func NewEstimatorFactory(wfInformer cache.SharedIndexInformer, hydrator hydrator.Interface, wfArchive sqldb.WorkflowArchive, estimationConfig config.Estimation) EstimatorFactory {
+	return &estimatorFactory{wfInformer, hydrator, wfArchive, estimationConfig, utilcache.NewExpiring()}
+}
+
+// how long the percentiles of a template's archived runs are used for before they are computed again
+const percentilesTTL = 5 * time.Minute
+
+type percentilesKey struct {
+	namespace, labelName, labelValue string
+}
+
+// templateLabels are the labels of workflows that are created from the same template, and the indexes of the
+// workflows of each. A workflow may have more than one, e.g. a cron workflow's that uses a workflow template, so they
+// are in a fixed order, the most specific first.
+var templateLabels = []struct {
+	name      string
+	indexName string
+}{
+	{common.LabelKeyCronWorkflow, indexes.CronWorkflowIndex},
+	{common.LabelKeyWorkflowTemplate, indexes.WorkflowTemplateIndex},
+	{common.LabelKeyClusterWorkflowTemplate, indexes.ClusterWorkflowTemplateIndex},
 }
 
 func (f *estimatorFactory) NewEstimator(wf *wfv1.Workflow) (Estimator, error) {
+	if f.config.Estimator == config.PercentileEstimator {
+		e, err := f.newPercentileEstimator(wf)
+		if err != nil {
+			return &estimator{wf: wf}, err
+		}
+		if e != nil {
+			return e, nil
+		}
+		// there are too few archived workflows, so we fall back to the baseline estimator
+	}
+	return f.newBaselineEstimator(wf)
+}
+
+// newPercentileEstimator returns nil if, for each of the workflow's template labels, there are fewer archived workflows
+// than the configured minimum
+func (f *estimatorFactory) newPercentileEstimator(wf *wfv1.Workflow) (Estimator, error) {
+	for _, label := range templateLabels {
+		labelValue, exists := wf.Labels[label.name]
+		if !exists {
+			continue
+		}
+		p, err := f.getPercentiles(percentilesKey{wf.Namespace, label.name, labelValue})
+		if err != nil {
+			return nil, err
+		}
+		if p != nil {
+			return &percentileEstimator{wf, p}, nil
+		}
+	}
+	return nil, nil
+}
+
+// getPercentiles returns the percentiles of the archived runs with the label, or nil if there are too few
+func (f *estimatorFactory) getPercentiles(key percentilesKey) (*percentiles, error) {
+	if p, ok := f.percentiles.Get(key); ok {
+		return p.(*percentiles), nil
+	}
+	requirements, err := labels.ParseToRequirements(common.LabelKeyPhase + "=" + string(wfv1.NodeSucceeded) + "," + key.labelName + "=" + key.labelValue)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse selector to requirements: %v", err)
+	}
+	workflows, err := f.wfArchive.ListWorkflows(sqldb.ListOptions{Namespace: key.namespace, LabelRequirements: requirements, Limit: f.config.GetRuns()})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list archived workflows: %v", err)
+	}
+	var p *percentiles
+	if len(workflows) >= f.config.GetMinRuns() {
+		// the list only has the metadata of the workflows, so we need to get their nodes
+		runs := make([]*wfv1.Workflow, len(workflows))
+		for i, md := range workflows {
+			run, err := f.wfArchive.GetWorkflow(string(md.UID))
+			if err != nil {
+				return nil, fmt.Errorf("failed to get archived workflow: %v", err)
+			}
+			if run == nil {
+				return nil, fmt.Errorf("archived workflow %s was deleted", md.UID)
+			}
+			runs[i] = run
+		}
+		p = newPercentiles(runs, f.config.GetPercentile())
+	}
+	f.percentiles.Set(key, p, percentilesTTL)
+	return p, nil
+}
+
+func (f *estimatorFactory) newBaselineEstimator(wf *wfv1.Workflow) (Estimator, error) {
 	defaultEstimator := &estimator{wf: wf}
-	for labelName, indexName := range map[string]string{
-		common.LabelKeyWorkflowTemplate:        indexes.WorkflowTemplateIndex,
-		common.LabelKeyClusterWorkflowTemplate: indexes.ClusterWorkflowTemplateIndex,
-		common.LabelKeyCronWorkflow:            indexes.CronWorkflowIndex,
-	} {
+	for _, label := range templateLabels {
+		labelName := label.name
 		labelValue, exists := wf.Labels[labelName]
 		if exists {
-			objs, err := f.wfInformer.GetIndexer().ByIndex(indexName, indexes.MetaNamespaceLabelIndex(wf.Namespace, labelValue))
+			objs, err := f.wfInformer.GetIndexer().ByIndex(label.indexName, indexes.MetaNamespaceLabelIndex(wf.Namespace, labelValue))
 			if err != nil {
 				return defaultEstimator, fmt.Errorf("failed to list workflows by index: %v", err)
 			}
@@ -76,7 +163,7 @@ func (f *estimatorFactory) NewEstimator(wf *wfv1.Workflow) (Estimator, error) {
 			if err != nil {
 				return defaultEstimator, fmt.Errorf("failed to parse selector to requirements: %v", err)
 			}
-			workflows, err := f.wfArchive.ListWorkflows(wf.Namespace, time.Time{}, time.Time{}, requirements, 1, 0)
+			workflows, err := f.wfArchive.ListWorkflows(sqldb.ListOptions{Namespace: wf.Namespace, LabelRequirements: requirements, Limit: 1})
 			if err != nil {
 				return defaultEstimator, fmt.Errorf("failed to list archived workflows: %v", err)
 			}
@@ -1,13 +1,17 @@
 package estimation
 
 import (
+	"fmt"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/assert"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
 
+	"github.com/argoproj/argo/v2/config"
+	"github.com/argoproj/argo/v2/persist/sqldb"
 	sqldbmocks "github.com/argoproj/argo/v2/persist/sqldb/mocks"
 	wfv1 "github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1"
 	testutil "github.com/argoproj/argo/v2/test/util"
@@ -53,12 +57,12 @@ metadata:
 	wfArchive := &sqldbmocks.WorkflowArchive{}
 	r, err := labels.ParseToRequirements("workflows.argoproj.io/phase=Succeeded,workflows.argoproj.io/workflow-template=my-archived-wftmpl")
 	assert.NoError(t, err)
-	wfArchive.On("ListWorkflows", "my-ns", time.Time{}, time.Time{}, labels.Requirements(r), 1, 0).Return(wfv1.Workflows{
+	wfArchive.On("ListWorkflows", sqldb.ListOptions{Namespace: "my-ns", LabelRequirements: r, Limit: 1}).Return(wfv1.Workflows{
 		*testutil.MustUnmarshallWorkflow(`
 metadata:
   name: my-archived-wftmpl-baseline`),
 	}, nil)
-	f := NewEstimatorFactory(informer, hydratorfake.Always, wfArchive)
+	f := NewEstimatorFactory(informer, hydratorfake.Always, wfArchive, config.Estimation{})
 	t.Run("None", func(t *testing.T) {
 		p, err := f.NewEstimator(&wfv1.Workflow{})
 		if assert.NoError(t, err) && assert.NotNil(t, p) {
@@ -111,3 +115,100 @@ metadata:
 		}
 	})
 }
+
+func Test_estimatorFactory_Percentile(t *testing.T) {
+	wfArchive := &sqldbmocks.WorkflowArchive{}
+	requirements := func(labelValue string) labels.Requirements {
+		return templateRequirements(t, "workflows.argoproj.io/cron-workflow", labelValue)
+	}
+	var runs wfv1.Workflows
+	for i, d := range []time.Duration{time.Second, 2 * time.Second, 3 * time.Second, time.Hour} {
+		name := fmt.Sprintf("my-cwf-%d", i)
+		run := wfv1.Workflow{
+			ObjectMeta: metav1.ObjectMeta{Name: name, UID: types.UID(name)},
+			Status: wfv1.WorkflowStatus{
+				StartedAt:  metav1.Time{},
+				FinishedAt: metav1.Time{Time: time.Time{}.Add(d)},
+				Nodes: wfv1.Nodes{
+					name:           {Name: name, FinishedAt: metav1.Time{Time: time.Time{}.Add(d)}},
+					name + "-1234": {Name: name + ".a", FinishedAt: metav1.Time{Time: time.Time{}.Add(d / 2)}},
+				},
+			},
+		}
+		runs = append(runs, run)
+		wfArchive.On("GetWorkflow", name).Return(run.DeepCopy(), nil)
+	}
+	wfArchive.On("ListWorkflows", sqldb.ListOptions{Namespace: "my-ns", LabelRequirements: requirements("my-cwf"), Limit: 10}).Return(runs, nil)
+	wfArchive.On("ListWorkflows", sqldb.ListOptions{Namespace: "my-ns", LabelRequirements: requirements("my-new-cwf"), Limit: 10}).Return(runs[:2], nil)
+	wfArchive.On("ListWorkflows", sqldb.ListOptions{Namespace: "my-ns", LabelRequirements: templateRequirements(t, "workflows.argoproj.io/workflow-template", "my-wft"), Limit: 10}).Return(runs[:3], nil)
+	informer := testutil.NewSharedIndexInformer()
+	informer.Indexer.SetByIndex(indexes.CronWorkflowIndex, "my-ns/my-new-cwf", testutil.MustUnmarshallUnstructured(`
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  name: my-new-cwf-baseline
+  labels:
+    workflows.argoproj.io/phase: Succeeded
+`))
+	f := NewEstimatorFactory(informer, hydratorfake.Always, wfArchive, config.Estimation{Estimator: config.PercentileEstimator})
+	t.Run("Percentile", func(t *testing.T) {
+		e, err := f.NewEstimator(&wfv1.Workflow{
+			ObjectMeta: metav1.ObjectMeta{Name: "my-wf", Namespace: "my-ns", Labels: map[string]string{common.LabelKeyCronWorkflow: "my-cwf"}},
+		})
+		if assert.NoError(t, err) && assert.IsType(t, &percentileEstimator{}, e) {
+			// the one hour outlier does not skew the median
+			assert.Equal(t, wfv1.EstimatedDuration(2), e.EstimateWorkflowDuration())
+			assert.Equal(t, wfv1.EstimatedDuration(2), e.EstimateNodeDuration("my-wf"))
+			assert.Equal(t, wfv1.EstimatedDuration(1), e.EstimateNodeDuration("my-wf.a"))
+			assert.Equal(t, wfv1.EstimatedDuration(0), e.EstimateNodeDuration("my-wf.b"))
+		}
+	})
+	t.Run("Cached", func(t *testing.T) {
+		e, err := f.NewEstimator(&wfv1.Workflow{
+			ObjectMeta: metav1.ObjectMeta{Name: "my-other-wf", Namespace: "my-ns", Labels: map[string]string{common.LabelKeyCronWorkflow: "my-cwf"}},
+		})
+		if assert.NoError(t, err) && assert.IsType(t, &percentileEstimator{}, e) {
+			assert.Equal(t, wfv1.EstimatedDuration(1), e.EstimateNodeDuration("my-other-wf.a"))
+		}
+		wfArchive.AssertNumberOfCalls(t, "ListWorkflows", 1)
+		wfArchive.AssertNumberOfCalls(t, "GetWorkflow", 4)
+	})
+	t.Run("FewRunsOfCronWorkflow", func(t *testing.T) {
+		// the cron workflow has too few runs, but the workflow template it uses has enough
+		e, err := f.NewEstimator(&wfv1.Workflow{
+			ObjectMeta: metav1.ObjectMeta{Name: "my-wf", Namespace: "my-ns", Labels: map[string]string{common.LabelKeyCronWorkflow: "my-new-cwf", common.LabelKeyWorkflowTemplate: "my-wft"}},
+		})
+		if assert.NoError(t, err) && assert.IsType(t, &percentileEstimator{}, e) {
+			assert.Equal(t, wfv1.EstimatedDuration(2), e.EstimateWorkflowDuration())
+		}
+	})
+	t.Run("FewRuns", func(t *testing.T) {
+		e, err := f.NewEstimator(&wfv1.Workflow{
+			ObjectMeta: metav1.ObjectMeta{Name: "my-wf", Namespace: "my-ns", Labels: map[string]string{common.LabelKeyCronWorkflow: "my-new-cwf"}},
+		})
+		if assert.NoError(t, err) && assert.IsType(t, &estimator{}, e) {
+			assert.Equal(t, "my-new-cwf-baseline", e.(*estimator).baselineWF.Name)
+		}
+	})
+	t.Run("NoTemplate", func(t *testing.T) {
+		e, err := f.NewEstimator(&wfv1.Workflow{})
+		if assert.NoError(t, err) {
+			assert.IsType(t, &estimator{}, e)
+		}
+	})
+}
+
+func templateRequirements(t *testing.T, labelName, labelValue string) labels.Requirements {
+	r, err := labels.ParseToRequirements("workflows.argoproj.io/phase=Succeeded," + labelName + "=" + labelValue)
+	assert.NoError(t, err)
+	return r
+}
+
+func Test_percentile(t *testing.T) {
+	assert.Zero(t, percentile(nil, 50))
+	durations := []time.Duration{4, 1, 3, 2}
+	assert.Equal(t, time.Duration(1), percentile(durations, 1))
+	assert.Equal(t, time.Duration(2), percentile(durations, 50))
+	assert.Equal(t, time.Duration(4), percentile(durations, 90))
+	assert.Equal(t, time.Duration(4), percentile(durations, 100))
+}
@@ -0,0 +1,70 @@
+package estimation
+
+import (
+	"math"
+	"sort"
+	"strings"
+	"time"
+
+	wfv1 "github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1"
+)
+
+// percentileEstimator estimates from a percentile of the durations of previous runs, so that a single outlier run does
+// not skew the estimates
+type percentileEstimator struct {
+	wf *wfv1.Workflow
+	*percentiles
+}
+
+// percentiles are the percentiles of the durations of the previous runs of a template, shared by the estimators of
+// its workflows
+type percentiles struct {
+	workflowDuration time.Duration
+	// the node durations, keyed by their name without the workflow name
+	nodeDurations map[string]time.Duration
+}
+
+func newPercentiles(runs []*wfv1.Workflow, p int) *percentiles {
+	var workflowDurations []time.Duration
+	nodeDurations := map[string][]time.Duration{}
+	for _, run := range runs {
+		workflowDurations = append(workflowDurations, run.Status.GetDuration())
+		for _, node := range run.Status.Nodes {
+			if node.FinishedAt.IsZero() {
+				continue
+			}
+			key := strings.TrimPrefix(node.Name, run.Name)
+			nodeDurations[key] = append(nodeDurations[key], node.GetDuration())
+		}
+	}
+	result := &percentiles{
+		workflowDuration: percentile(workflowDurations, p),
+		nodeDurations:    make(map[string]time.Duration, len(nodeDurations)),
+	}
+	for key, durations := range nodeDurations {
+		result.nodeDurations[key] = percentile(durations, p)
+	}
+	return result
+}
+
+func (e *percentileEstimator) EstimateWorkflowDuration() wfv1.EstimatedDuration {
+	return wfv1.NewEstimatedDuration(e.workflowDuration)
+}
+
+func (e *percentileEstimator) EstimateNodeDuration(nodeName string) wfv1.EstimatedDuration {
+	return wfv1.NewEstimatedDuration(e.nodeDurations[strings.TrimPrefix(nodeName, e.wf.Name)])
+}
+
+// percentile returns the p-th percentile of the durations using the nearest-rank method, so it is always one of the
+// durations
+func percentile(durations []time.Duration, p int) time.Duration {
+	if len(durations) == 0 {
+		return 0
+	}
+	sort.Slice(durations, func(i, j int) bool { return durations[i] < durations[j] })
+	rank := int(math.Ceil(float64(p) / 100 * float64(len(durations))))
+	if rank < 1 {
+		rank = 1
+	}
+	return durations[rank-1]
+}
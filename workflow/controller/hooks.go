@@ -0,0 +1,135 @@
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/antonmedv/expr"
+	"github.com/valyala/fasttemplate"
+
+	"github.com/argoproj/argo/v2/errors"
+	wfv1 "github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo/v2/workflow/common"
+	"github.com/argoproj/argo/v2/workflow/templateresolution"
+)
+
+// executeLifecycleHooks runs the hooks of a node whose expression evaluates to true. A hook runs at most once: once
+// its node exists, its expression is no longer evaluated and its template is only executed for its node to progress.
+// Hook nodes are not children of the node they hook, as the children of retry and task group nodes are their
+// attempts, but are in its boundary. It returns whether the nodes of all the hooks which ran are fulfilled.
+func (woc *wfOperationCtx) executeLifecycleHooks(ctx context.Context, hooks map[string]wfv1.LifecycleHook, parentNodeName, boundaryID string, tmplCtx *templateresolution.Context, params common.Parameters) (bool, error) {
+	if len(hooks) == 0 {
+		return true, nil
+	}
+	names := make([]string, 0, len(hooks))
+	for name := range hooks {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	if _, ok := params[common.GlobalVarWorkflowStatus]; !ok {
+		params = params.Merge(common.Parameters{common.GlobalVarWorkflowStatus: string(woc.wf.Status.Phase)})
+	}
+	env := lifecycleHookEnv(params)
+	fulfilled := true
+	for _, name := range names {
+		hook := hooks[name]
+		hookNodeName := common.GenerateLifecycleHookNodeName(parentNodeName, name)
+		log := woc.log.WithField("hook", hookNodeName)
+		if woc.wf.GetNodeByName(hookNodeName) == nil {
+			if !woc.execWf.Spec.Shutdown.ShouldExecute(false) {
+				continue
+			}
+			triggered, err := evaluateLifecycleHookExpression(hook.Expression, env)
+			if err != nil {
+				// the variables of the expression may not be known yet, e.g. the status of a step which did not start
+				log.WithError(err).Debug("Lifecycle hook expression not evaluated")
+				continue
+			}
+			if !triggered {
+				continue
+			}
+			log.Info("Running lifecycle hook")
+		}
+		args, err := substituteLifecycleHookArguments(hook.Arguments, params)
+		if err != nil {
+			return false, err
+		}
+		hookNode, err := woc.executeTemplate(ctx, hookNodeName, &wfv1.WorkflowStep{Template: hook.Template}, tmplCtx, args, &executeTemplateOpts{boundaryID: boundaryID})
+		if err != nil {
+			switch err {
+			case ErrDeadlineExceeded, ErrParallelismReached:
+				fulfilled = false
+				continue
+			}
+			return false, fmt.Errorf("hook '%s' errored: %w", hookNodeName, err)
+		}
+		if hookNode == nil || !hookNode.Fulfilled() {
+			fulfilled = false
+		}
+	}
+	return fulfilled, nil
+}
+
+// lifecycleHookEnv returns the variables of hook expressions, nested by the dots of their names so that
+// `steps.train.status` can be evaluated. A variable which is also the prefix of other variables is not available.
+func lifecycleHookEnv(params common.Parameters) map[string]interface{} {
+	keys := make([]string, 0, len(params))
+	for key := range params {
+		keys = append(keys, key)
+	}
+	// shorter keys first, so that a prefix of other keys is replaced by their map
+	sort.Slice(keys, func(i, j int) bool { return len(keys[i]) < len(keys[j]) })
+	env := make(map[string]interface{})
+	for _, key := range keys {
+		parts := strings.Split(key, ".")
+		m := env
+		for _, part := range parts[:len(parts)-1] {
+			child, ok := m[part].(map[string]interface{})
+			if !ok {
+				child = make(map[string]interface{})
+				m[part] = child
+			}
+			m = child
+		}
+		if _, ok := m[parts[len(parts)-1]].(map[string]interface{}); !ok {
+			m[parts[len(parts)-1]] = params[key]
+		}
+	}
+	return env
+}
+
+func evaluateLifecycleHookExpression(expression string, env map[string]interface{}) (bool, error) {
+	result, err := expr.Eval(expression, env)
+	if err != nil {
+		return false, err
+	}
+	triggered, ok := result.(bool)
+	if !ok {
+		return false, fmt.Errorf("expression must evaluate to a boolean, not %v", result)
+	}
+	return triggered, nil
+}
+
+func substituteLifecycleHookArguments(args wfv1.Arguments, params common.Parameters) (wfv1.Arguments, error) {
+	argsBytes, err := json.Marshal(args)
+	if err != nil {
+		return args, errors.InternalWrapError(err)
+	}
+	fstTmpl, err := fasttemplate.NewTemplate(string(argsBytes), "{{", "}}")
+	if err != nil {
+		return args, fmt.Errorf("unable to parse argo variable: %w", err)
+	}
+	newArgsStr, err := common.Replace(fstTmpl, params, false)
+	if err != nil {
+		return args, err
+	}
+	var newArgs wfv1.Arguments
+	if err := json.Unmarshal([]byte(newArgsStr), &newArgs); err != nil {
+		return args, errors.InternalWrapError(err)
+	}
+	return newArgs, nil
+}
@@ -0,0 +1,187 @@
+package controller
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	apiv1 "k8s.io/api/core/v1"
+
+	wfv1 "github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo/v2/workflow/common"
+)
+
+var lifecycleHooksStepsWf = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  name: lifecycle-hooks
+  namespace: default
+spec:
+  entrypoint: main
+  hooks:
+    running:
+      expression: workflow.status == "Running"
+      template: notify
+      arguments:
+        parameters:
+        - name: message
+          value: "{{workflow.name}} is running"
+  templates:
+  - name: main
+    steps:
+    - - name: train
+        template: train
+        hooks:
+          running:
+            expression: steps.train.status == "Running"
+            template: notify
+            arguments:
+              parameters:
+              - name: message
+                value: "{{steps.train.status}}"
+  - name: train
+    container:
+      image: alpine:3.7
+  - name: notify
+    inputs:
+      parameters:
+      - name: message
+    container:
+      image: alpine:3.7
+      args: ["{{inputs.parameters.message}}"]
+`
+
+// withHookPodPhase sets the phase of the pods of the hooks, or of the other pods if hook is false
+func withHookPodPhase(phase apiv1.PodPhase, hook bool) with {
+	return func(pod *apiv1.Pod) {
+		if strings.Contains(pod.Annotations[common.AnnotationKeyNodeName], ".hooks.") == hook {
+			pod.Status.Phase = phase
+		}
+	}
+}
+
+func TestStepsLifecycleHooks(t *testing.T) {
+	wf := unmarshalWF(lifecycleHooksStepsWf)
+	cancel, controller := newController(wf)
+	defer cancel()
+	ctx := context.Background()
+	woc := newWorkflowOperationCtx(wf, controller)
+	woc.operate(ctx)
+
+	wfHookNode := woc.wf.GetNodeByName("lifecycle-hooks.hooks.running")
+	if assert.NotNil(t, wfHookNode) {
+		assert.Equal(t, "", wfHookNode.BoundaryID)
+		assert.Equal(t, "lifecycle-hooks is running", wfHookNode.Inputs.Parameters[0].Value.String())
+	}
+	assert.Nil(t, woc.wf.GetNodeByName("lifecycle-hooks[0].train.hooks.running"))
+
+	makePodsPhase(ctx, woc, apiv1.PodRunning)
+	woc = newWorkflowOperationCtx(woc.wf, controller)
+	woc.operate(ctx)
+	stepHookNode := woc.wf.GetNodeByName("lifecycle-hooks[0].train.hooks.running")
+	if assert.NotNil(t, stepHookNode) {
+		assert.Equal(t, woc.wf.NodeID("lifecycle-hooks"), stepHookNode.BoundaryID)
+		assert.Equal(t, "train.hooks.running", stepHookNode.DisplayName)
+		assert.Equal(t, "Running", stepHookNode.Inputs.Parameters[0].Value.String())
+		assert.NotContains(t, woc.wf.GetNodeByName("lifecycle-hooks[0].train").Children, stepHookNode.ID)
+	}
+	pods, err := listPods(woc)
+	if assert.NoError(t, err) {
+		assert.Len(t, pods.Items, 3)
+	}
+
+	t.Run("RunOnce", func(t *testing.T) {
+		woc = newWorkflowOperationCtx(woc.wf, controller)
+		woc.operate(ctx)
+		pods, err := listPods(woc)
+		if assert.NoError(t, err) {
+			assert.Len(t, pods.Items, 3)
+		}
+	})
+	t.Run("WaitForHooks", func(t *testing.T) {
+		makePodsPhase(ctx, woc, apiv1.PodSucceeded, withHookPodPhase(apiv1.PodRunning, true))
+		woc = newWorkflowOperationCtx(woc.wf, controller)
+		woc.operate(ctx)
+		assert.Equal(t, wfv1.NodeSucceeded, woc.wf.GetNodeByName("lifecycle-hooks[0].train").Phase)
+		assert.Equal(t, wfv1.NodeRunning, woc.wf.GetNodeByName("lifecycle-hooks[0]").Phase)
+		assert.Equal(t, wfv1.WorkflowRunning, woc.wf.Status.Phase)
+
+		makePodsPhase(ctx, woc, apiv1.PodSucceeded)
+		woc = newWorkflowOperationCtx(woc.wf, controller)
+		woc.operate(ctx)
+		assert.Equal(t, wfv1.WorkflowSucceeded, woc.wf.Status.Phase)
+	})
+}
+
+var lifecycleHooksDAGWf = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  name: lifecycle-hooks
+  namespace: default
+spec:
+  entrypoint: main
+  templates:
+  - name: main
+    dag:
+      tasks:
+      - name: train
+        template: train
+        hooks:
+          failed:
+            expression: tasks.train.status == "Failed"
+            template: notify
+  - name: train
+    container:
+      image: alpine:3.7
+  - name: notify
+    container:
+      image: alpine:3.7
+`
+
+func TestDAGLifecycleHooks(t *testing.T) {
+	wf := unmarshalWF(lifecycleHooksDAGWf)
+	cancel, controller := newController(wf)
+	defer cancel()
+	ctx := context.Background()
+	woc := newWorkflowOperationCtx(wf, controller)
+	woc.operate(ctx)
+	makePodsPhase(ctx, woc, apiv1.PodRunning)
+	woc = newWorkflowOperationCtx(woc.wf, controller)
+	woc.operate(ctx)
+	assert.Nil(t, woc.wf.GetNodeByName("lifecycle-hooks.train.hooks.failed"))
+
+	makePodsPhase(ctx, woc, apiv1.PodFailed)
+	woc = newWorkflowOperationCtx(woc.wf, controller)
+	woc.operate(ctx)
+	hookNode := woc.wf.GetNodeByName("lifecycle-hooks.train.hooks.failed")
+	if assert.NotNil(t, hookNode) {
+		assert.Equal(t, woc.wf.NodeID("lifecycle-hooks"), hookNode.BoundaryID)
+	}
+	assert.Equal(t, wfv1.NodeRunning, woc.wf.GetNodeByName("lifecycle-hooks").Phase)
+
+	makePodsPhase(ctx, woc, apiv1.PodSucceeded, withHookPodPhase(apiv1.PodFailed, false))
+	woc = newWorkflowOperationCtx(woc.wf, controller)
+	woc.operate(ctx)
+	assert.Equal(t, wfv1.NodeFailed, woc.wf.GetNodeByName("lifecycle-hooks.train").Phase)
+	assert.Equal(t, wfv1.NodeSucceeded, woc.wf.GetNodeByName("lifecycle-hooks.train.hooks.failed").Phase)
+	assert.Equal(t, wfv1.WorkflowFailed, woc.wf.Status.Phase)
+}
+
+func TestLifecycleHookEnv(t *testing.T) {
+	env := lifecycleHookEnv(common.Parameters{
+		"steps.train.status":           "Running",
+		"workflow.creationTimestamp":   "2021-01-01T00:00:00Z",
+		"workflow.creationTimestamp.Y": "2021",
+	})
+	assert.Equal(t, map[string]interface{}{
+		"steps": map[string]interface{}{
+			"train": map[string]interface{}{"status": "Running"},
+		},
+		"workflow": map[string]interface{}{
+			"creationTimestamp": map[string]interface{}{"Y": "2021"},
+		},
+	}, env)
+}
@@ -0,0 +1,181 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/antonmedv/expr"
+	"k8s.io/client-go/tools/cache"
+
+	wfv1 "github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo/v2/util"
+)
+
+const (
+	// httpStatusCodeParameterName is the name of the output parameter that holds the response status code
+	httpStatusCodeParameterName = "statusCode"
+	defaultHTTPTimeoutSeconds   = 30
+	// maxHTTPResponseBodySize limits the size of the response body, because it is stored in the workflow's status
+	maxHTTPResponseBodySize = 256 * 1024
+	// maxHTTPRedirects is the number of redirects followed, the same as http.DefaultClient
+	maxHTTPRedirects = 10
+)
+
+// executeHTTP sends the HTTP request of the template in the background. The node is created as running before the
+// request is sent, and the request is only sent once the node is persisted, so that it is not sent again if the
+// workflow fails to be updated. A running node whose request is unknown to the controller, because it restarted, errors
+// rather than sending the request again.
+func (woc *wfOperationCtx) executeHTTP(ctx context.Context, nodeName string, templateScope string, tmpl *wfv1.Template, orgTmpl wfv1.TemplateReferenceHolder, opts *executeTemplateOpts) (*wfv1.NodeStatus, error) {
+	node := woc.wf.GetNodeByName(nodeName)
+	if node == nil {
+		node = woc.initializeExecutableNode(nodeName, wfv1.NodeTypeHTTP, templateScope, tmpl, orgTmpl, opts.boundaryID, wfv1.NodeRunning)
+		if err := woc.checkHTTPRequest(tmpl.HTTP); err != nil {
+			return node, err
+		}
+		woc.controller.httpRequests.register(woc.httpRequestKey(node.ID))
+		woc.requeue()
+		return node, nil
+	}
+
+	key := woc.httpRequestKey(node.ID)
+	result, ok := woc.controller.httpRequests.result(key)
+	if !ok {
+		wfKey, _ := cache.MetaNamespaceKeyFunc(woc.wf)
+		httpTmpl := tmpl.HTTP.DeepCopy()
+		namespace := woc.wf.Namespace
+		timeout := woc.httpRequestTimeout(httpTmpl)
+		sending := woc.controller.httpRequests.send(key, wfKey, func() (int, string, error) {
+			return woc.sendHTTPRequest(ctx, namespace, httpTmpl, timeout)
+		})
+		if !sending {
+			return node, fmt.Errorf("the controller restarted while the HTTP request was being sent, it is not sent again")
+		}
+		return node, nil
+	}
+	if result.err != nil {
+		return node, result.err
+	}
+
+	node = woc.wf.GetNodeByName(nodeName)
+	node.Outputs = &wfv1.Outputs{
+		Result:     &result.body,
+		Parameters: []wfv1.Parameter{{Name: httpStatusCodeParameterName, Value: wfv1.AnyStringPtr(result.statusCode)}},
+	}
+	woc.wf.Status.Nodes[node.ID] = *node
+	woc.updated = true
+
+	succeeded, err := evaluateHTTPSuccessCondition(tmpl.HTTP.SuccessCondition, result.statusCode, result.body)
+	if err != nil {
+		return node, err
+	}
+	if !succeeded {
+		return woc.markNodePhase(nodeName, wfv1.NodeFailed, fmt.Sprintf("HTTP request failed with status code %d", result.statusCode)), nil
+	}
+	return woc.markNodePhase(nodeName, wfv1.NodeSucceeded), nil
+}
+
+func (woc *wfOperationCtx) httpRequestKey(nodeID string) string {
+	return string(woc.wf.UID) + "/" + nodeID
+}
+
+// checkHTTPRequest returns an error if the controller's configuration does not allow the request to be sent
+func (woc *wfOperationCtx) checkHTTPRequest(tmpl *wfv1.HTTPTemplate) error {
+	cfg := woc.controller.Config.HTTPTemplate
+	if tmpl.TimeoutSeconds != nil && *tmpl.TimeoutSeconds > cfg.GetMaxTimeoutSeconds() {
+		return fmt.Errorf("timeoutSeconds %d is greater than the maximum of %d seconds", *tmpl.TimeoutSeconds, cfg.GetMaxTimeoutSeconds())
+	}
+	return cfg.CheckURL(tmpl.URL)
+}
+
+// httpRequestTimeout returns the timeout of the request, the default timeout is lowered to the maximum timeout
+func (woc *wfOperationCtx) httpRequestTimeout(tmpl *wfv1.HTTPTemplate) time.Duration {
+	timeoutSeconds := int64(defaultHTTPTimeoutSeconds)
+	if tmpl.TimeoutSeconds != nil {
+		timeoutSeconds = *tmpl.TimeoutSeconds
+	}
+	if max := woc.controller.Config.HTTPTemplate.GetMaxTimeoutSeconds(); timeoutSeconds > max {
+		timeoutSeconds = max
+	}
+	return time.Duration(timeoutSeconds) * time.Second
+}
+
+// sendHTTPRequest returns the status code and body of the response
+func (woc *wfOperationCtx) sendHTTPRequest(ctx context.Context, namespace string, tmpl *wfv1.HTTPTemplate, timeout time.Duration) (int, string, error) {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	method := tmpl.Method
+	if method == "" {
+		method = http.MethodGet
+	}
+	var body io.Reader
+	if tmpl.Body != "" {
+		body = strings.NewReader(tmpl.Body)
+	}
+	req, err := http.NewRequestWithContext(ctx, method, tmpl.URL, body)
+	if err != nil {
+		return 0, "", err
+	}
+	for _, header := range tmpl.Headers {
+		value := header.Value
+		if header.ValueFrom != nil && header.ValueFrom.SecretKeyRef != nil {
+			secret := header.ValueFrom.SecretKeyRef
+			bytes, err := util.GetSecrets(ctx, woc.controller.kubeclientset, namespace, secret.Name, secret.Key)
+			if err != nil {
+				return 0, "", fmt.Errorf("failed to get the value of header %s: %w", header.Name, err)
+			}
+			value = string(bytes)
+		}
+		req.Header.Add(header.Name, value)
+	}
+
+	woc.log.WithField("method", method).WithField("url", tmpl.URL).Info("Sending HTTP request")
+	cfg := woc.controller.Config.HTTPTemplate
+	client := &http.Client{
+		// the URLs redirected to must be allowed too, otherwise an allowed URL could redirect to a denied one
+		CheckRedirect: func(req *http.Request, via []*http.Request) error {
+			if len(via) >= maxHTTPRedirects {
+				return fmt.Errorf("stopped after %d redirects", maxHTTPRedirects)
+			}
+			return cfg.CheckURL(req.URL.String())
+		},
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return 0, "", err
+	}
+	defer func() { _ = resp.Body.Close() }()
+	data, err := ioutil.ReadAll(io.LimitReader(resp.Body, maxHTTPResponseBodySize+1))
+	if err != nil {
+		return 0, "", err
+	}
+	if len(data) > maxHTTPResponseBodySize {
+		return 0, "", fmt.Errorf("HTTP response body is larger than %d bytes", maxHTTPResponseBodySize)
+	}
+	return resp.StatusCode, string(data), nil
+}
+
+func evaluateHTTPSuccessCondition(condition string, statusCode int, body string) (bool, error) {
+	if condition == "" {
+		return statusCode >= 200 && statusCode < 300, nil
+	}
+	result, err := expr.Eval(condition, map[string]interface{}{
+		"response": map[string]interface{}{
+			"statusCode": statusCode,
+			"body":       body,
+		},
+	})
+	if err != nil {
+		return false, fmt.Errorf("failed to evaluate successCondition: %w", err)
+	}
+	succeeded, ok := result.(bool)
+	if !ok {
+		return false, fmt.Errorf("successCondition must evaluate to a boolean, not %v", result)
+	}
+	return succeeded, nil
+}
@@ -0,0 +1,108 @@
+package controller
+
+import (
+	"sync"
+	"time"
+)
+
+// httpRequestTTL is how long the outcome of a request is kept for its node to be updated with it, e.g. it is not if
+// the workflow is deleted
+const httpRequestTTL = time.Hour
+
+type httpRequestPhase int
+
+const (
+	// httpRequestRegistered is the phase of a request whose node may not be persisted yet, so it is not sent
+	httpRequestRegistered httpRequestPhase = iota
+	httpRequestSending
+	httpRequestDone
+)
+
+type httpRequestResult struct {
+	statusCode int
+	body       string
+	err        error
+}
+
+type httpRequest struct {
+	phase     httpRequestPhase
+	result    httpRequestResult
+	updatedAt time.Time
+}
+
+// httpRequests sends the requests of HTTP templates in the background, so that slow endpoints do not hold the workflow
+// workers, and at most parallelism requests are sent at the same time. A request is keyed by the UID of its workflow
+// and the ID of its node. It is sent at most once by the controller, and its outcome is kept until its node is updated.
+type httpRequests struct {
+	mutex    sync.Mutex
+	requests map[string]*httpRequest
+	slots    chan struct{}
+	// requeue requeues the workflow of a request once it is done
+	requeue func(wfKey string)
+}
+
+func newHTTPRequests(parallelism int, requeue func(wfKey string)) *httpRequests {
+	return &httpRequests{
+		requests: make(map[string]*httpRequest),
+		slots:    make(chan struct{}, parallelism),
+		requeue:  requeue,
+	}
+}
+
+// register records the request of a node which is being created, it is only sent once the node is persisted
+func (r *httpRequests) register(key string) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	if _, ok := r.requests[key]; !ok {
+		r.requests[key] = &httpRequest{phase: httpRequestRegistered, updatedAt: time.Now()}
+	}
+}
+
+// send sends a registered request in the background, unless it was already sent. It returns false if the request is
+// unknown, e.g. because the controller restarted since its node was created.
+func (r *httpRequests) send(key, wfKey string, send func() (int, string, error)) bool {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	req, ok := r.requests[key]
+	if !ok {
+		return false
+	}
+	if req.phase != httpRequestRegistered {
+		return true
+	}
+	req.phase = httpRequestSending
+	go func() {
+		r.slots <- struct{}{}
+		statusCode, body, err := send()
+		<-r.slots
+		r.mutex.Lock()
+		req.phase = httpRequestDone
+		req.result = httpRequestResult{statusCode: statusCode, body: body, err: err}
+		req.updatedAt = time.Now()
+		r.mutex.Unlock()
+		r.requeue(wfKey)
+	}()
+	return true
+}
+
+// result returns the outcome of a request, if it is done
+func (r *httpRequests) result(key string) (httpRequestResult, bool) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	req, ok := r.requests[key]
+	if !ok || req.phase != httpRequestDone {
+		return httpRequestResult{}, false
+	}
+	return req.result, true
+}
+
+// gc forgets the requests which are not being sent and whose node was not updated for a while
+func (r *httpRequests) gc() {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	for key, req := range r.requests {
+		if req.phase != httpRequestSending && time.Since(req.updatedAt) > httpRequestTTL {
+			delete(r.requests, key)
+		}
+	}
+}
@@ -0,0 +1,242 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	apiv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/pointer"
+
+	wfv1 "github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1"
+)
+
+var httpWf = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  name: http
+  namespace: default
+spec:
+  entrypoint: main
+  templates:
+  - name: main
+    http:
+      method: POST
+      url: "%s/items"
+      headers:
+      - name: Content-Type
+        value: application/json
+      - name: Authorization
+        valueFrom:
+          secretKeyRef:
+            name: my-secret
+            key: token
+      body: '{"name": "my-item"}'
+      successCondition: "%s"
+`
+
+// operateHTTP operates the workflow until it is fulfilled: the request is sent once its node is persisted, and the
+// node is updated once the request is done
+func operateHTTP(ctx context.Context, t *testing.T, woc *wfOperationCtx) *wfv1.Workflow {
+	for i := 0; i < 100 && !woc.wf.Status.Fulfilled(); i++ {
+		woc = newWorkflowOperationCtx(woc.wf, woc.controller)
+		woc.operate(ctx)
+		time.Sleep(10 * time.Millisecond)
+	}
+	assert.True(t, woc.wf.Status.Fulfilled(), "the workflow is fulfilled")
+	return woc.wf
+}
+
+func TestExecuteHTTP(t *testing.T) {
+	var requests int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		body, _ := ioutil.ReadAll(r.Body)
+		if r.Method != http.MethodPost || r.URL.Path != "/items" || r.Header.Get("Authorization") != "Bearer my-token" || string(body) != `{"name": "my-item"}` {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write([]byte(`{"id": 1}`))
+	}))
+	defer server.Close()
+
+	ctx := context.Background()
+	newWoc := func(successCondition string, options ...interface{}) (context.CancelFunc, *wfOperationCtx) {
+		wf := unmarshalWF(fmt.Sprintf(httpWf, server.URL, successCondition))
+		cancel, controller := newController(append(options, wf)...)
+		_, err := controller.kubeclientset.CoreV1().Secrets("default").Create(ctx, &apiv1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "my-secret"},
+			Data:       map[string][]byte{"token": []byte("Bearer my-token")},
+		}, metav1.CreateOptions{})
+		if err != nil {
+			panic(err)
+		}
+		woc := newWorkflowOperationCtx(wf, controller)
+		woc.operate(ctx)
+		return cancel, woc
+	}
+	run := func(t *testing.T, successCondition string) *wfv1.Workflow {
+		cancel, woc := newWoc(successCondition)
+		defer cancel()
+		wf := operateHTTP(ctx, t, woc)
+		pods, err := listPods(woc)
+		if assert.NoError(t, err) {
+			assert.Empty(t, pods.Items, "no pod is created")
+		}
+		return wf
+	}
+
+	t.Run("Succeeded", func(t *testing.T) {
+		wf := run(t, "response.statusCode == 201 && response.body contains 'id'")
+		assert.Equal(t, wfv1.WorkflowSucceeded, wf.Status.Phase)
+		node := wf.Status.Nodes.FindByDisplayName("http")
+		if assert.NotNil(t, node) {
+			assert.Equal(t, wfv1.NodeTypeHTTP, node.Type)
+			assert.Equal(t, wfv1.NodeSucceeded, node.Phase)
+			if assert.NotNil(t, node.Outputs) {
+				assert.Equal(t, `{"id": 1}`, *node.Outputs.Result)
+				if assert.Len(t, node.Outputs.Parameters, 1) {
+					assert.Equal(t, "statusCode", node.Outputs.Parameters[0].Name)
+					assert.Equal(t, "201", node.Outputs.Parameters[0].Value.String())
+				}
+			}
+		}
+	})
+	t.Run("Failed", func(t *testing.T) {
+		wf := run(t, "response.statusCode == 200")
+		assert.Equal(t, wfv1.WorkflowFailed, wf.Status.Phase)
+		node := wf.Status.Nodes.FindByDisplayName("http")
+		if assert.NotNil(t, node) {
+			assert.Equal(t, wfv1.NodeFailed, node.Phase)
+			assert.Equal(t, "HTTP request failed with status code 201", node.Message)
+		}
+	})
+	t.Run("InvalidCondition", func(t *testing.T) {
+		wf := run(t, "response.statusCode")
+		assert.Equal(t, wfv1.WorkflowError, wf.Status.Phase)
+		node := wf.Status.Nodes.FindByDisplayName("http")
+		if assert.NotNil(t, node) {
+			assert.Equal(t, wfv1.NodeError, node.Phase)
+			assert.Equal(t, "successCondition must evaluate to a boolean, not 201", node.Message)
+		}
+	})
+	t.Run("SentOnce", func(t *testing.T) {
+		atomic.StoreInt32(&requests, 0)
+		cancel, woc := newWoc("")
+		defer cancel()
+		node := woc.wf.Status.Nodes.FindByDisplayName("http")
+		if assert.NotNil(t, node) {
+			assert.Equal(t, wfv1.NodeRunning, node.Phase)
+		}
+		assert.Equal(t, int32(0), atomic.LoadInt32(&requests), "the request is not sent before the node is persisted")
+
+		// the workflow fails to be updated, so the node is created again
+		conflicted := unmarshalWF(fmt.Sprintf(httpWf, server.URL, ""))
+		conflictedWoc := newWorkflowOperationCtx(conflicted, woc.controller)
+		conflictedWoc.operate(ctx)
+
+		wf := operateHTTP(ctx, t, woc)
+		assert.Equal(t, wfv1.WorkflowSucceeded, wf.Status.Phase)
+		woc = newWorkflowOperationCtx(wf, woc.controller)
+		woc.operate(ctx)
+		assert.Equal(t, int32(1), atomic.LoadInt32(&requests))
+	})
+	t.Run("Restarted", func(t *testing.T) {
+		cancel, woc := newWoc("")
+		defer cancel()
+		woc.controller.httpRequests = newHTTPRequests(1, func(string) {})
+		woc = newWorkflowOperationCtx(woc.wf, woc.controller)
+		woc.operate(ctx)
+		node := woc.wf.Status.Nodes.FindByDisplayName("http")
+		if assert.NotNil(t, node) {
+			assert.Equal(t, wfv1.NodeError, node.Phase)
+			assert.Equal(t, "the controller restarted while the HTTP request was being sent, it is not sent again", node.Message)
+		}
+	})
+	t.Run("MaxTimeout", func(t *testing.T) {
+		wf := unmarshalWF(fmt.Sprintf(httpWf, server.URL, ""))
+		wf.Spec.Templates[0].HTTP.TimeoutSeconds = pointer.Int64Ptr(20)
+		cancel, controller := newController(wf, func(wfc *WorkflowController) {
+			wfc.Config.HTTPTemplate.MaxTimeoutSeconds = 10
+		})
+		defer cancel()
+		woc := newWorkflowOperationCtx(wf, controller)
+		woc.operate(ctx)
+		node := woc.wf.Status.Nodes.FindByDisplayName("http")
+		if assert.NotNil(t, node) {
+			assert.Equal(t, wfv1.NodeError, node.Phase)
+			assert.Equal(t, "timeoutSeconds 20 is greater than the maximum of 10 seconds", node.Message)
+		}
+	})
+	t.Run("DeniedURL", func(t *testing.T) {
+		atomic.StoreInt32(&requests, 0)
+		cancel, woc := newWoc("", func(wfc *WorkflowController) {
+			wfc.Config.HTTPTemplate.DeniedURLs = []string{`http://127\.0\.0\.1:.*`}
+		})
+		defer cancel()
+		node := woc.wf.Status.Nodes.FindByDisplayName("http")
+		if assert.NotNil(t, node) {
+			assert.Equal(t, wfv1.NodeError, node.Phase)
+			assert.Equal(t, "URL "+server.URL+"/items is denied by the controller's configuration", node.Message)
+		}
+		operateHTTP(ctx, t, woc)
+		assert.Equal(t, int32(0), atomic.LoadInt32(&requests))
+	})
+	t.Run("RedirectedToDeniedURL", func(t *testing.T) {
+		atomic.StoreInt32(&requests, 0)
+		redirector := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			http.Redirect(w, r, server.URL+r.URL.Path, http.StatusFound)
+		}))
+		defer redirector.Close()
+		wf := unmarshalWF(fmt.Sprintf(httpWf, redirector.URL, ""))
+		cancel, controller := newController(wf, func(wfc *WorkflowController) {
+			wfc.Config.HTTPTemplate.DeniedURLs = []string{regexp.QuoteMeta(server.URL) + "/.*"}
+		})
+		defer cancel()
+		_, err := controller.kubeclientset.CoreV1().Secrets("default").Create(ctx, &apiv1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "my-secret"},
+			Data:       map[string][]byte{"token": []byte("Bearer my-token")},
+		}, metav1.CreateOptions{})
+		assert.NoError(t, err)
+		woc := newWorkflowOperationCtx(wf, controller)
+		woc.operate(ctx)
+		wf = operateHTTP(ctx, t, woc)
+		assert.Equal(t, wfv1.WorkflowError, wf.Status.Phase)
+		node := wf.Status.Nodes.FindByDisplayName("http")
+		if assert.NotNil(t, node) {
+			assert.Equal(t, wfv1.NodeError, node.Phase)
+			assert.Contains(t, node.Message, "URL "+server.URL+"/items is denied by the controller's configuration")
+		}
+		assert.Equal(t, int32(0), atomic.LoadInt32(&requests), "the denied URL is not requested")
+	})
+}
+
+func TestEvaluateHTTPSuccessCondition(t *testing.T) {
+	for _, tt := range []struct {
+		condition  string
+		statusCode int
+		want       bool
+	}{
+		{"", 200, true},
+		{"", 299, true},
+		{"", 301, false},
+		{"", 404, false},
+		{"response.statusCode == 404", 404, true},
+		{"response.body == 'ok'", 500, true},
+	} {
+		got, err := evaluateHTTPSuccessCondition(tt.condition, tt.statusCode, "ok")
+		if assert.NoError(t, err) {
+			assert.Equal(t, tt.want, got, "%q %d", tt.condition, tt.statusCode)
+		}
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"io"
 	"math"
 	"os"
 	"reflect"
@@ -43,6 +44,7 @@ import (
 	"github.com/argoproj/argo/v2/util/intstr"
 	"github.com/argoproj/argo/v2/util/resource"
 	"github.com/argoproj/argo/v2/util/retry"
+	"github.com/argoproj/argo/v2/util/slice"
 	waitutil "github.com/argoproj/argo/v2/util/wait"
 	"github.com/argoproj/argo/v2/workflow/common"
 	controllercache "github.com/argoproj/argo/v2/workflow/controller/cache"
@@ -178,6 +180,7 @@ func (woc *wfOperationCtx) operate(ctx context.Context) {
 		if woc.wf.Status.Fulfilled() {
 			_ = woc.killDaemonedChildren(ctx, "")
 		}
+		woc.addArtifactGCFinalizer()
 		woc.persistUpdates(ctx)
 	}()
 	defer func() {
@@ -220,9 +223,16 @@ func (woc *wfOperationCtx) operate(ctx context.Context) {
 	}
 	woc.artifactRepository = repo
 
+	woc.setGlobalParameters(woc.execWf.Spec.Arguments)
+
 	// Workflow Level Synchronization lock
 	if woc.execWf.Spec.Synchronization != nil {
-		acquired, wfUpdate, msg, err := woc.controller.syncManager.TryAcquire(woc.wf, "", woc.execWf.Spec.Synchronization)
+		synchronization, err := woc.substituteParamsInSynchronization(woc.execWf.Spec.Synchronization)
+		if err != nil {
+			woc.markWorkflowFailed(ctx, fmt.Sprintf("Failed to resolve the synchronization lock. %s", err.Error()))
+			return
+		}
+		acquired, wfUpdate, msg, _, err := woc.controller.syncManager.TryAcquire(woc.wf, "", synchronization)
 		if err != nil {
 			woc.log.Warn("Failed to acquire the lock")
 			woc.markWorkflowFailed(ctx, fmt.Sprintf("Failed to acquire the synchronization lock. %s", err.Error()))
@@ -244,8 +254,6 @@ func (woc *wfOperationCtx) operate(ctx context.Context) {
 		woc.preExecutionNodePhases[node.ID] = node.Phase
 	}
 
-	woc.setGlobalParameters(woc.execWf.Spec.Arguments)
-
 	// Perform one-time workflow validation
 	if woc.wf.Status.Phase == wfv1.WorkflowUnknown {
 		woc.markWorkflowRunning(ctx)
@@ -343,6 +351,9 @@ func (woc *wfOperationCtx) operate(ctx context.Context) {
 		woc.markWorkflowRunning(ctx)
 	}
 
+	// pending pods are created again below, which sets the condition again if the quota is still exceeded
+	woc.wf.Status.Conditions.RemoveCondition(wfv1.ConditionTypeResourceQuotaExceeded)
+
 	node, err := woc.executeTemplate(ctx, woc.wf.ObjectMeta.Name, &wfv1.WorkflowStep{Template: woc.execWf.Spec.Entrypoint}, tmplCtx, woc.execWf.Spec.Arguments, &executeTemplateOpts{})
 	if err != nil {
 		woc.log.WithError(err).Error("error in entry template execution")
@@ -360,11 +371,21 @@ func (woc *wfOperationCtx) operate(ctx context.Context) {
 		return
 	}
 
-	if node == nil || !node.Fulfilled() {
+	if node == nil {
 		// node can be nil if a workflow created immediately in a parallelism == 0 state
 		return
 	}
 
+	hooksFulfilled, err := woc.executeLifecycleHooks(ctx, woc.execWf.Spec.Hooks, node.Name, "", tmplCtx, woc.globalParams)
+	if err != nil {
+		woc.log.WithError(err).Error("error in lifecycle hook execution")
+		woc.markWorkflowError(ctx, err)
+		return
+	}
+	if !node.Fulfilled() || !hooksFulfilled {
+		return
+	}
+
 	workflowStatus := map[wfv1.NodePhase]wfv1.WorkflowPhase{
 		wfv1.NodePending:   wfv1.WorkflowPending,
 		wfv1.NodeRunning:   wfv1.WorkflowRunning,
@@ -815,9 +836,15 @@ func (woc *wfOperationCtx) processNodeRetries(node *wfv1.NodeStatus, retryStrate
 		node = woc.markNodePhase(node.Name, node.Phase, "")
 	}
 
+	retryPolicy := retryStrategy.RetryPolicy
+	if retryPolicy == "" && retryStrategy.Expression != "" {
+		// let the expression decide which failures and errors are retried
+		retryPolicy = wfv1.RetryPolicyAlways
+	}
+
 	var retryOnFailed bool
 	var retryOnError bool
-	switch retryStrategy.RetryPolicy {
+	switch retryPolicy {
 	case wfv1.RetryPolicyAlways:
 		retryOnFailed = true
 		retryOnError = true
@@ -836,6 +863,17 @@ func (woc *wfOperationCtx) processNodeRetries(node *wfv1.NodeStatus, retryStrate
 		return woc.markNodePhase(node.Name, lastChildNode.Phase, lastChildNode.Message), true, nil
 	}
 
+	if retryStrategy.Expression != "" {
+		retry, err := shouldRetry(retryStrategy.Expression, lastChildNode)
+		if err != nil {
+			return nil, false, err
+		}
+		if !retry {
+			woc.log.Infof("Node not set to be retried: retryStrategy.expression '%s' evaluated to false", retryStrategy.Expression)
+			return woc.markNodePhase(node.Name, lastChildNode.Phase, lastChildNode.Message), true, nil
+		}
+	}
+
 	if !lastChildNode.CanRetry() {
 		woc.log.Infof("Node cannot be retried. Marking it failed")
 		return woc.markNodePhase(node.Name, lastChildNode.Phase, lastChildNode.Message), true, nil
@@ -854,6 +892,41 @@ func (woc *wfOperationCtx) processNodeRetries(node *wfv1.NodeStatus, retryStrate
 	return node, true, nil
 }
 
+// expressionStringEscaper escapes a value substituted into a quoted string of an expression, e.g. a message such as
+// "sidecar 'wait' failed" in '{{lastRetry.message}}'. Unlike common.Replace, it escapes single quotes.
+var expressionStringEscaper = strings.NewReplacer(`\`, `\\`, `'`, `\'`, `"`, `\"`)
+
+// shouldRetry substitutes the lastRetry variables into a retryStrategy expression and evaluates it
+func shouldRetry(expression string, lastChildNode *wfv1.NodeStatus) (bool, error) {
+	exitCode := "-1"
+	if lastChildNode.Outputs != nil && lastChildNode.Outputs.ExitCode != nil {
+		exitCode = *lastChildNode.Outputs.ExitCode
+	}
+	fstTmpl, err := fasttemplate.NewTemplate(expression, "{{", "}}")
+	if err != nil {
+		return false, fmt.Errorf("unable to parse retryStrategy.expression: %w", err)
+	}
+	vars := map[string]string{
+		common.LocalVarRetriesLastExitCode: exitCode,
+		common.LocalVarRetriesLastStatus:   string(lastChildNode.Phase),
+		common.LocalVarRetriesLastDuration: fmt.Sprint(int64(lastChildNode.FinishedAt.Sub(lastChildNode.StartedAt.Time).Seconds())),
+		common.LocalVarRetriesLastMessage:  lastChildNode.Message,
+	}
+	var unresolvedErr error
+	expression = fstTmpl.ExecuteFuncString(func(w io.Writer, tag string) (int, error) {
+		value, ok := vars[strings.TrimSpace(tag)]
+		if !ok {
+			unresolvedErr = errors.Errorf(errors.CodeBadRequest, "failed to resolve {{%s}}", tag)
+			return 0, nil
+		}
+		return w.Write([]byte(expressionStringEscaper.Replace(value)))
+	})
+	if unresolvedErr != nil {
+		return false, unresolvedErr
+	}
+	return shouldExecute(expression)
+}
+
 // podReconciliation is the process by which a workflow will examine all its related
 // pods and update the node state before continuing the evaluation of the workflow.
 // Records all pods which were observed completed, which will be labeled completed=true
@@ -884,9 +957,7 @@ func (woc *wfOperationCtx) podReconciliation(ctx context.Context) error {
 				woc.wf.Status.Nodes[nodeID] = *newState
 				woc.addOutputsToGlobalScope(node.Outputs)
 				if node.MemoizationStatus != nil {
-					c := woc.controller.cacheFactory.GetCache(controllercache.ConfigMapCache, node.MemoizationStatus.CacheName)
-					err := c.Save(ctx, node.MemoizationStatus.Key, node.ID, node.Outputs)
-					if err != nil {
+					if err := woc.saveToMemoizationCache(ctx, &node); err != nil {
 						woc.log.WithFields(log.Fields{"nodeID": node.ID}).WithError(err).Error("Failed to save node outputs to cache")
 						node.Phase = wfv1.NodeError
 					}
@@ -896,6 +967,7 @@ func (woc *wfOperationCtx) podReconciliation(ctx context.Context) error {
 				}
 				woc.updated = true
 			}
+			woc.updateContainerNodes(pod, nodeID)
 			node := woc.wf.Status.Nodes[pod.ObjectMeta.Name]
 			if node.Fulfilled() && !node.IsDaemoned() {
 				if pod.GetLabels()[common.LabelKeyCompleted] == "true" {
@@ -970,6 +1042,7 @@ func (woc *wfOperationCtx) podReconciliation(ctx context.Context) error {
 				woc.updated = true
 			}
 			woc.markNodePhase(node.Name, wfv1.NodeError, "pod deleted")
+			woc.updateContainerNodes(nil, nodeID)
 		} else {
 			// At this point we are certain that the pod associated with our node is running or has been run;
 			// it is safe to extract the k8s-node information given this knowledge.
@@ -1292,6 +1365,7 @@ func inferFailedReason(pod *apiv1.Pod) (wfv1.NodePhase, string) {
 		// NOTE: we consider artifact load issues as Error instead of Failed
 		return wfv1.NodeError, errMsg
 	}
+	containerSet := getContainerSet(pod)
 	failMessages := make(map[string]string)
 	for _, ctr := range pod.Status.ContainerStatuses {
 		// Virtual Kubelet environment will not set the terminate on waiting container
@@ -1334,21 +1408,22 @@ func inferFailedReason(pod *apiv1.Pod) (wfv1.NodePhase, string) {
 		if ctr.State.Terminated.Message != "" {
 			errMsg := ctr.State.Terminated.Message
 			if ctr.Name != common.MainContainerName {
-				errMsg = fmt.Sprintf("sidecar '%s' %s", ctr.Name, errMsg)
+				errMsg = fmt.Sprintf("%s '%s' %s", containerKind(containerSet, ctr.Name), ctr.Name, errMsg)
 			}
 			failMessages[ctr.Name] = errMsg
 			continue
 		}
 		errMsg := fmt.Sprintf("failed with exit code %d", ctr.State.Terminated.ExitCode)
 		if ctr.Name != common.MainContainerName {
-			if ctr.State.Terminated.ExitCode == 137 || ctr.State.Terminated.ExitCode == 143 {
+			kind := containerKind(containerSet, ctr.Name)
+			if kind == "sidecar" && (ctr.State.Terminated.ExitCode == 137 || ctr.State.Terminated.ExitCode == 143) {
 				// if the sidecar was SIGKILL'd (exit code 137) assume it was because argoexec
 				// forcibly killed the container, which we ignore the error for.
 				// Java code 143 is a normal exit 128 + 15 https://github.com/elastic/elasticsearch/issues/31847
 				log.Infof("Ignoring %d exit code of sidecar '%s'", ctr.State.Terminated.ExitCode, ctr.Name)
 				continue
 			}
-			errMsg = fmt.Sprintf("sidecar '%s' %s", ctr.Name, errMsg)
+			errMsg = fmt.Sprintf("%s '%s' %s", kind, ctr.Name, errMsg)
 		}
 		failMessages[ctr.Name] = errMsg
 	}
@@ -1559,10 +1634,11 @@ func (woc *wfOperationCtx) executeTemplate(ctx context.Context, nodeName string,
 
 	// If memoization is on, check if node output exists in cache
 	if node == nil && processedTmpl.Memoize != nil {
-		memoizationCache := woc.controller.cacheFactory.GetCache(controllercache.ConfigMapCache, processedTmpl.Memoize.Cache.ConfigMap.Name)
+		cacheType, cacheName := processedTmpl.Memoize.Cache.GetType(), processedTmpl.Memoize.Cache.GetName()
+		memoizationCache := woc.controller.cacheFactory.GetCache(cacheType, cacheName)
 		if memoizationCache == nil {
 			err := fmt.Errorf("cache could not be found or created")
-			woc.log.WithFields(log.Fields{"cacheName": processedTmpl.Memoize.Cache.ConfigMap.Name}).WithError(err)
+			woc.log.WithFields(log.Fields{"cacheType": cacheType, "cacheName": cacheName}).WithError(err)
 			return woc.initializeNodeOrMarkError(node, nodeName, templateScope, orgTmpl, opts.boundaryID, err), err
 		}
 
@@ -1592,10 +1668,14 @@ func (woc *wfOperationCtx) executeTemplate(ctx context.Context, nodeName string,
 		memoizationStatus := &wfv1.MemoizationStatus{
 			Hit:       hit,
 			Key:       processedTmpl.Memoize.Key,
-			CacheName: processedTmpl.Memoize.Cache.ConfigMap.Name,
+			CacheName: cacheName,
+		}
+		if cacheType != wfv1.MemoizationCacheTypeConfigMap {
+			memoizationStatus.CacheType = cacheType
 		}
 		if hit {
 			node = woc.initializeCacheHitNode(nodeName, processedTmpl, templateScope, orgTmpl, opts.boundaryID, outputs, memoizationStatus)
+			woc.restoreMemoizedNodes(node, entry.GetNodes())
 		} else {
 			node = woc.initializeCacheNode(nodeName, processedTmpl, templateScope, orgTmpl, opts.boundaryID, memoizationStatus)
 		}
@@ -1652,7 +1732,12 @@ func (woc *wfOperationCtx) executeTemplate(ctx context.Context, nodeName string,
 	}
 
 	if processedTmpl.Synchronization != nil {
-		lockAcquired, wfUpdated, msg, err := woc.controller.syncManager.TryAcquire(woc.wf, woc.wf.NodeID(nodeName), processedTmpl.Synchronization)
+		// the names of the locks are resolved with the template's inputs, which may make them invalid
+		if err := argosync.ValidateLockNames(processedTmpl.Synchronization, woc.wf.Namespace); err != nil {
+			err = fmt.Errorf("failed to resolve the synchronization lock: %w", err)
+			return woc.initializeNodeOrMarkError(node, nodeName, templateScope, orgTmpl, opts.boundaryID, err), err
+		}
+		lockAcquired, wfUpdated, msg, waitingLockName, err := woc.controller.syncManager.TryAcquire(woc.wf, woc.wf.NodeID(nodeName), processedTmpl.Synchronization)
 		if err != nil {
 			return woc.initializeNodeOrMarkError(node, nodeName, templateScope, orgTmpl, opts.boundaryID, err), err
 		}
@@ -1660,13 +1745,7 @@ func (woc *wfOperationCtx) executeTemplate(ctx context.Context, nodeName string,
 			if node == nil {
 				node = woc.initializeExecutableNode(nodeName, wfutil.GetNodeType(processedTmpl), templateScope, processedTmpl, orgTmpl, opts.boundaryID, wfv1.NodePending, msg)
 			}
-			lockName, err := argosync.GetLockName(processedTmpl.Synchronization, woc.wf.Namespace)
-			if err != nil {
-				// If an error were to be returned here, it would have been caught by TryAcquire. If it didn't, then it is
-				// unexpected behavior and is a bug.
-				panic("bug: GetLockName should not return an error after a call to TryAcquire")
-			}
-			return woc.markNodeWaitingForLock(node.Name, lockName.EncodeName()), nil
+			return woc.markNodeWaitingForLock(node.Name, waitingLockName), nil
 		} else {
 			woc.log.Infof("Node %s acquired synchronization lock", nodeName)
 			if node != nil {
@@ -1758,6 +1837,14 @@ func (woc *wfOperationCtx) executeTemplate(ctx context.Context, nodeName string,
 		node, err = woc.executeDAG(ctx, nodeName, newTmplCtx, templateScope, processedTmpl, orgTmpl, opts)
 	case wfv1.TemplateTypeSuspend:
 		node, err = woc.executeSuspend(nodeName, templateScope, processedTmpl, orgTmpl, opts)
+	case wfv1.TemplateTypeHTTP:
+		node, err = woc.executeHTTP(ctx, nodeName, templateScope, processedTmpl, orgTmpl, opts)
+	case wfv1.TemplateTypeContainerSet:
+		node, err = woc.executeContainerSet(ctx, nodeName, templateScope, processedTmpl, orgTmpl, opts)
+	case wfv1.TemplateTypeData:
+		node, err = woc.executeData(ctx, nodeName, templateScope, processedTmpl, orgTmpl, opts)
+	case wfv1.TemplateTypeWorkflow:
+		node, err = woc.executeChildWorkflow(ctx, nodeName, templateScope, processedTmpl, orgTmpl, opts)
 	default:
 		err = errors.Errorf(errors.CodeBadRequest, "Template '%s' missing specification", processedTmpl.Name)
 		return woc.initializeNode(nodeName, wfv1.NodeTypeSkipped, templateScope, orgTmpl, opts.boundaryID, wfv1.NodeError, err.Error()), err
@@ -1779,6 +1866,16 @@ func (woc *wfOperationCtx) executeTemplate(ctx context.Context, nodeName string,
 		}
 	}
 
+	// Steps and DAG templates are only saved to the cache once their whole sub-tree has succeeded
+	if !processedTmpl.IsLeaf() && node.MemoizationStatus != nil && !node.MemoizationStatus.Hit && node.Phase == wfv1.NodeSucceeded {
+		if prevPhase, ok := woc.preExecutionNodePhases[node.ID]; !ok || !prevPhase.Fulfilled() {
+			if err := woc.saveToMemoizationCache(ctx, node); err != nil {
+				woc.log.WithFields(log.Fields{"nodeID": node.ID}).WithError(err).Error("Failed to save node outputs to cache")
+				return woc.markNodeError(node.Name, err), err
+			}
+		}
+	}
+
 	if processedTmpl.Metrics != nil {
 		// Check if the node was just created, if it was emit realtime metrics.
 		// If the node did not previously exist, we can infer that it was created during the current operation, emit real time metrics.
@@ -2006,7 +2103,12 @@ func (woc *wfOperationCtx) initializeCacheNode(nodeName string, resolvedTmpl *wf
 		panic(err)
 	}
 	woc.log.Debug("Initializing cached node ", nodeName, common.GetTemplateHolderString(orgTmpl), boundaryID)
-	node := woc.initializeExecutableNode(nodeName, wfutil.GetNodeType(resolvedTmpl), templateScope, resolvedTmpl, orgTmpl, boundaryID, wfv1.NodePending, messages...)
+	phase := wfv1.NodePending
+	if !resolvedTmpl.IsLeaf() {
+		// steps and DAG nodes are running as soon as they are created, as they do not wait for a pod
+		phase = wfv1.NodeRunning
+	}
+	node := woc.initializeExecutableNode(nodeName, wfutil.GetNodeType(resolvedTmpl), templateScope, resolvedTmpl, orgTmpl, boundaryID, phase, messages...)
 	node.MemoizationStatus = memStat
 	return node
 }
@@ -2020,6 +2122,101 @@ func (woc *wfOperationCtx) initializeCacheHitNode(nodeName string, resolvedTmpl
 	return node
 }
 
+// restoreMemoizedNodes restores the sub-tree of a steps or DAG node from its cache entry, so that its nodes and their
+// outputs are the same as when it was saved, marked as cache hits like the node itself
+func (woc *wfOperationCtx) restoreMemoizedNodes(node *wfv1.NodeStatus, entryNodes []controllercache.EntryNode) {
+	nodeIDs := func(names []string) []string {
+		var ids []string
+		for _, name := range names {
+			ids = append(ids, woc.wf.NodeID(node.Name+name))
+		}
+		return ids
+	}
+	for _, entryNode := range entryNodes {
+		if entryNode.Name == "" {
+			node.Children = nodeIDs(entryNode.Children)
+			node.OutboundNodes = nodeIDs(entryNode.OutboundNodes)
+			continue
+		}
+		name := node.Name + entryNode.Name
+		boundaryID := woc.wf.NodeID(node.Name + entryNode.BoundaryName)
+		woc.wf.Status.Nodes[woc.wf.NodeID(name)] = wfv1.NodeStatus{
+			ID:                woc.wf.NodeID(name),
+			Name:              name,
+			DisplayName:       entryNode.DisplayName,
+			Type:              entryNode.Type,
+			TemplateName:      entryNode.TemplateName,
+			TemplateRef:       entryNode.TemplateRef,
+			TemplateScope:     entryNode.TemplateScope,
+			Phase:             entryNode.Phase,
+			BoundaryID:        boundaryID,
+			Children:          nodeIDs(entryNode.Children),
+			OutboundNodes:     nodeIDs(entryNode.OutboundNodes),
+			Outputs:           entryNode.Outputs,
+			StartedAt:         node.StartedAt,
+			FinishedAt:        node.FinishedAt,
+			MemoizationStatus: node.MemoizationStatus,
+		}
+		woc.addOutputsToGlobalScope(entryNode.Outputs)
+	}
+}
+
+// memoizedNodes returns the sub-tree of a steps or DAG node, with names relative to its name, to be saved to the cache
+func (woc *wfOperationCtx) memoizedNodes(node *wfv1.NodeStatus) []controllercache.EntryNode {
+	relativeNames := func(ids []string) []string {
+		var names []string
+		for _, id := range ids {
+			names = append(names, strings.TrimPrefix(woc.wf.Status.Nodes[id].Name, node.Name))
+		}
+		return names
+	}
+	var entryNodes []controllercache.EntryNode
+	visited := make(map[string]bool)
+	var visit func(id string)
+	visit = func(id string) {
+		n, ok := woc.wf.Status.Nodes[id]
+		if !ok || visited[id] || !strings.HasPrefix(n.Name, node.Name) {
+			return
+		}
+		visited[id] = true
+		entryNode := controllercache.EntryNode{
+			Name:          strings.TrimPrefix(n.Name, node.Name),
+			Children:      relativeNames(n.Children),
+			OutboundNodes: relativeNames(n.OutboundNodes),
+		}
+		if id != node.ID {
+			entryNode.DisplayName = n.DisplayName
+			entryNode.Type = n.Type
+			entryNode.TemplateName = n.TemplateName
+			entryNode.TemplateRef = n.TemplateRef
+			entryNode.TemplateScope = n.TemplateScope
+			entryNode.Phase = n.Phase
+			entryNode.BoundaryName = strings.TrimPrefix(woc.wf.Status.Nodes[n.BoundaryID].Name, node.Name)
+			entryNode.Outputs = n.Outputs
+		}
+		entryNodes = append(entryNodes, entryNode)
+		for _, child := range n.Children {
+			visit(child)
+		}
+	}
+	visit(node.ID)
+	return entryNodes
+}
+
+// saveToMemoizationCache saves the outputs of a memoized node to the cache it was loaded from, and the sub-tree of a steps
+// or DAG node
+func (woc *wfOperationCtx) saveToMemoizationCache(ctx context.Context, node *wfv1.NodeStatus) error {
+	c := woc.controller.cacheFactory.GetCache(node.MemoizationStatus.GetCacheType(), node.MemoizationStatus.CacheName)
+	if c == nil {
+		return fmt.Errorf("cache could not be found or created")
+	}
+	var nodes []controllercache.EntryNode
+	if node.Type == wfv1.NodeTypeSteps || node.Type == wfv1.NodeTypeDAG {
+		nodes = woc.memoizedNodes(node)
+	}
+	return c.Save(ctx, node.MemoizationStatus.Key, node.ID, node.Outputs, nodes)
+}
+
 func (woc *wfOperationCtx) initializeNode(nodeName string, nodeType wfv1.NodeType, templateScope string, orgTmpl wfv1.TemplateReferenceHolder, boundaryID string, phase wfv1.NodePhase, messages ...string) *wfv1.NodeStatus {
 	woc.log.Debugf("Initializing node %s: template: %s, boundaryID: %s", nodeName, common.GetTemplateHolderString(orgTmpl), boundaryID)
 
@@ -2135,6 +2332,24 @@ func (woc *wfOperationCtx) markNodePending(nodeName string, err error) *wfv1.Nod
 	return woc.markNodePhase(nodeName, wfv1.NodePending, err.Error()) // this error message will not change often
 }
 
+const waitingForResourceQuotaMessage = "Waiting for resource quota"
+
+// markNodeWaitingForResourceQuota marks the node as pending because its pod cannot be created until the namespace's
+// resource quota allows it. The workflow is requeued when a resource quota in its namespace is updated.
+func (woc *wfOperationCtx) markNodeWaitingForResourceQuota(nodeName string, err error) *wfv1.NodeStatus {
+	message := fmt.Sprintf("%s: %v", waitingForResourceQuotaMessage, err)
+	woc.log.WithField("nodeName", nodeName).Info(message)
+	woc.wf.Status.Conditions.UpsertCondition(wfv1.Condition{
+		Type:    wfv1.ConditionTypeResourceQuotaExceeded,
+		Status:  metav1.ConditionTrue,
+		Message: message,
+	})
+	if !woc.controller.isWatchingResourceQuotas() {
+		woc.requeue()
+	}
+	return woc.markNodePhase(nodeName, wfv1.NodePending, message)
+}
+
 // markNodeWaitingForLock is a convenience method to mark that a node is waiting for a lock
 func (woc *wfOperationCtx) markNodeWaitingForLock(nodeName string, lockName string) *wfv1.NodeStatus {
 	node := woc.wf.GetNodeByName(nodeName)
@@ -2239,7 +2454,7 @@ func (woc *wfOperationCtx) executeContainer(ctx context.Context, nodeName string
 func (woc *wfOperationCtx) getOutboundNodes(nodeID string) []string {
 	node := woc.wf.Status.Nodes[nodeID]
 	switch node.Type {
-	case wfv1.NodeTypePod, wfv1.NodeTypeSkipped, wfv1.NodeTypeSuspend:
+	case wfv1.NodeTypePod, wfv1.NodeTypeSkipped, wfv1.NodeTypeSuspend, wfv1.NodeTypeHTTP, wfv1.NodeTypeWorkflow:
 		return []string{node.ID}
 	case wfv1.NodeTypeTaskGroup:
 		if len(node.Children) == 0 {
@@ -2383,6 +2598,9 @@ func (woc *wfOperationCtx) executeScript(ctx context.Context, nodeName string, t
 }
 
 func (woc *wfOperationCtx) requeueIfTransientErr(err error, nodeName string) (*wfv1.NodeStatus, error) {
+	if errorsutil.IsExceededQuotaErr(err) {
+		return woc.markNodeWaitingForResourceQuota(nodeName, err), nil
+	}
 	if errorsutil.IsTransientErr(err) {
 		// Our error was most likely caused by a lack of resources.
 		woc.requeue()
@@ -2676,6 +2894,25 @@ func (woc *wfOperationCtx) executeResource(ctx context.Context, nodeName string,
 	return node, err
 }
 
+// executeData creates the pod of a data template. As for resource templates, argoexec runs as the main container and
+// annotates the pod with the outputs itself.
+func (woc *wfOperationCtx) executeData(ctx context.Context, nodeName string, templateScope string, tmpl *wfv1.Template, orgTmpl wfv1.TemplateReferenceHolder, opts *executeTemplateOpts) (*wfv1.NodeStatus, error) {
+	node := woc.wf.GetNodeByName(nodeName)
+	if node == nil {
+		node = woc.initializeExecutableNode(nodeName, wfv1.NodeTypePod, templateScope, tmpl, orgTmpl, opts.boundaryID, wfv1.NodePending)
+	} else if !node.Pending() {
+		return node, nil
+	}
+
+	mainCtr := woc.newExecContainer(common.MainContainerName, tmpl)
+	mainCtr.Command = []string{"argoexec", "data"}
+	_, err := woc.createWorkflowPod(ctx, nodeName, *mainCtr, tmpl, &createWorkflowPodOpts{onExitPod: opts.onExitTemplate, executionDeadline: opts.executionDeadline})
+	if err != nil {
+		return woc.requeueIfTransientErr(err, node.Name)
+	}
+	return node, err
+}
+
 func (woc *wfOperationCtx) executeSuspend(nodeName string, templateScope string, tmpl *wfv1.Template, orgTmpl wfv1.TemplateReferenceHolder, opts *executeTemplateOpts) (*wfv1.NodeStatus, error) {
 	node := woc.wf.GetNodeByName(nodeName)
 	if node == nil {
@@ -2886,6 +3123,28 @@ func (woc *wfOperationCtx) substituteParamsInVolumes(params map[string]string) e
 	return nil
 }
 
+// substituteParamsInSynchronization resolves the global parameters in the names of a workflow's locks
+func (woc *wfOperationCtx) substituteParamsInSynchronization(synchronization *wfv1.Synchronization) (*wfv1.Synchronization, error) {
+	synchronizationBytes, err := json.Marshal(synchronization)
+	if err != nil {
+		return nil, errors.InternalWrapError(err)
+	}
+	fstTmpl, err := fasttemplate.NewTemplate(string(synchronizationBytes), "{{", "}}")
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse argo variable: %w", err)
+	}
+	newSynchronizationStr, err := common.Replace(fstTmpl, woc.globalParams, false)
+	if err != nil {
+		return nil, err
+	}
+	newSynchronization := &wfv1.Synchronization{}
+	err = json.Unmarshal([]byte(newSynchronizationStr), newSynchronization)
+	if err != nil {
+		return nil, errors.InternalWrapError(err)
+	}
+	return newSynchronization, argosync.ValidateLockNames(newSynchronization, woc.wf.Namespace)
+}
+
 // createTemplateContext creates a new template context.
 func (woc *wfOperationCtx) createTemplateContext(scope wfv1.ResourceScope, resourceName string) (*templateresolution.Context, error) {
 	var clusterWorkflowTemplateGetter templateresolution.ClusterWorkflowTemplateGetter
@@ -3041,6 +3300,44 @@ func (woc *wfOperationCtx) reportMetricEmissionError(errorString string) {
 	woc.log.Error(errorString)
 }
 
+// addArtifactGCFinalizer adds the artifact GC finalizer, so that the workflow cannot be deleted before its
+// artifacts have been garbage collected, if the workflow or any of its output artifacts have an artifact GC strategy
+func (woc *wfOperationCtx) addArtifactGCFinalizer() {
+	if woc.execWf == nil || woc.wf.DeletionTimestamp != nil || slice.ContainsString(woc.wf.Finalizers, common.FinalizerArtifactGC) {
+		return
+	}
+	if !woc.needsArtifactGC() {
+		return
+	}
+	woc.log.Info("Adding artifact GC finalizer")
+	woc.wf.Finalizers = append(woc.wf.Finalizers, common.FinalizerArtifactGC)
+	woc.updated = true
+}
+
+func (woc *wfOperationCtx) needsArtifactGC() bool {
+	if woc.execWf.Spec.ArtifactGC.GetStrategy() != wfv1.ArtifactGCNever {
+		return true
+	}
+	for _, tmpl := range woc.execWf.Spec.Templates {
+		for _, art := range tmpl.Outputs.Artifacts {
+			if art.ArtifactGC.GetStrategy() != wfv1.ArtifactGCNever {
+				return true
+			}
+		}
+	}
+	for _, node := range woc.wf.Status.Nodes {
+		if node.Outputs == nil {
+			continue
+		}
+		for _, art := range node.Outputs.Artifacts {
+			if art.ArtifactGC.GetStrategy() != wfv1.ArtifactGCNever {
+				return true
+			}
+		}
+	}
+	return false
+}
+
 func (woc *wfOperationCtx) createPDBResource(ctx context.Context) error {
 
 	if woc.execWf.Spec.PodDisruptionBudget == nil {
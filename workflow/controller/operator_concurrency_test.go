@@ -2,14 +2,19 @@ package controller
 
 import (
 	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
 	"strconv"
 	"strings"
 	"testing"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
 
 	"github.com/stretchr/testify/assert"
+	authorizationv1 "k8s.io/api/authorization/v1"
 	v1 "k8s.io/api/core/v1"
 	"sigs.k8s.io/yaml"
 
@@ -132,7 +137,7 @@ func TestSemaphoreTmplLevel(t *testing.T) {
 	defer cancel()
 	ctx := context.Background()
 	controller.syncManager = sync.NewLockManager(GetSyncLimitFunc(ctx, controller.kubeclientset), func(key string) {
-	}, workflowExistenceFunc)
+	}, workflowExistenceFunc, nil)
 	var cm v1.ConfigMap
 	err := yaml.Unmarshal([]byte(configMap), &cm)
 	assert.NoError(t, err)
@@ -195,7 +200,7 @@ func TestSemaphoreScriptTmplLevel(t *testing.T) {
 	defer cancel()
 	ctx := context.Background()
 	controller.syncManager = sync.NewLockManager(GetSyncLimitFunc(ctx, controller.kubeclientset), func(key string) {
-	}, workflowExistenceFunc)
+	}, workflowExistenceFunc, nil)
 	var cm v1.ConfigMap
 	err := yaml.Unmarshal([]byte(configMap), &cm)
 	assert.NoError(t, err)
@@ -257,7 +262,7 @@ func TestSemaphoreResourceTmplLevel(t *testing.T) {
 	defer cancel()
 	ctx := context.Background()
 	controller.syncManager = sync.NewLockManager(GetSyncLimitFunc(ctx, controller.kubeclientset), func(key string) {
-	}, workflowExistenceFunc)
+	}, workflowExistenceFunc, nil)
 	var cm v1.ConfigMap
 	err := yaml.Unmarshal([]byte(configMap), &cm)
 	assert.NoError(t, err)
@@ -320,7 +325,7 @@ func TestSemaphoreWithOutConfigMap(t *testing.T) {
 
 	ctx := context.Background()
 	controller.syncManager = sync.NewLockManager(GetSyncLimitFunc(ctx, controller.kubeclientset), func(key string) {
-	}, workflowExistenceFunc)
+	}, workflowExistenceFunc, nil)
 
 	t.Run("SemaphoreRefWithOutConfigMap", func(t *testing.T) {
 		wf := unmarshalWF(wfWithSemaphore)
@@ -377,7 +382,7 @@ func TestMutexInDAG(t *testing.T) {
 	defer cancel()
 	ctx := context.Background()
 	controller.syncManager = sync.NewLockManager(GetSyncLimitFunc(ctx, controller.kubeclientset), func(key string) {
-	}, workflowExistenceFunc)
+	}, workflowExistenceFunc, nil)
 	t.Run("MutexWithDAG", func(t *testing.T) {
 		wf := unmarshalWF(DAGWithMutex)
 		wf, err := controller.wfclientset.ArgoprojV1alpha1().Workflows(wf.Namespace).Create(ctx, wf, metav1.CreateOptions{})
@@ -441,7 +446,7 @@ func TestSynchronizationWithRetry(t *testing.T) {
 	defer cancel()
 	ctx := context.Background()
 	controller.syncManager = sync.NewLockManager(GetSyncLimitFunc(ctx, controller.kubeclientset), func(key string) {
-	}, workflowExistenceFunc)
+	}, workflowExistenceFunc, nil)
 	var cm v1.ConfigMap
 	err := yaml.Unmarshal([]byte(configMap), &cm)
 	assert.NoError(err)
@@ -486,3 +491,163 @@ func TestSynchronizationWithRetry(t *testing.T) {
 
 	})
 }
+
+const wfWithParameterisedMutexes = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  name: parameterised-mutexes
+  namespace: default
+spec:
+  entrypoint: deploy-all
+  arguments:
+    parameters:
+    - name: team
+      value: platform
+  synchronization:
+    mutex:
+      name: "{{workflow.parameters.team}}"
+  templates:
+  - name: deploy-all
+    dag:
+      tasks:
+      - name: dev
+        template: deploy
+        arguments:
+          parameters: [{name: env, value: dev}]
+      - name: prod
+        template: deploy
+        arguments:
+          parameters: [{name: env, value: prod}]
+      - name: prod-again
+        template: deploy
+        arguments:
+          parameters: [{name: env, value: prod}]
+  - name: deploy
+    inputs:
+      parameters:
+      - name: env
+    synchronization:
+      mutex:
+        name: "deploy-{{inputs.parameters.env}}"
+    container:
+      image: alpine:3.7
+      command: [sh, -c, "exit 0"]
+`
+
+func TestParameterisedMutexes(t *testing.T) {
+	assert := assert.New(t)
+	cancel, controller := newController()
+	defer cancel()
+	ctx := context.Background()
+	controller.syncManager = sync.NewLockManager(GetSyncLimitFunc(ctx, controller.kubeclientset), func(key string) {
+	}, workflowExistenceFunc, nil)
+
+	wf := unmarshalWF(wfWithParameterisedMutexes)
+	wf, err := controller.wfclientset.ArgoprojV1alpha1().Workflows(wf.Namespace).Create(ctx, wf, metav1.CreateOptions{})
+	assert.NoError(err)
+	woc := newWorkflowOperationCtx(wf, controller)
+	woc.operate(ctx)
+	assert.Equal(wfv1.WorkflowRunning, woc.wf.Status.Phase)
+	assert.Contains(woc.wf.Status.Synchronization.Mutex.Holding, wfv1.MutexHolding{Mutex: "default/Mutex/platform", Holder: "parameterised-mutexes"})
+	waiting := 0
+	for _, node := range woc.wf.Status.Nodes {
+		if node.SynchronizationStatus != nil && node.SynchronizationStatus.Waiting != "" {
+			waiting++
+			assert.Equal("default/Mutex/deploy-prod", node.SynchronizationStatus.Waiting)
+		}
+	}
+	assert.Equal(1, waiting, "only one of the prod deployments waits")
+
+	makePodsPhase(ctx, woc, v1.PodSucceeded)
+	woc = newWorkflowOperationCtx(woc.wf, controller)
+	woc.operate(ctx)
+	for _, node := range woc.wf.Status.Nodes {
+		assert.Nil(node.SynchronizationStatus, "the waiting prod deployment acquired the released lock")
+	}
+}
+
+func TestParameterisedMutexes_InvalidName(t *testing.T) {
+	ctx := context.Background()
+	newWoc := func(wf *wfv1.Workflow) (context.CancelFunc, *wfOperationCtx) {
+		cancel, controller := newController(wf)
+		controller.syncManager = sync.NewLockManager(GetSyncLimitFunc(ctx, controller.kubeclientset), func(key string) {
+		}, workflowExistenceFunc, nil)
+		woc := newWorkflowOperationCtx(wf, controller)
+		woc.operate(ctx)
+		return cancel, woc
+	}
+
+	t.Run("Workflow", func(t *testing.T) {
+		wf := unmarshalWF(wfWithParameterisedMutexes)
+		wf.Spec.Arguments.Parameters[0].Value = wfv1.AnyStringPtr("a/b")
+		cancel, woc := newWoc(wf)
+		defer cancel()
+		assert.Equal(t, wfv1.WorkflowFailed, woc.wf.Status.Phase)
+		assert.Equal(t, `Failed to resolve the synchronization lock. Invalid lock key: "a/b" must not contain "/"`, woc.wf.Status.Message)
+	})
+	t.Run("Template", func(t *testing.T) {
+		wf := unmarshalWF(wfWithParameterisedMutexes)
+		wf.Spec.Synchronization = nil
+		wf.Spec.Templates[0].DAG.Tasks[0].Arguments.Parameters[0].Value = wfv1.AnyStringPtr("a/b")
+		cancel, woc := newWoc(wf)
+		defer cancel()
+		node := woc.wf.Status.Nodes.FindByDisplayName("dev")
+		if assert.NotNil(t, node) {
+			assert.Equal(t, wfv1.NodeError, node.Phase)
+			assert.Contains(t, node.Message, `failed to resolve the synchronization lock: Invalid lock key: "deploy-a/b" must not contain "/"`)
+		}
+	})
+}
+
+func TestServeSyncLocks(t *testing.T) {
+	cancel, controller := newController()
+	defer cancel()
+	controller.syncManager = sync.NewLockManager(nil, func(string) {}, workflowExistenceFunc, nil)
+	wf := unmarshalWF(helloWorldWf)
+	wf.Namespace = "default"
+	_, _, _, _, err := controller.syncManager.TryAcquire(wf, "", &wfv1.Synchronization{Mutex: &wfv1.Mutex{Name: "my-mutex"}})
+	assert.NoError(t, err)
+
+	// the API server, which allows the owner of my-token to list workflows
+	reviews := 0
+	apiServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		reviews++
+		if r.Header.Get("Authorization") != "Bearer my-token" && r.Header.Get("Authorization") != "Bearer other-token" {
+			w.WriteHeader(http.StatusUnauthorized)
+			_, _ = w.Write([]byte(`{"kind":"Status","apiVersion":"v1","status":"Failure","reason":"Unauthorized","code":401}`))
+			return
+		}
+		review := &authorizationv1.SelfSubjectAccessReview{}
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(review))
+		assert.Equal(t, "/apis/authorization.k8s.io/v1/selfsubjectaccessreviews", r.URL.Path)
+		assert.Equal(t, "list", review.Spec.ResourceAttributes.Verb)
+		assert.Equal(t, "workflows", review.Spec.ResourceAttributes.Resource)
+		review.Status.Allowed = r.Header.Get("Authorization") == "Bearer my-token"
+		w.Header().Set("Content-Type", "application/json")
+		assert.NoError(t, json.NewEncoder(w).Encode(review))
+	}))
+	defer apiServer.Close()
+	controller.restConfig = &rest.Config{Host: apiServer.URL}
+
+	serve := func(token string) *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		r := httptest.NewRequest("GET", sync.LocksPath, nil)
+		if token != "" {
+			r.Header.Set("Authorization", "Bearer "+token)
+		}
+		controller.serveSyncLocks(w, r)
+		return w
+	}
+	w := serve("my-token")
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "application/json", w.Header().Get("Content-Type"))
+	assert.JSONEq(t, `{"items":[{"name":"default/Mutex/my-mutex","type":"Mutex","limit":1,"holders":["default/hello-world"]}]}`, w.Body.String())
+	assert.Equal(t, http.StatusUnauthorized, serve("").Code)
+	assert.Equal(t, http.StatusUnauthorized, serve("bad-token").Code)
+	assert.Equal(t, http.StatusForbidden, serve("other-token").Code)
+	reviews = 0
+	assert.Equal(t, http.StatusOK, serve("my-token").Code)
+	assert.Equal(t, http.StatusForbidden, serve("other-token").Code)
+	assert.Equal(t, 0, reviews, "the access of the tokens is remembered")
+}
@@ -571,6 +571,94 @@ func TestProcessNodesWithRetriesOnErrors(t *testing.T) {
 	assert.Equal(t, n.Phase, wfv1.NodeError)
 }
 
+// TestProcessNodesWithRetriesWithExpression tests that the retryStrategy expression decides whether to retry
+func TestProcessNodesWithRetriesWithExpression(t *testing.T) {
+	cancel, controller := newController()
+	defer cancel()
+	wf := unmarshalWF(helloWorldWf)
+	woc := newWorkflowOperationCtx(wf, controller)
+
+	nodeName := "test-node"
+	node := woc.initializeNode(nodeName, wfv1.NodeTypeRetry, "", &wfv1.Template{}, "", wfv1.NodeRunning)
+	retries := wfv1.RetryStrategy{
+		Limit:      intstrutil.ParsePtr("10"),
+		Expression: "{{lastRetry.exitCode}} == 137 || '{{lastRetry.message}}' =~ 'OOMKilled'",
+	}
+
+	addFailedChild := func(i int, exitCode string, message string) {
+		childNode := fmt.Sprintf("child-node-%d", i)
+		woc.initializeNode(childNode, wfv1.NodeTypePod, "", &wfv1.Template{}, "", wfv1.NodeFailed, message)
+		child := woc.wf.GetNodeByName(childNode)
+		child.Outputs = &wfv1.Outputs{ExitCode: &exitCode}
+		woc.wf.Status.Nodes[child.ID] = *child
+		woc.addChildNode(nodeName, childNode)
+	}
+
+	addFailedChild(0, "137", "")
+	n, _, err := woc.processNodeRetries(woc.wf.GetNodeByName(nodeName), retries, &executeTemplateOpts{})
+	if assert.NoError(t, err) {
+		assert.Equal(t, wfv1.NodeRunning, n.Phase)
+	}
+
+	addFailedChild(1, "2", "OOMKilled (exit code 2)")
+	n, _, err = woc.processNodeRetries(woc.wf.GetNodeByName(nodeName), retries, &executeTemplateOpts{})
+	if assert.NoError(t, err) {
+		assert.Equal(t, wfv1.NodeRunning, n.Phase)
+	}
+
+	addFailedChild(2, "1", "failed with exit code 1")
+	n, _, err = woc.processNodeRetries(woc.wf.GetNodeByName(nodeName), retries, &executeTemplateOpts{})
+	if assert.NoError(t, err) {
+		assert.Equal(t, wfv1.NodeFailed, n.Phase)
+		assert.Equal(t, "failed with exit code 1", n.Message)
+	}
+	assert.Equal(t, node.ID, n.ID)
+}
+
+func TestShouldRetry(t *testing.T) {
+	exitCode := "137"
+	node := &wfv1.NodeStatus{
+		Phase:      wfv1.NodeError,
+		Message:    "OOMKilled",
+		StartedAt:  metav1.Time{Time: time.Unix(0, 0)},
+		FinishedAt: metav1.Time{Time: time.Unix(90, 0)},
+		Outputs:    &wfv1.Outputs{ExitCode: &exitCode},
+	}
+	for expression, expected := range map[string]bool{
+		"{{lastRetry.exitCode}} == 137":      true,
+		"{{lastRetry.exitCode}} == 1":        false,
+		"{{lastRetry.status}} == Error":      true,
+		"{{lastRetry.status}} == Failed":     false,
+		"{{lastRetry.duration}} < 60":        false,
+		"{{lastRetry.duration}} < 120":       true,
+		"'{{lastRetry.message}}' =~ 'OOM.*'": true,
+	} {
+		t.Run(expression, func(t *testing.T) {
+			retry, err := shouldRetry(expression, node)
+			if assert.NoError(t, err) {
+				assert.Equal(t, expected, retry)
+			}
+		})
+	}
+
+	// no exit code is available when the pod could not be created
+	retry, err := shouldRetry("{{lastRetry.exitCode}} == -1", &wfv1.NodeStatus{})
+	if assert.NoError(t, err) {
+		assert.True(t, retry)
+	}
+
+	_, err = shouldRetry("{{lastRetry.foo}} == 1", node)
+	assert.Error(t, err)
+
+	// messages may contain quotes and backslashes
+	for _, message := range []string{`sidecar 'wait' failed with exit code 1`, `failed to parse "foo"`, `C:\ not found`} {
+		retry, err := shouldRetry("'{{lastRetry.message}}' =~ 'failed' || '{{lastRetry.message}}' == \"{{lastRetry.message}}\"", &wfv1.NodeStatus{Message: message})
+		if assert.NoError(t, err, message) {
+			assert.True(t, retry, message)
+		}
+	}
+}
+
 func TestProcessNodesWithRetriesWithBackoff(t *testing.T) {
 	cancel, controller := newController()
 	defer cancel()
@@ -2600,6 +2688,51 @@ func TestResourceTemplate(t *testing.T) {
 	assert.Empty(t, cm.ObjectMeta.OwnerReferences)
 }
 
+var dataTemplate = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  name: data-template
+spec:
+  entrypoint: main
+  templates:
+  - name: main
+    data:
+      source:
+        artifactPaths:
+          s3:
+            key: logs
+      transformation:
+      - expression: 'filter(data, {# endsWith ".log"})'
+`
+
+func TestDataTemplate(t *testing.T) {
+	cancel, controller := newController()
+	defer cancel()
+	ctx := context.Background()
+	wf := unmarshalWF(dataTemplate)
+	woc := newWorkflowOperationCtx(wf, controller)
+	woc.operate(ctx)
+	assert.Equal(t, wfv1.WorkflowRunning, woc.wf.Status.Phase)
+
+	pod, err := getPod(woc, "data-template")
+	if !assert.NoError(t, err) {
+		t.Fatal(err)
+	}
+	// argoexec annotates the outputs itself, there is no wait container
+	if assert.Len(t, pod.Spec.Containers, 1) {
+		assert.Equal(t, common.MainContainerName, pod.Spec.Containers[0].Name)
+		assert.Equal(t, "executor:latest", pod.Spec.Containers[0].Image)
+		assert.Equal(t, []string{"argoexec", "data"}, pod.Spec.Containers[0].Command)
+	}
+	tmpl := wfv1.Template{}
+	err = json.Unmarshal([]byte(pod.Annotations[common.AnnotationKeyTemplate]), &tmpl)
+	if assert.NoError(t, err) && assert.NotNil(t, tmpl.ArchiveLocation) && assert.NotNil(t, tmpl.ArchiveLocation.S3) {
+		// the key of the artifact paths is relocated to the default artifact repository by the executor
+		assert.Equal(t, "my-bucket", tmpl.ArchiveLocation.S3.Bucket)
+	}
+}
+
 var resourceWithOwnerReferenceTemplate = `
 apiVersion: argoproj.io/v1alpha1
 kind: Workflow
@@ -4545,6 +4678,130 @@ func TestConfigMapCacheSaveOperate(t *testing.T) {
 	}
 }
 
+var workflowCachedDAG = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  name: memoized-dag-test
+spec:
+  entrypoint: main
+  arguments:
+    parameters:
+    - name: message
+      value: hi-there-world
+  templates:
+  - name: main
+    inputs:
+      parameters:
+      - name: message
+    memoize:
+      key: "{{inputs.parameters.message}}"
+      cache:
+        configMap:
+          name: dag-cache
+    dag:
+      tasks:
+      - name: whalesay
+        template: whalesay
+    outputs:
+      parameters:
+      - name: hello
+        valueFrom:
+          parameter: "{{tasks.whalesay.outputs.parameters.hello}}"
+  - name: whalesay
+    container:
+      image: docker/whalesay:latest
+    outputs:
+      parameters:
+      - name: hello
+        valueFrom:
+          path: /tmp/hello_world.txt
+`
+
+func TestConfigMapCacheSaveOperateDAG(t *testing.T) {
+	wf := unmarshalWF(workflowCachedDAG)
+	cancel, controller := newController(wf)
+	defer cancel()
+
+	ctx := context.Background()
+	woc := newWorkflowOperationCtx(wf, controller)
+	woc.operate(ctx)
+	node := woc.wf.Status.Nodes.FindByDisplayName("memoized-dag-test")
+	if assert.NotNil(t, node) {
+		assert.Equal(t, wfv1.NodeRunning, node.Phase)
+	}
+
+	makePodsPhase(ctx, woc, apiv1.PodSucceeded, withOutputs(`{"parameters": [{"name": "hello", "value": "foobar"}]}`))
+	woc = newWorkflowOperationCtx(woc.wf, controller)
+	woc.operate(ctx)
+	assert.Equal(t, wfv1.WorkflowSucceeded, woc.wf.Status.Phase)
+
+	cm, err := controller.kubeclientset.CoreV1().ConfigMaps("default").Get(ctx, "dag-cache", metav1.GetOptions{})
+	if assert.NoError(t, err) {
+		var entry cache.Entry
+		testutil.MustUnmarshallJSON(cm.Data["hi-there-world"], &entry)
+		assert.Equal(t, woc.wf.NodeID("memoized-dag-test"), entry.NodeID)
+		if assert.NotNil(t, entry.Outputs) && assert.Len(t, entry.Outputs.Parameters, 1) {
+			assert.Equal(t, "foobar", entry.Outputs.Parameters[0].Value.String())
+		}
+		if assert.Len(t, entry.Nodes, 2) {
+			assert.Equal(t, cache.EntryNode{Name: "", Children: []string{".whalesay"}, OutboundNodes: []string{".whalesay"}}, entry.Nodes[0])
+			task := entry.Nodes[1]
+			assert.Equal(t, ".whalesay", task.Name)
+			assert.Equal(t, wfv1.NodeTypePod, task.Type)
+			assert.Equal(t, wfv1.NodeSucceeded, task.Phase)
+			assert.Equal(t, "", task.BoundaryName)
+			if assert.NotNil(t, task.Outputs) && assert.Len(t, task.Outputs.Parameters, 1) {
+				assert.Equal(t, "foobar", task.Outputs.Parameters[0].Value.String())
+			}
+		}
+	}
+}
+
+func TestConfigMapCacheLoadOperateDAG(t *testing.T) {
+	wf := unmarshalWF(workflowCachedDAG)
+	cancel, controller := newController(wf)
+	defer cancel()
+
+	ctx := context.Background()
+	_, err := controller.kubeclientset.CoreV1().ConfigMaps("default").Create(ctx, &apiv1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: "dag-cache"},
+		Data: map[string]string{
+			"hi-there-world": `{"nodeID":"memoized-dag-test-1","outputs":{"parameters":[{"name":"hello","value":"foobar"}]},"creationTimestamp":"2020-09-21T18:12:56Z",` +
+				`"nodes":[{"name":"","children":[".whalesay"],"outboundNodes":[".whalesay"]},` +
+				`{"name":".whalesay","displayName":"whalesay","type":"Pod","templateName":"whalesay","phase":"Succeeded","outputs":{"parameters":[{"name":"hello","value":"foobar"}]}}]}`,
+		},
+	}, metav1.CreateOptions{})
+	assert.NoError(t, err)
+
+	woc := newWorkflowOperationCtx(wf, controller)
+	woc.operate(ctx)
+
+	assert.Equal(t, wfv1.WorkflowSucceeded, woc.wf.Status.Phase)
+	// the whole sub-tree is restored from the cache, so no pods are created
+	pods, err := controller.kubeclientset.CoreV1().Pods("").List(ctx, metav1.ListOptions{})
+	if assert.NoError(t, err) {
+		assert.Empty(t, pods.Items)
+	}
+	if assert.Len(t, woc.wf.Status.Nodes, 2) {
+		node := woc.wf.Status.Nodes.FindByDisplayName("memoized-dag-test")
+		task := woc.wf.Status.Nodes.FindByDisplayName("whalesay")
+		if assert.NotNil(t, node) && assert.NotNil(t, task) {
+			assert.Equal(t, wfv1.NodeSucceeded, node.Phase)
+			assert.True(t, node.MemoizationStatus.Hit)
+			assert.Equal(t, "foobar", node.Outputs.Parameters[0].Value.String())
+			assert.Equal(t, []string{task.ID}, node.Children)
+			assert.Equal(t, []string{task.ID}, node.OutboundNodes)
+
+			assert.Equal(t, woc.wf.NodeID("memoized-dag-test.whalesay"), task.ID)
+			assert.Equal(t, wfv1.NodeSucceeded, task.Phase)
+			assert.Equal(t, node.ID, task.BoundaryID)
+			assert.True(t, task.MemoizationStatus.Hit)
+			assert.Equal(t, "foobar", task.Outputs.Parameters[0].Value.String())
+		}
+	}
+}
+
 var propagate = `
 apiVersion: argoproj.io/v1alpha1
 kind: Workflow
@@ -5209,6 +5466,47 @@ func TestStorageQuota(t *testing.T) {
 	assert.Contains(t, woc.wf.Status.Message, "BadRequest")
 }
 
+func TestPodResourceQuota(t *testing.T) {
+	wf := unmarshalWF(helloWorldWf)
+	cancel, controller := newController(wf)
+	defer cancel()
+
+	quotaExceeded := true
+	controller.kubeclientset.(*fake.Clientset).Fake.PrependReactor("create", "pods", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		if quotaExceeded {
+			return true, nil, apierr.NewForbidden(schema.GroupResource{Resource: "pods"}, "hello-world", errors.New("exceeded quota: my-quota"))
+		}
+		return false, nil, nil
+	})
+
+	ctx := context.Background()
+	woc := newWorkflowOperationCtx(wf, controller)
+	woc.operate(ctx)
+	assert.Equal(t, wfv1.WorkflowRunning, woc.wf.Status.Phase)
+	node := woc.wf.Status.Nodes.FindByDisplayName("hello-world")
+	if assert.NotNil(t, node) {
+		assert.Equal(t, wfv1.NodePending, node.Phase)
+		assert.Contains(t, node.Message, "Waiting for resource quota: ")
+		assert.Contains(t, node.Message, "exceeded quota: my-quota")
+	}
+	if assert.Len(t, woc.wf.Status.Conditions, 1) {
+		assert.Equal(t, wfv1.ConditionTypeResourceQuotaExceeded, woc.wf.Status.Conditions[0].Type)
+		assert.Equal(t, metav1.ConditionTrue, woc.wf.Status.Conditions[0].Status)
+	}
+
+	quotaExceeded = false
+	woc = newWorkflowOperationCtx(woc.wf, controller)
+	woc.operate(ctx)
+	node = woc.wf.Status.Nodes.FindByDisplayName("hello-world")
+	if assert.NotNil(t, node) {
+		assert.Equal(t, wfv1.NodePending, node.Phase)
+		assert.Empty(t, node.Message)
+	}
+	for _, condition := range woc.wf.Status.Conditions {
+		assert.NotEqual(t, wfv1.ConditionTypeResourceQuotaExceeded, condition.Type)
+	}
+}
+
 var podWithFailed = `
 apiVersion: v1
 kind: Pod
@@ -5761,3 +6059,51 @@ func TestRetryOnDiffHost(t *testing.T) {
 	}
 	assert.Equal(t, sourceNodeSelectorRequirement, targetNodeSelectorRequirement)
 }
+
+var artifactGCWf = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  name: artifact-gc
+spec:
+  entrypoint: main
+  templates:
+  - name: main
+    container:
+      image: argoproj/argosay:v2
+    outputs:
+      artifacts:
+      - name: my-artifact
+        path: /tmp/my-artifact
+        artifactGC:
+          strategy: OnWorkflowDeletion
+`
+
+func TestArtifactGCFinalizer(t *testing.T) {
+	ctx := context.Background()
+	t.Run("ArtifactStrategy", func(t *testing.T) {
+		wf := unmarshalWF(artifactGCWf)
+		cancel, controller := newController(wf)
+		defer cancel()
+		woc := newWorkflowOperationCtx(wf, controller)
+		woc.operate(ctx)
+		assert.Contains(t, woc.wf.Finalizers, common.FinalizerArtifactGC)
+	})
+	t.Run("WorkflowStrategy", func(t *testing.T) {
+		wf := unmarshalWF(helloWorldWf)
+		wf.Spec.ArtifactGC = &wfv1.ArtifactGC{Strategy: wfv1.ArtifactGCOnWorkflowCompletion}
+		cancel, controller := newController(wf)
+		defer cancel()
+		woc := newWorkflowOperationCtx(wf, controller)
+		woc.operate(ctx)
+		assert.Contains(t, woc.wf.Finalizers, common.FinalizerArtifactGC)
+	})
+	t.Run("None", func(t *testing.T) {
+		wf := unmarshalWF(helloWorldWf)
+		cancel, controller := newController(wf)
+		defer cancel()
+		woc := newWorkflowOperationCtx(wf, controller)
+		woc.operate(ctx)
+		assert.NotContains(t, woc.wf.Finalizers, common.FinalizerArtifactGC)
+	})
+}
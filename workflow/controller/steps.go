@@ -257,9 +257,14 @@ func (woc *wfOperationCtx) executeStepGroup(ctx context.Context, stepGroup []wfv
 		}
 	}
 
+	hooksFulfilled, err := woc.executeStepsLifecycleHooks(ctx, stepGroup, sgNodeName, stepsCtx)
+	if err != nil {
+		return woc.markNodeError(sgNodeName, fmt.Errorf("step group deemed errored due to %w", err))
+	}
+
 	node = woc.wf.GetNodeByName(sgNodeName)
-	// Return if not all children completed
-	completed := true
+	// Return if not all children and hooks completed
+	completed := hooksFulfilled
 	for _, childNodeID := range node.Children {
 		childNode := woc.wf.Status.Nodes[childNodeID]
 		step := nodeSteps[childNode.Name]
@@ -293,6 +298,36 @@ func (woc *wfOperationCtx) executeStepGroup(ctx context.Context, stepGroup []wfv
 	return woc.markNodePhase(node.Name, wfv1.NodeSucceeded)
 }
 
+// executeStepsLifecycleHooks runs the hooks of the steps of the group. Their expressions can refer to the steps of the
+// group, e.g. `steps.train.status`, in addition to the previous steps. It returns whether all the hooks which ran
+// are fulfilled.
+func (woc *wfOperationCtx) executeStepsLifecycleHooks(ctx context.Context, stepGroup []wfv1.WorkflowStep, sgNodeName string, stepsCtx *stepsContext) (bool, error) {
+	scope := &wfScope{tmpl: stepsCtx.scope.tmpl, scope: make(map[string]interface{})}
+	for key, val := range stepsCtx.scope.scope {
+		scope.scope[key] = val
+	}
+	for _, step := range stepGroup {
+		if childNode := woc.wf.GetNodeByName(fmt.Sprintf("%s.%s", sgNodeName, step.Name)); childNode != nil {
+			woc.buildLocalScope(scope, fmt.Sprintf("steps.%s", step.Name), childNode)
+		}
+	}
+	params := woc.globalParams.Merge(scope.getParameters())
+
+	fulfilled := true
+	for _, step := range stepGroup {
+		childNodeName := fmt.Sprintf("%s.%s", sgNodeName, step.Name)
+		if len(step.Hooks) == 0 || woc.wf.GetNodeByName(childNodeName) == nil {
+			continue
+		}
+		hooksFulfilled, err := woc.executeLifecycleHooks(ctx, step.Hooks, childNodeName, stepsCtx.boundaryID, stepsCtx.tmplCtx, params)
+		if err != nil {
+			return false, err
+		}
+		fulfilled = fulfilled && hooksFulfilled
+	}
+	return fulfilled, nil
+}
+
 // shouldExecute evaluates a already substituted when expression to decide whether or not a step should execute
 func shouldExecute(when string) (bool, error) {
 	if when == "" {
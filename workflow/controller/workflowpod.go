@@ -8,6 +8,7 @@ import (
 	"os"
 	"path/filepath"
 	"strconv"
+	"strings"
 	"time"
 
 	log "github.com/sirupsen/logrus"
@@ -159,6 +160,9 @@ func (woc *wfOperationCtx) createWorkflowPod(ctx context.Context, nodeName strin
 	if isResourcesSpecified(tmpl.Container) && tmpl.Container.Name == common.MainContainerName {
 		mainCtr.Resources = *tmpl.Container.Resources.DeepCopy()
 	}
+	if tmpl.ContainerSet != nil && isResourcesSpecified(&tmpl.ContainerSet.GetContainerByName(common.MainContainerName).Container) {
+		mainCtr.Resources = *tmpl.ContainerSet.GetContainerByName(common.MainContainerName).Resources.DeepCopy()
+	}
 
 	var activeDeadlineSeconds *int64
 	wfDeadline := woc.getWorkflowDeadline()
@@ -233,8 +237,8 @@ func (woc *wfOperationCtx) createWorkflowPod(ctx context.Context, nodeName strin
 		return nil, err
 	}
 
-	if tmpl.GetType() != wfv1.TemplateTypeResource {
-		// we do not need the wait container for resource templates because
+	if tmpl.GetType() != wfv1.TemplateTypeResource && tmpl.GetType() != wfv1.TemplateTypeData {
+		// we do not need the wait container for resource and data templates because
 		// argoexec runs as the main container and will perform the job of
 		// annotating the outputs or errors, making the wait container redundant.
 		waitCtr, err := woc.newWaitContainer(tmpl)
@@ -248,10 +252,18 @@ func (woc *wfOperationCtx) createWorkflowPod(ctx context.Context, nodeName strin
 	// wait container to start before the main, so that it always has the chance to see the main
 	// container's PID and root filesystem.
 	pod.Spec.Containers = append(pod.Spec.Containers, mainCtr)
+	if tmpl.ContainerSet != nil {
+		for _, ctr := range tmpl.ContainerSet.Containers {
+			if ctr.Name != common.MainContainerName {
+				pod.Spec.Containers = append(pod.Spec.Containers, newContainerSetContainer(tmpl.ContainerSet, ctr))
+			}
+		}
+	}
 
 	// Add init container only if it needs input artifacts. This is also true for
-	// script templates (which needs to populate the script)
-	if len(tmpl.Inputs.Artifacts) > 0 || tmpl.GetType() == wfv1.TemplateTypeScript {
+	// script templates (which needs to populate the script) and container set
+	// templates (which need the executor to run their containers)
+	if len(tmpl.Inputs.Artifacts) > 0 || tmpl.GetType() == wfv1.TemplateTypeScript || tmpl.GetType() == wfv1.TemplateTypeContainerSet {
 		initCtr := woc.newInitContainer(tmpl)
 		pod.Spec.InitContainers = []apiv1.Container{initCtr}
 	}
@@ -273,6 +285,10 @@ func (woc *wfOperationCtx) createWorkflowPod(ctx context.Context, nodeName strin
 		addScriptStagingVolume(pod)
 	}
 
+	if tmpl.GetType() == wfv1.TemplateTypeContainerSet {
+		addContainerSetVolume(pod, tmpl)
+	}
+
 	// addInitContainers, addSidecars and addOutputArtifactsVolumes should be called after all
 	// volumes have been manipulated in the main container since volumeMounts are mirrored
 	err = addInitContainers(pod, tmpl)
@@ -389,6 +405,9 @@ func (woc *wfOperationCtx) createWorkflowPod(ctx context.Context, nodeName strin
 		return nil, errors.InternalWrapError(err)
 	}
 	woc.log.Infof("Created pod: %s (%s)", nodeName, created.Name)
+	if node := woc.wf.GetNodeByName(nodeName); node != nil && strings.HasPrefix(node.Message, waitingForResourceQuotaMessage) {
+		woc.markNodePhase(nodeName, node.Phase, "")
+	}
 	woc.activePods++
 	return created, nil
 }
@@ -453,11 +472,18 @@ func (woc *wfOperationCtx) newWaitContainer(tmpl *wfv1.Template) (*apiv1.Contain
 	return ctr, nil
 }
 
-// hasPrivilegedContainers tests if the main container or sidecars is privileged
+// hasPrivilegedContainers tests if the main container, the containers of the container set or sidecars is privileged
 func hasPrivilegedContainers(tmpl *wfv1.Template) bool {
 	if containerIsPrivileged(tmpl.Container) {
 		return true
 	}
+	if tmpl.ContainerSet != nil {
+		for _, ctr := range tmpl.ContainerSet.Containers {
+			if containerIsPrivileged(&ctr.Container) {
+				return true
+			}
+		}
+	}
 	for _, side := range tmpl.Sidecars {
 		if containerIsPrivileged(&side.Container) {
 			return true
@@ -702,7 +728,7 @@ func addSchedulingConstraints(pod *apiv1.Pod, wfSpec *wfv1.WorkflowSpec, tmpl *w
 // These are either specified in the workflow.spec.volumes or the workflow.spec.volumeClaimTemplate section
 func addVolumeReferences(pod *apiv1.Pod, vols []apiv1.Volume, tmpl *wfv1.Template, pvcs []apiv1.Volume) error {
 	switch tmpl.GetType() {
-	case wfv1.TemplateTypeContainer, wfv1.TemplateTypeScript:
+	case wfv1.TemplateTypeContainer, wfv1.TemplateTypeScript, wfv1.TemplateTypeContainerSet:
 	default:
 		return nil
 	}
@@ -765,6 +791,18 @@ func addVolumeReferences(pod *apiv1.Pod, vols []apiv1.Volume, tmpl *wfv1.Templat
 			return err
 		}
 	}
+	if tmpl.ContainerSet != nil {
+		err := addVolumeRef(tmpl.ContainerSet.VolumeMounts)
+		if err != nil {
+			return err
+		}
+		for _, ctr := range tmpl.ContainerSet.Containers {
+			err := addVolumeRef(ctr.VolumeMounts)
+			if err != nil {
+				return err
+			}
+		}
+	}
 
 	for _, container := range tmpl.InitContainers {
 		err := addVolumeRef(container.VolumeMounts)
@@ -842,6 +880,8 @@ func (woc *wfOperationCtx) addInputArtifactsVolumes(pod *apiv1.Pod, tmpl *wfv1.T
 				tmplVolumeMounts = tmpl.Container.VolumeMounts
 			} else if tmpl.Script != nil {
 				tmplVolumeMounts = tmpl.Script.Container.VolumeMounts
+			} else if tmpl.ContainerSet != nil {
+				tmplVolumeMounts = tmpl.ContainerSet.VolumeMounts
 			}
 			for _, mnt := range tmplVolumeMounts {
 				mnt.MountPath = filepath.Join(common.ExecutorMainFilesystemDir, mnt.MountPath)
@@ -892,6 +932,12 @@ func (woc *wfOperationCtx) addInputArtifactsVolumes(pod *apiv1.Pod, tmpl *wfv1.T
 			mainCtr.VolumeMounts = make([]apiv1.VolumeMount, 0)
 		}
 		mainCtr.VolumeMounts = append(mainCtr.VolumeMounts, volMount)
+		// the input artifacts are available in all the containers of a container set
+		for i, ctr := range pod.Spec.Containers {
+			if ctr.Name != common.MainContainerName && tmpl.ContainerSet != nil && tmpl.ContainerSet.GetContainerByName(ctr.Name) != nil {
+				pod.Spec.Containers[i].VolumeMounts = append(pod.Spec.Containers[i].VolumeMounts, volMount)
+			}
+		}
 	}
 	pod.Spec.Containers[mainCtrIndex] = *mainCtr
 	return nil
@@ -903,7 +949,7 @@ func (woc *wfOperationCtx) addInputArtifactsVolumes(pod *apiv1.Pod, tmpl *wfv1.T
 // them to the wait sidecar. In order for this to work, we mirror all volume mounts in the main
 // container under a well-known path.
 func addOutputArtifactsVolumes(pod *apiv1.Pod, tmpl *wfv1.Template) {
-	if tmpl.GetType() == wfv1.TemplateTypeResource {
+	if tmpl.GetType() == wfv1.TemplateTypeResource || tmpl.GetType() == wfv1.TemplateTypeData {
 		return
 	}
 	mainCtrIndex := -1
@@ -947,6 +993,9 @@ func (woc *wfOperationCtx) addArchiveLocation(tmpl *wfv1.Template) {
 			needLocation = true
 		}
 	}
+	if tmpl.Data != nil && tmpl.Data.Source.ArtifactPaths != nil && !tmpl.Data.Source.ArtifactPaths.HasLocation() {
+		needLocation = true
+	}
 	woc.log.WithField("needLocation", needLocation).Debug()
 	if !needLocation {
 		return
@@ -1038,6 +1087,33 @@ func addScriptStagingVolume(pod *apiv1.Pod) {
 	}
 }
 
+// addContainerSetVolume sets up a volume shared by the init container and the containers of a container set. The
+// init container copies argoexec to it, and the containers record their exit code in it for their dependants.
+func addContainerSetVolume(pod *apiv1.Pod, tmpl *wfv1.Template) {
+	volName := "var-run-argo"
+	pod.Spec.Volumes = append(pod.Spec.Volumes, apiv1.Volume{
+		Name: volName,
+		VolumeSource: apiv1.VolumeSource{
+			EmptyDir: &apiv1.EmptyDirVolumeSource{},
+		},
+	})
+	volMount := apiv1.VolumeMount{
+		Name:      volName,
+		MountPath: common.ExecutorVarRunArgoDir,
+	}
+	for i, initCtr := range pod.Spec.InitContainers {
+		if initCtr.Name == common.InitContainerName {
+			pod.Spec.InitContainers[i].VolumeMounts = append(initCtr.VolumeMounts, volMount)
+			break
+		}
+	}
+	for i, ctr := range pod.Spec.Containers {
+		if tmpl.ContainerSet.GetContainerByName(ctr.Name) != nil {
+			pod.Spec.Containers[i].VolumeMounts = append(ctr.VolumeMounts, volMount)
+		}
+	}
+}
+
 // addInitContainers adds all init containers to the pod spec of the step
 // Optionally volume mounts from the main container to the init containers
 func addInitContainers(pod *apiv1.Pod, tmpl *wfv1.Template) error {
@@ -1146,6 +1222,9 @@ func createArchiveLocationSecret(tmpl *wfv1.Template, volMap map[string]apiv1.Vo
 		createSecretVal(volMap, ossRepo.SecretKeySecret, uniqueKeyMap)
 	} else if gcsRepo := tmpl.ArchiveLocation.GCS; gcsRepo != nil {
 		createSecretVal(volMap, gcsRepo.ServiceAccountKeySecret, uniqueKeyMap)
+	} else if azureRepo := tmpl.ArchiveLocation.Azure; azureRepo != nil {
+		createSecretVal(volMap, azureRepo.AccountKeySecret, uniqueKeyMap)
+		createSecretVal(volMap, azureRepo.SASTokenSecret, uniqueKeyMap)
 	}
 }
 
@@ -1168,6 +1247,9 @@ func createSecretVolume(volMap map[string]apiv1.Volume, art wfv1.Artifact, keyMa
 		createSecretVal(volMap, art.OSS.SecretKeySecret, keyMap)
 	} else if art.GCS != nil {
 		createSecretVal(volMap, art.GCS.ServiceAccountKeySecret, keyMap)
+	} else if art.Azure != nil {
+		createSecretVal(volMap, art.Azure.AccountKeySecret, keyMap)
+		createSecretVal(volMap, art.Azure.SASTokenSecret, keyMap)
 	}
 }
 
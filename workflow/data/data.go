@@ -0,0 +1,63 @@
+package data
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/antonmedv/expr"
+
+	wfv1 "github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1"
+)
+
+// SourceProcessor sources the data of a data template
+type SourceProcessor interface {
+	// ProcessArtifactPaths returns the keys of the objects under the artifact location
+	ProcessArtifactPaths(ctx context.Context, artifact *wfv1.ArtifactLocation) ([]interface{}, error)
+}
+
+// ProcessData sources the data of the template and applies each step of its transformation to it
+func ProcessData(ctx context.Context, tmpl *wfv1.DataTemplate, processor SourceProcessor) (interface{}, error) {
+	data, err := processSource(ctx, tmpl.Source, processor)
+	if err != nil {
+		return nil, fmt.Errorf("unable to process data source: %w", err)
+	}
+	for i, step := range tmpl.Transformation {
+		data, err = processStep(data, step)
+		if err != nil {
+			return nil, fmt.Errorf("unable to process transformation step %d: %w", i, err)
+		}
+	}
+	return data, nil
+}
+
+func processSource(ctx context.Context, source wfv1.DataSource, processor SourceProcessor) (interface{}, error) {
+	switch {
+	case source.ArtifactPaths != nil:
+		return processor.ProcessArtifactPaths(ctx, source.ArtifactPaths)
+	}
+	return nil, fmt.Errorf("no source specified")
+}
+
+func processStep(data interface{}, step wfv1.TransformationStep) (interface{}, error) {
+	return expr.Eval(step.Expression, map[string]interface{}{
+		"data":  data,
+		"batch": batch,
+	})
+}
+
+// batch groups the items into lists of the given size, the last list holding the remaining items. It panics on an
+// invalid size, as expr only reports panics as errors.
+func batch(items []interface{}, size int) []interface{} {
+	if size <= 0 {
+		panic("batch size must be a positive integer > 0")
+	}
+	batches := make([]interface{}, 0, (len(items)+size-1)/size)
+	for i := 0; i < len(items); i += size {
+		end := i + size
+		if end > len(items) {
+			end = len(items)
+		}
+		batches = append(batches, items[i:end])
+	}
+	return batches
+}
@@ -0,0 +1,76 @@
+package data
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	wfv1 "github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1"
+)
+
+type fakeSourceProcessor []interface{}
+
+func (f fakeSourceProcessor) ProcessArtifactPaths(context.Context, *wfv1.ArtifactLocation) ([]interface{}, error) {
+	if f == nil {
+		return nil, fmt.Errorf("not found")
+	}
+	return f, nil
+}
+
+func TestProcessData(t *testing.T) {
+	ctx := context.Background()
+	keys := fakeSourceProcessor{"foo/a.csv", "foo/b.log", "foo/c.csv", "foo/d.csv"}
+	source := wfv1.DataSource{ArtifactPaths: &wfv1.ArtifactLocation{S3: &wfv1.S3Artifact{Key: "foo"}}}
+	transform := func(expressions ...string) []wfv1.TransformationStep {
+		var steps []wfv1.TransformationStep
+		for _, expression := range expressions {
+			steps = append(steps, wfv1.TransformationStep{Expression: expression})
+		}
+		return steps
+	}
+
+	t.Run("NoTransformation", func(t *testing.T) {
+		data, err := ProcessData(ctx, &wfv1.DataTemplate{Source: source}, keys)
+		if assert.NoError(t, err) {
+			assert.Equal(t, []interface{}{"foo/a.csv", "foo/b.log", "foo/c.csv", "foo/d.csv"}, data)
+		}
+	})
+	t.Run("Filter", func(t *testing.T) {
+		data, err := ProcessData(ctx, &wfv1.DataTemplate{Source: source, Transformation: transform(`filter(data, {# endsWith ".csv"})`)}, keys)
+		if assert.NoError(t, err) {
+			assert.Equal(t, []interface{}{"foo/a.csv", "foo/c.csv", "foo/d.csv"}, data)
+		}
+	})
+	t.Run("Map", func(t *testing.T) {
+		data, err := ProcessData(ctx, &wfv1.DataTemplate{Source: source, Transformation: transform(`map(data, {# + ".gz"})`)}, keys)
+		if assert.NoError(t, err) {
+			assert.Equal(t, []interface{}{"foo/a.csv.gz", "foo/b.log.gz", "foo/c.csv.gz", "foo/d.csv.gz"}, data)
+		}
+	})
+	t.Run("Batch", func(t *testing.T) {
+		data, err := ProcessData(ctx, &wfv1.DataTemplate{Source: source, Transformation: transform(`filter(data, {# endsWith ".csv"})`, `batch(data, 2)`)}, keys)
+		if assert.NoError(t, err) {
+			assert.Equal(t, []interface{}{[]interface{}{"foo/a.csv", "foo/c.csv"}, []interface{}{"foo/d.csv"}}, data)
+		}
+	})
+	t.Run("InvalidBatchSize", func(t *testing.T) {
+		_, err := ProcessData(ctx, &wfv1.DataTemplate{Source: source, Transformation: transform(`batch(data, 0)`)}, keys)
+		if assert.Error(t, err) {
+			assert.Contains(t, err.Error(), "batch size must be a positive integer > 0")
+		}
+	})
+	t.Run("InvalidExpression", func(t *testing.T) {
+		_, err := ProcessData(ctx, &wfv1.DataTemplate{Source: source, Transformation: transform(`map(data, {# + 1})`, `filter(`)}, keys)
+		assert.Error(t, err)
+	})
+	t.Run("SourceError", func(t *testing.T) {
+		_, err := ProcessData(ctx, &wfv1.DataTemplate{Source: source}, fakeSourceProcessor(nil))
+		assert.EqualError(t, err, "unable to process data source: not found")
+	})
+	t.Run("NoSource", func(t *testing.T) {
+		_, err := ProcessData(ctx, &wfv1.DataTemplate{}, keys)
+		assert.EqualError(t, err, "unable to process data source: no source specified")
+	})
+}
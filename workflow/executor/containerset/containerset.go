@@ -0,0 +1,113 @@
+package containerset
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"os/signal"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// OmittedExitCode is recorded as the exit code of a container that did not run, so that its dependants do not run either
+const OmittedExitCode = -1
+
+// pollInterval is the interval at which the exit codes of the dependencies are checked
+var pollInterval = time.Second
+
+// exitCodePath returns the path of the file holding the exit code of a container. The containers of a set share
+// the directory through an emptydir volume.
+func exitCodePath(dir, name string) string {
+	return filepath.Join(dir, "ctr", name, "exitcode")
+}
+
+// WaitDependencies waits for all the dependencies to complete. It returns the name of the first dependency that did
+// not succeed, or an empty string if they all succeeded.
+func WaitDependencies(ctx context.Context, dir string, dependencies []string) (string, error) {
+	for _, dependency := range dependencies {
+		log.Debugf("Waiting for dependency %s", dependency)
+		exitCode, err := waitExitCode(ctx, dir, dependency)
+		if err != nil {
+			return "", err
+		}
+		if exitCode != 0 {
+			return dependency, nil
+		}
+	}
+	return "", nil
+}
+
+func waitExitCode(ctx context.Context, dir, name string) (int, error) {
+	for {
+		data, err := ioutil.ReadFile(exitCodePath(dir, name))
+		if err == nil {
+			return strconv.Atoi(strings.TrimSpace(string(data)))
+		}
+		if !os.IsNotExist(err) {
+			return 0, err
+		}
+		select {
+		case <-ctx.Done():
+			return 0, ctx.Err()
+		case <-time.After(pollInterval):
+		}
+	}
+}
+
+// WriteExitCode records the exit code of a container for its dependants
+func WriteExitCode(dir, name string, exitCode int) error {
+	path := exitCodePath(dir, name)
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return err
+	}
+	// write then rename, so that dependants never read a partial file
+	tmp := path + ".tmp"
+	if err := ioutil.WriteFile(tmp, []byte(strconv.Itoa(exitCode)), 0644); err != nil {
+		return err
+	}
+	return os.Rename(tmp, path)
+}
+
+// RunCommand runs the command, forwarding it the termination signals, and returns its exit code
+func RunCommand(ctx context.Context, command []string) (int, error) {
+	if len(command) == 0 {
+		return 0, fmt.Errorf("no command to run")
+	}
+	cmd := exec.CommandContext(ctx, command[0], command[1:]...)
+	cmd.Stdin = os.Stdin
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	if err := cmd.Start(); err != nil {
+		return 0, err
+	}
+
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, os.Interrupt, syscall.SIGTERM)
+	defer func() {
+		signal.Stop(signals)
+		close(signals)
+	}()
+	go func() {
+		for s := range signals {
+			log.Infof("Forwarding signal %v", s)
+			_ = cmd.Process.Signal(s)
+		}
+	}()
+
+	err := cmd.Wait()
+	if exitErr, ok := err.(*exec.ExitError); ok {
+		if status, ok := exitErr.Sys().(syscall.WaitStatus); ok && status.Signaled() {
+			// same as a shell, so that a killed command is not mistaken for an omitted one
+			return 128 + int(status.Signal()), nil
+		}
+		return exitErr.ExitCode(), nil
+	}
+	return 0, err
+}
@@ -0,0 +1,85 @@
+package containerset
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWaitDependencies(t *testing.T) {
+	pollInterval = 10 * time.Millisecond
+	dir, err := ioutil.TempDir("", "containerset")
+	if !assert.NoError(t, err) {
+		return
+	}
+	defer func() { _ = os.RemoveAll(dir) }()
+	ctx := context.Background()
+
+	t.Run("NoDependencies", func(t *testing.T) {
+		failed, err := WaitDependencies(ctx, dir, nil)
+		if assert.NoError(t, err) {
+			assert.Empty(t, failed)
+		}
+	})
+	t.Run("Succeeded", func(t *testing.T) {
+		assert.NoError(t, WriteExitCode(dir, "a", 0))
+		go func() {
+			time.Sleep(50 * time.Millisecond)
+			_ = WriteExitCode(dir, "b", 0)
+		}()
+		failed, err := WaitDependencies(ctx, dir, []string{"a", "b"})
+		if assert.NoError(t, err) {
+			assert.Empty(t, failed)
+		}
+	})
+	t.Run("Failed", func(t *testing.T) {
+		assert.NoError(t, WriteExitCode(dir, "c", 1))
+		failed, err := WaitDependencies(ctx, dir, []string{"a", "c"})
+		if assert.NoError(t, err) {
+			assert.Equal(t, "c", failed)
+		}
+	})
+	t.Run("Omitted", func(t *testing.T) {
+		assert.NoError(t, WriteExitCode(dir, "d", OmittedExitCode))
+		failed, err := WaitDependencies(ctx, dir, []string{"d"})
+		if assert.NoError(t, err) {
+			assert.Equal(t, "d", failed)
+		}
+	})
+	t.Run("Cancelled", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(ctx, 50*time.Millisecond)
+		defer cancel()
+		_, err := WaitDependencies(ctx, dir, []string{"never"})
+		assert.Equal(t, context.DeadlineExceeded, err)
+	})
+}
+
+func TestRunCommand(t *testing.T) {
+	ctx := context.Background()
+	t.Run("Succeeded", func(t *testing.T) {
+		exitCode, err := RunCommand(ctx, []string{"sh", "-c", "exit 0"})
+		if assert.NoError(t, err) {
+			assert.Equal(t, 0, exitCode)
+		}
+	})
+	t.Run("Failed", func(t *testing.T) {
+		exitCode, err := RunCommand(ctx, []string{"sh", "-c", "exit 3"})
+		if assert.NoError(t, err) {
+			assert.Equal(t, 3, exitCode)
+		}
+	})
+	t.Run("Killed", func(t *testing.T) {
+		exitCode, err := RunCommand(ctx, []string{"sh", "-c", "kill -9 $$"})
+		if assert.NoError(t, err) {
+			assert.Equal(t, 137, exitCode)
+		}
+	})
+	t.Run("NotFound", func(t *testing.T) {
+		_, err := RunCommand(ctx, []string{"not-a-command"})
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,56 @@
+package executor
+
+import (
+	"context"
+	"encoding/json"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/argoproj/argo/v2/errors"
+	wfv1 "github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo/v2/workflow/data"
+)
+
+// maxDataSize is the maximum size of the data of a data template, as it is stored in an annotation of the pod
+const maxDataSize int = 256 * (1 << 10) // 256 kB
+
+// Data sources and transforms the data of a data template, and annotates the pod with it as the outputs.result
+func (we *WorkflowExecutor) Data(ctx context.Context) error {
+	log.Infof("Processing data")
+	transformed, err := data.ProcessData(ctx, we.Template.Data, we)
+	if err != nil {
+		return err
+	}
+	out, err := json.Marshal(transformed)
+	if err != nil {
+		return errors.InternalWrapError(err)
+	}
+	// unlike a script result, the data cannot be truncated as it would no longer be valid JSON
+	if len(out) > maxDataSize {
+		return errors.Errorf(errors.CodeBadRequest, "data is larger than the maximum allowed size of 256 kB")
+	}
+	result := string(out)
+	we.Template.Outputs.Result = &result
+	return we.AnnotateOutputs(ctx, nil)
+}
+
+// ProcessArtifactPaths returns the keys of the objects under the artifact location, as listed by its artifact driver
+func (we *WorkflowExecutor) ProcessArtifactPaths(ctx context.Context, artifact *wfv1.ArtifactLocation) ([]interface{}, error) {
+	driverArt, err := we.newDriverArt(&wfv1.Artifact{Name: "artifactPaths", ArtifactLocation: *artifact})
+	if err != nil {
+		return nil, err
+	}
+	driver, err := we.InitDriver(ctx, driverArt)
+	if err != nil {
+		return nil, err
+	}
+	keys, err := driver.ListObjects(driverArt)
+	if err != nil {
+		return nil, err
+	}
+	items := make([]interface{}, len(keys))
+	for i, key := range keys {
+		items[i] = key
+	}
+	return items, nil
+}
@@ -37,6 +37,7 @@ import (
 	waitutil "github.com/argoproj/argo/v2/util/wait"
 	artifact "github.com/argoproj/argo/v2/workflow/artifacts"
 	"github.com/argoproj/argo/v2/workflow/common"
+	argowait "github.com/argoproj/argo/v2/workflow/executor/common/wait"
 	os_specific "github.com/argoproj/argo/v2/workflow/executor/os-specific"
 )
 
@@ -238,11 +239,14 @@ func (we *WorkflowExecutor) LoadArtifacts(ctx context.Context) error {
 	return nil
 }
 
-// StageFiles will create any files required by script/resource templates
+// StageFiles will create any files required by script/resource/container set templates
 func (we *WorkflowExecutor) StageFiles() error {
 	var filePath string
 	var body []byte
 	switch we.Template.GetType() {
+	case wfv1.TemplateTypeContainerSet:
+		log.Infof("Copying executor to %s", common.ExecutorContainerSetPath)
+		return copyExecutable(common.ExecutorContainerSetPath)
 	case wfv1.TemplateTypeScript:
 		log.Infof("Loading script source to %s", common.ExecutorScriptSourcePath)
 		filePath = common.ExecutorScriptSourcePath
@@ -261,9 +265,34 @@ func (we *WorkflowExecutor) StageFiles() error {
 	return nil
 }
 
-// SaveArtifacts uploads artifacts to the archive location
+// copyExecutable copies the executable of the current process, so that another container can run it
+func copyExecutable(path string) error {
+	executable, err := os.Executable()
+	if err != nil {
+		return errors.InternalWrapError(err)
+	}
+	in, err := os.Open(executable)
+	if err != nil {
+		return errors.InternalWrapError(err)
+	}
+	defer func() { _ = in.Close() }()
+	out, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0755)
+	if err != nil {
+		return errors.InternalWrapError(err)
+	}
+	if _, err := io.Copy(out, in); err != nil {
+		_ = out.Close()
+		return errors.InternalWrapError(err)
+	}
+	if err := out.Close(); err != nil {
+		return errors.InternalWrapError(err)
+	}
+	return nil
+}
+
+// SaveArtifacts uploads artifacts to the archive location, including those of each container of a container set
 func (we *WorkflowExecutor) SaveArtifacts(ctx context.Context) error {
-	if len(we.Template.Outputs.Artifacts) == 0 {
+	if len(we.Template.Outputs.Artifacts) == 0 && !we.hasContainerOutputs(func(o wfv1.Outputs) bool { return len(o.Artifacts) > 0 }) {
 		log.Infof("No output artifacts")
 		return nil
 	}
@@ -278,22 +307,47 @@ func (we *WorkflowExecutor) SaveArtifacts(ctx context.Context) error {
 		return errors.InternalWrapError(err)
 	}
 
-	for i, art := range we.Template.Outputs.Artifacts {
-		err := we.saveArtifact(ctx, mainCtrID, &art)
+	err = we.saveArtifacts(ctx, mainCtrID, "", we.Template.Outputs.Artifacts)
+	if err != nil {
+		return err
+	}
+	if we.Template.ContainerSet == nil {
+		return nil
+	}
+	for _, ctr := range we.Template.ContainerSet.Containers {
+		if len(ctr.Outputs.Artifacts) == 0 {
+			continue
+		}
+		ctrID, err := we.getContainerID(ctx, ctr.Name)
+		if err != nil {
+			return err
+		}
+		// the artifacts of a container are saved in a directory named after it, as their names are only unique within it
+		err = we.saveArtifacts(ctx, ctrID, ctr.Name, ctr.Outputs.Artifacts)
 		if err != nil {
 			return err
 		}
-		we.Template.Outputs.Artifacts[i] = art
 	}
 	return nil
 }
 
-func (we *WorkflowExecutor) saveArtifact(ctx context.Context, mainCtrID string, art *wfv1.Artifact) error {
+func (we *WorkflowExecutor) saveArtifacts(ctx context.Context, ctrID, dir string, arts []wfv1.Artifact) error {
+	for i, art := range arts {
+		err := we.saveArtifact(ctx, ctrID, dir, &art)
+		if err != nil {
+			return err
+		}
+		arts[i] = art
+	}
+	return nil
+}
+
+func (we *WorkflowExecutor) saveArtifact(ctx context.Context, ctrID, dir string, art *wfv1.Artifact) error {
 	// Determine the file path of where to find the artifact
 	if art.Path == "" {
 		return errors.InternalErrorf("Artifact %s did not specify a path", art.Name)
 	}
-	fileName, localArtPath, err := we.stageArchiveFile(mainCtrID, art)
+	fileName, localArtPath, err := we.stageArchiveFile(ctrID, art)
 	if err != nil {
 		if art.Optional && errors.IsCode(errors.CodeNotFound, err) {
 			log.Warnf("Ignoring optional artifact '%s' which does not exist in path '%s': %v", art.Name, art.Path, err)
@@ -301,7 +355,20 @@ func (we *WorkflowExecutor) saveArtifact(ctx context.Context, mainCtrID string,
 		}
 		return err
 	}
-	return we.saveArtifactFromFile(ctx, art, fileName, localArtPath)
+	return we.saveArtifactFromFile(ctx, art, path.Join(dir, fileName), localArtPath)
+}
+
+// hasContainerOutputs is true if any container of a container set has outputs matching f
+func (we *WorkflowExecutor) hasContainerOutputs(f func(wfv1.Outputs) bool) bool {
+	if we.Template.ContainerSet == nil {
+		return false
+	}
+	for _, ctr := range we.Template.ContainerSet.Containers {
+		if f(ctr.Outputs) {
+			return true
+		}
+	}
+	return false
 }
 
 // fileBase is probably path.Base(filePath), but can be something else
@@ -472,9 +539,10 @@ func (we *WorkflowExecutor) isBaseImagePath(path string) bool {
 	return true
 }
 
-// SaveParameters will save the content in the specified file path as output parameter value
+// SaveParameters will save the content in the specified file path as output parameter value, including the output
+// parameters of each container of a container set
 func (we *WorkflowExecutor) SaveParameters(ctx context.Context) error {
-	if len(we.Template.Outputs.Parameters) == 0 {
+	if len(we.Template.Outputs.Parameters) == 0 && !we.hasContainerOutputs(func(o wfv1.Outputs) bool { return len(o.Parameters) > 0 }) {
 		log.Infof("No output parameters")
 		return nil
 	}
@@ -483,8 +551,31 @@ func (we *WorkflowExecutor) SaveParameters(ctx context.Context) error {
 	if err != nil {
 		return err
 	}
+	err = we.saveParameters(mainCtrID, we.Template.Outputs.Parameters)
+	if err != nil {
+		return err
+	}
+	if we.Template.ContainerSet == nil {
+		return nil
+	}
+	for _, ctr := range we.Template.ContainerSet.Containers {
+		if len(ctr.Outputs.Parameters) == 0 {
+			continue
+		}
+		ctrID, err := we.getContainerID(ctx, ctr.Name)
+		if err != nil {
+			return err
+		}
+		err = we.saveParameters(ctrID, ctr.Outputs.Parameters)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
 
-	for i, param := range we.Template.Outputs.Parameters {
+func (we *WorkflowExecutor) saveParameters(ctrID string, params []wfv1.Parameter) error {
+	for i, param := range params {
 		log.Infof("Saving path output parameter: %s", param.Name)
 		// Determine the file path of where to find the parameter
 		if param.ValueFrom == nil || param.ValueFrom.Path == "" {
@@ -500,7 +591,7 @@ func (we *WorkflowExecutor) SaveParameters(ctx context.Context) error {
 				continue
 			}
 			log.Infof("Copying %s from base image layer", param.ValueFrom.Path)
-			fileContents, err := we.RuntimeExecutor.GetFileContents(mainCtrID, param.ValueFrom.Path)
+			fileContents, err := we.RuntimeExecutor.GetFileContents(ctrID, param.ValueFrom.Path)
 			if err != nil {
 				// We have a default value to use instead of returning an error
 				if param.ValueFrom.Default != nil {
@@ -529,7 +620,7 @@ func (we *WorkflowExecutor) SaveParameters(ctx context.Context) error {
 
 		// Trims off a single newline for user convenience
 		output = wfv1.AnyStringPtr(strings.TrimSuffix(output.String(), "\n"))
-		we.Template.Outputs.Parameters[i].Value = output
+		params[i].Value = output
 		log.Infof("Successfully saved output parameter: %s", param.Name)
 	}
 	return nil
@@ -681,12 +772,17 @@ func (we *WorkflowExecutor) GetSecrets(ctx context.Context, namespace, name, key
 
 // GetMainContainerStatus returns the container status of the main container, nil if the main container does not exist
 func (we *WorkflowExecutor) GetMainContainerStatus(ctx context.Context) (*apiv1.ContainerStatus, error) {
+	return we.getContainerStatus(ctx, common.MainContainerName)
+}
+
+// getContainerStatus returns the status of the container of the pod with the given name, nil if it does not exist
+func (we *WorkflowExecutor) getContainerStatus(ctx context.Context, name string) (*apiv1.ContainerStatus, error) {
 	pod, err := we.getPod(ctx)
 	if err != nil {
 		return nil, err
 	}
 	for _, ctrStatus := range pod.Status.ContainerStatuses {
-		if ctrStatus.Name == common.MainContainerName {
+		if ctrStatus.Name == name {
 			return &ctrStatus, nil
 		}
 	}
@@ -698,15 +794,24 @@ func (we *WorkflowExecutor) GetMainContainerID(ctx context.Context) (string, err
 	if we.mainContainerID != "" {
 		return we.mainContainerID, nil
 	}
-	ctrStatus, err := we.GetMainContainerStatus(ctx)
+	ctrID, err := we.getContainerID(ctx, common.MainContainerName)
+	if err != nil {
+		return "", err
+	}
+	we.mainContainerID = ctrID
+	return we.mainContainerID, nil
+}
+
+// getContainerID returns the container id of the container of the pod with the given name, empty if it does not exist
+func (we *WorkflowExecutor) getContainerID(ctx context.Context, name string) (string, error) {
+	ctrStatus, err := we.getContainerStatus(ctx, name)
 	if err != nil {
 		return "", err
 	}
 	if ctrStatus == nil {
 		return "", nil
 	}
-	we.mainContainerID = containerID(ctrStatus.ContainerID)
-	return we.mainContainerID, nil
+	return containerID(ctrStatus.ContainerID), nil
 }
 
 // CaptureScriptResult will add the stdout of a script template as output result
@@ -716,8 +821,8 @@ func (we *WorkflowExecutor) CaptureScriptResult(ctx context.Context) error {
 		log.Infof("No Script output reference in workflow. Capturing script output ignored")
 		return nil
 	}
-	if we.Template.Script == nil && we.Template.Container == nil {
-		log.Infof("Template type is neither of Script or Container. Capturing script output ignored")
+	if we.Template.Script == nil && we.Template.Container == nil && we.Template.ContainerSet == nil {
+		log.Infof("Template type is neither of Script, Container or ContainerSet. Capturing script output ignored")
 		return nil
 	}
 	log.Infof("Capturing script output")
@@ -754,8 +859,8 @@ func (we *WorkflowExecutor) CaptureScriptResult(ctx context.Context) error {
 
 // CaptureScriptExitCode will add the exit code of a script template as output exit code
 func (we *WorkflowExecutor) CaptureScriptExitCode(ctx context.Context) error {
-	if we.Template.Script == nil && we.Template.Container == nil {
-		log.Infof("Template type is neither of Script or Container. Capturing exit code ignored")
+	if we.Template.Script == nil && we.Template.Container == nil && we.Template.ContainerSet == nil {
+		log.Infof("Template type is neither of Script, Container or ContainerSet. Capturing exit code ignored")
 		return nil
 	}
 	log.Infof("Capturing script exit code")
@@ -774,8 +879,13 @@ func (we *WorkflowExecutor) CaptureScriptExitCode(ctx context.Context) error {
 	return nil
 }
 
-// AnnotateOutputs annotation to the pod indicating all the outputs.
+// AnnotateOutputs annotation to the pod indicating all the outputs, and the outputs of each container of a container set
 func (we *WorkflowExecutor) AnnotateOutputs(ctx context.Context, logArt *wfv1.Artifact) error {
+	err := we.annotateContainerOutputs(ctx)
+	if err != nil {
+		return err
+	}
+
 	outputs := we.Template.Outputs.DeepCopy()
 	if logArt != nil {
 		outputs.Artifacts = append(outputs.Artifacts, *logArt)
@@ -792,6 +902,24 @@ func (we *WorkflowExecutor) AnnotateOutputs(ctx context.Context, logArt *wfv1.Ar
 	return we.AddAnnotation(ctx, common.AnnotationKeyOutputs, string(outputBytes))
 }
 
+func (we *WorkflowExecutor) annotateContainerOutputs(ctx context.Context) error {
+	if !we.hasContainerOutputs(func(o wfv1.Outputs) bool { return o.HasOutputs() }) {
+		return nil
+	}
+	outputs := make(map[string]wfv1.Outputs)
+	for _, ctr := range we.Template.ContainerSet.Containers {
+		if ctr.Outputs.HasOutputs() {
+			outputs[ctr.Name] = ctr.Outputs
+		}
+	}
+	log.Infof("Annotating pod with container outputs")
+	outputBytes, err := json.Marshal(outputs)
+	if err != nil {
+		return errors.InternalWrapError(err)
+	}
+	return we.AddAnnotation(ctx, common.AnnotationKeyContainerOutputs, string(outputBytes))
+}
+
 // AddError adds an error to the list of encountered errors durign execution
 func (we *WorkflowExecutor) AddError(err error) {
 	log.Errorf("executor error: %+v", err)
@@ -1003,11 +1131,45 @@ func (we *WorkflowExecutor) Wait(ctx context.Context) error {
 		return err
 	}
 	log.Infof("Main container completed")
+	return we.waitContainerSet(ctx)
+}
+
+// waitContainerSet waits for the other containers of a container set to complete, as the main container does not
+// necessarily depend on all of them
+func (we *WorkflowExecutor) waitContainerSet(ctx context.Context) error {
+	if we.Template.ContainerSet == nil {
+		return nil
+	}
+	for _, ctr := range we.Template.ContainerSet.Containers {
+		if ctr.Name == common.MainContainerName {
+			continue
+		}
+		log.Infof("Waiting on %s container", ctr.Name)
+		containerID, err := we.waitContainerStart(ctx, ctr.Name)
+		if err != nil {
+			return err
+		}
+		err = argowait.UntilTerminated(ctx, we.ClientSet, we.Namespace, we.PodName, containerID)
+		if err != nil {
+			return err
+		}
+		log.Infof("%s container completed", ctr.Name)
+	}
 	return nil
 }
 
 // waitMainContainerStart waits for the main container to start and returns its container ID.
 func (we *WorkflowExecutor) waitMainContainerStart(ctx context.Context) (string, error) {
+	containerID, err := we.waitContainerStart(ctx, common.MainContainerName)
+	if err != nil {
+		return "", err
+	}
+	we.mainContainerID = containerID
+	return containerID, nil
+}
+
+// waitContainerStart waits for a container to start and returns its container ID.
+func (we *WorkflowExecutor) waitContainerStart(ctx context.Context, containerName string) (string, error) {
 	for {
 		podsIf := we.ClientSet.CoreV1().Pods(we.Namespace)
 		fieldSelector := fields.ParseSelectorOrDie(fmt.Sprintf("metadata.name=%s", we.PodName))
@@ -1027,7 +1189,7 @@ func (we *WorkflowExecutor) waitMainContainerStart(ctx context.Context) (string,
 		}
 		for watchEv := range watchIf.ResultChan() {
 			if watchEv.Type == watch.Error {
-				return "", errors.InternalErrorf("Pod watch error waiting for main to start: %v", watchEv.Object)
+				return "", errors.InternalErrorf("Pod watch error waiting for %s to start: %v", containerName, watchEv.Object)
 			}
 			pod, ok := watchEv.Object.(*apiv1.Pod)
 			if !ok {
@@ -1035,18 +1197,17 @@ func (we *WorkflowExecutor) waitMainContainerStart(ctx context.Context) (string,
 				continue
 			}
 			for _, ctrStatus := range pod.Status.ContainerStatuses {
-				if ctrStatus.Name == common.MainContainerName {
+				if ctrStatus.Name == containerName {
 					log.Debug(ctrStatus)
 					if ctrStatus.State.Waiting != nil {
-						// main container is still in waiting status
+						// container is still in waiting status
 					} else if ctrStatus.State.Waiting == nil && ctrStatus.State.Running == nil && ctrStatus.State.Terminated == nil {
 						// status still not ready, wait
 					} else if ctrStatus.ContainerID != "" {
-						we.mainContainerID = containerID(ctrStatus.ContainerID)
 						return containerID(ctrStatus.ContainerID), nil
 					} else {
-						// main container in running or terminated state but missing container ID
-						return "", errors.InternalError("Main container ID cannot be found")
+						// container in running or terminated state but missing container ID
+						return "", errors.InternalErrorf("%s container ID cannot be found", containerName)
 					}
 				}
 			}
@@ -1180,7 +1341,8 @@ func (we *WorkflowExecutor) monitorDeadline(ctx context.Context, annotationsUpda
 					_ = we.AddAnnotation(ctx, common.AnnotationKeyNodeMessage, message)
 					log.Infof("Killing main container")
 					mainContainerID, _ := we.GetMainContainerID(ctx)
-					err := we.RuntimeExecutor.Kill(ctx, []string{mainContainerID})
+					containerIDs := append([]string{mainContainerID}, we.getContainerSetContainerIDs(ctx)...)
+					err := we.RuntimeExecutor.Kill(ctx, containerIDs)
 					if err != nil {
 						log.Warnf("Failed to kill main container: %v", err)
 					}
@@ -1192,6 +1354,28 @@ func (we *WorkflowExecutor) monitorDeadline(ctx context.Context, annotationsUpda
 	}
 }
 
+// getContainerSetContainerIDs returns the IDs of the running containers of a container set, other than main
+func (we *WorkflowExecutor) getContainerSetContainerIDs(ctx context.Context) []string {
+	if we.Template.ContainerSet == nil {
+		return nil
+	}
+	pod, err := we.getPod(ctx)
+	if err != nil {
+		log.Warnf("Failed to get the containers of the container set: %v", err)
+		return nil
+	}
+	var containerIDs []string
+	for _, ctrStatus := range pod.Status.ContainerStatuses {
+		if ctrStatus.Name == common.MainContainerName || we.Template.ContainerSet.GetContainerByName(ctrStatus.Name) == nil {
+			continue
+		}
+		if ctrStatus.State.Running != nil {
+			containerIDs = append(containerIDs, containerID(ctrStatus.ContainerID))
+		}
+	}
+	return containerIDs
+}
+
 // KillSidecars kills any sidecars to the main container
 func (we *WorkflowExecutor) KillSidecars(ctx context.Context) error {
 	log.Infof("Killing sidecars")
@@ -9,9 +9,11 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes/fake"
 
 	wfv1 "github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo/v2/workflow/common"
 	"github.com/argoproj/argo/v2/workflow/executor/mocks"
 )
 
@@ -55,6 +57,47 @@ func TestSaveParameters(t *testing.T) {
 	assert.Equal(t, "has a newline", we.Template.Outputs.Parameters[0].Value.String())
 }
 
+func TestSaveParameters_ContainerSet(t *testing.T) {
+	fakeClientset := fake.NewSimpleClientset(&corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: fakePodName, Namespace: fakeNamespace},
+		Status: corev1.PodStatus{ContainerStatuses: []corev1.ContainerStatus{
+			{Name: "main", ContainerID: "docker://" + fakeContainerID},
+			{Name: "build", ContainerID: "docker://build-id"},
+		}},
+	})
+	mockRuntimeExecutor := mocks.ContainerRuntimeExecutor{}
+	we := WorkflowExecutor{
+		PodName:   fakePodName,
+		Namespace: fakeNamespace,
+		Template: wfv1.Template{
+			ContainerSet: &wfv1.ContainerSetTemplate{Containers: []wfv1.ContainerNode{
+				{Container: corev1.Container{Name: "main"}},
+				{
+					Container: corev1.Container{Name: "build"},
+					Outputs:   wfv1.Outputs{Parameters: []wfv1.Parameter{{Name: "version", ValueFrom: &wfv1.ValueFrom{Path: "/version"}}}},
+				},
+			}},
+		},
+		ClientSet:       fakeClientset,
+		RuntimeExecutor: &mockRuntimeExecutor,
+	}
+	mockRuntimeExecutor.On("GetFileContents", "build-id", "/version").Return("1.0\n", nil)
+
+	ctx := context.Background()
+	err := we.SaveParameters(ctx)
+	if assert.NoError(t, err) {
+		assert.Equal(t, "1.0", we.Template.ContainerSet.Containers[1].Outputs.Parameters[0].Value.String())
+	}
+	err = we.AnnotateOutputs(ctx, nil)
+	if assert.NoError(t, err) {
+		pod, err := fakeClientset.CoreV1().Pods(fakeNamespace).Get(ctx, fakePodName, metav1.GetOptions{})
+		if assert.NoError(t, err) {
+			assert.JSONEq(t, `{"build": {"parameters": [{"name": "version", "value": "1.0", "valueFrom": {"path": "/version"}}]}}`, pod.Annotations[common.AnnotationKeyContainerOutputs])
+			assert.NotContains(t, pod.Annotations, common.AnnotationKeyOutputs)
+		}
+	}
+}
+
 // TestIsBaseImagePath tests logic of isBaseImagePath which determines if a path is coming from a
 // base image layer versus a shared volumeMount.
 func TestIsBaseImagePath(t *testing.T) {
@@ -52,6 +52,8 @@ type Metrics struct {
 	customMetrics      map[string]metric
 	workqueueMetrics   map[string]prometheus.Metric
 	workersBusy        map[string]prometheus.Gauge
+	memoizationMetrics map[string]prometheus.Metric
+	parallelismQuotas  map[string]prometheus.Gauge
 
 	// Used to quickly check if a metric desc is already used by the system
 	defaultMetricDescs map[string]bool
@@ -83,6 +85,8 @@ func New(metricsConfig, telemetryConfig ServerConfig) *Metrics {
 		customMetrics:      make(map[string]metric),
 		workqueueMetrics:   make(map[string]prometheus.Metric),
 		workersBusy:        make(map[string]prometheus.Gauge),
+		memoizationMetrics: make(map[string]prometheus.Metric),
+		parallelismQuotas:  make(map[string]prometheus.Gauge),
 		defaultMetricDescs: make(map[string]bool),
 		metricNameHelps:    make(map[string]string),
 		logMetric: prometheus.NewCounterVec(prometheus.CounterOpts{
@@ -127,6 +131,12 @@ func (m *Metrics) allMetrics() []prometheus.Metric {
 	for _, metric := range m.workersBusy {
 		allMetrics = append(allMetrics, metric)
 	}
+	for _, metric := range m.memoizationMetrics {
+		allMetrics = append(allMetrics, metric)
+	}
+	for _, metric := range m.parallelismQuotas {
+		allMetrics = append(allMetrics, metric)
+	}
 	for _, metric := range m.customMetrics {
 		allMetrics = append(allMetrics, metric.metric)
 	}
@@ -223,6 +233,47 @@ func (m *Metrics) CronWorkflowSubmissionError() {
 	m.errors[ErrorCauseCronWorkflowSubmissionError].Inc()
 }
 
+func (m *Metrics) MemoizationCacheHit(cacheType, cacheName string) {
+	m.memoizationCounter("hits", "Number of memoization cache hits", cacheType, cacheName).Inc()
+}
+
+func (m *Metrics) MemoizationCacheMiss(cacheType, cacheName string) {
+	m.memoizationCounter("misses", "Number of memoization cache misses", cacheType, cacheName).Inc()
+}
+
+func (m *Metrics) MemoizationCacheEvictions(cacheType, cacheName string, num int) {
+	m.memoizationCounter("evictions", "Number of entries evicted from memoization caches", cacheType, cacheName).Add(float64(num))
+}
+
+func (m *Metrics) memoizationCounter(kind, help, cacheType, cacheName string) prometheus.Counter {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	key := fmt.Sprintf("%s-%s-%s", kind, cacheType, cacheName)
+	if _, ok := m.memoizationMetrics[key]; !ok {
+		m.memoizationMetrics[key] = newCounter(fmt.Sprintf("memoization_cache_%s_count", kind), help, map[string]string{"cache_type": cacheType, "cache_name": cacheName})
+	}
+	return m.memoizationMetrics[key].(prometheus.Counter)
+}
+
+// SetParallelismQuota records the number of workflows running and pending in a parallelism quota bucket, and its limit
+func (m *Metrics) SetParallelismQuota(quota, bucket string, inProgress, pending, limit int) {
+	m.parallelismQuotaGauge("current", "Number of workflows running in the parallelism quota bucket", quota, bucket).Set(float64(inProgress))
+	m.parallelismQuotaGauge("pending", "Number of workflows waiting for the parallelism quota bucket", quota, bucket).Set(float64(pending))
+	m.parallelismQuotaGauge("limit", "Maximum number of workflows running in the parallelism quota bucket", quota, bucket).Set(float64(limit))
+}
+
+func (m *Metrics) parallelismQuotaGauge(kind, help, quota, bucket string) prometheus.Gauge {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	key := fmt.Sprintf("%s-%s-%s", kind, quota, bucket)
+	if _, ok := m.parallelismQuotas[key]; !ok {
+		m.parallelismQuotas[key] = newGauge(fmt.Sprintf("parallelism_quota_%s", kind), help, map[string]string{"quota": quota, "bucket": bucket})
+	}
+	return m.parallelismQuotas[key]
+}
+
 // Act as a metrics provider for a workflow queue
 var _ workqueue.MetricsProvider = &Metrics{}
 
@@ -136,6 +136,41 @@ func TestWorkflowQueueMetrics(t *testing.T) {
 	}
 }
 
+func TestMemoizationCacheMetrics(t *testing.T) {
+	m := New(ServerConfig{}, ServerConfig{})
+	m.MemoizationCacheHit("ConfigMap", "my-cache")
+	m.MemoizationCacheHit("ConfigMap", "my-cache")
+	m.MemoizationCacheMiss("ConfigMap", "my-cache")
+	m.MemoizationCacheEvictions("SQL", "my-cache", 3)
+
+	if assert.NotNil(t, m.memoizationMetrics["hits-ConfigMap-my-cache"]) {
+		assert.Equal(t, 2.0, *write(m.memoizationMetrics["hits-ConfigMap-my-cache"]).Counter.Value)
+	}
+	if assert.NotNil(t, m.memoizationMetrics["misses-ConfigMap-my-cache"]) {
+		assert.Equal(t, 1.0, *write(m.memoizationMetrics["misses-ConfigMap-my-cache"]).Counter.Value)
+	}
+	if assert.NotNil(t, m.memoizationMetrics["evictions-SQL-my-cache"]) {
+		assert.Equal(t, 3.0, *write(m.memoizationMetrics["evictions-SQL-my-cache"]).Counter.Value)
+	}
+	assert.Nil(t, m.memoizationMetrics["evictions-ConfigMap-my-cache"])
+}
+
+func TestParallelismQuotaMetrics(t *testing.T) {
+	m := New(ServerConfig{}, ServerConfig{})
+	m.SetParallelismQuota("namespace", "my-ns", 1, 2, 3)
+	m.SetParallelismQuota("namespace", "my-ns", 2, 1, 3)
+
+	if assert.NotNil(t, m.parallelismQuotas["current-namespace-my-ns"]) {
+		assert.Equal(t, 2.0, *write(m.parallelismQuotas["current-namespace-my-ns"]).Gauge.Value)
+	}
+	if assert.NotNil(t, m.parallelismQuotas["pending-namespace-my-ns"]) {
+		assert.Equal(t, 1.0, *write(m.parallelismQuotas["pending-namespace-my-ns"]).Gauge.Value)
+	}
+	if assert.NotNil(t, m.parallelismQuotas["limit-namespace-my-ns"]) {
+		assert.Equal(t, 3.0, *write(m.parallelismQuotas["limit-namespace-my-ns"]).Gauge.Value)
+	}
+}
+
 func TestRealTimeMetricDeletion(t *testing.T) {
 	config := ServerConfig{
 		Enabled: true,
@@ -1,16 +1,32 @@
 package sync
 
-import "time"
+import (
+	"fmt"
+	"strings"
+	"time"
+)
 
 type Semaphore interface {
 	acquire(holderKey string) bool
 	tryAcquire(holderKey string) (bool, string)
+	// checkAcquire returns whether tryAcquire would acquire the lock now, without acquiring it, and the waiting message if not
+	checkAcquire(holderKey string) (bool, string)
 	release(key string) bool
 	addToQueue(holderKey string, priority int32, creationTime time.Time)
 	removeFromQueue(holderKey string)
 	getCurrentHolders() []string
 	getCurrentPending() []string
+	getCurrentWaiters() []LockWaiter
 	getName() string
 	getLimit() int
 	resize(n int) bool
 }
+
+// getWorkflowKey returns the key of the workflow of a holder key, which may be the key of a node of the workflow
+func getWorkflowKey(holderKey string) string {
+	items := strings.Split(holderKey, "/")
+	if len(items) == 3 {
+		return fmt.Sprintf("%s/%s", items[0], items[1])
+	}
+	return holderKey
+}
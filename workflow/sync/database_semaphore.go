@@ -0,0 +1,218 @@
+package sync
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/argoproj/argo/v2/persist/sqldb"
+)
+
+type GetSemaphoreRepository func() sqldb.SemaphoreRepository
+
+// DatabaseSemaphore is a semaphore whose limit and holders are stored in the database, so it can be shared by several
+// controllers. The pending holders are only queued locally, so priority is only honoured between the workflows of
+// this controller.
+type DatabaseSemaphore struct {
+	name       string
+	key        string
+	pending    *priorityQueue
+	lockHolder map[string]bool
+	// acquiring are the holders being acquired in the database, which is not queried while holding the lock
+	acquiring     map[string]bool
+	lock          *sync.Mutex
+	getRepository GetSemaphoreRepository
+	nextWorkflow  NextWorkflow
+	log           *log.Entry
+}
+
+var _ Semaphore = &DatabaseSemaphore{}
+
+// NewDatabaseSemaphore returns a semaphore named `name`, which is stored in the database as `key`
+func NewDatabaseSemaphore(name, key string, getRepository GetSemaphoreRepository, nextWorkflow NextWorkflow) *DatabaseSemaphore {
+	return &DatabaseSemaphore{
+		name:          name,
+		key:           key,
+		pending:       &priorityQueue{itemByKey: make(map[string]*item)},
+		lockHolder:    make(map[string]bool),
+		acquiring:     make(map[string]bool),
+		lock:          &sync.Mutex{},
+		getRepository: getRepository,
+		nextWorkflow:  nextWorkflow,
+		log: log.WithFields(log.Fields{
+			"semaphore": name,
+		}),
+	}
+}
+
+func (s *DatabaseSemaphore) getName() string {
+	return s.name
+}
+
+func (s *DatabaseSemaphore) getLimit() int {
+	limit, err := s.getRepository().GetLimit(s.key)
+	if err != nil {
+		s.log.WithError(err).Error("failed to get semaphore limit")
+	}
+	return limit
+}
+
+func (s *DatabaseSemaphore) getCurrentPending() []string {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	var keys []string
+	for _, item := range s.pending.items {
+		keys = append(keys, item.key)
+	}
+	return keys
+}
+
+func (s *DatabaseSemaphore) getCurrentWaiters() []LockWaiter {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return s.pending.waiters()
+}
+
+// getCurrentHolders returns the holders from this controller, which are the only ones the controller can release
+func (s *DatabaseSemaphore) getCurrentHolders() []string {
+	keys, err := s.getRepository().ListHolders(s.key)
+	if err != nil {
+		s.log.WithError(err).Error("failed to list semaphore holders")
+	}
+	return keys
+}
+
+// getHolderKeys returns the holders this controller holds, or is acquiring, in memory, which are the only ones it
+// refreshes in the database
+func (s *DatabaseSemaphore) getHolderKeys() []string {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	var keys []string
+	for key := range s.lockHolder {
+		keys = append(keys, key)
+	}
+	for key := range s.acquiring {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// resize is a no-op, the limit is read from the database when the semaphore is acquired
+func (s *DatabaseSemaphore) resize(int) bool {
+	return true
+}
+
+func (s *DatabaseSemaphore) release(key string) bool {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	err := s.getRepository().Release(s.key, key)
+	if err != nil {
+		s.log.WithError(err).Errorf("failed to release lock held by %s", key)
+		return false
+	}
+	if _, ok := s.lockHolder[key]; ok {
+		delete(s.lockHolder, key)
+		s.log.Infof("Lock has been released by %s", key)
+		s.enqueueNext()
+	}
+	return true
+}
+
+// enqueueNext enqueues the workflow of the holder at the front of the queue, so it can try to acquire the lock
+func (s *DatabaseSemaphore) enqueueNext() {
+	if s.pending.Len() > 0 {
+		workflowKey := getWorkflowKey(s.pending.peek().key)
+		s.log.Debugf("Enqueue the workflow %s", workflowKey)
+		s.nextWorkflow(workflowKey)
+	}
+}
+
+// notify enqueues the next workflow, because holders from other controllers may have released the lock, or expired,
+// without this controller knowing
+func (s *DatabaseSemaphore) notify() {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.enqueueNext()
+}
+
+func (s *DatabaseSemaphore) addToQueue(holderKey string, priority int32, creationTime time.Time) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if _, ok := s.lockHolder[holderKey]; ok {
+		s.log.Debugf("Lock is already acquired by %s", holderKey)
+		return
+	}
+
+	s.pending.add(holderKey, priority, creationTime)
+	s.log.Debugf("Added into queue: %s", holderKey)
+}
+
+func (s *DatabaseSemaphore) removeFromQueue(holderKey string) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	s.pending.remove(holderKey)
+	s.log.Debugf("Removed from queue: %s", holderKey)
+}
+
+func (s *DatabaseSemaphore) acquire(holderKey string) bool {
+	s.lock.Lock()
+	s.acquiring[holderKey] = true
+	s.lock.Unlock()
+	acquired, err := s.getRepository().TryAcquire(s.key, holderKey)
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	delete(s.acquiring, holderKey)
+	if err != nil {
+		s.log.WithError(err).Errorf("failed to acquire lock for %s", holderKey)
+		return false
+	}
+	if acquired {
+		s.lockHolder[holderKey] = true
+		s.pending.remove(holderKey)
+	}
+	return acquired
+}
+
+func (s *DatabaseSemaphore) tryAcquire(holderKey string) (bool, string) {
+	s.lock.Lock()
+	if _, ok := s.lockHolder[holderKey]; ok {
+		s.lock.Unlock()
+		s.log.Debugf("%s is already holding a lock", holderKey)
+		return true, ""
+	}
+
+	waitingMsg := fmt.Sprintf("Waiting for %s lock", s.name)
+
+	// Like the PrioritySemaphore, only the front of the queue may acquire the lock.
+	if s.pending.Len() > 0 && s.pending.peek().key != holderKey {
+		s.lock.Unlock()
+		return false, waitingMsg
+	}
+	s.lock.Unlock()
+
+	if s.acquire(holderKey) {
+		s.log.Infof("%s acquired by %s ", s.name, holderKey)
+		return true, ""
+	}
+	return false, waitingMsg
+}
+
+// checkAcquire does not check the database, so another controller may acquire the lock before tryAcquire is invoked
+func (s *DatabaseSemaphore) checkAcquire(holderKey string) (bool, string) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if _, ok := s.lockHolder[holderKey]; ok {
+		return true, ""
+	}
+	if s.pending.Len() > 0 && s.pending.peek().key != holderKey {
+		return false, fmt.Sprintf("Waiting for %s lock", s.name)
+	}
+	return true, ""
+}
@@ -0,0 +1,119 @@
+package sync
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"k8s.io/utils/pointer"
+
+	"github.com/argoproj/argo/v2/persist/sqldb"
+	sqldbmocks "github.com/argoproj/argo/v2/persist/sqldb/mocks"
+	wfv1 "github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1"
+)
+
+var databaseSemaphore = &wfv1.Synchronization{Semaphore: &wfv1.SemaphoreRef{Database: &wfv1.SyncDatabaseRef{Key: "my-key"}}}
+
+func newDatabaseSemaphoreManager(repository sqldb.SemaphoreRepository, nextWorkflow NextWorkflow) *Manager {
+	return NewLockManager(nil, nextWorkflow, WorkflowExistenceFunc, func() sqldb.SemaphoreRepository { return repository })
+}
+
+func TestDatabaseSemaphore(t *testing.T) {
+	t.Run("PersistenceNotConfigured", func(t *testing.T) {
+		mgr := newDatabaseSemaphoreManager(nil, func(string) {})
+		wf := unmarshalWF(wfWithSemaphore)
+		_, _, _, _, err := mgr.TryAcquire(wf, "", databaseSemaphore)
+		assert.EqualError(t, err, "database semaphore \"my-key\" requires persistence to be configured")
+	})
+	t.Run("AcquireAndRelease", func(t *testing.T) {
+		repository := &sqldbmocks.SemaphoreRepository{}
+		repository.On("IsEnabled").Return(true)
+		repository.On("TryAcquire", "my-key", "default/hello-world").Return(true, nil)
+		repository.On("TryAcquire", "my-key", "default/hello-world-2").Return(false, nil).Once()
+		repository.On("ListHolders", "my-key").Return([]string{"default/hello-world"}, nil)
+		repository.On("Release", "my-key", mock.Anything).Return(nil)
+		var nextKey string
+		mgr := newDatabaseSemaphoreManager(repository, func(key string) { nextKey = key })
+
+		wf := unmarshalWF(wfWithSemaphore)
+		acquired, updated, msg, _, err := mgr.TryAcquire(wf, "", databaseSemaphore)
+		if assert.NoError(t, err) {
+			assert.True(t, acquired)
+			assert.True(t, updated)
+			assert.Empty(t, msg)
+			assert.Equal(t, "default/Database/my-key", wf.Status.Synchronization.Semaphore.Holding[0].Semaphore)
+		}
+
+		wf2 := wf.DeepCopy()
+		wf2.Name = "hello-world-2"
+		wf2.Status.Synchronization = nil
+		acquired, _, msg, _, err = mgr.TryAcquire(wf2, "", databaseSemaphore)
+		if assert.NoError(t, err) {
+			assert.False(t, acquired)
+			assert.Equal(t, "Waiting for default/Database/my-key lock", msg)
+		}
+
+		mgr.Release(wf, "", databaseSemaphore)
+		repository.AssertCalled(t, "Release", "my-key", "default/hello-world")
+		assert.Equal(t, "default/hello-world-2", nextKey)
+
+		repository.On("TryAcquire", "my-key", "default/hello-world-2").Return(true, nil)
+		acquired, _, _, _, err = mgr.TryAcquire(wf2, "", databaseSemaphore)
+		if assert.NoError(t, err) {
+			assert.True(t, acquired)
+		}
+	})
+	t.Run("Priority", func(t *testing.T) {
+		repository := &sqldbmocks.SemaphoreRepository{}
+		repository.On("IsEnabled").Return(true)
+		repository.On("TryAcquire", "my-key", mock.Anything).Return(false, nil).Twice()
+		repository.On("TryAcquire", "my-key", mock.Anything).Return(true, nil)
+		repository.On("ListHolders", "my-key").Return(nil, nil)
+		mgr := newDatabaseSemaphoreManager(repository, func(string) {})
+
+		low := unmarshalWF(wfWithSemaphore)
+		high := low.DeepCopy()
+		high.Name = "high"
+		high.Spec.Priority = pointer.Int32Ptr(10)
+		_, _, _, _, err := mgr.TryAcquire(low, "", databaseSemaphore)
+		assert.NoError(t, err)
+		_, _, _, _, err = mgr.TryAcquire(high, "", databaseSemaphore)
+		assert.NoError(t, err)
+
+		acquired, _, _, _, err := mgr.TryAcquire(low, "", databaseSemaphore)
+		if assert.NoError(t, err) {
+			assert.False(t, acquired, "the higher priority workflow is first")
+		}
+		acquired, _, _, _, err = mgr.TryAcquire(high, "", databaseSemaphore)
+		if assert.NoError(t, err) {
+			assert.True(t, acquired)
+		}
+	})
+	t.Run("Heartbeat", func(t *testing.T) {
+		repository := &sqldbmocks.SemaphoreRepository{}
+		repository.On("IsEnabled").Return(true)
+		repository.On("TryAcquire", "my-key", "default/hello-world").Return(true, nil)
+		repository.On("TryAcquire", "my-key", mock.Anything).Return(false, nil)
+		repository.On("ListHolders", "my-key").Return(nil, nil)
+		repository.On("Heartbeat", mock.Anything, mock.Anything).Return(nil)
+		var nextKey string
+		mgr := newDatabaseSemaphoreManager(repository, func(key string) { nextKey = key })
+
+		wf := unmarshalWF(wfWithSemaphore)
+		acquired, _, _, _, err := mgr.TryAcquire(wf, "", databaseSemaphore)
+		if assert.NoError(t, err) {
+			assert.True(t, acquired)
+		}
+		wf2 := wf.DeepCopy()
+		wf2.Name = "hello-world-2"
+		wf2.Status.Synchronization = nil
+		acquired, _, _, _, err = mgr.TryAcquire(wf2, "", databaseSemaphore)
+		if assert.NoError(t, err) {
+			assert.False(t, acquired)
+		}
+
+		mgr.Heartbeat()
+		repository.AssertCalled(t, "Heartbeat", map[string][]string{"my-key": {"default/hello-world"}}, mock.Anything)
+		assert.Equal(t, "default/hello-world-2", nextKey, "the lock may have been released by another controller")
+	})
+}
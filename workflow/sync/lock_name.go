@@ -13,6 +13,7 @@ type LockKind string
 const (
 	LockKindConfigMap LockKind = "ConfigMap"
 	LockKindMutex     LockKind = "Mutex"
+	LockKindDatabase  LockKind = "Database"
 )
 
 type LockName struct {
@@ -37,7 +38,10 @@ func GetLockName(sync *v1alpha1.Synchronization, namespace string) (*LockName, e
 		if sync.Semaphore.ConfigMapKeyRef != nil {
 			return NewLockName(namespace, sync.Semaphore.ConfigMapKeyRef.Name, sync.Semaphore.ConfigMapKeyRef.Key, LockKindConfigMap), nil
 		}
-		return nil, fmt.Errorf("cannot get LockName for a Semaphore without a ConfigMapRef")
+		if sync.Semaphore.Database != nil {
+			return NewLockName(namespace, sync.Semaphore.Database.Key, "", LockKindDatabase), nil
+		}
+		return nil, fmt.Errorf("cannot get LockName for a Semaphore without a ConfigMapRef or Database")
 	case v1alpha1.SynchronizationTypeMutex:
 		return NewLockName(namespace, sync.Mutex.Name, "", LockKindMutex), nil
 	default:
@@ -54,7 +58,7 @@ func DecodeLockName(lockName string) (*LockName, error) {
 	var lock LockName
 	lockKind := LockKind(items[1])
 	switch lockKind {
-	case LockKindMutex:
+	case LockKindMutex, LockKindDatabase:
 		lock = LockName{Namespace: items[0], Kind: LockKind(items[1]), ResourceName: items[2]}
 	case LockKindConfigMap:
 		lock = LockName{Namespace: items[0], Kind: LockKind(items[1]), ResourceName: items[2], Key: items[3]}
@@ -70,7 +74,7 @@ func DecodeLockName(lockName string) (*LockName, error) {
 }
 
 func (ln *LockName) EncodeName() string {
-	if ln.Kind == LockKindMutex {
+	if ln.Kind == LockKindMutex || ln.Kind == LockKindDatabase {
 		return ln.ValidateEncoding(fmt.Sprintf("%s/%s/%s", ln.Namespace, ln.Kind, ln.ResourceName))
 	}
 	return ln.ValidateEncoding(fmt.Sprintf("%s/%s/%s/%s", ln.Namespace, ln.Kind, ln.ResourceName, ln.Key))
@@ -89,6 +93,31 @@ func (ln *LockName) Validate() error {
 	if ln.Kind == LockKindConfigMap && ln.Key == "" {
 		return errors.New(errors.CodeBadRequest, "Invalid lock key: Key is missing for ConfigMap lock")
 	}
+	// the parts of a lock's name are separated by "/", e.g. a name resolved from a parameter may contain one
+	for _, part := range []string{ln.Namespace, ln.ResourceName, ln.Key} {
+		if strings.Contains(part, "/") {
+			return errors.Errorf(errors.CodeBadRequest, "Invalid lock key: \"%s\" must not contain \"/\"", part)
+		}
+	}
+	return nil
+}
+
+// ValidateLockNames returns an error if the name of one of the locks of the synchronization is not valid, e.g.
+// because a template variable in it was resolved to a value containing "/"
+func ValidateLockNames(synchronization *v1alpha1.Synchronization, namespace string) error {
+	syncLocks := synchronization.GetLocks()
+	if len(syncLocks) == 0 {
+		syncLocks = []*v1alpha1.Synchronization{synchronization}
+	}
+	for _, syncLock := range syncLocks {
+		lockName, err := GetLockName(syncLock, namespace)
+		if err != nil {
+			return err
+		}
+		if err := lockName.Validate(); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
@@ -0,0 +1,58 @@
+package sync
+
+import (
+	"sort"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	wfv1 "github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1"
+)
+
+const (
+	// LocksServerPort is the port of the controller's server that serves the status of its locks, independently of
+	// its metrics server
+	LocksServerPort = 9093
+	// LocksPath is the path of the controller's server that serves the status of its locks
+	LocksPath = "/sync/locks"
+)
+
+// LockStatus is the status of a semaphore or mutex. It is served as the JSON of the Argo Server's SyncLock.
+type LockStatus struct {
+	Name    string                   `json:"name"`
+	Type    wfv1.SynchronizationType `json:"type"`
+	Limit   int                      `json:"limit"`
+	Holders []string                 `json:"holders,omitempty"`
+	// Waiters are in the order they will acquire the lock
+	Waiters []LockWaiter `json:"waiters,omitempty"`
+}
+
+type LockWaiter struct {
+	HolderKey         string      `json:"holderKey"`
+	Priority          int32       `json:"priority"`
+	CreationTimestamp metav1.Time `json:"creationTimestamp"`
+}
+
+type LockStatusList struct {
+	Items []LockStatus `json:"items"`
+}
+
+// waiters returns the holders in the queue, in the order they will acquire the lock
+func (pq *priorityQueue) waiters() []LockWaiter {
+	var waiters []LockWaiter
+	for _, item := range pq.sorted() {
+		waiters = append(waiters, LockWaiter{HolderKey: item.key, Priority: item.priority, CreationTimestamp: metav1.NewTime(item.creationTime)})
+	}
+	return waiters
+}
+
+func getLockStatus(lock Semaphore) LockStatus {
+	holders := lock.getCurrentHolders()
+	sort.Strings(holders)
+	return LockStatus{
+		Name:    lock.getName(),
+		Type:    getLockType(lock.getName()),
+		Limit:   lock.getLimit(),
+		Holders: holders,
+		Waiters: lock.getCurrentWaiters(),
+	}
+}
@@ -0,0 +1,113 @@
+package sync
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"time"
+
+	apiv1 "k8s.io/api/core/v1"
+	apierr "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	corev1 "k8s.io/client-go/kubernetes/typed/core/v1"
+)
+
+const (
+	// LocksService is the service of the controller's server that serves the status of its locks
+	LocksService = "workflow-controller-sync"
+	// LocksTLSSecret is the secret with the certificate of the controller's server that serves the status of its
+	// locks. The controller creates a self-signed one if the secret does not exist.
+	LocksTLSSecret = "workflow-controller-sync-tls"
+	// how long the certificates the controller creates are valid for
+	locksCertificateValidity = 10 * 365 * 24 * time.Hour
+)
+
+// LocksServerName returns the name the certificate of the controller's locks server must be valid for
+func LocksServerName(namespace string) string {
+	return fmt.Sprintf("%s.%s.svc", LocksService, namespace)
+}
+
+// GetOrCreateLocksCertificate returns the certificate of the controller's locks server, creating a self-signed one if
+// the secret does not exist, so that every replica of the controller uses the same one
+func GetOrCreateLocksCertificate(ctx context.Context, secrets corev1.SecretInterface, namespace string) (tls.Certificate, error) {
+	secret, err := secrets.Get(ctx, LocksTLSSecret, metav1.GetOptions{})
+	if apierr.IsNotFound(err) {
+		certPEM, keyPEM, genErr := newSelfSignedCertificate(LocksServerName(namespace))
+		if genErr != nil {
+			return tls.Certificate{}, fmt.Errorf("failed to create the certificate of the sync locks server: %w", genErr)
+		}
+		_, err = secrets.Create(ctx, &apiv1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: LocksTLSSecret},
+			Type:       apiv1.SecretTypeTLS,
+			Data:       map[string][]byte{apiv1.TLSCertKey: certPEM, apiv1.TLSPrivateKeyKey: keyPEM},
+		}, metav1.CreateOptions{})
+		// another replica may have created it first, in which case we use theirs
+		if err != nil && !apierr.IsAlreadyExists(err) {
+			return tls.Certificate{}, fmt.Errorf("failed to create secret %s: %w", LocksTLSSecret, err)
+		}
+		secret, err = secrets.Get(ctx, LocksTLSSecret, metav1.GetOptions{})
+	}
+	if err != nil {
+		return tls.Certificate{}, fmt.Errorf("failed to read secret %s: %w", LocksTLSSecret, err)
+	}
+	return tls.X509KeyPair(secret.Data[apiv1.TLSCertKey], secret.Data[apiv1.TLSPrivateKeyKey])
+}
+
+// GetLocksCertPool returns the certificates the certificate of the controller's locks server is trusted by: its CA
+// if the secret has one (e.g. if it was issued by cert-manager), and the certificate itself otherwise
+func GetLocksCertPool(ctx context.Context, secrets corev1.SecretInterface) (*x509.CertPool, error) {
+	secret, err := secrets.Get(ctx, LocksTLSSecret, metav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to read secret %s: %w", LocksTLSSecret, err)
+	}
+	certPEM := secret.Data["ca.crt"]
+	if len(certPEM) == 0 {
+		certPEM = secret.Data[apiv1.TLSCertKey]
+	}
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(certPEM) {
+		return nil, fmt.Errorf("secret %s has no certificate", LocksTLSSecret)
+	}
+	return pool, nil
+}
+
+func newSelfSignedCertificate(serverName string) (certPEM, keyPEM []byte, err error) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, nil, err
+	}
+	serialNumber, err := rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 128))
+	if err != nil {
+		return nil, nil, err
+	}
+	now := time.Now()
+	template := &x509.Certificate{
+		SerialNumber:          serialNumber,
+		Subject:               pkix.Name{CommonName: serverName},
+		DNSNames:              []string{serverName},
+		NotBefore:             now.Add(-time.Hour),
+		NotAfter:              now.Add(locksCertificateValidity),
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	certDER, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		return nil, nil, err
+	}
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		return nil, nil, err
+	}
+	certPEM = pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: certDER})
+	keyPEM = pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER})
+	return certPEM, keyPEM, nil
+}
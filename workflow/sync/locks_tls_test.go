@@ -0,0 +1,35 @@
+package sync
+
+import (
+	"context"
+	"crypto/x509"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func TestGetOrCreateLocksCertificate(t *testing.T) {
+	ctx := context.Background()
+	secrets := fake.NewSimpleClientset().CoreV1().Secrets("argo")
+	_, err := GetLocksCertPool(ctx, secrets)
+	assert.Error(t, err, "the controller has not created the certificate yet")
+	cert, err := GetOrCreateLocksCertificate(ctx, secrets, "argo")
+	if !assert.NoError(t, err) {
+		return
+	}
+	other, err := GetOrCreateLocksCertificate(ctx, secrets, "argo")
+	if assert.NoError(t, err) {
+		assert.Equal(t, cert.Certificate, other.Certificate, "the certificate is only created once")
+	}
+	pool, err := GetLocksCertPool(ctx, secrets)
+	if assert.NoError(t, err) {
+		leaf, err := x509.ParseCertificate(cert.Certificate[0])
+		if assert.NoError(t, err) {
+			_, err = leaf.Verify(x509.VerifyOptions{Roots: pool, DNSName: "workflow-controller-sync.argo.svc"})
+			assert.NoError(t, err)
+			_, err = leaf.Verify(x509.VerifyOptions{Roots: pool, DNSName: "workflow-controller-sync.other.svc"})
+			assert.Error(t, err)
+		}
+	}
+}
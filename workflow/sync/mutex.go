@@ -15,6 +15,10 @@ func (m *PriorityMutex) getCurrentPending() []string {
 	return m.mutex.getCurrentPending()
 }
 
+func (m *PriorityMutex) getCurrentWaiters() []LockWaiter {
+	return m.mutex.getCurrentWaiters()
+}
+
 var _ Semaphore = &PriorityMutex{}
 
 // NewMutex creates new mutex lock object
@@ -73,3 +77,9 @@ func (m *PriorityMutex) tryAcquire(holderKey string) (bool, string) {
 	defer m.lock.Unlock()
 	return m.mutex.tryAcquire(holderKey)
 }
+
+func (m *PriorityMutex) checkAcquire(holderKey string) (bool, string) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	return m.mutex.checkAcquire(holderKey)
+}
@@ -94,7 +94,7 @@ func TestMutexLock(t *testing.T) {
 	syncLimitFunc := GetSyncLimitFunc(kube)
 	t.Run("InitializeSynchronization", func(t *testing.T) {
 		concurrenyMgr := NewLockManager(syncLimitFunc, func(key string) {
-		}, WorkflowExistenceFunc)
+		}, WorkflowExistenceFunc, nil)
 		wf := unmarshalWF(mutexwfstatus)
 		wfclientset := fakewfclientset.NewSimpleClientset(wf)
 
@@ -108,12 +108,12 @@ func TestMutexLock(t *testing.T) {
 		var nextWorkflow string
 		concurrenyMgr := NewLockManager(syncLimitFunc, func(key string) {
 			nextWorkflow = key
-		}, WorkflowExistenceFunc)
+		}, WorkflowExistenceFunc, nil)
 		wf := unmarshalWF(mutexWf)
 		wf1 := wf.DeepCopy()
 		wf2 := wf.DeepCopy()
 		wf3 := wf.DeepCopy()
-		status, wfUpdate, msg, err := concurrenyMgr.TryAcquire(wf, "", wf.Spec.Synchronization)
+		status, wfUpdate, msg, _, err := concurrenyMgr.TryAcquire(wf, "", wf.Spec.Synchronization)
 		assert.NoError(t, err)
 		assert.Empty(t, msg)
 		assert.True(t, status)
@@ -124,14 +124,14 @@ func TestMutexLock(t *testing.T) {
 		assert.Equal(t, wf.Name, wf.Status.Synchronization.Mutex.Holding[0].Holder)
 
 		// Try to acquire again
-		status, wfUpdate, msg, err = concurrenyMgr.TryAcquire(wf, "", wf.Spec.Synchronization)
+		status, wfUpdate, msg, _, err = concurrenyMgr.TryAcquire(wf, "", wf.Spec.Synchronization)
 		assert.NoError(t, err)
 		assert.True(t, status)
 		assert.Empty(t, msg)
 		assert.False(t, wfUpdate)
 
 		wf1.Name = "two"
-		status, wfUpdate, msg, err = concurrenyMgr.TryAcquire(wf1, "", wf1.Spec.Synchronization)
+		status, wfUpdate, msg, _, err = concurrenyMgr.TryAcquire(wf1, "", wf1.Spec.Synchronization)
 		assert.NoError(t, err)
 		assert.NotEmpty(t, msg)
 		assert.False(t, status)
@@ -140,14 +140,14 @@ func TestMutexLock(t *testing.T) {
 		wf2.Name = "three"
 		wf2.Spec.Priority = pointer.Int32Ptr(5)
 		holderKey2 := getHolderKey(wf2, "")
-		status, wfUpdate, msg, err = concurrenyMgr.TryAcquire(wf2, "", wf2.Spec.Synchronization)
+		status, wfUpdate, msg, _, err = concurrenyMgr.TryAcquire(wf2, "", wf2.Spec.Synchronization)
 		assert.NoError(t, err)
 		assert.NotEmpty(t, msg)
 		assert.False(t, status)
 		assert.True(t, wfUpdate)
 
 		wf3.Name = "four"
-		status, wfUpdate, msg, err = concurrenyMgr.TryAcquire(wf3, "", wf3.Spec.Synchronization)
+		status, wfUpdate, msg, _, err = concurrenyMgr.TryAcquire(wf3, "", wf3.Spec.Synchronization)
 		assert.NoError(t, err)
 		assert.NotEmpty(t, msg)
 		assert.False(t, status)
@@ -159,14 +159,14 @@ func TestMutexLock(t *testing.T) {
 		assert.Equal(t, 0, len(wf.Status.Synchronization.Mutex.Holding))
 
 		// Low priority workflow try to acquire the lock
-		status, wfUpdate, msg, err = concurrenyMgr.TryAcquire(wf1, "", wf1.Spec.Synchronization)
+		status, wfUpdate, msg, _, err = concurrenyMgr.TryAcquire(wf1, "", wf1.Spec.Synchronization)
 		assert.NoError(t, err)
 		assert.NotEmpty(t, msg)
 		assert.False(t, status)
 		assert.False(t, wfUpdate)
 
 		// High Priority workflow acquires the lock
-		status, wfUpdate, msg, err = concurrenyMgr.TryAcquire(wf2, "", wf2.Spec.Synchronization)
+		status, wfUpdate, msg, _, err = concurrenyMgr.TryAcquire(wf2, "", wf2.Spec.Synchronization)
 		assert.NoError(t, err)
 		assert.Empty(t, msg)
 		assert.True(t, status)
@@ -287,11 +287,11 @@ func TestMutexTmplLevel(t *testing.T) {
 		//var nextKey string
 		concurrenyMgr := NewLockManager(syncLimitFunc, func(key string) {
 			//nextKey = key
-		}, WorkflowExistenceFunc)
+		}, WorkflowExistenceFunc, nil)
 		wf := unmarshalWF(mutexWfWithTmplLevel)
 		tmpl := wf.Spec.Templates[1]
 
-		status, wfUpdate, msg, err := concurrenyMgr.TryAcquire(wf, "synchronization-tmpl-level-mutex-vjcdk-3941195474", tmpl.Synchronization)
+		status, wfUpdate, msg, _, err := concurrenyMgr.TryAcquire(wf, "synchronization-tmpl-level-mutex-vjcdk-3941195474", tmpl.Synchronization)
 		assert.NoError(t, err)
 		assert.Empty(t, msg)
 		assert.True(t, status)
@@ -301,13 +301,13 @@ func TestMutexTmplLevel(t *testing.T) {
 		assert.Equal(t, "synchronization-tmpl-level-mutex-vjcdk-3941195474", wf.Status.Synchronization.Mutex.Holding[0].Holder)
 
 		// Try to acquire again
-		status, wfUpdate, msg, err = concurrenyMgr.TryAcquire(wf, "synchronization-tmpl-level-mutex-vjcdk-2216915482", tmpl.Synchronization)
+		status, wfUpdate, msg, _, err = concurrenyMgr.TryAcquire(wf, "synchronization-tmpl-level-mutex-vjcdk-2216915482", tmpl.Synchronization)
 		assert.NoError(t, err)
 		assert.True(t, wfUpdate)
 		assert.False(t, status)
 		assert.NotEmpty(t, msg)
 
-		status, wfUpdate, msg, err = concurrenyMgr.TryAcquire(wf, "synchronization-tmpl-level-mutex-vjcdk-1432992664", tmpl.Synchronization)
+		status, wfUpdate, msg, _, err = concurrenyMgr.TryAcquire(wf, "synchronization-tmpl-level-mutex-vjcdk-1432992664", tmpl.Synchronization)
 		assert.NoError(t, err)
 		assert.NotEmpty(t, msg)
 		assert.False(t, wfUpdate)
@@ -319,7 +319,7 @@ func TestMutexTmplLevel(t *testing.T) {
 		assert.NotNil(t, wf.Status.Synchronization.Mutex)
 		assert.Empty(t, wf.Status.Synchronization.Mutex.Holding)
 
-		status, wfUpdate, msg, err = concurrenyMgr.TryAcquire(wf, "synchronization-tmpl-level-mutex-vjcdk-2216915482", tmpl.Synchronization)
+		status, wfUpdate, msg, _, err = concurrenyMgr.TryAcquire(wf, "synchronization-tmpl-level-mutex-vjcdk-2216915482", tmpl.Synchronization)
 		assert.NoError(t, err)
 		assert.Empty(t, msg)
 		assert.True(t, status)
@@ -2,7 +2,6 @@ package sync
 
 import (
 	"fmt"
-	"strings"
 	"sync"
 	"time"
 
@@ -43,10 +42,14 @@ func (s *PrioritySemaphore) getName() string {
 }
 
 func (s *PrioritySemaphore) getLimit() int {
+	s.lock.Lock()
+	defer s.lock.Unlock()
 	return s.limit
 }
 
 func (s *PrioritySemaphore) getCurrentPending() []string {
+	s.lock.Lock()
+	defer s.lock.Unlock()
 	var keys []string
 	for _, item := range s.pending.items {
 		keys = append(keys, item.key)
@@ -54,7 +57,15 @@ func (s *PrioritySemaphore) getCurrentPending() []string {
 	return keys
 }
 
+func (s *PrioritySemaphore) getCurrentWaiters() []LockWaiter {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return s.pending.waiters()
+}
+
 func (s *PrioritySemaphore) getCurrentHolders() []string {
+	s.lock.Lock()
+	defer s.lock.Unlock()
 	var keys []string
 	for k := range s.lockHolder {
 		keys = append(keys, k)
@@ -103,12 +114,7 @@ func (s *PrioritySemaphore) release(key string) bool {
 			}
 			for idx := 0; idx < triggerCount; idx++ {
 				item := s.pending.items[idx]
-				keyStr := fmt.Sprint(item.key)
-				items := strings.Split(keyStr, "/")
-				workflowKey := keyStr
-				if len(items) == 3 {
-					workflowKey = fmt.Sprintf("%s/%s", items[0], items[1])
-				}
+				workflowKey := getWorkflowKey(item.key)
 				s.log.Debugf("Enqueue the workflow %s", workflowKey)
 				s.nextWorkflow(workflowKey)
 			}
@@ -182,3 +188,30 @@ func (s *PrioritySemaphore) tryAcquire(holderKey string) (bool, string) {
 	s.log.Debugf("Current semaphore Holders. %v", s.lockHolder)
 	return false, waitingMsg
 }
+
+func (s *PrioritySemaphore) checkAcquire(holderKey string) (bool, string) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if _, ok := s.lockHolder[holderKey]; ok {
+		return true, ""
+	}
+
+	waitingMsg := fmt.Sprintf("Waiting for %s lock. Lock status: %d/%d ", s.name, s.limit-len(s.lockHolder), s.limit)
+
+	if s.pending.Len() > 0 {
+		nextKey := s.pending.peek().key
+		if holderKey != nextKey {
+			// Enqueue the front workflow if lock is available
+			if len(s.lockHolder) < s.limit {
+				s.nextWorkflow(getWorkflowKey(nextKey))
+			}
+			return false, waitingMsg
+		}
+	}
+
+	if len(s.lockHolder) >= s.limit {
+		return false, waitingMsg
+	}
+	return true, ""
+}
@@ -2,11 +2,14 @@ package sync
 
 import (
 	"fmt"
+	"sort"
 	"strings"
 	"sync"
+	"time"
 
 	log "github.com/sirupsen/logrus"
 
+	"github.com/argoproj/argo/v2/persist/sqldb"
 	wfv1 "github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1"
 )
 
@@ -15,20 +18,26 @@ type GetSyncLimit func(string) (int, error)
 type IsWorkflowDeleted func(string) bool
 
 type Manager struct {
-	syncLockMap  map[string]Semaphore
-	lock         *sync.Mutex
-	nextWorkflow NextWorkflow
-	getSyncLimit GetSyncLimit
-	isWFDeleted  IsWorkflowDeleted
+	syncLockMap            map[string]Semaphore
+	lock                   *sync.Mutex
+	nextWorkflow           NextWorkflow
+	getSyncLimit           GetSyncLimit
+	isWFDeleted            IsWorkflowDeleted
+	getSemaphoreRepository GetSemaphoreRepository
+	// holderLocks is the keys of the locks each holder has requested, resolved at acquire time, so the holder can
+	// release them even if the names of the locks are parameterised
+	holderLocks map[string][]string
 }
 
-func NewLockManager(getSyncLimit GetSyncLimit, nextWorkflow NextWorkflow, isWFDeleted IsWorkflowDeleted) *Manager {
+func NewLockManager(getSyncLimit GetSyncLimit, nextWorkflow NextWorkflow, isWFDeleted IsWorkflowDeleted, getSemaphoreRepository GetSemaphoreRepository) *Manager {
 	return &Manager{
-		syncLockMap:  make(map[string]Semaphore),
-		lock:         &sync.Mutex{},
-		nextWorkflow: nextWorkflow,
-		getSyncLimit: getSyncLimit,
-		isWFDeleted:  isWFDeleted,
+		syncLockMap:            make(map[string]Semaphore),
+		lock:                   &sync.Mutex{},
+		nextWorkflow:           nextWorkflow,
+		getSyncLimit:           getSyncLimit,
+		isWFDeleted:            isWFDeleted,
+		getSemaphoreRepository: getSemaphoreRepository,
+		holderLocks:            make(map[string][]string),
 	}
 }
 
@@ -45,6 +54,14 @@ func (cm *Manager) getWorkflowKey(key string) (string, error) {
 
 func (cm *Manager) CheckWorkflowExistence() {
 	log.Infof("Check the workflow existence")
+	cm.lock.Lock()
+	defer cm.lock.Unlock()
+	for holderKey := range cm.holderLocks {
+		wfKey, err := cm.getWorkflowKey(holderKey)
+		if err == nil && !cm.isWFDeleted(wfKey) {
+			delete(cm.holderLocks, holderKey)
+		}
+	}
 	for _, lock := range cm.syncLockMap {
 		keys := lock.getCurrentHolders()
 		keys = append(keys, lock.getCurrentPending()...)
@@ -61,6 +78,49 @@ func (cm *Manager) CheckWorkflowExistence() {
 	}
 }
 
+// ListLocks returns the status of every lock known to the manager, ordered by name
+func (cm *Manager) ListLocks() []LockStatus {
+	cm.lock.Lock()
+	defer cm.lock.Unlock()
+	locks := make([]LockStatus, 0, len(cm.syncLockMap))
+	for _, lock := range cm.syncLockMap {
+		locks = append(locks, getLockStatus(lock))
+	}
+	sort.Slice(locks, func(i, j int) bool { return locks[i].Name < locks[j].Name })
+	return locks
+}
+
+// Heartbeat refreshes the holders of database semaphores this controller holds in memory, so they do not expire, and
+// releases its other holders, which it can never release otherwise. It also enqueues the workflows waiting for database
+// semaphores, which may have been released by other controllers.
+func (cm *Manager) Heartbeat() {
+	repository := cm.semaphoreRepository()
+	if !repository.IsEnabled() {
+		return
+	}
+	// holders acquired once the holders are listed are refreshed since then, so they are not released
+	since := time.Now()
+	cm.lock.Lock()
+	var semaphores []*DatabaseSemaphore
+	for _, lock := range cm.syncLockMap {
+		if semaphore, ok := lock.(*DatabaseSemaphore); ok {
+			semaphores = append(semaphores, semaphore)
+		}
+	}
+	cm.lock.Unlock()
+	holders := make(map[string][]string)
+	for _, semaphore := range semaphores {
+		holders[semaphore.key] = append(holders[semaphore.key], semaphore.getHolderKeys()...)
+	}
+	err := repository.Heartbeat(holders, since)
+	if err != nil {
+		log.WithError(err).Error("Database semaphore heartbeat failed")
+	}
+	for _, semaphore := range semaphores {
+		semaphore.notify()
+	}
+}
+
 func (cm *Manager) Initialize(wfs []wfv1.Workflow) {
 	for _, wf := range wfs {
 		if wf.Status.Synchronization == nil {
@@ -82,6 +142,7 @@ func (cm *Manager) Initialize(wfs []wfv1.Workflow) {
 
 				for _, holders := range holding.Holders {
 					resourceKey := getResourceKey(wf.Namespace, wf.Name, holders)
+					cm.trackLock(resourceKey, holding.Semaphore)
 					if semaphore != nil && semaphore.acquire(resourceKey) {
 						log.Infof("Lock acquired by %s from %s", resourceKey, holding.Semaphore)
 					}
@@ -100,54 +161,32 @@ func (cm *Manager) Initialize(wfs []wfv1.Workflow) {
 						continue
 					}
 					if holding.Holder != "" {
-						resourceKey := getResourceKey(wf.Namespace, wf.Name, holding.Holder)
-						mutex.acquire(resourceKey)
+						mutex.acquire(getResourceKey(wf.Namespace, wf.Name, holding.Holder))
 					}
 					cm.syncLockMap[holding.Mutex] = mutex
 				}
+				if holding.Holder != "" {
+					cm.trackLock(getResourceKey(wf.Namespace, wf.Name, holding.Holder), holding.Mutex)
+				}
 			}
 		}
 	}
 	log.Infof("Manager initialized successfully")
 }
 
-// TryAcquire tries to acquire the lock from semaphore.
-// It returns status of acquiring a lock , status of Workflow status updated, waiting message if lock is not available and any error encountered
-func (cm *Manager) TryAcquire(wf *wfv1.Workflow, nodeName string, syncLockRef *wfv1.Synchronization) (bool, bool, string, error) {
-	cm.lock.Lock()
-	defer cm.lock.Unlock()
-
+// TryAcquire tries to acquire every semaphore and mutex of the synchronization, or none of them, so that a holder never
+// holds some locks while it waits for others.
+// It returns status of acquiring the locks, status of Workflow status updated, waiting message if a lock is not
+// available, the name of that lock, and any error encountered
+func (cm *Manager) TryAcquire(wf *wfv1.Workflow, nodeName string, syncLockRef *wfv1.Synchronization) (bool, bool, string, string, error) {
 	if syncLockRef == nil {
-		return false, false, "", fmt.Errorf("cannot acquire lock from nil Synchronization")
+		return false, false, "", "", fmt.Errorf("cannot acquire lock from nil Synchronization")
 	}
 
-	syncLockName, err := GetLockName(syncLockRef, wf.Namespace)
-	if err != nil {
-		return false, false, "", fmt.Errorf("requested configuration is invalid: %w", err)
-	}
-
-	lockKey := syncLockName.EncodeName()
-	lock, found := cm.syncLockMap[lockKey]
-	if !found {
-		switch syncLockRef.GetType() {
-		case wfv1.SynchronizationTypeSemaphore:
-			lock, err = cm.initializeSemaphore(lockKey)
-		case wfv1.SynchronizationTypeMutex:
-			lock, err = cm.initializeMutex(lockKey)
-		default:
-			return false, false, "", fmt.Errorf("unknown Synchronization Type")
-		}
-		if err != nil {
-			return false, false, "", err
-		}
-		cm.syncLockMap[lockKey] = lock
-	}
-
-	if syncLockRef.GetType() == wfv1.SynchronizationTypeSemaphore {
-		err := cm.checkAndUpdateSemaphoreSize(lock)
-		if err != nil {
-			return false, false, "", err
-		}
+	syncLocks := syncLockRef.GetLocks()
+	if len(syncLocks) == 0 {
+		// GetLockName returns the error for a synchronization without a lock
+		syncLocks = []*wfv1.Synchronization{syncLockRef}
 	}
 
 	holderKey := getHolderKey(wf, nodeName)
@@ -158,20 +197,94 @@ func (cm *Manager) TryAcquire(wf *wfv1.Workflow, nodeName string, syncLockRef *w
 		priority = 0
 	}
 	creationTime := wf.CreationTimestamp
-	lock.addToQueue(holderKey, priority, creationTime.Time)
 
-	ensureInit(wf, syncLockRef.GetType())
-	currentHolders := cm.getCurrentLockHolders(lockKey)
-	acquired, msg := lock.tryAcquire(holderKey)
-	if acquired {
-		updated := wf.Status.Synchronization.GetStatus(syncLockRef.GetType()).LockAcquired(holderKey, lockKey, currentHolders)
-		return true, updated, "", nil
+	lockKeys, locks, err := cm.queue(wf, holderKey, priority, creationTime.Time, syncLocks)
+	if err != nil {
+		return false, false, "", "", err
+	}
+
+	// The locks are acquired without holding the manager's lock, because acquiring a database semaphore, or listing its
+	// holders, queries the database. Each lock has its own lock, and the locks of a holder are only acquired by the
+	// worker of its workflow.
+	for i, lock := range locks {
+		if acquirable, msg := lock.checkAcquire(holderKey); !acquirable {
+			updated := wf.Status.Synchronization.GetStatus(syncLocks[i].GetType()).LockWaiting(holderKey, lockKeys[i], lock.getCurrentHolders())
+			return false, updated, msg, lockKeys[i], nil
+		}
 	}
 
-	updated := wf.Status.Synchronization.GetStatus(syncLockRef.GetType()).LockWaiting(holderKey, lockKey, currentHolders)
-	return false, updated, msg, nil
+	updated := false
+	for i, lock := range locks {
+		currentHolders := lock.getCurrentHolders()
+		acquired, msg := lock.tryAcquire(holderKey)
+		if !acquired {
+			// another controller acquired a database semaphore since we checked it, so we release what we acquired
+			for j := 0; j < i; j++ {
+				locks[j].release(holderKey)
+				locks[j].addToQueue(holderKey, priority, creationTime.Time)
+				wf.Status.Synchronization.GetStatus(syncLocks[j].GetType()).LockReleased(holderKey, lockKeys[j])
+			}
+			wf.Status.Synchronization.GetStatus(syncLocks[i].GetType()).LockWaiting(holderKey, lockKeys[i], currentHolders)
+			return false, true, msg, lockKeys[i], nil
+		}
+		if wf.Status.Synchronization.GetStatus(syncLocks[i].GetType()).LockAcquired(holderKey, lockKeys[i], currentHolders) {
+			updated = true
+		}
+	}
+	return true, updated, "", "", nil
 }
 
+// queue initializes the locks of the holder, if needed, and adds the holder to their queues
+func (cm *Manager) queue(wf *wfv1.Workflow, holderKey string, priority int32, creationTime time.Time, syncLocks []*wfv1.Synchronization) ([]string, []Semaphore, error) {
+	cm.lock.Lock()
+	defer cm.lock.Unlock()
+
+	lockKeys := make([]string, len(syncLocks))
+	locks := make([]Semaphore, len(syncLocks))
+	for i, syncLock := range syncLocks {
+		syncLockName, err := GetLockName(syncLock, wf.Namespace)
+		if err == nil {
+			err = syncLockName.Validate()
+		}
+		if err != nil {
+			return nil, nil, fmt.Errorf("requested configuration is invalid: %w", err)
+		}
+
+		lockKey := syncLockName.EncodeName()
+		lock, found := cm.syncLockMap[lockKey]
+		if !found {
+			switch syncLock.GetType() {
+			case wfv1.SynchronizationTypeSemaphore:
+				lock, err = cm.initializeSemaphore(lockKey)
+			case wfv1.SynchronizationTypeMutex:
+				lock, err = cm.initializeMutex(lockKey)
+			default:
+				return nil, nil, fmt.Errorf("unknown Synchronization Type")
+			}
+			if err != nil {
+				return nil, nil, err
+			}
+			cm.syncLockMap[lockKey] = lock
+		}
+
+		if syncLockName.Kind == LockKindConfigMap {
+			err := cm.checkAndUpdateSemaphoreSize(lock)
+			if err != nil {
+				return nil, nil, err
+			}
+		}
+
+		lock.addToQueue(holderKey, priority, creationTime)
+		ensureInit(wf, syncLock.GetType())
+		lockKeys[i] = lockKey
+		locks[i] = lock
+	}
+	cm.holderLocks[holderKey] = lockKeys
+	return lockKeys, locks, nil
+}
+
+// Release releases the locks of the synchronization held by, or queued for, the holder. The locks the holder requested
+// are released by the names they were resolved to at acquire time, so syncRef may contain unresolved template variables.
 func (cm *Manager) Release(wf *wfv1.Workflow, nodeName string, syncRef *wfv1.Synchronization) {
 	cm.lock.Lock()
 	defer cm.lock.Unlock()
@@ -181,18 +294,43 @@ func (cm *Manager) Release(wf *wfv1.Workflow, nodeName string, syncRef *wfv1.Syn
 	}
 
 	holderKey := getHolderKey(wf, nodeName)
-	lockName, err := GetLockName(syncRef, wf.Namespace)
-	if err != nil {
-		return
+	lockKeys, tracked := cm.holderLocks[holderKey]
+	if !tracked {
+		for _, syncLock := range syncRef.GetLocks() {
+			lockName, err := GetLockName(syncLock, wf.Namespace)
+			if err != nil {
+				continue
+			}
+			lockKeys = append(lockKeys, lockName.EncodeName())
+		}
+	}
+	delete(cm.holderLocks, holderKey)
+
+	for _, lockKey := range lockKeys {
+		if syncLockHolder, ok := cm.syncLockMap[lockKey]; ok {
+			syncLockHolder.release(holderKey)
+			syncLockHolder.removeFromQueue(holderKey)
+			log.Debugf("%s sync lock is released by %s", lockKey, holderKey)
+			wf.Status.Synchronization.GetStatus(getLockType(lockKey)).LockReleased(holderKey, lockKey)
+		}
+	}
+}
+
+func (cm *Manager) trackLock(holderKey, lockKey string) {
+	for _, key := range cm.holderLocks[holderKey] {
+		if key == lockKey {
+			return
+		}
 	}
+	cm.holderLocks[holderKey] = append(cm.holderLocks[holderKey], lockKey)
+}
 
-	if syncLockHolder, ok := cm.syncLockMap[lockName.EncodeName()]; ok {
-		syncLockHolder.release(holderKey)
-		syncLockHolder.removeFromQueue(holderKey)
-		log.Debugf("%s sync lock is released by %s", lockName.EncodeName(), holderKey)
-		lockKey := lockName.EncodeName()
-		wf.Status.Synchronization.GetStatus(syncRef.GetType()).LockReleased(holderKey, lockKey)
+// getLockType returns the type of the synchronization of an encoded lock name
+func getLockType(lockKey string) wfv1.SynchronizationType {
+	if lockName, err := DecodeLockName(lockKey); err == nil && lockName.Kind == LockKindMutex {
+		return wfv1.SynchronizationTypeMutex
 	}
+	return wfv1.SynchronizationTypeSemaphore
 }
 
 func (cm *Manager) ReleaseAll(wf *wfv1.Workflow) bool {
@@ -265,16 +403,26 @@ func (cm *Manager) ReleaseAll(wf *wfv1.Workflow) bool {
 		}
 	}
 
+	workflowKey := getHolderKey(wf, "")
+	for holderKey := range cm.holderLocks {
+		if holderKey == workflowKey || strings.HasPrefix(holderKey, workflowKey+"/") {
+			delete(cm.holderLocks, holderKey)
+		}
+	}
+
 	wf.Status.Synchronization = nil
 	return true
 }
 
 func ensureInit(wf *wfv1.Workflow, lockType wfv1.SynchronizationType) {
-	if lockType == wfv1.SynchronizationTypeSemaphore && (wf.Status.Synchronization == nil || wf.Status.Synchronization.Semaphore == nil) {
-		wf.Status.Synchronization = &wfv1.SynchronizationStatus{Semaphore: &wfv1.SemaphoreStatus{}}
+	if wf.Status.Synchronization == nil {
+		wf.Status.Synchronization = &wfv1.SynchronizationStatus{}
 	}
-	if lockType == wfv1.SynchronizationTypeMutex && (wf.Status.Synchronization == nil || wf.Status.Synchronization.Mutex == nil) {
-		wf.Status.Synchronization = &wfv1.SynchronizationStatus{Mutex: &wfv1.MutexStatus{}}
+	if lockType == wfv1.SynchronizationTypeSemaphore && wf.Status.Synchronization.Semaphore == nil {
+		wf.Status.Synchronization.Semaphore = &wfv1.SemaphoreStatus{}
+	}
+	if lockType == wfv1.SynchronizationTypeMutex && wf.Status.Synchronization.Mutex == nil {
+		wf.Status.Synchronization.Mutex = &wfv1.MutexStatus{}
 	}
 }
 
@@ -297,14 +445,22 @@ func getResourceKey(namespace, wfName, resourceName string) string {
 	return resourceKey
 }
 
-func (cm *Manager) getCurrentLockHolders(lockName string) []string {
-	if concurrency, ok := cm.syncLockMap[lockName]; ok {
-		return concurrency.getCurrentHolders()
+func (cm *Manager) semaphoreRepository() sqldb.SemaphoreRepository {
+	if cm.getSemaphoreRepository != nil {
+		if repository := cm.getSemaphoreRepository(); repository != nil {
+			return repository
+		}
 	}
-	return nil
+	return sqldb.NullSemaphoreRepository
 }
 
 func (cm *Manager) initializeSemaphore(semaphoreName string) (Semaphore, error) {
+	if lockName, err := DecodeLockName(semaphoreName); err == nil && lockName.Kind == LockKindDatabase {
+		if !cm.semaphoreRepository().IsEnabled() {
+			return nil, fmt.Errorf("database semaphore \"%s\" requires persistence to be configured", lockName.ResourceName)
+		}
+		return NewDatabaseSemaphore(semaphoreName, lockName.ResourceName, cm.semaphoreRepository, cm.nextWorkflow), nil
+	}
 	limit, err := cm.getSyncLimit(semaphoreName)
 	if err != nil {
 		return nil, err
@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes/fake"
@@ -16,6 +17,8 @@ import (
 	"sigs.k8s.io/yaml"
 
 	argoErr "github.com/argoproj/argo/v2/errors"
+	"github.com/argoproj/argo/v2/persist/sqldb"
+	sqldbmocks "github.com/argoproj/argo/v2/persist/sqldb/mocks"
 	wfv1 "github.com/argoproj/argo/v2/pkg/apis/workflow/v1alpha1"
 	fakewfclientset "github.com/argoproj/argo/v2/pkg/client/clientset/versioned/fake"
 )
@@ -354,7 +357,7 @@ func TestSemaphoreWfLevel(t *testing.T) {
 	syncLimitFunc := GetSyncLimitFunc(kube)
 	t.Run("InitializeSynchronization", func(t *testing.T) {
 		concurrenyMgr := NewLockManager(syncLimitFunc, func(key string) {
-		}, WorkflowExistenceFunc)
+		}, WorkflowExistenceFunc, nil)
 		wf := unmarshalWF(wfWithStatus)
 		wfclientset := fakewfclientset.NewSimpleClientset(wf)
 
@@ -366,7 +369,7 @@ func TestSemaphoreWfLevel(t *testing.T) {
 	t.Run("InitializeSynchronizationWithInvalid", func(t *testing.T) {
 		concurrenyMgr := NewLockManager(syncLimitFunc, func(key string) {
 
-		}, WorkflowExistenceFunc)
+		}, WorkflowExistenceFunc, nil)
 		wf := unmarshalWF(wfWithStatus)
 		invalidSync := []wfv1.SemaphoreHolding{{Semaphore: "default/configmap/my-config1/workflow", Holders: []string{"hello-world-vcrg5"}}}
 		wf.Status.Synchronization.Semaphore.Holding = invalidSync
@@ -381,12 +384,12 @@ func TestSemaphoreWfLevel(t *testing.T) {
 		var nextKey string
 		concurrenyMgr := NewLockManager(syncLimitFunc, func(key string) {
 			nextKey = key
-		}, WorkflowExistenceFunc)
+		}, WorkflowExistenceFunc, nil)
 		wf := unmarshalWF(wfWithSemaphore)
 		wf1 := wf.DeepCopy()
 		wf2 := wf.DeepCopy()
 		wf3 := wf.DeepCopy()
-		status, wfUpdate, msg, err := concurrenyMgr.TryAcquire(wf, "", wf.Spec.Synchronization)
+		status, wfUpdate, msg, _, err := concurrenyMgr.TryAcquire(wf, "", wf.Spec.Synchronization)
 		assert.NoError(t, err)
 		assert.Empty(t, msg)
 		assert.True(t, status)
@@ -397,14 +400,14 @@ func TestSemaphoreWfLevel(t *testing.T) {
 		assert.Equal(t, wf.Name, wf.Status.Synchronization.Semaphore.Holding[0].Holders[0])
 
 		// Try to acquire again
-		status, wfUpdate, msg, err = concurrenyMgr.TryAcquire(wf, "", wf.Spec.Synchronization)
+		status, wfUpdate, msg, _, err = concurrenyMgr.TryAcquire(wf, "", wf.Spec.Synchronization)
 		assert.NoError(t, err)
 		assert.True(t, status)
 		assert.Empty(t, msg)
 		assert.False(t, wfUpdate)
 
 		wf1.Name = "two"
-		status, wfUpdate, msg, err = concurrenyMgr.TryAcquire(wf1, "", wf1.Spec.Synchronization)
+		status, wfUpdate, msg, _, err = concurrenyMgr.TryAcquire(wf1, "", wf1.Spec.Synchronization)
 		assert.NoError(t, err)
 		assert.NotEmpty(t, msg)
 		assert.False(t, status)
@@ -413,14 +416,14 @@ func TestSemaphoreWfLevel(t *testing.T) {
 		wf2.Name = "three"
 		wf2.Spec.Priority = pointer.Int32Ptr(5)
 		holderKey2 := getHolderKey(wf2, "")
-		status, wfUpdate, msg, err = concurrenyMgr.TryAcquire(wf2, "", wf2.Spec.Synchronization)
+		status, wfUpdate, msg, _, err = concurrenyMgr.TryAcquire(wf2, "", wf2.Spec.Synchronization)
 		assert.NoError(t, err)
 		assert.NotEmpty(t, msg)
 		assert.False(t, status)
 		assert.True(t, wfUpdate)
 
 		wf3.Name = "four"
-		status, wfUpdate, msg, err = concurrenyMgr.TryAcquire(wf3, "", wf3.Spec.Synchronization)
+		status, wfUpdate, msg, _, err = concurrenyMgr.TryAcquire(wf3, "", wf3.Spec.Synchronization)
 		assert.NoError(t, err)
 		assert.NotEmpty(t, msg)
 		assert.False(t, status)
@@ -432,14 +435,14 @@ func TestSemaphoreWfLevel(t *testing.T) {
 		assert.Equal(t, 0, len(wf.Status.Synchronization.Semaphore.Holding[0].Holders))
 
 		// Low priority workflow try to acquire the lock
-		status, wfUpdate, msg, err = concurrenyMgr.TryAcquire(wf1, "", wf1.Spec.Synchronization)
+		status, wfUpdate, msg, _, err = concurrenyMgr.TryAcquire(wf1, "", wf1.Spec.Synchronization)
 		assert.NoError(t, err)
 		assert.NotEmpty(t, msg)
 		assert.False(t, status)
 		assert.True(t, wfUpdate)
 
 		// High Priority workflow acquires the lock
-		status, wfUpdate, msg, err = concurrenyMgr.TryAcquire(wf2, "", wf2.Spec.Synchronization)
+		status, wfUpdate, msg, _, err = concurrenyMgr.TryAcquire(wf2, "", wf2.Spec.Synchronization)
 		assert.NoError(t, err)
 		assert.Empty(t, msg)
 		assert.True(t, status)
@@ -474,12 +477,12 @@ func TestResizeSemaphoreSize(t *testing.T) {
 	syncLimitFunc := GetSyncLimitFunc(kube)
 	t.Run("WfLevelAcquireAndRelease", func(t *testing.T) {
 		concurrenyMgr := NewLockManager(syncLimitFunc, func(key string) {
-		}, WorkflowExistenceFunc)
+		}, WorkflowExistenceFunc, nil)
 		wf := unmarshalWF(wfWithSemaphore)
 		wf.CreationTimestamp = metav1.Time{Time: time.Now()}
 		wf1 := wf.DeepCopy()
 		wf2 := wf.DeepCopy()
-		status, wfUpdate, msg, err := concurrenyMgr.TryAcquire(wf, "", wf.Spec.Synchronization)
+		status, wfUpdate, msg, _, err := concurrenyMgr.TryAcquire(wf, "", wf.Spec.Synchronization)
 		assert.NoError(t, err)
 		assert.Empty(t, msg)
 		assert.True(t, status)
@@ -489,14 +492,14 @@ func TestResizeSemaphoreSize(t *testing.T) {
 		assert.Equal(t, wf.Name, wf.Status.Synchronization.Semaphore.Holding[0].Holders[0])
 
 		wf1.Name = "two"
-		status, wfUpdate, msg, err = concurrenyMgr.TryAcquire(wf1, "", wf1.Spec.Synchronization)
+		status, wfUpdate, msg, _, err = concurrenyMgr.TryAcquire(wf1, "", wf1.Spec.Synchronization)
 		assert.NoError(t, err)
 		assert.NotEmpty(t, msg)
 		assert.False(t, status)
 		assert.True(t, wfUpdate)
 
 		wf2.Name = "three"
-		status, wfUpdate, msg, err = concurrenyMgr.TryAcquire(wf2, "", wf2.Spec.Synchronization)
+		status, wfUpdate, msg, _, err = concurrenyMgr.TryAcquire(wf2, "", wf2.Spec.Synchronization)
 		assert.NoError(t, err)
 		assert.NotEmpty(t, msg)
 		assert.False(t, status)
@@ -509,7 +512,7 @@ func TestResizeSemaphoreSize(t *testing.T) {
 		_, err = kube.CoreV1().ConfigMaps("default").Update(ctx, cm, metav1.UpdateOptions{})
 		assert.NoError(t, err)
 
-		status, wfUpdate, msg, err = concurrenyMgr.TryAcquire(wf1, "", wf1.Spec.Synchronization)
+		status, wfUpdate, msg, _, err = concurrenyMgr.TryAcquire(wf1, "", wf1.Spec.Synchronization)
 		assert.NoError(t, err)
 		assert.True(t, status)
 		assert.Empty(t, msg)
@@ -518,7 +521,7 @@ func TestResizeSemaphoreSize(t *testing.T) {
 		assert.NotNil(t, wf1.Status.Synchronization.Semaphore)
 		assert.Equal(t, wf1.Name, wf1.Status.Synchronization.Semaphore.Holding[0].Holders[0])
 
-		status, wfUpdate, msg, err = concurrenyMgr.TryAcquire(wf2, "", wf2.Spec.Synchronization)
+		status, wfUpdate, msg, _, err = concurrenyMgr.TryAcquire(wf2, "", wf2.Spec.Synchronization)
 		assert.NoError(t, err)
 		assert.Empty(t, msg)
 		assert.True(t, status)
@@ -545,11 +548,11 @@ func TestSemaphoreTmplLevel(t *testing.T) {
 		//var nextKey string
 		concurrenyMgr := NewLockManager(syncLimitFunc, func(key string) {
 			//nextKey = key
-		}, WorkflowExistenceFunc)
+		}, WorkflowExistenceFunc, nil)
 		wf := unmarshalWF(wfWithTmplSemaphore)
 		tmpl := wf.Spec.Templates[2]
 
-		status, wfUpdate, msg, err := concurrenyMgr.TryAcquire(wf, "semaphore-tmpl-level-xjvln-3448864205", tmpl.Synchronization)
+		status, wfUpdate, msg, _, err := concurrenyMgr.TryAcquire(wf, "semaphore-tmpl-level-xjvln-3448864205", tmpl.Synchronization)
 		assert.NoError(t, err)
 		assert.Empty(t, msg)
 		assert.True(t, status)
@@ -559,13 +562,13 @@ func TestSemaphoreTmplLevel(t *testing.T) {
 		assert.Equal(t, "semaphore-tmpl-level-xjvln-3448864205", wf.Status.Synchronization.Semaphore.Holding[0].Holders[0])
 
 		// Try to acquire again
-		status, wfUpdate, msg, err = concurrenyMgr.TryAcquire(wf, "semaphore-tmpl-level-xjvln-3448864205", tmpl.Synchronization)
+		status, wfUpdate, msg, _, err = concurrenyMgr.TryAcquire(wf, "semaphore-tmpl-level-xjvln-3448864205", tmpl.Synchronization)
 		assert.NoError(t, err)
 		assert.True(t, status)
 		assert.False(t, wfUpdate)
 		assert.Empty(t, msg)
 
-		status, wfUpdate, msg, err = concurrenyMgr.TryAcquire(wf, "semaphore-tmpl-level-xjvln-1607747183", tmpl.Synchronization)
+		status, wfUpdate, msg, _, err = concurrenyMgr.TryAcquire(wf, "semaphore-tmpl-level-xjvln-1607747183", tmpl.Synchronization)
 		assert.NoError(t, err)
 		assert.NotEmpty(t, msg)
 		assert.True(t, wfUpdate)
@@ -576,7 +579,7 @@ func TestSemaphoreTmplLevel(t *testing.T) {
 		assert.NotNil(t, wf.Status.Synchronization.Semaphore)
 		assert.Empty(t, wf.Status.Synchronization.Semaphore.Holding[0].Holders)
 
-		status, wfUpdate, msg, err = concurrenyMgr.TryAcquire(wf, "semaphore-tmpl-level-xjvln-1607747183", tmpl.Synchronization)
+		status, wfUpdate, msg, _, err = concurrenyMgr.TryAcquire(wf, "semaphore-tmpl-level-xjvln-1607747183", tmpl.Synchronization)
 		assert.NoError(t, err)
 		assert.Empty(t, msg)
 		assert.True(t, status)
@@ -605,12 +608,12 @@ func TestTriggerWFWithAvailableLock(t *testing.T) {
 		triggerCount := 0
 		concurrenyMgr := NewLockManager(syncLimitFunc, func(key string) {
 			triggerCount++
-		}, WorkflowExistenceFunc)
+		}, WorkflowExistenceFunc, nil)
 		var wfs []wfv1.Workflow
 		for i := 0; i < 3; i++ {
 			wf := unmarshalWF(wfWithSemaphore)
 			wf.Name = fmt.Sprintf("%s-%d", "acquired", i)
-			status, wfUpdate, msg, err := concurrenyMgr.TryAcquire(wf, "", wf.Spec.Synchronization)
+			status, wfUpdate, msg, _, err := concurrenyMgr.TryAcquire(wf, "", wf.Spec.Synchronization)
 			assert.NoError(err)
 			assert.Empty(msg)
 			assert.True(status)
@@ -621,7 +624,7 @@ func TestTriggerWFWithAvailableLock(t *testing.T) {
 		for i := 0; i < 3; i++ {
 			wf := unmarshalWF(wfWithSemaphore)
 			wf.Name = fmt.Sprintf("%s-%d", "wait", i)
-			status, wfUpdate, msg, err := concurrenyMgr.TryAcquire(wf, "", wf.Spec.Synchronization)
+			status, wfUpdate, msg, _, err := concurrenyMgr.TryAcquire(wf, "", wf.Spec.Synchronization)
 			assert.NoError(err)
 			assert.NotEmpty(msg)
 			assert.False(status)
@@ -641,12 +644,12 @@ func TestMutexWfLevel(t *testing.T) {
 		//var nextKey string
 		concurrenyMgr := NewLockManager(syncLimitFunc, func(key string) {
 			//nextKey = key
-		}, WorkflowExistenceFunc)
+		}, WorkflowExistenceFunc, nil)
 		wf := unmarshalWF(wfWithMutex)
 		wf1 := wf.DeepCopy()
 		wf2 := wf.DeepCopy()
 
-		status, wfUpdate, msg, err := concurrenyMgr.TryAcquire(wf, "", wf.Spec.Synchronization)
+		status, wfUpdate, msg, _, err := concurrenyMgr.TryAcquire(wf, "", wf.Spec.Synchronization)
 		assert.NoError(t, err)
 		assert.Empty(t, msg)
 		assert.True(t, status)
@@ -656,14 +659,14 @@ func TestMutexWfLevel(t *testing.T) {
 		assert.NotNil(t, wf.Status.Synchronization.Mutex.Holding)
 
 		wf1.Name = "two"
-		status, wfUpdate, msg, err = concurrenyMgr.TryAcquire(wf1, "", wf1.Spec.Synchronization)
+		status, wfUpdate, msg, _, err = concurrenyMgr.TryAcquire(wf1, "", wf1.Spec.Synchronization)
 		assert.NoError(t, err)
 		assert.NotEmpty(t, msg)
 		assert.False(t, status)
 		assert.True(t, wfUpdate)
 
 		wf2.Name = "three"
-		status, wfUpdate, msg, err = concurrenyMgr.TryAcquire(wf2, "", wf2.Spec.Synchronization)
+		status, wfUpdate, msg, _, err = concurrenyMgr.TryAcquire(wf2, "", wf2.Spec.Synchronization)
 		assert.NoError(t, err)
 		assert.NotEmpty(t, msg)
 		assert.False(t, status)
@@ -697,17 +700,17 @@ func TestCheckWorkflowExistence(t *testing.T) {
 			//nextKey = key
 		}, func(s string) bool {
 			return strings.Contains(s, "test1")
-		})
+		}, nil)
 		wfMutex := unmarshalWF(wfWithMutex)
 		wfMutex1 := wfMutex.DeepCopy()
 		wfMutex1.Name = "test1"
 		wfSema := unmarshalWF(wfWithSemaphore)
 		wfSema1 := wfSema.DeepCopy()
 		wfSema1.Name = "test2"
-		_, _, _, _ = concurrenyMgr.TryAcquire(wfMutex, "", wfMutex.Spec.Synchronization)
-		_, _, _, _ = concurrenyMgr.TryAcquire(wfMutex1, "", wfMutex.Spec.Synchronization)
-		_, _, _, _ = concurrenyMgr.TryAcquire(wfSema, "", wfSema.Spec.Synchronization)
-		_, _, _, _ = concurrenyMgr.TryAcquire(wfSema1, "", wfSema.Spec.Synchronization)
+		_, _, _, _, _ = concurrenyMgr.TryAcquire(wfMutex, "", wfMutex.Spec.Synchronization)
+		_, _, _, _, _ = concurrenyMgr.TryAcquire(wfMutex1, "", wfMutex.Spec.Synchronization)
+		_, _, _, _, _ = concurrenyMgr.TryAcquire(wfSema, "", wfSema.Spec.Synchronization)
+		_, _, _, _, _ = concurrenyMgr.TryAcquire(wfSema1, "", wfSema.Spec.Synchronization)
 		mutex := concurrenyMgr.syncLockMap["default/Mutex/my-mutex"].(*PriorityMutex)
 		semaphore := concurrenyMgr.syncLockMap["default/ConfigMap/my-config/workflow"]
 
@@ -723,3 +726,171 @@ func TestCheckWorkflowExistence(t *testing.T) {
 	})
 
 }
+
+func TestMultipleLocks(t *testing.T) {
+	kube := fake.NewSimpleClientset()
+	var cm v1.ConfigMap
+	err := yaml.Unmarshal([]byte(configMap), &cm)
+	assert.NoError(t, err)
+	ctx := context.Background()
+	_, err = kube.CoreV1().ConfigMaps("default").Create(ctx, &cm, metav1.CreateOptions{})
+	assert.NoError(t, err)
+
+	syncRef := &wfv1.Synchronization{
+		Semaphores: []*wfv1.SemaphoreRef{{ConfigMapKeyRef: &v1.ConfigMapKeySelector{LocalObjectReference: v1.LocalObjectReference{Name: "my-config"}, Key: "template"}}},
+		Mutexes:    []*wfv1.Mutex{{Name: "my-mutex"}},
+	}
+
+	t.Run("AllOrNothing", func(t *testing.T) {
+		concurrenyMgr := NewLockManager(GetSyncLimitFunc(kube), func(key string) {
+		}, WorkflowExistenceFunc, nil)
+		wf := unmarshalWF(wfWithSemaphore)
+		wf.Spec.Synchronization = nil
+		status, wfUpdate, msg, lockName, err := concurrenyMgr.TryAcquire(wf, "", syncRef)
+		if assert.NoError(t, err) {
+			assert.True(t, status)
+			assert.True(t, wfUpdate)
+			assert.Empty(t, msg)
+			assert.Empty(t, lockName)
+			assert.Equal(t, []wfv1.SemaphoreHolding{{Semaphore: "default/ConfigMap/my-config/template", Holders: []string{"hello-world"}}}, wf.Status.Synchronization.Semaphore.Holding)
+			assert.Equal(t, []wfv1.MutexHolding{{Mutex: "default/Mutex/my-mutex", Holder: "hello-world"}}, wf.Status.Synchronization.Mutex.Holding)
+		}
+
+		// only needs the mutex, which is held
+		wf1 := wf.DeepCopy()
+		wf1.Name = "two"
+		wf1.Status.Synchronization = nil
+		status, _, msg, lockName, err = concurrenyMgr.TryAcquire(wf1, "", &wfv1.Synchronization{Mutex: &wfv1.Mutex{Name: "my-mutex"}})
+		if assert.NoError(t, err) {
+			assert.False(t, status)
+			assert.NotEmpty(t, msg)
+			assert.Equal(t, "default/Mutex/my-mutex", lockName)
+		}
+
+		// needs the semaphore, which is free, and the mutex, which is not
+		wf2 := wf.DeepCopy()
+		wf2.Name = "three"
+		wf2.Status.Synchronization = nil
+		status, _, _, lockName, err = concurrenyMgr.TryAcquire(wf2, "", &wfv1.Synchronization{
+			Semaphore: &wfv1.SemaphoreRef{ConfigMapKeyRef: &v1.ConfigMapKeySelector{LocalObjectReference: v1.LocalObjectReference{Name: "my-config"}, Key: "workflow"}},
+			Mutex:     &wfv1.Mutex{Name: "my-mutex"},
+		})
+		if assert.NoError(t, err) {
+			assert.False(t, status)
+			assert.Equal(t, "default/Mutex/my-mutex", lockName)
+			assert.Empty(t, wf2.Status.Synchronization.Semaphore.Holding, "does not hold the semaphore while it waits for the mutex")
+		}
+
+		concurrenyMgr.Release(wf, "", syncRef)
+		assert.Empty(t, wf.Status.Synchronization.Semaphore.Holding[0].Holders)
+		assert.Empty(t, wf.Status.Synchronization.Mutex.Holding)
+
+		status, _, _, _, err = concurrenyMgr.TryAcquire(wf1, "", &wfv1.Synchronization{Mutex: &wfv1.Mutex{Name: "my-mutex"}})
+		if assert.NoError(t, err) {
+			assert.True(t, status)
+		}
+	})
+	t.Run("RollbackOnDatabaseSemaphore", func(t *testing.T) {
+		repository := &sqldbmocks.SemaphoreRepository{}
+		repository.On("IsEnabled").Return(true)
+		repository.On("TryAcquire", "my-key", mock.Anything).Return(false, nil)
+		repository.On("ListHolders", "my-key").Return(nil, nil)
+		concurrenyMgr := NewLockManager(GetSyncLimitFunc(kube), func(key string) {
+		}, WorkflowExistenceFunc, func() sqldb.SemaphoreRepository { return repository })
+		wf := unmarshalWF(wfWithSemaphore)
+		wf.Spec.Synchronization = nil
+		status, wfUpdate, _, lockName, err := concurrenyMgr.TryAcquire(wf, "", &wfv1.Synchronization{
+			Semaphores: []*wfv1.SemaphoreRef{syncRef.Semaphores[0], databaseSemaphore.Semaphore},
+		})
+		if assert.NoError(t, err) {
+			assert.False(t, status)
+			assert.True(t, wfUpdate)
+			assert.Equal(t, "default/Database/my-key", lockName)
+			assert.Empty(t, wf.Status.Synchronization.Semaphore.Holding[0].Holders, "released the config map semaphore")
+			assert.Empty(t, concurrenyMgr.syncLockMap["default/ConfigMap/my-config/template"].getCurrentHolders())
+		}
+	})
+}
+
+func TestParameterisedLockNames(t *testing.T) {
+	kube := fake.NewSimpleClientset()
+	var nextKey string
+	concurrenyMgr := NewLockManager(GetSyncLimitFunc(kube), func(key string) {
+		nextKey = key
+	}, WorkflowExistenceFunc, nil)
+	wf := unmarshalWF(wfWithMutex)
+	wf1 := wf.DeepCopy()
+	wf1.Name = "two"
+	resolved := &wfv1.Synchronization{Mutex: &wfv1.Mutex{Name: "deploy-prod"}}
+	unresolved := &wfv1.Synchronization{Mutex: &wfv1.Mutex{Name: "deploy-{{inputs.parameters.env}}"}}
+
+	status, _, _, _, err := concurrenyMgr.TryAcquire(wf, "node-1", resolved)
+	if assert.NoError(t, err) {
+		assert.True(t, status)
+		assert.Equal(t, []string{"default/Mutex/deploy-prod"}, concurrenyMgr.holderLocks["default/hello-world/node-1"])
+	}
+	status, _, _, lockName, err := concurrenyMgr.TryAcquire(wf1, "node-1", resolved)
+	if assert.NoError(t, err) {
+		assert.False(t, status)
+		assert.Equal(t, "default/Mutex/deploy-prod", lockName)
+	}
+
+	// the holder releases the lock it acquired, even though the names are unresolved
+	concurrenyMgr.Release(wf, "node-1", unresolved)
+	assert.Empty(t, wf.Status.Synchronization.Mutex.Holding)
+	assert.NotContains(t, concurrenyMgr.holderLocks, "default/hello-world/node-1")
+	assert.Equal(t, "default/two", nextKey)
+
+	status, _, _, _, err = concurrenyMgr.TryAcquire(wf1, "node-1", resolved)
+	if assert.NoError(t, err) {
+		assert.True(t, status)
+	}
+	concurrenyMgr.ReleaseAll(wf1)
+	assert.Empty(t, concurrenyMgr.holderLocks)
+}
+
+func TestValidateLockNames(t *testing.T) {
+	assert.NoError(t, ValidateLockNames(&wfv1.Synchronization{Mutex: &wfv1.Mutex{Name: "deploy-prod"}}, "default"))
+	err := ValidateLockNames(&wfv1.Synchronization{Mutex: &wfv1.Mutex{Name: "deploy-a/b"}}, "default")
+	assert.EqualError(t, err, `Invalid lock key: "deploy-a/b" must not contain "/"`)
+	err = ValidateLockNames(&wfv1.Synchronization{Semaphore: &wfv1.SemaphoreRef{ConfigMapKeyRef: &v1.ConfigMapKeySelector{
+		LocalObjectReference: v1.LocalObjectReference{Name: "my-config"},
+		Key:                  "a/b",
+	}}}, "default")
+	assert.EqualError(t, err, `Invalid lock key: "a/b" must not contain "/"`)
+
+	// the lock is not acquired, rather than failing to encode its name
+	concurrenyMgr := NewLockManager(GetSyncLimitFunc(fake.NewSimpleClientset()), func(string) {}, WorkflowExistenceFunc, nil)
+	_, _, _, _, err = concurrenyMgr.TryAcquire(unmarshalWF(wfWithMutex), "", &wfv1.Synchronization{Mutex: &wfv1.Mutex{Name: "deploy-a/b"}})
+	assert.EqualError(t, err, `requested configuration is invalid: Invalid lock key: "deploy-a/b" must not contain "/"`)
+}
+
+func TestListLocks(t *testing.T) {
+	kube := fake.NewSimpleClientset()
+	concurrenyMgr := NewLockManager(GetSyncLimitFunc(kube), func(key string) {
+	}, WorkflowExistenceFunc, nil)
+	wf := unmarshalWF(wfWithMutex)
+	low := wf.DeepCopy()
+	low.Name = "low"
+	high := wf.DeepCopy()
+	high.Name = "high"
+	high.Spec.Priority = pointer.Int32Ptr(10)
+	for _, w := range []*wfv1.Workflow{wf, low, high} {
+		_, _, _, _, err := concurrenyMgr.TryAcquire(w, "", w.Spec.Synchronization)
+		assert.NoError(t, err)
+	}
+	_, _, _, _, err := concurrenyMgr.TryAcquire(wf, "node-1", &wfv1.Synchronization{Mutex: &wfv1.Mutex{Name: "another-mutex"}})
+	assert.NoError(t, err)
+
+	locks := concurrenyMgr.ListLocks()
+	if assert.Len(t, locks, 2) {
+		assert.Equal(t, LockStatus{Name: "default/Mutex/another-mutex", Type: wfv1.SynchronizationTypeMutex, Limit: 1, Holders: []string{"default/hello-world/node-1"}}, locks[0])
+		assert.Equal(t, "default/Mutex/my-mutex", locks[1].Name)
+		assert.Equal(t, []string{"default/hello-world"}, locks[1].Holders)
+		if assert.Len(t, locks[1].Waiters, 2) {
+			assert.Equal(t, "default/high", locks[1].Waiters[0].HolderKey)
+			assert.Equal(t, int32(10), locks[1].Waiters[0].Priority)
+			assert.Equal(t, "default/low", locks[1].Waiters[1].HolderKey)
+		}
+	}
+}
@@ -2,8 +2,14 @@ package sync
 
 import (
 	"container/heap"
+	"reflect"
+	"sort"
 	"sync"
 	"time"
+
+	"k8s.io/client-go/tools/cache"
+
+	"github.com/argoproj/argo/v2/config"
 )
 
 // Throttler allows the controller to limit number of items it is processing in parallel.
@@ -11,65 +17,201 @@ import (
 // will be kept pending until the processing is complete.
 // Implementations should be idempotent.
 type Throttler interface {
-	Add(key string, priority int32, creationTime time.Time)
+	Add(key string, labels map[string]string, priority int32, creationTime time.Time)
 	// Admin returns if the item should be processed.
 	Admit(key string) bool
 	// Remove notifies throttler that item processing is no longer needed
 	Remove(key string)
 }
 
+const (
+	// GlobalQuota is the quota of the bucket all items are in
+	GlobalQuota = "global"
+	// NamespaceQuota is the quota of the buckets of the items in each namespace
+	NamespaceQuota = "namespace"
+	// LabelQuotaPrefix prefixes the label key of the quota of the buckets of items with each value of the label
+	LabelQuotaPrefix = "label:"
+)
+
+// Quotas configures the buckets, and their limits, of the items
+type Quotas struct {
+	Parallelism int
+	Namespace   *config.ParallelismQuota
+	Labels      []config.LabelParallelismQuota
+}
+
+// BucketStatsFunc is notified of the number of items in progress and pending in a bucket, when they change
+type BucketStatsFunc func(quota, bucket string, inProgress, pending, limit int)
+
+type bucketKey struct {
+	quota string
+	name  string
+}
+
+type bucket struct {
+	limit      int
+	inProgress int
+	pending    int
+}
+
 type throttler struct {
-	queue       func(key string)
-	inProgress  map[string]bool
-	pending     *priorityQueue
-	lock        *sync.Mutex
-	parallelism int
+	queue   func(key string)
+	quotas  Quotas
+	stats   BucketStatsFunc
+	buckets map[bucketKey]*bucket
+	// the buckets of each item in progress, which we release when the item is removed
+	inProgress map[string][]bucketKey
+	pending    *priorityQueue
+	// whether pending items may have become admissible since we last looked
+	dirty bool
+	lock  *sync.Mutex
 }
 
-// NewThrottler returns a throttle that only runs `parallelism` items at once. When an item may need processing,
-// `queue` is invoked.
-func NewThrottler(parallelism int, queue func(key string)) Throttler {
+// NewThrottler returns a throttle that only runs `quotas.Parallelism` items at once, and the number of items in each
+// namespace and with each label value allowed by the other quotas. An item only runs when every bucket it is in is
+// below its limit, so each bucket is processed in priority order. When an item may need processing, `queue` is invoked.
+// `stats` may be nil.
+func NewThrottler(quotas Quotas, queue func(key string), stats BucketStatsFunc) Throttler {
 	return &throttler{
-		queue:       queue,
-		inProgress:  make(map[string]bool),
-		lock:        &sync.Mutex{},
-		parallelism: parallelism,
-		pending:     &priorityQueue{itemByKey: make(map[string]*item)},
+		queue:      queue,
+		quotas:     quotas,
+		stats:      stats,
+		buckets:    make(map[bucketKey]*bucket),
+		inProgress: make(map[string][]bucketKey),
+		lock:       &sync.Mutex{},
+		pending:    &priorityQueue{itemByKey: make(map[string]*item)},
+	}
+}
+
+// bucketsFor returns the buckets, that have limits, the item is in
+func (t *throttler) bucketsFor(key string, labels map[string]string) []bucketKey {
+	var buckets []bucketKey
+	if t.quotas.Parallelism > 0 {
+		buckets = append(buckets, bucketKey{GlobalQuota, ""})
+	}
+	namespace, _, _ := cache.SplitMetaNamespaceKey(key)
+	if t.quotas.Namespace.GetLimit(namespace) > 0 {
+		buckets = append(buckets, bucketKey{NamespaceQuota, namespace})
+	}
+	for _, q := range t.quotas.Labels {
+		if value, ok := labels[q.Label]; ok && q.GetLimit(value) > 0 {
+			buckets = append(buckets, bucketKey{LabelQuotaPrefix + q.Label, value})
+		}
+	}
+	return buckets
+}
+
+func (t *throttler) limit(k bucketKey) int {
+	switch {
+	case k.quota == GlobalQuota:
+		return t.quotas.Parallelism
+	case k.quota == NamespaceQuota:
+		return t.quotas.Namespace.GetLimit(k.name)
+	}
+	for _, q := range t.quotas.Labels {
+		if k.quota == LabelQuotaPrefix+q.Label {
+			return q.GetLimit(k.name)
+		}
+	}
+	return 0
+}
+
+func (t *throttler) bucket(k bucketKey) *bucket {
+	if _, ok := t.buckets[k]; !ok {
+		t.buckets[k] = &bucket{limit: t.limit(k)}
+	}
+	return t.buckets[k]
+}
+
+// update updates the bucket's counts by the deltas, and notifies the stats func
+func (t *throttler) update(keys []bucketKey, inProgress, pending int) {
+	for _, k := range keys {
+		b := t.bucket(k)
+		b.inProgress += inProgress
+		b.pending += pending
+		if t.stats != nil {
+			t.stats(k.quota, k.name, b.inProgress, b.pending, b.limit)
+		}
 	}
 }
-func (t *throttler) Add(key string, priority int32, creationTime time.Time) {
+
+func (t *throttler) Add(key string, labels map[string]string, priority int32, creationTime time.Time) {
 	t.lock.Lock()
 	defer t.lock.Unlock()
-	if t.parallelism == 0 {
+	if _, ok := t.inProgress[key]; ok {
+		return
+	}
+	buckets := t.bucketsFor(key, labels)
+	if existing, ok := t.pending.itemByKey[key]; ok {
+		if existing.priority == priority && reflect.DeepEqual(existing.buckets, buckets) {
+			return
+		}
+		// the labels, and therefore the buckets, may have changed
+		t.update(existing.buckets, 0, -1)
+		t.pending.remove(key)
+	}
+	if len(buckets) == 0 {
 		return
 	}
 	t.pending.add(key, priority, creationTime)
+	t.pending.itemByKey[key].buckets = buckets
+	t.update(buckets, 0, 1)
+	t.dirty = true
 	t.queueThrottled()
 }
 
 func (t *throttler) Admit(key string) bool {
 	t.lock.Lock()
 	defer t.lock.Unlock()
-	if t.parallelism == 0 || t.inProgress[key] {
+	if _, ok := t.pending.itemByKey[key]; !ok {
+		// either in progress, or not limited by any quota
 		return true
 	}
 	t.queueThrottled()
-	return false
+	_, ok := t.inProgress[key]
+	return ok
 }
 
 func (t *throttler) Remove(key string) {
 	t.lock.Lock()
 	defer t.lock.Unlock()
-	delete(t.inProgress, key)
-	t.pending.remove(key)
+	if buckets, ok := t.inProgress[key]; ok {
+		t.update(buckets, -1, 0)
+		delete(t.inProgress, key)
+		t.dirty = true
+	}
+	if item, ok := t.pending.itemByKey[key]; ok {
+		t.update(item.buckets, 0, -1)
+		t.pending.remove(key)
+	}
 	t.queueThrottled()
 }
 
+func (t *throttler) admissible(buckets []bucketKey) bool {
+	for _, k := range buckets {
+		b := t.bucket(k)
+		if b.inProgress >= b.limit {
+			return false
+		}
+	}
+	return true
+}
+
+// queueThrottled starts the pending items, in priority order, that are below the limits of all of their buckets.
+// Lower-priority items may start before higher-priority items in other buckets.
 func (t *throttler) queueThrottled() {
-	for t.pending.Len() > 0 && t.parallelism > len(t.inProgress) {
-		key := t.pending.pop().key
-		t.inProgress[key] = true
-		t.queue(key)
+	if !t.dirty {
+		return
+	}
+	t.dirty = false
+	for _, item := range t.pending.sorted() {
+		if !t.admissible(item.buckets) {
+			continue
+		}
+		t.pending.remove(item.key)
+		t.update(item.buckets, 1, -1)
+		t.inProgress[item.key] = item.buckets
+		t.queue(item.key)
 	}
 }
 
@@ -77,6 +219,7 @@ type item struct {
 	key          string
 	creationTime time.Time
 	priority     int32
+	buckets      []bucketKey
 	index        int
 }
 
@@ -85,6 +228,14 @@ type priorityQueue struct {
 	itemByKey map[string]*item
 }
 
+// sorted returns the items in priority order
+func (pq *priorityQueue) sorted() []*item {
+	items := make([]*item, len(pq.items))
+	copy(items, pq.items)
+	sort.Slice(items, func(i, j int) bool { return less(items[i], items[j]) })
+	return items
+}
+
 func (pq *priorityQueue) pop() *item {
 	return heap.Pop(pq).(*item)
 }
@@ -113,11 +264,13 @@ func (pq *priorityQueue) remove(key string) {
 
 func (pq priorityQueue) Len() int { return len(pq.items) }
 
-func (pq priorityQueue) Less(i, j int) bool {
-	if pq.items[i].priority == pq.items[j].priority {
-		return pq.items[i].creationTime.Before(pq.items[j].creationTime)
+func (pq priorityQueue) Less(i, j int) bool { return less(pq.items[i], pq.items[j]) }
+
+func less(a, b *item) bool {
+	if a.priority == b.priority {
+		return a.creationTime.Before(b.creationTime)
 	}
-	return pq.items[i].priority > pq.items[j].priority
+	return a.priority > b.priority
 }
 
 func (pq priorityQueue) Swap(i, j int) {
@@ -5,14 +5,16 @@ import (
 	"time"
 
 	"github.com/stretchr/testify/assert"
+
+	"github.com/argoproj/argo/v2/config"
 )
 
 func TestNoParallelismSamePriority(t *testing.T) {
-	throttler := NewThrottler(0, nil)
+	throttler := NewThrottler(Quotas{}, nil, nil)
 
-	throttler.Add("c", 0, time.Now().Add(2*time.Hour))
-	throttler.Add("b", 0, time.Now().Add(1*time.Hour))
-	throttler.Add("a", 0, time.Now())
+	throttler.Add("c", nil, 0, time.Now().Add(2*time.Hour))
+	throttler.Add("b", nil, 0, time.Now().Add(1*time.Hour))
+	throttler.Add("a", nil, 0, time.Now())
 
 	assert.True(t, throttler.Admit("a"))
 	assert.True(t, throttler.Admit("b"))
@@ -21,12 +23,12 @@ func TestNoParallelismSamePriority(t *testing.T) {
 
 func TestWithParallelismLimitAndPriority(t *testing.T) {
 	queuedKey := ""
-	throttler := NewThrottler(2, func(key string) { queuedKey = key })
+	throttler := NewThrottler(Quotas{Parallelism: 2}, func(key string) { queuedKey = key }, nil)
 
-	throttler.Add("a", 1, time.Now())
-	throttler.Add("b", 2, time.Now())
-	throttler.Add("c", 3, time.Now())
-	throttler.Add("d", 4, time.Now())
+	throttler.Add("a", nil, 1, time.Now())
+	throttler.Add("b", nil, 2, time.Now())
+	throttler.Add("c", nil, 3, time.Now())
+	throttler.Add("d", nil, 4, time.Now())
 
 	assert.True(t, throttler.Admit("a"), "is started, even though low priority")
 	assert.True(t, throttler.Admit("b"), "is started, even though low priority")
@@ -47,3 +49,65 @@ func TestWithParallelismLimitAndPriority(t *testing.T) {
 	assert.True(t, throttler.Admit("c"), "now running too")
 	assert.Equal(t, "c", queuedKey)
 }
+
+func TestNamespaceParallelism(t *testing.T) {
+	var queuedKeys []string
+	throttler := NewThrottler(Quotas{
+		Namespace: &config.ParallelismQuota{Default: 1, Limits: map[string]int{"big": 2}},
+	}, func(key string) { queuedKeys = append(queuedKeys, key) }, nil)
+
+	throttler.Add("small/a", nil, 1, time.Now())
+	throttler.Add("small/b", nil, 2, time.Now())
+	throttler.Add("big/c", nil, 1, time.Now())
+	throttler.Add("big/d", nil, 2, time.Now())
+	throttler.Add("big/e", nil, 3, time.Now())
+
+	assert.True(t, throttler.Admit("small/a"))
+	assert.False(t, throttler.Admit("small/b"), "namespace is full")
+	assert.True(t, throttler.Admit("big/c"))
+	assert.True(t, throttler.Admit("big/d"))
+	assert.False(t, throttler.Admit("big/e"), "namespace is full")
+	assert.Equal(t, []string{"small/a", "big/c", "big/d"}, queuedKeys)
+	queuedKeys = nil
+
+	throttler.Remove("big/c")
+	assert.False(t, throttler.Admit("small/b"), "other namespaces are not affected")
+	assert.True(t, throttler.Admit("big/e"))
+	assert.Equal(t, []string{"big/e"}, queuedKeys)
+}
+
+func TestLabelParallelism(t *testing.T) {
+	var queuedKeys []string
+	type stats struct{ inProgress, pending, limit int }
+	bucketStats := make(map[string]stats)
+	throttler := NewThrottler(Quotas{
+		Parallelism: 3,
+		Labels: []config.LabelParallelismQuota{
+			{Label: "team", ParallelismQuota: config.ParallelismQuota{Limits: map[string]int{"blue": 1}}},
+		},
+	}, func(key string) { queuedKeys = append(queuedKeys, key) }, func(quota, bucket string, inProgress, pending, limit int) {
+		bucketStats[quota+"/"+bucket] = stats{inProgress, pending, limit}
+	})
+
+	throttler.Add("ns/a", map[string]string{"team": "blue"}, 1, time.Now())
+	throttler.Add("ns/b", map[string]string{"team": "blue"}, 2, time.Now())
+	throttler.Add("ns/c", map[string]string{"team": "red"}, 0, time.Now())
+	throttler.Add("ns/d", nil, 0, time.Now())
+	throttler.Add("ns/e", nil, 0, time.Now())
+
+	assert.True(t, throttler.Admit("ns/a"))
+	assert.False(t, throttler.Admit("ns/b"), "team is full")
+	assert.True(t, throttler.Admit("ns/c"), "team has no limit")
+	assert.True(t, throttler.Admit("ns/d"), "lower priority, but not in the full bucket")
+	assert.False(t, throttler.Admit("ns/e"), "global limit reached")
+	assert.Equal(t, stats{1, 1, 1}, bucketStats["label:team/blue"])
+	assert.Equal(t, stats{3, 2, 3}, bucketStats["global/"])
+	queuedKeys = nil
+
+	throttler.Remove("ns/a")
+	assert.True(t, throttler.Admit("ns/b"), "higher priority than ns/e")
+	assert.False(t, throttler.Admit("ns/e"))
+	assert.Equal(t, []string{"ns/b"}, queuedKeys)
+	assert.Equal(t, stats{1, 0, 1}, bucketStats["label:team/blue"])
+	assert.Equal(t, stats{3, 1, 3}, bucketStats["global/"])
+}
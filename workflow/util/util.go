@@ -685,23 +685,138 @@ func retryWorkflow(ctx context.Context, kubeClient kubernetes.Interface, hydrato
 	if err != nil {
 		return nil, err
 	}
+	newWF, err := prepareRetryWorkflow(ctx, kubeClient, hydrator, wfClient, wf, restartSuccessful, nodeFieldSelector)
+	if err != nil {
+		return nil, err
+	}
+	err = hydrator.Dehydrate(newWF)
+	if err != nil {
+		return nil, fmt.Errorf("unable to compress or offload workflow nodes: %s", err)
+	}
+	return wfClient.Update(ctx, newWF, metav1.UpdateOptions{})
+}
+
+// RetryArchivedWorkflow re-creates an archived workflow, deleting all failed steps as well as the onExit node (and children)
+func RetryArchivedWorkflow(ctx context.Context, kubeClient kubernetes.Interface, hydrator hydrator.Interface, wfClient v1alpha1.WorkflowInterface, wf *wfv1.Workflow, restartSuccessful bool, nodeFieldSelector string) (*wfv1.Workflow, error) {
+	// we check the workflow no longer exists before deleting any pod or retrying any child workflow, as they would be
+	// the pods and child workflows of the live workflow
+	_, err := wfClient.Get(ctx, wf.Name, metav1.GetOptions{})
+	if err == nil {
+		return nil, errors.Errorf(errors.CodeBadRequest, "workflow %s still exists, it must be retried using its name rather than from the archive", wf.Name)
+	}
+	if !apierr.IsNotFound(err) {
+		return nil, err
+	}
+	newWF, err := prepareRetryWorkflow(ctx, kubeClient, hydrator, wfClient, wf, restartSuccessful, nodeFieldSelector)
+	if err != nil {
+		return nil, err
+	}
+	// the workflow no longer exists, so we create a new object with the same name
+	newWF.ObjectMeta.ResourceVersion = ""
+	newWF.ObjectMeta.UID = ""
+	newWF.ObjectMeta.SelfLink = ""
+	newWF.ObjectMeta.CreationTimestamp = metav1.Time{}
+	newWF.ObjectMeta.Generation = 0
+	newWF.ObjectMeta.ManagedFields = nil
+	newWF.ObjectMeta.DeletionTimestamp = nil
+	newWF.ObjectMeta.DeletionGracePeriodSeconds = nil
+	newWF.ObjectMeta.Finalizers = nil
+	// the nodes cannot be offloaded, as they would be offloaded under the UID of the archived workflow rather than the
+	// UID of the new object
+	err = packer.CompressWorkflowIfNeeded(newWF)
+	if packer.IsTooLargeError(err) {
+		return nil, errors.Errorf(errors.CodeBadRequest, "workflow %s is too large to be retried from the archive: %v", wf.Name, err)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("unable to compress workflow nodes: %s", err)
+	}
+	created, err := wfClient.Create(ctx, newWF, metav1.CreateOptions{})
+	if apierr.IsAlreadyExists(err) {
+		return nil, errors.Errorf(errors.CodeBadRequest, "workflow %s still exists, it must be retried using its name rather than from the archive", wf.Name)
+	}
+	return created, err
+}
+
+// prepareRetryWorkflow formulates the retried workflow, deletes the pods of the nodes that will be re-run and retries
+// their child workflows
+func prepareRetryWorkflow(ctx context.Context, kubeClient kubernetes.Interface, hydrator hydrator.Interface, wfClient v1alpha1.WorkflowInterface, wf *wfv1.Workflow, restartSuccessful bool, nodeFieldSelector string) (*wfv1.Workflow, error) {
 	switch wf.Status.Phase {
 	case wfv1.WorkflowFailed, wfv1.WorkflowError:
 	default:
 		return nil, errors.Errorf(errors.CodeBadRequest, "workflow must be Failed/Error to retry")
 	}
-	err = hydrator.Hydrate(wf)
+	err := hydrator.Hydrate(wf)
+	if err != nil {
+		return nil, err
+	}
+
+	newWF, podsToDelete, err := FormulateRetryWorkflow(wf, restartSuccessful, nodeFieldSelector)
 	if err != nil {
 		return nil, err
 	}
 
-	newWF := wf.DeepCopy()
 	podIf := kubeClient.CoreV1().Pods(wf.ObjectMeta.Namespace)
+	for _, podName := range podsToDelete {
+		log.Infof("Deleting pod: %s", podName)
+		err := podIf.Delete(ctx, podName, metav1.DeleteOptions{})
+		if err != nil && !apierr.IsNotFound(err) {
+			return nil, errors.InternalWrapError(err)
+		}
+	}
+
+	for _, node := range wf.Status.Nodes {
+		if _, ok := newWF.Status.Nodes[node.ID]; ok || node.Type != wfv1.NodeTypeWorkflow {
+			continue
+		}
+		err := retryChildWorkflow(ctx, kubeClient, hydrator, wfClient, ChildWorkflowName(wf, node.ID), restartSuccessful)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	newWF.Status.StoredTemplates = make(map[string]wfv1.Template)
+	for id, tmpl := range wf.Status.StoredTemplates {
+		newWF.Status.StoredTemplates[id] = tmpl
+	}
+	return newWF, nil
+}
+
+// retryChildWorkflow retries the child workflow of a node which will be re-run. A child workflow which did not fail is
+// deleted, so that it is created again when its node is re-run.
+func retryChildWorkflow(ctx context.Context, kubeClient kubernetes.Interface, hydrator hydrator.Interface, wfClient v1alpha1.WorkflowInterface, name string, restartSuccessful bool) error {
+	child, err := wfClient.Get(ctx, name, metav1.GetOptions{})
+	if apierr.IsNotFound(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	switch child.Status.Phase {
+	case wfv1.WorkflowFailed, wfv1.WorkflowError:
+		log.Infof("Retrying child workflow: %s", name)
+		_, err = RetryWorkflow(ctx, kubeClient, hydrator, wfClient, name, restartSuccessful, "")
+		return err
+	}
+	log.Infof("Deleting child workflow: %s", name)
+	err = wfClient.Delete(ctx, name, metav1.DeleteOptions{})
+	if err != nil && !apierr.IsNotFound(err) {
+		return err
+	}
+	return nil
+}
+
+// FormulateRetryWorkflow formulates a retried workflow from a completed (and hydrated) workflow, resetting the failed
+// nodes. It returns the new workflow and the names of the pods that must be deleted before it is run.
+func FormulateRetryWorkflow(wf *wfv1.Workflow, restartSuccessful bool, nodeFieldSelector string) (*wfv1.Workflow, []string, error) {
+	newWF := wf.DeepCopy()
 
 	// Delete/reset fields which indicate workflow completed
 	delete(newWF.Labels, common.LabelKeyCompleted)
 	delete(newWF.Labels, common.LabelKeyWorkflowArchivingStatus)
 	newWF.Status.Conditions.UpsertCondition(wfv1.Condition{Status: metav1.ConditionFalse, Type: wfv1.ConditionTypeCompleted})
+	if newWF.ObjectMeta.Labels == nil {
+		newWF.ObjectMeta.Labels = make(map[string]string)
+	}
 	newWF.ObjectMeta.Labels[common.LabelKeyPhase] = string(wfv1.NodeRunning)
 	newWF.Status.Phase = wfv1.WorkflowRunning
 	newWF.Status.Nodes = make(wfv1.Nodes)
@@ -717,11 +832,12 @@ func retryWorkflow(ctx context.Context, kubeClient kubernetes.Interface, hydrato
 	// Get all children of nodes that match filter
 	nodeIDsToReset, err := getNodeIDsToReset(restartSuccessful, nodeFieldSelector, wf.Status.Nodes)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	// Iterate the previous nodes. If it was successful Pod carry it forward
 	deletedNodes := make(map[string]bool)
+	var podsToDelete []string
 	for _, node := range wf.Status.Nodes {
 		doForceResetNode := false
 		if _, present := nodeIDsToReset[node.ID]; present {
@@ -748,14 +864,10 @@ func retryWorkflow(ctx context.Context, kubeClient kubernetes.Interface, hydrato
 			// do not add this status to the node. pretend as if this node never existed.
 		default:
 			// Do not allow retry of workflows with pods in Running/Pending phase
-			return nil, errors.InternalErrorf("Workflow cannot be retried with node %s in %s phase", node.Name, node.Phase)
+			return nil, nil, errors.InternalErrorf("Workflow cannot be retried with node %s in %s phase", node.Name, node.Phase)
 		}
 		if node.Type == wfv1.NodeTypePod {
-			log.Infof("Deleting pod: %s", node.ID)
-			err := podIf.Delete(ctx, node.ID, metav1.DeleteOptions{})
-			if err != nil && !apierr.IsNotFound(err) {
-				return nil, errors.InternalWrapError(err)
-			}
+			podsToDelete = append(podsToDelete, node.ID)
 		} else if node.Name == wf.ObjectMeta.Name {
 			newNode := node.DeepCopy()
 			newNode.Phase = wfv1.NodeRunning
@@ -766,6 +878,19 @@ func retryWorkflow(ctx context.Context, kubeClient kubernetes.Interface, hydrato
 		}
 	}
 
+	// the containers of a container set are re-run with their pod
+	for _, node := range wf.Status.Nodes {
+		if node.Type != wfv1.NodeTypePod || !deletedNodes[node.ID] {
+			continue
+		}
+		for _, childID := range node.Children {
+			if child, ok := wf.Status.Nodes[childID]; ok && child.Type == wfv1.NodeTypeContainer {
+				delete(newWF.Status.Nodes, childID)
+				deletedNodes[childID] = true
+			}
+		}
+	}
+
 	if len(deletedNodes) > 0 {
 		for _, node := range newWF.Status.Nodes {
 			var newChildren []string
@@ -788,17 +913,7 @@ func retryWorkflow(ctx context.Context, kubeClient kubernetes.Interface, hydrato
 		}
 	}
 
-	err = hydrator.Dehydrate(newWF)
-	if err != nil {
-		return nil, fmt.Errorf("unable to compress or offload workflow nodes: %s", err)
-	}
-
-	newWF.Status.StoredTemplates = make(map[string]wfv1.Template)
-	for id, tmpl := range wf.Status.StoredTemplates {
-		newWF.Status.StoredTemplates[id] = tmpl
-	}
-
-	return wfClient.Update(ctx, newWF, metav1.UpdateOptions{})
+	return newWF, podsToDelete, nil
 }
 
 func getNodeIDsToReset(restartSuccessful bool, nodeFieldSelector string, nodes wfv1.Nodes) (map[string]bool, error) {
@@ -1025,12 +1140,21 @@ func ValidateJsonStr(jsonStr string, schema interface{}) bool {
 	return err == nil
 }
 
+// ChildWorkflowName returns the name of the child workflow of a node. It is the ID of the node, like for pods, unless
+// the node is the root node, whose ID is the name of the workflow.
+func ChildWorkflowName(wf *wfv1.Workflow, nodeID string) string {
+	if nodeID == wf.Name {
+		return wf.Name + "-child"
+	}
+	return nodeID
+}
+
 func GetNodeType(tmpl *wfv1.Template) wfv1.NodeType {
 	if tmpl.RetryStrategy != nil {
 		return wfv1.NodeTypeRetry
 	}
 	switch tmpl.GetType() {
-	case wfv1.TemplateTypeContainer, wfv1.TemplateTypeScript, wfv1.TemplateTypeResource:
+	case wfv1.TemplateTypeContainer, wfv1.TemplateTypeScript, wfv1.TemplateTypeResource, wfv1.TemplateTypeContainerSet, wfv1.TemplateTypeData:
 		return wfv1.NodeTypePod
 	case wfv1.TemplateTypeDAG:
 		return wfv1.NodeTypeDAG
@@ -1038,6 +1162,10 @@ func GetNodeType(tmpl *wfv1.Template) wfv1.NodeType {
 		return wfv1.NodeTypeSteps
 	case wfv1.TemplateTypeSuspend:
 		return wfv1.NodeTypeSuspend
+	case wfv1.TemplateTypeHTTP:
+		return wfv1.NodeTypeHTTP
+	case wfv1.TemplateTypeWorkflow:
+		return wfv1.NodeTypeWorkflow
 	}
 	return ""
 }
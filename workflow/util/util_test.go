@@ -10,6 +10,7 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	v1 "k8s.io/api/core/v1"
+	apierr "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	kubefake "k8s.io/client-go/kubernetes/fake"
@@ -20,6 +21,7 @@ import (
 	argofake "github.com/argoproj/argo/v2/pkg/client/clientset/versioned/fake"
 	"github.com/argoproj/argo/v2/workflow/common"
 	hydratorfake "github.com/argoproj/argo/v2/workflow/hydrator/fake"
+	"github.com/argoproj/argo/v2/workflow/packer"
 )
 
 // TestSubmitDryRun
@@ -772,6 +774,59 @@ func TestRetryWorkflow(t *testing.T) {
 	}
 }
 
+func TestRetryArchivedWorkflow(t *testing.T) {
+	kubeClient := kubefake.NewSimpleClientset()
+	wfClient := argofake.NewSimpleClientset().ArgoprojV1alpha1().Workflows("my-ns")
+	newArchivedWf := func() *wfv1.Workflow {
+		return &wfv1.Workflow{
+			ObjectMeta: metav1.ObjectMeta{Name: "my-wf", Namespace: "my-ns", UID: "archived-uid"},
+			Status: wfv1.WorkflowStatus{
+				Phase: wfv1.WorkflowFailed,
+				Nodes: wfv1.Nodes{
+					"my-wf":   {ID: "my-wf", Name: "my-wf", Type: wfv1.NodeTypeSteps, Phase: wfv1.NodeFailed, Children: []string{"my-wf-1"}},
+					"my-wf-1": {ID: "my-wf-1", Name: "my-wf[0].succeeded", Type: wfv1.NodeTypePod, Phase: wfv1.NodeSucceeded, BoundaryID: "my-wf"},
+				},
+			},
+		}
+	}
+	ctx := context.Background()
+
+	t.Run("NotOffloaded", func(t *testing.T) {
+		wf, err := RetryArchivedWorkflow(ctx, kubeClient, hydratorfake.Always, wfClient, newArchivedWf(), false, "")
+		if assert.NoError(t, err) {
+			assert.Empty(t, wf.Status.OffloadNodeStatusVersion)
+			assert.Contains(t, wf.Status.Nodes, "my-wf-1")
+		}
+		assert.NoError(t, wfClient.Delete(ctx, "my-wf", metav1.DeleteOptions{}))
+	})
+	t.Run("TooLarge", func(t *testing.T) {
+		defer packer.SetMaxWorkflowSize(10)()
+		_, err := RetryArchivedWorkflow(ctx, kubeClient, hydratorfake.Always, wfClient, newArchivedWf(), false, "")
+		if assert.Error(t, err) {
+			assert.Contains(t, err.Error(), "workflow my-wf is too large to be retried from the archive")
+		}
+	})
+	t.Run("StillExists", func(t *testing.T) {
+		wf := newArchivedWf()
+		wf.Status.Nodes["my-wf-2"] = wfv1.NodeStatus{ID: "my-wf-2", Name: "my-wf[0].failed", Type: wfv1.NodeTypePod, Phase: wfv1.NodeFailed, BoundaryID: "my-wf"}
+		wf.Status.Nodes["my-wf-3"] = wfv1.NodeStatus{ID: "my-wf-3", Name: "my-wf[0].child", Type: wfv1.NodeTypeWorkflow, Phase: wfv1.NodeFailed, BoundaryID: "my-wf"}
+		_, err := wfClient.Create(ctx, wf, metav1.CreateOptions{})
+		assert.NoError(t, err)
+		defer func() { _ = wfClient.Delete(ctx, "my-wf", metav1.DeleteOptions{}) }()
+		_, err = kubeClient.CoreV1().Pods("my-ns").Create(ctx, &v1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "my-wf-2"}}, metav1.CreateOptions{})
+		assert.NoError(t, err)
+		_, err = wfClient.Create(ctx, &wfv1.Workflow{ObjectMeta: metav1.ObjectMeta{Name: "my-wf-3"}, Status: wfv1.WorkflowStatus{Phase: wfv1.WorkflowSucceeded}}, metav1.CreateOptions{})
+		assert.NoError(t, err)
+
+		_, err = RetryArchivedWorkflow(ctx, kubeClient, hydratorfake.Always, wfClient, wf, false, "")
+		assert.EqualError(t, err, "workflow my-wf still exists, it must be retried using its name rather than from the archive")
+		_, err = kubeClient.CoreV1().Pods("my-ns").Get(ctx, "my-wf-2", metav1.GetOptions{})
+		assert.NoError(t, err, "the pod of the live workflow is not deleted")
+		_, err = wfClient.Get(ctx, "my-wf-3", metav1.GetOptions{})
+		assert.NoError(t, err, "the child workflow of the live workflow is not deleted")
+	})
+}
+
 func TestFromUnstructuredObj(t *testing.T) {
 	un := &unstructured.Unstructured{}
 	err := yaml.Unmarshal([]byte(`apiVersion: argoproj.io/v1alpha1
@@ -805,3 +860,44 @@ func TestToUnstructured(t *testing.T) {
 		assert.Equal(t, workflow.Version, gv.Version)
 	}
 }
+
+func TestRetryWorkflowChildWorkflows(t *testing.T) {
+	kubeClient := kubefake.NewSimpleClientset()
+	wfClient := argofake.NewSimpleClientset().ArgoprojV1alpha1().Workflows("my-ns")
+	wf := &wfv1.Workflow{
+		ObjectMeta: metav1.ObjectMeta{Name: "parent", Namespace: "my-ns"},
+		Status: wfv1.WorkflowStatus{
+			Phase: wfv1.WorkflowFailed,
+			Nodes: wfv1.Nodes{
+				"parent":   {ID: "parent", Name: "parent", Type: wfv1.NodeTypeSteps, Phase: wfv1.NodeFailed, Children: []string{"parent-1", "parent-2"}},
+				"parent-1": {ID: "parent-1", Name: "parent[0].failed", Type: wfv1.NodeTypeWorkflow, Phase: wfv1.NodeFailed, BoundaryID: "parent"},
+				"parent-2": {ID: "parent-2", Name: "parent[0].missing-output", Type: wfv1.NodeTypeWorkflow, Phase: wfv1.NodeFailed, BoundaryID: "parent"},
+			},
+		},
+	}
+	ctx := context.Background()
+	for _, child := range []*wfv1.Workflow{
+		{ObjectMeta: metav1.ObjectMeta{Name: "parent-1"}, Status: wfv1.WorkflowStatus{Phase: wfv1.WorkflowFailed}},
+		{ObjectMeta: metav1.ObjectMeta{Name: "parent-2"}, Status: wfv1.WorkflowStatus{Phase: wfv1.WorkflowSucceeded}},
+		wf,
+	} {
+		_, err := wfClient.Create(ctx, child, metav1.CreateOptions{})
+		assert.NoError(t, err)
+	}
+
+	_, err := RetryWorkflow(ctx, kubeClient, hydratorfake.Always, wfClient, "parent", false, "")
+	if assert.NoError(t, err) {
+		child, err := wfClient.Get(ctx, "parent-1", metav1.GetOptions{})
+		if assert.NoError(t, err) {
+			assert.Equal(t, wfv1.WorkflowRunning, child.Status.Phase)
+		}
+		_, err = wfClient.Get(ctx, "parent-2", metav1.GetOptions{})
+		assert.True(t, apierr.IsNotFound(err))
+	}
+}
+
+func TestChildWorkflowName(t *testing.T) {
+	wf := &wfv1.Workflow{ObjectMeta: metav1.ObjectMeta{Name: "parent"}}
+	assert.Equal(t, "parent-child", ChildWorkflowName(wf, "parent"))
+	assert.Equal(t, "parent-123", ChildWorkflowName(wf, "parent-123"))
+}
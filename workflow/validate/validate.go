@@ -4,15 +4,18 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"net/http"
 	"reflect"
 	"regexp"
 	"strconv"
 	"strings"
 	"time"
 
+	"github.com/antonmedv/expr"
 	"github.com/robfig/cron/v3"
 	"github.com/sirupsen/logrus"
 	"github.com/valyala/fasttemplate"
+	apierr "k8s.io/apimachinery/pkg/api/errors"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	apivalidation "k8s.io/apimachinery/pkg/util/validation"
 	"sigs.k8s.io/yaml"
@@ -184,6 +187,13 @@ func ValidateWorkflow(wftmplGetter templateresolution.WorkflowTemplateNamespaced
 		return nil, errors.New(errors.CodeBadRequest, "spec.entrypoint is required")
 	}
 
+	if wf.Spec.Synchronization != nil {
+		err = ctx.validateSynchronization(wf.Spec.Synchronization)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	// Make sure that templates are not defined with deprecated fields
 	for _, template := range wf.Spec.Templates {
 		if !template.Arguments.IsEmpty() {
@@ -237,6 +247,11 @@ func ValidateWorkflow(wftmplGetter templateresolution.WorkflowTemplateNamespaced
 		}
 	}
 
+	err = ctx.validateLifecycleHooks(wf.Spec.Hooks, tmplCtx, "")
+	if err != nil {
+		return nil, err
+	}
+
 	if wf.Spec.PodGC != nil {
 		switch wf.Spec.PodGC.Strategy {
 		case wfv1.PodGCOnPodCompletion, wfv1.PodGCOnPodSuccess, wfv1.PodGCOnWorkflowCompletion, wfv1.PodGCOnWorkflowSuccess:
@@ -255,6 +270,24 @@ func ValidateWorkflow(wftmplGetter templateresolution.WorkflowTemplateNamespaced
 	return wfConditions, nil
 }
 
+// validateSynchronization ensures the names of the workflow's locks only use global variables, which are the only
+// variables available when the workflow acquires its locks
+func (ctx *templateValidationCtx) validateSynchronization(synchronization *wfv1.Synchronization) error {
+	synchronizationBytes, err := json.Marshal(synchronization)
+	if err != nil {
+		return errors.InternalWrapError(err)
+	}
+	scope := make(map[string]interface{})
+	for globalVar, val := range ctx.globalParams {
+		scope[globalVar] = val
+	}
+	err = resolveAllVariables(scope, string(synchronizationBytes))
+	if err != nil {
+		return errors.Errorf(errors.CodeBadRequest, "spec.synchronization %s", err.Error())
+	}
+	return nil
+}
+
 func ValidateWorkflowTemplateRefFields(wfSpec wfv1.WorkflowSpec) error {
 	if len(wfSpec.Templates) > 0 {
 		return errors.Errorf(errors.CodeBadRequest, "Templates is invalid field in spec if workflow referred WorkflowTemplate reference")
@@ -376,6 +409,11 @@ func (ctx *templateValidationCtx) validateTemplate(tmpl *wfv1.Template, tmplCtx
 	if tmpl.RetryStrategy != nil {
 		localParams[common.LocalVarRetries] = placeholderGenerator.NextPlaceholder()
 		scope[common.LocalVarRetries] = placeholderGenerator.NextPlaceholder()
+		// only resolved when retryStrategy.expression is evaluated
+		scope[common.LocalVarRetriesLastExitCode] = true
+		scope[common.LocalVarRetriesLastStatus] = true
+		scope[common.LocalVarRetriesLastDuration] = true
+		scope[common.LocalVarRetriesLastMessage] = true
 	}
 	if tmpl.IsLeaf() {
 		for _, art := range tmpl.Outputs.Artifacts {
@@ -407,6 +445,10 @@ func (ctx *templateValidationCtx) validateTemplate(tmpl *wfv1.Template, tmplCtx
 
 	}
 
+	if newTmpl.Memoize != nil && newTmpl.Memoize.Cache.GetType() == "" {
+		return errors.Errorf(errors.CodeBadRequest, "templates.%s.memoize.cache must specify one of configMap, sql or artifact", newTmpl.Name)
+	}
+
 	tmplID := getTemplateID(tmpl)
 	_, ok := ctx.results[tmplID]
 	if ok {
@@ -423,6 +465,8 @@ func (ctx *templateValidationCtx) validateTemplate(tmpl *wfv1.Template, tmplCtx
 		err = ctx.validateSteps(scope, tmplCtx, newTmpl)
 	case wfv1.TemplateTypeDAG:
 		err = ctx.validateDAG(scope, tmplCtx, newTmpl)
+	case wfv1.TemplateTypeWorkflow:
+		err = ctx.validateChildWorkflow(scope, tmplCtx, newTmpl)
 	default:
 		err = ctx.validateLeaf(scope, newTmpl)
 	}
@@ -521,7 +565,7 @@ func (ctx *templateValidationCtx) validateTemplateHolder(tmplHolder wfv1.Templat
 // validateTemplateType validates that only one template type is defined
 func validateTemplateType(tmpl *wfv1.Template) error {
 	numTypes := 0
-	for _, tmplType := range []interface{}{tmpl.TemplateRef, tmpl.Container, tmpl.Steps, tmpl.Script, tmpl.Resource, tmpl.DAG, tmpl.Suspend} {
+	for _, tmplType := range []interface{}{tmpl.TemplateRef, tmpl.Container, tmpl.Steps, tmpl.Script, tmpl.Resource, tmpl.DAG, tmpl.Suspend, tmpl.HTTP, tmpl.ContainerSet, tmpl.Data, tmpl.Workflow} {
 		if !reflect.ValueOf(tmplType).IsNil() {
 			numTypes++
 		}
@@ -531,11 +575,11 @@ func validateTemplateType(tmpl *wfv1.Template) error {
 	}
 	switch numTypes {
 	case 0:
-		return errors.Errorf(errors.CodeBadRequest, "templates.%s template type unspecified. choose one of: container, steps, script, resource, dag, suspend, template, template ref", tmpl.Name)
+		return errors.Errorf(errors.CodeBadRequest, "templates.%s template type unspecified. choose one of: container, steps, script, resource, dag, suspend, http, containerSet, data, workflow, template, template ref", tmpl.Name)
 	case 1:
 		// Do nothing
 	default:
-		return errors.Errorf(errors.CodeBadRequest, "templates.%s multiple template types specified. choose one of: container, steps, script, resource, dag, suspend, template, template ref", tmpl.Name)
+		return errors.Errorf(errors.CodeBadRequest, "templates.%s multiple template types specified. choose one of: container, steps, script, resource, dag, suspend, http, containerSet, data, workflow, template, template ref", tmpl.Name)
 	}
 	return nil
 }
@@ -704,6 +748,24 @@ func (ctx *templateValidationCtx) validateLeaf(scope map[string]interface{}, tmp
 			return errors.Errorf(errors.CodeBadRequest, "templates.%s.script.image may not be empty", tmpl.Name)
 		}
 	}
+	if tmpl.HTTP != nil {
+		err = validateHTTP(tmpl)
+		if err != nil {
+			return err
+		}
+	}
+	if tmpl.ContainerSet != nil {
+		err = validateContainerSet(tmpl)
+		if err != nil {
+			return err
+		}
+	}
+	if tmpl.Data != nil {
+		err = validateData(tmpl)
+		if err != nil {
+			return err
+		}
+	}
 	if tmpl.ActiveDeadlineSeconds != nil {
 		if !intstr.IsValidIntOrArgoVariable(tmpl.ActiveDeadlineSeconds) && !placeholderGenerator.IsPlaceholder(tmpl.ActiveDeadlineSeconds.StrVal) {
 			return errors.Errorf(errors.CodeBadRequest, "templates.%s.activeDeadlineSeconds must be a positive integer > 0 or an argo variable", tmpl.Name)
@@ -733,6 +795,187 @@ func (ctx *templateValidationCtx) validateLeaf(scope map[string]interface{}, tmp
 	return nil
 }
 
+func validateHTTP(tmpl *wfv1.Template) error {
+	if tmpl.HTTP.URL == "" {
+		return errors.Errorf(errors.CodeBadRequest, "templates.%s.http.url may not be empty", tmpl.Name)
+	}
+	if !placeholderGenerator.IsPlaceholder(tmpl.HTTP.Method) {
+		switch tmpl.HTTP.Method {
+		case "", http.MethodGet, http.MethodHead, http.MethodPost, http.MethodPut, http.MethodPatch, http.MethodDelete, http.MethodOptions:
+			// OK
+		default:
+			return errors.Errorf(errors.CodeBadRequest, "templates.%s.http.method must be one of: GET, HEAD, POST, PUT, PATCH, DELETE, OPTIONS", tmpl.Name)
+		}
+	}
+	for i, header := range tmpl.HTTP.Headers {
+		if header.Name == "" {
+			return errors.Errorf(errors.CodeBadRequest, "templates.%s.http.headers[%d].name may not be empty", tmpl.Name, i)
+		}
+		if header.ValueFrom != nil && (header.ValueFrom.SecretKeyRef == nil || header.ValueFrom.SecretKeyRef.Name == "" || header.ValueFrom.SecretKeyRef.Key == "") {
+			return errors.Errorf(errors.CodeBadRequest, "templates.%s.http.headers[%d].valueFrom.secretKeyRef must have a name and a key", tmpl.Name, i)
+		}
+	}
+	if tmpl.HTTP.TimeoutSeconds != nil && *tmpl.HTTP.TimeoutSeconds <= 0 {
+		return errors.Errorf(errors.CodeBadRequest, "templates.%s.http.timeoutSeconds must be a positive integer > 0", tmpl.Name)
+	}
+	if tmpl.HTTP.SuccessCondition != "" && !strings.Contains(tmpl.HTTP.SuccessCondition, "{{") {
+		if _, err := expr.Compile(tmpl.HTTP.SuccessCondition); err != nil {
+			return errors.Errorf(errors.CodeBadRequest, "templates.%s.http.successCondition is not a valid expression: %v", tmpl.Name, err)
+		}
+	}
+	for _, param := range tmpl.Outputs.Parameters {
+		if param.ValueFrom != nil {
+			return errors.Errorf(errors.CodeBadRequest, "templates.%s.outputs.parameters.%s.valueFrom is not supported for http templates", tmpl.Name, param.Name)
+		}
+	}
+	return nil
+}
+
+func validateContainerSet(tmpl *wfv1.Template) error {
+	containerSet := tmpl.ContainerSet
+	if len(containerSet.Containers) == 0 {
+		return errors.Errorf(errors.CodeBadRequest, "templates.%s.containerSet.containers must have at least one container", tmpl.Name)
+	}
+	names := make(map[string]bool)
+	for i, ctr := range containerSet.Containers {
+		switch ctr.Name {
+		case "":
+			return errors.Errorf(errors.CodeBadRequest, "templates.%s.containerSet.containers[%d].name may not be empty", tmpl.Name, i)
+		case common.WaitContainerName, common.InitContainerName:
+			return errors.Errorf(errors.CodeBadRequest, "templates.%s.containerSet.containers[%d].name '%s' is reserved", tmpl.Name, i, ctr.Name)
+		}
+		if names[ctr.Name] {
+			return errors.Errorf(errors.CodeBadRequest, "templates.%s.containerSet.containers[%d].name '%s' is not unique", tmpl.Name, i, ctr.Name)
+		}
+		names[ctr.Name] = true
+		if ctr.Image == "" {
+			return errors.Errorf(errors.CodeBadRequest, "templates.%s.containerSet.containers.%s.image may not be empty", tmpl.Name, ctr.Name)
+		}
+		// the command is run by the executor, which cannot know the entrypoint of the image
+		if len(ctr.Command) == 0 {
+			return errors.Errorf(errors.CodeBadRequest, "templates.%s.containerSet.containers.%s.command may not be empty", tmpl.Name, ctr.Name)
+		}
+	}
+	if !names[common.MainContainerName] {
+		return errors.Errorf(errors.CodeBadRequest, "templates.%s.containerSet.containers must have a container named '%s'", tmpl.Name, common.MainContainerName)
+	}
+	dependencies := make(map[string][]string)
+	for _, ctr := range containerSet.Containers {
+		for _, dependency := range ctr.Dependencies {
+			if !names[dependency] {
+				return errors.Errorf(errors.CodeBadRequest, "templates.%s.containerSet.containers.%s.dependencies '%s' is not a container of the set", tmpl.Name, ctr.Name, dependency)
+			}
+		}
+		dependencies[ctr.Name] = ctr.Dependencies
+	}
+	// the containers must not wait for each other
+	visited := make(map[string]bool)
+	var visit func(name string, path []string) error
+	visit = func(name string, path []string) error {
+		for i, prev := range path {
+			if prev == name {
+				return errors.Errorf(errors.CodeBadRequest, "templates.%s.containerSet.containers have a dependency cycle: %s", tmpl.Name, strings.Join(append(path[i:], name), " -> "))
+			}
+		}
+		if visited[name] {
+			return nil
+		}
+		for _, dependency := range dependencies[name] {
+			if err := visit(dependency, append(path, name)); err != nil {
+				return err
+			}
+		}
+		visited[name] = true
+		return nil
+	}
+	for _, ctr := range containerSet.Containers {
+		if err := visit(ctr.Name, nil); err != nil {
+			return err
+		}
+	}
+	for _, ctr := range containerSet.Containers {
+		for _, param := range ctr.Outputs.Parameters {
+			if param.ValueFrom == nil || param.ValueFrom.Path == "" {
+				return errors.Errorf(errors.CodeBadRequest, "templates.%s.containerSet.containers.%s.outputs.parameters.%s must specify valueFrom.path", tmpl.Name, ctr.Name, param.Name)
+			}
+		}
+		for _, art := range ctr.Outputs.Artifacts {
+			if art.Path == "" {
+				return errors.Errorf(errors.CodeBadRequest, "templates.%s.containerSet.containers.%s.outputs.artifacts.%s must specify path", tmpl.Name, ctr.Name, art.Name)
+			}
+		}
+	}
+	return nil
+}
+
+func validateData(tmpl *wfv1.Template) error {
+	source := tmpl.Data.Source
+	if source.ArtifactPaths == nil {
+		return errors.Errorf(errors.CodeBadRequest, "templates.%s.data.source must specify artifactPaths", tmpl.Name)
+	}
+	if !source.ArtifactPaths.HasLocationOrKey() {
+		return errors.Errorf(errors.CodeBadRequest, "templates.%s.data.source.artifactPaths must specify a location or a key", tmpl.Name)
+	}
+	for i, step := range tmpl.Data.Transformation {
+		if step.Expression == "" {
+			return errors.Errorf(errors.CodeBadRequest, "templates.%s.data.transformation[%d].expression may not be empty", tmpl.Name, i)
+		}
+		if !strings.Contains(step.Expression, "{{") {
+			if _, err := expr.Compile(step.Expression); err != nil {
+				return errors.Errorf(errors.CodeBadRequest, "templates.%s.data.transformation[%d].expression is not a valid expression: %v", tmpl.Name, i, err)
+			}
+		}
+	}
+	// the data is the only output, there is no container to collect others from
+	for _, param := range tmpl.Outputs.Parameters {
+		if param.ValueFrom != nil {
+			return errors.Errorf(errors.CodeBadRequest, "templates.%s.outputs.parameters.%s.valueFrom is not supported for data templates", tmpl.Name, param.Name)
+		}
+	}
+	if len(tmpl.Outputs.Artifacts) > 0 {
+		return errors.Errorf(errors.CodeBadRequest, "templates.%s.outputs.artifacts are not supported for data templates", tmpl.Name)
+	}
+	return nil
+}
+
+func (ctx *templateValidationCtx) validateChildWorkflow(scope map[string]interface{}, tmplCtx *templateresolution.Context, tmpl *wfv1.Template) error {
+	err := ctx.validateLeaf(scope, tmpl)
+	if err != nil {
+		return err
+	}
+	ref := tmpl.Workflow.WorkflowTemplateRef
+	if ref == nil || ref.Name == "" {
+		return errors.Errorf(errors.CodeBadRequest, "templates.%s.workflow.workflowTemplateRef.name is required", tmpl.Name)
+	}
+	if !placeholderGenerator.IsPlaceholder(ref.Name) && !strings.Contains(ref.Name, "{{") {
+		if ref.ClusterScope {
+			_, err = tmplCtx.WithClusterWorkflowTemplate(ref.Name)
+		} else {
+			_, err = tmplCtx.WithWorkflowTemplate(ref.Name)
+		}
+		if err != nil {
+			if apierr.IsNotFound(err) {
+				return errors.Errorf(errors.CodeBadRequest, "templates.%s.workflow.workflowTemplateRef %s not found", tmpl.Name, ref.Name)
+			}
+			return err
+		}
+	}
+	err = validateArguments(fmt.Sprintf("templates.%s.workflow.arguments.", tmpl.Name), tmpl.Workflow.Arguments)
+	if err != nil {
+		return err
+	}
+	// the outputs are the global outputs of the child workflow, there is no container to collect others from
+	for _, param := range tmpl.Outputs.Parameters {
+		if param.ValueFrom != nil {
+			return errors.Errorf(errors.CodeBadRequest, "templates.%s.outputs.parameters.%s.valueFrom is not supported for workflow templates", tmpl.Name, param.Name)
+		}
+		if param.Value != nil {
+			return errors.Errorf(errors.CodeBadRequest, "templates.%s.outputs.parameters.%s.value is not supported for workflow templates", tmpl.Name, param.Name)
+		}
+	}
+	return nil
+}
+
 func validateArguments(prefix string, arguments wfv1.Arguments) error {
 	err := validateArgumentsFieldNames(prefix, arguments)
 	if err != nil {
@@ -815,6 +1058,10 @@ func (ctx *templateValidationCtx) validateSteps(scope map[string]interface{}, tm
 			if err != nil {
 				return err
 			}
+			err = ctx.validateLifecycleHooks(step.Hooks, tmplCtx, fmt.Sprintf("templates.%s.steps[%d].%s.", tmpl.Name, i, step.Name))
+			if err != nil {
+				return err
+			}
 			resolvedTmpl, err := ctx.validateTemplateHolder(&step, tmplCtx, &FakeArguments{})
 			if err != nil {
 				return errors.Errorf(errors.CodeBadRequest, "templates.%s.steps[%d].%s %s", tmpl.Name, i, step.Name, err.Error())
@@ -846,6 +1093,33 @@ func (ctx *templateValidationCtx) validateSteps(scope map[string]interface{}, tm
 	return nil
 }
 
+// validateLifecycleHooks validates the hooks of the workflow, a step or a task, prefix being the path of their holder
+func (ctx *templateValidationCtx) validateLifecycleHooks(hooks map[string]wfv1.LifecycleHook, tmplCtx *templateresolution.Context, prefix string) error {
+	for name, hook := range hooks {
+		if errs := isValidWorkflowFieldName(name); len(errs) != 0 {
+			return errors.Errorf(errors.CodeBadRequest, "%shooks.%s name is invalid: %s", prefix, name, strings.Join(errs, ";"))
+		}
+		if hook.Template == "" {
+			return errors.Errorf(errors.CodeBadRequest, "%shooks.%s.template is required", prefix, name)
+		}
+		if hook.Expression == "" {
+			return errors.Errorf(errors.CodeBadRequest, "%shooks.%s.expression is required", prefix, name)
+		}
+		if _, err := expr.Compile(hook.Expression); err != nil {
+			return errors.Errorf(errors.CodeBadRequest, "%shooks.%s.expression is not a valid expression: %v", prefix, name, err)
+		}
+		err := validateArguments(fmt.Sprintf("%shooks.%s.arguments.", prefix, name), hook.Arguments)
+		if err != nil {
+			return err
+		}
+		_, err = ctx.validateTemplateHolder(&wfv1.WorkflowStep{Template: hook.Template}, tmplCtx, &FakeArguments{})
+		if err != nil {
+			return errors.Errorf(errors.CodeBadRequest, "%shooks.%s %s", prefix, name, err.Error())
+		}
+	}
+	return nil
+}
+
 func addItemsToScope(prefix string, withItems []wfv1.Item, withParam string, withSequence *wfv1.Sequence, scope map[string]interface{}) error {
 	defined := 0
 	if len(withItems) > 0 {
@@ -896,10 +1170,17 @@ func (ctx *templateValidationCtx) addOutputsToScope(tmpl *wfv1.Template, prefix
 	if tmpl.Daemon != nil && *tmpl.Daemon {
 		scope[fmt.Sprintf("%s.ip", prefix)] = true
 	}
-	if tmpl.Script != nil || tmpl.Container != nil {
+	if tmpl.Script != nil || tmpl.Container != nil || tmpl.ContainerSet != nil {
 		scope[fmt.Sprintf("%s.outputs.result", prefix)] = true
 		scope[fmt.Sprintf("%s.exitCode", prefix)] = true
 	}
+	if tmpl.HTTP != nil {
+		scope[fmt.Sprintf("%s.outputs.result", prefix)] = true
+		scope[fmt.Sprintf("%s.outputs.parameters.statusCode", prefix)] = true
+	}
+	if tmpl.Data != nil {
+		scope[fmt.Sprintf("%s.outputs.result", prefix)] = true
+	}
 	for _, param := range tmpl.Outputs.Parameters {
 		scope[fmt.Sprintf("%s.outputs.parameters.%s", prefix, param.Name)] = true
 		if param.GlobalName != "" {
@@ -980,14 +1261,17 @@ func validateOutputs(scope map[string]interface{}, tmpl *wfv1.Template) error {
 	}
 	for _, param := range tmpl.Outputs.Parameters {
 		paramRef := fmt.Sprintf("templates.%s.outputs.parameters.%s", tmpl.Name, param.Name)
-		err = validateOutputParameter(paramRef, &param)
-		if err != nil {
-			return err
+		// the output parameters of workflow templates are the global outputs of their child workflow of the same name
+		if tmpl.GetType() != wfv1.TemplateTypeWorkflow {
+			err = validateOutputParameter(paramRef, &param)
+			if err != nil {
+				return err
+			}
 		}
 		if param.ValueFrom != nil {
 			tmplType := tmpl.GetType()
 			switch tmplType {
-			case wfv1.TemplateTypeContainer, wfv1.TemplateTypeScript:
+			case wfv1.TemplateTypeContainer, wfv1.TemplateTypeScript, wfv1.TemplateTypeContainerSet:
 				if param.ValueFrom.Path == "" {
 					return errors.Errorf(errors.CodeBadRequest, "%s.path must be specified for %s templates", paramRef, tmplType)
 				}
@@ -1248,6 +1532,11 @@ func (ctx *templateValidationCtx) validateDAG(scope map[string]interface{}, tmpl
 			return errors.Errorf(errors.CodeBadRequest, "templates.%s.tasks.%s %s", tmpl.Name, task.Name, err.Error())
 		}
 
+		err = ctx.validateLifecycleHooks(task.Hooks, tmplCtx, fmt.Sprintf("templates.%s.tasks.%s.", tmpl.Name, task.Name))
+		if err != nil {
+			return err
+		}
+
 		for depName, depType := range dagValidationCtx.GetTaskDependenciesWithDependencyTypes(task.Name) {
 			task, ok := dagValidationCtx.tasks[depName]
 			if !ok {
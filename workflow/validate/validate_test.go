@@ -2,6 +2,7 @@ package validate
 
 import (
 	"context"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -2927,3 +2928,406 @@ func TestValidActiveDeadlineSecondsArgoVariable(t *testing.T) {
 	err := validateWorkflowTemplate(validActiveDeadlineSecondsArgoVariable)
 	assert.NoError(t, err)
 }
+
+var memoizeNoCache = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  generateName: memoize-
+spec:
+  entrypoint: main
+  templates:
+  - name: main
+    memoize:
+      key: my-key
+      cache: {}
+    container:
+      image: argoproj/argosay:v2
+`
+
+func TestMemoizeCache(t *testing.T) {
+	_, err := validate(memoizeNoCache)
+	if assert.Error(t, err) {
+		assert.Contains(t, err.Error(), "memoize.cache must specify one of configMap, sql or artifact")
+	}
+	wf := strings.Replace(memoizeNoCache, "cache: {}", "cache: {sql: {name: my-cache}}", 1)
+	_, err = validate(wf)
+	assert.NoError(t, err)
+}
+
+var retryStrategyExpression = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  generateName: retry-expression-
+spec:
+  entrypoint: main
+  templates:
+  - name: main
+    retryStrategy:
+      limit: 3
+      expression: "{{lastRetry.exitCode}} == 137 && {{lastRetry.status}} == Failed && {{lastRetry.duration}} < 60 && '{{lastRetry.message}}' != ''"
+    container:
+      image: argoproj/argosay:v2
+`
+
+func TestRetryStrategyExpression(t *testing.T) {
+	_, err := validate(retryStrategyExpression)
+	assert.NoError(t, err)
+	_, err = validate(strings.Replace(retryStrategyExpression, "lastRetry.exitCode", "lastRetry.foo", 1))
+	if assert.Error(t, err) {
+		assert.Contains(t, err.Error(), "failed to resolve {{lastRetry.foo}}")
+	}
+	// lastRetry is only available to templates with a retryStrategy
+	_, err = validate(strings.Replace(retryStrategyExpression, "      image: argoproj/argosay:v2", "      image: argoproj/argosay:v2\n  - name: other\n    container:\n      image: argoproj/argosay:v2\n      args: ['{{lastRetry.exitCode}}']", 1))
+	if assert.Error(t, err) {
+		assert.Contains(t, err.Error(), "failed to resolve {{lastRetry.exitCode}}")
+	}
+}
+
+var parameterisedMutex = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  generateName: parameterised-mutex-
+spec:
+  entrypoint: main
+  arguments:
+    parameters:
+    - name: env
+      value: dev
+  synchronization:
+    mutex:
+      name: "deploy-{{workflow.parameters.env}}"
+  templates:
+  - name: main
+    inputs:
+      parameters:
+      - name: env
+        value: prod
+    synchronization:
+      mutex:
+        name: "deploy-{{inputs.parameters.env}}"
+    container:
+      image: argoproj/argosay:v2
+`
+
+func TestParameterisedMutex(t *testing.T) {
+	_, err := validate(parameterisedMutex)
+	assert.NoError(t, err)
+	// only global variables are available when the workflow acquires its locks
+	_, err = validate(strings.Replace(parameterisedMutex, "deploy-{{workflow.parameters.env}}", "deploy-{{inputs.parameters.env}}", 1))
+	if assert.Error(t, err) {
+		assert.Contains(t, err.Error(), "spec.synchronization failed to resolve {{inputs.parameters.env}}")
+	}
+	_, err = validate(strings.Replace(parameterisedMutex, "deploy-{{inputs.parameters.env}}", "deploy-{{inputs.parameters.foo}}", 1))
+	if assert.Error(t, err) {
+		assert.Contains(t, err.Error(), "failed to resolve {{inputs.parameters.foo}}")
+	}
+}
+
+var httpTemplate = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  generateName: http-
+spec:
+  entrypoint: main
+  templates:
+  - name: main
+    steps:
+    - - name: call
+        template: call
+        arguments:
+          parameters:
+          - name: id
+            value: "1"
+    - - name: print
+        template: print
+        arguments:
+          parameters:
+          - name: body
+            value: "{{steps.call.outputs.result}}"
+          - name: status-code
+            value: "{{steps.call.outputs.parameters.statusCode}}"
+  - name: call
+    inputs:
+      parameters:
+      - name: id
+    http:
+      method: POST
+      url: "https://example.com/items/{{inputs.parameters.id}}"
+      headers:
+      - name: Authorization
+        valueFrom:
+          secretKeyRef:
+            name: my-secret
+            key: token
+      body: '{"id": {{inputs.parameters.id}}}'
+      timeoutSeconds: 10
+      successCondition: response.statusCode == 201
+  - name: print
+    inputs:
+      parameters:
+      - name: body
+      - name: status-code
+    container:
+      image: argoproj/argosay:v2
+      args: [echo, "{{inputs.parameters.body}}"]
+`
+
+func TestHTTPTemplate(t *testing.T) {
+	_, err := validate(httpTemplate)
+	assert.NoError(t, err)
+	_, err = validate(strings.Replace(httpTemplate, `      url: "https://example.com/items/{{inputs.parameters.id}}"`, "", 1))
+	assert.EqualError(t, err, "templates.main.steps[0].call templates.call.http.url may not be empty")
+	_, err = validate(strings.Replace(httpTemplate, "method: POST", "method: FOO", 1))
+	assert.EqualError(t, err, "templates.main.steps[0].call templates.call.http.method must be one of: GET, HEAD, POST, PUT, PATCH, DELETE, OPTIONS")
+	_, err = validate(strings.Replace(httpTemplate, "          secretKeyRef:\n            name: my-secret\n", "          secretKeyRef:\n", 1))
+	assert.EqualError(t, err, "templates.main.steps[0].call templates.call.http.headers[0].valueFrom.secretKeyRef must have a name and a key")
+	_, err = validate(strings.Replace(httpTemplate, "timeoutSeconds: 10", "timeoutSeconds: 0", 1))
+	assert.EqualError(t, err, "templates.main.steps[0].call templates.call.http.timeoutSeconds must be a positive integer > 0")
+	_, err = validate(strings.Replace(httpTemplate, "response.statusCode == 201", "response.statusCode ==", 1))
+	if assert.Error(t, err) {
+		assert.Contains(t, err.Error(), "templates.call.http.successCondition is not a valid expression")
+	}
+	_, err = validate(strings.Replace(httpTemplate, "outputs.parameters.statusCode", "outputs.parameters.foo", 1))
+	if assert.Error(t, err) {
+		assert.Contains(t, err.Error(), "failed to resolve {{steps.call.outputs.parameters.foo}}")
+	}
+	_, err = validate(strings.Replace(httpTemplate, "    http:\n", "    container:\n      image: argoproj/argosay:v2\n    http:\n", 1))
+	if assert.Error(t, err) {
+		assert.Contains(t, err.Error(), "templates.call multiple template types specified")
+	}
+}
+
+var containerSetTemplate = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  generateName: container-set-
+spec:
+  entrypoint: main
+  volumes:
+  - name: workspace
+    emptyDir: {}
+  templates:
+  - name: main
+    steps:
+    - - name: build
+        template: build
+    - - name: print
+        template: print
+        arguments:
+          parameters:
+          - name: result
+            value: "{{steps.build.outputs.result}}"
+  - name: build
+    containerSet:
+      volumeMounts:
+      - name: workspace
+        mountPath: /workspace
+      containers:
+      - name: build
+        image: golang:1.15
+        command: [go, build]
+      - name: test
+        image: golang:1.15
+        command: [go, test]
+        dependencies: [build]
+      - name: main
+        image: alpine:3.7
+        command: [ls, /workspace]
+        dependencies: [test]
+  - name: print
+    inputs:
+      parameters:
+      - name: result
+    container:
+      image: alpine:3.7
+      command: [echo, "{{inputs.parameters.result}}"]
+`
+
+func TestContainerSetTemplate(t *testing.T) {
+	_, err := validate(containerSetTemplate)
+	assert.NoError(t, err)
+	_, err = validate(strings.Replace(containerSetTemplate, "      - name: main\n        image: alpine:3.7", "      - name: package\n        image: alpine:3.7", 1))
+	assert.EqualError(t, err, "templates.main.steps[0].build templates.build.containerSet.containers must have a container named 'main'")
+	_, err = validate(strings.Replace(containerSetTemplate, "      - name: test\n", "      - name: build\n", 1))
+	assert.EqualError(t, err, "templates.main.steps[0].build templates.build.containerSet.containers[1].name 'build' is not unique")
+	_, err = validate(strings.Replace(containerSetTemplate, "      - name: test\n", "      - name: wait\n", 1))
+	assert.EqualError(t, err, "templates.main.steps[0].build templates.build.containerSet.containers[1].name 'wait' is reserved")
+	_, err = validate(strings.Replace(containerSetTemplate, "        command: [go, test]\n", "", 1))
+	assert.EqualError(t, err, "templates.main.steps[0].build templates.build.containerSet.containers.test.command may not be empty")
+	_, err = validate(strings.Replace(containerSetTemplate, "dependencies: [build]", "dependencies: [lint]", 1))
+	assert.EqualError(t, err, "templates.main.steps[0].build templates.build.containerSet.containers.test.dependencies 'lint' is not a container of the set")
+	_, err = validate(strings.Replace(containerSetTemplate, "        command: [go, build]\n", "        command: [go, build]\n        dependencies: [main]\n", 1))
+	assert.EqualError(t, err, "templates.main.steps[0].build templates.build.containerSet.containers have a dependency cycle: build -> main -> test -> build")
+	_, err = validate(strings.Replace(containerSetTemplate, "        command: [go, build]\n", "        command: [go, build]\n        outputs:\n          parameters:\n          - name: version\n            valueFrom:\n              path: /workspace/version\n", 1))
+	assert.NoError(t, err)
+	_, err = validate(strings.Replace(containerSetTemplate, "        command: [go, build]\n", "        command: [go, build]\n        outputs:\n          parameters:\n          - name: version\n", 1))
+	assert.EqualError(t, err, "templates.main.steps[0].build templates.build.containerSet.containers.build.outputs.parameters.version must specify valueFrom.path")
+	_, err = validate(strings.Replace(containerSetTemplate, "        command: [go, build]\n", "        command: [go, build]\n        outputs:\n          artifacts:\n          - name: app\n", 1))
+	assert.EqualError(t, err, "templates.main.steps[0].build templates.build.containerSet.containers.build.outputs.artifacts.app must specify path")
+}
+
+var dataTemplate = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  generateName: data-
+spec:
+  entrypoint: main
+  templates:
+  - name: main
+    steps:
+    - - name: list
+        template: list
+    - - name: process
+        template: process
+        arguments:
+          parameters:
+          - name: key
+            value: "{{item}}"
+        withParam: "{{steps.list.outputs.result}}"
+  - name: list
+    data:
+      source:
+        artifactPaths:
+          s3:
+            key: logs
+      transformation:
+      - expression: 'filter(data, {# endsWith ".log"})'
+      - expression: 'batch(data, 10)'
+  - name: process
+    inputs:
+      parameters:
+      - name: key
+    container:
+      image: alpine:3.7
+      command: [echo, "{{inputs.parameters.key}}"]
+`
+
+func TestDataTemplate(t *testing.T) {
+	_, err := validate(dataTemplate)
+	assert.NoError(t, err)
+	_, err = validate(strings.Replace(dataTemplate, "        artifactPaths:\n          s3:\n            key: logs\n", "", 1))
+	assert.EqualError(t, err, "templates.main.steps[0].list templates.list.data.source must specify artifactPaths")
+	_, err = validate(strings.Replace(dataTemplate, "            key: logs\n", "            bucket: my-bucket\n", 1))
+	assert.EqualError(t, err, "templates.main.steps[0].list templates.list.data.source.artifactPaths must specify a location or a key")
+	_, err = validate(strings.Replace(dataTemplate, "'batch(data, 10)'", "'batch(data,'", 1))
+	if assert.Error(t, err) {
+		assert.Contains(t, err.Error(), "templates.list.data.transformation[1].expression is not a valid expression")
+	}
+	_, err = validate(strings.Replace(dataTemplate, "  - name: process\n", "    outputs:\n      artifacts:\n      - name: keys\n        path: /tmp/keys\n  - name: process\n", 1))
+	if assert.Error(t, err) {
+		assert.Contains(t, err.Error(), "templates.list.outputs.artifacts are not supported for data templates")
+	}
+}
+
+var lifecycleHooks = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  generateName: lifecycle-hooks-
+spec:
+  entrypoint: main
+  hooks:
+    running:
+      expression: workflow.status == "Running"
+      template: notify
+  templates:
+  - name: main
+    steps:
+    - - name: train
+        template: notify
+        hooks:
+          failed:
+            expression: steps.train.status == "Failed"
+            template: notify
+  - name: dag
+    dag:
+      tasks:
+      - name: train
+        template: notify
+        hooks:
+          running:
+            expression: tasks.train.status == "Running"
+            template: notify
+  - name: notify
+    container:
+      image: alpine:3.7
+`
+
+func TestLifecycleHooks(t *testing.T) {
+	_, err := validate(lifecycleHooks)
+	assert.NoError(t, err)
+	_, err = validate(strings.Replace(lifecycleHooks, `      expression: workflow.status == "Running"`, `      expression: workflow.status ==`, 1))
+	if assert.Error(t, err) {
+		assert.Contains(t, err.Error(), "hooks.running.expression is not a valid expression")
+	}
+	_, err = validate(strings.Replace(lifecycleHooks, `            expression: steps.train.status == "Failed"`+"\n", "", 1))
+	assert.EqualError(t, err, "templates.main.steps[0].train.hooks.failed.expression is required")
+	_, err = validate(strings.Replace(lifecycleHooks, "          running:\n            expression: tasks.train.status == \"Running\"\n            template: notify", "          running:\n            expression: tasks.train.status == \"Running\"\n            template: missing", 1))
+	if assert.Error(t, err) {
+		assert.Contains(t, err.Error(), "templates.dag.tasks.train.hooks.running template name 'missing' undefined")
+	}
+}
+
+var childWorkflow = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  generateName: child-workflow-
+spec:
+  entrypoint: main
+  templates:
+  - name: main
+    steps:
+    - - name: child
+        template: child
+    - - name: print
+        template: print
+        arguments:
+          parameters:
+          - name: message
+            value: "{{steps.child.outputs.parameters.message}}"
+  - name: child
+    workflow:
+      workflowTemplateRef:
+        name: template-ref-target
+      arguments:
+        parameters:
+        - name: message
+          value: hello
+    outputs:
+      parameters:
+      - name: message
+  - name: print
+    inputs:
+      parameters:
+      - name: message
+    container:
+      image: alpine:latest
+      args: ["{{inputs.parameters.message}}"]
+`
+
+func TestChildWorkflow(t *testing.T) {
+	err := createWorkflowTemplate(templateRefTarget)
+	assert.NoError(t, err)
+
+	_, err = validate(childWorkflow)
+	assert.NoError(t, err)
+	_, err = validate(strings.Replace(childWorkflow, "name: template-ref-target", "name: missing", 1))
+	if assert.Error(t, err) {
+		assert.Contains(t, err.Error(), "templates.child.workflow.workflowTemplateRef missing not found")
+	}
+	_, err = validate(strings.Replace(childWorkflow, "name: template-ref-target", "name: ''", 1))
+	if assert.Error(t, err) {
+		assert.Contains(t, err.Error(), "templates.child.workflow.workflowTemplateRef.name is required")
+	}
+	_, err = validate(strings.Replace(childWorkflow, "      - name: message\n  - name: print", "      - name: message\n        valueFrom:\n          path: /tmp/message\n  - name: print", 1))
+	if assert.Error(t, err) {
+		assert.Contains(t, err.Error(), "templates.child.outputs.parameters.message.valueFrom is not supported for workflow templates")
+	}
+}